@@ -18,6 +18,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/yorunikakeru4/oapi-codegen-dd/v3/pkg/codegen"
 	"go.yaml.in/yaml/v4"
@@ -32,11 +33,21 @@ const (
 var (
 	flagConfigFile string
 	flagPrintUsage bool
+	flagLint       bool
+	flagStats      bool
+	flagDiff       string
+
+	flagPackagePerSpecLocation bool
 )
 
 func main() {
 	flag.StringVar(&flagConfigFile, "config", "", "A YAML config file that controls oapi-codegen behavior.")
 	flag.BoolVar(&flagPrintUsage, "help", false, "Show this help and exit.")
+	flag.BoolVar(&flagLint, "lint", false, "Print warnings for inline schemas that should be extracted to named components.")
+	flag.BoolVar(&flagStats, "stats", false, "Print the field count, nesting depth and unions of every generated type.")
+	flag.StringVar(&flagDiff, "diff", "", "An older OpenAPI spec to compare the spec with. Prints the API changes instead of generating code.")
+	flag.BoolVar(&flagPackagePerSpecLocation, "package-per-spec-location", false,
+		"Move the types of each spec location into a sub-package, see output.package-per-spec-location.")
 
 	flag.Parse()
 
@@ -76,12 +87,31 @@ func main() {
 
 	cfg = cfg.WithDefaults()
 
+	// Resolve $ref to other files relative to a local spec
+	isURL := strings.HasPrefix(specPath, "http://") || strings.HasPrefix(specPath, "https://")
+	if cfg.BaseDir == "" && !isURL {
+		cfg.BaseDir = filepath.Dir(specPath)
+	}
+
+	if flagPackagePerSpecLocation && cfg.Output != nil {
+		cfg.Output.PackagePerSpecLocation = true
+	}
+
 	// If no config file was provided and input is a URL, output to stdout
 	// For local files without config, keep default behavior (write to gen.go)
-	if !hasConfigFile && (strings.HasPrefix(specPath, "http://") || strings.HasPrefix(specPath, "https://")) {
+	if !hasConfigFile && isURL {
 		cfg.Output = nil
 	}
 
+	if flagLint || flagStats {
+		analyze(specContents, cfg)
+	}
+
+	if flagDiff != "" {
+		diff(specContents, cfg)
+		return
+	}
+
 	code, err := codegen.Generate(specContents, cfg)
 	if err != nil {
 		errExit("Error generating code: %v", err)
@@ -113,13 +143,62 @@ func main() {
 		return
 	}
 
+	directive := ""
+	if cfg.Output.EmitGoGenerate {
+		outDir := destDir
+		if destFile != "" {
+			outDir = filepath.Dir(destFile)
+		}
+		directive, err = codegen.GoGenerateDirective(outDir, flagConfigFile, specPath)
+		if err != nil {
+			errExit("Error creating go:generate directive: %v", err)
+		}
+	}
+
 	if destFile != "" {
-		if err = os.WriteFile(destFile, []byte(code.GetCombined()), generatedFilePerm); err != nil {
+		combined := code.GetCombined()
+		if directive != "" {
+			combined = codegen.AddGoGenerateDirective(combined, directive)
+		}
+		if err = os.WriteFile(destFile, []byte(combined), generatedFilePerm); err != nil {
+			errExit("Error writing file: %v", err)
+		}
+	} else if directive != "" {
+		filePath := filepath.Join(destDir, codegen.GoGenerateFileName+".go")
+		if err = os.WriteFile(filePath, []byte(codegen.GoGenerateFile(cfg, directive)), generatedFilePerm); err != nil {
 			errExit("Error writing file: %v", err)
 		}
 	}
 
 	for name, contents := range code {
+		// Fuzz seed corpus files go to the testdata directory of the package
+		if codegen.IsFuzzSeedFile(name) {
+			pkgDir := destDir
+			if destFile != "" {
+				pkgDir = filepath.Dir(destFile)
+			}
+			filePath := filepath.Join(pkgDir, filepath.FromSlash(codegen.FuzzSeedFileName(name)))
+			if err := os.MkdirAll(filepath.Dir(filePath), 0750); err != nil {
+				errExit("Error creating directory: %v", err)
+			}
+			if err = os.WriteFile(filePath, []byte(contents), generatedFilePerm); err != nil {
+				errExit("Error writing file: %v", err)
+			}
+			continue
+		}
+
+		// Sub-package files go to their directory in the package directory
+		if codegen.IsSubPackageFile(name) {
+			filePath := filepath.Join(destDir, filepath.FromSlash(codegen.SubPackageFileName(name))+".go")
+			if err := os.MkdirAll(filepath.Dir(filePath), generatedDirPerm); err != nil {
+				errExit("Error creating directory: %v", err)
+			}
+			if err = os.WriteFile(filePath, []byte(contents), generatedFilePerm); err != nil {
+				errExit("Error writing file: %v", err)
+			}
+			continue
+		}
+
 		isScaffold := codegen.IsScaffoldFile(name)
 		actualName := name
 		if isScaffold {
@@ -165,6 +244,61 @@ func main() {
 	}
 }
 
+// analyze prints the lint warnings and the type stats to stderr, as requested by the flags.
+func analyze(specContents []byte, cfg codegen.Configuration) {
+	parseCtx, errs := codegen.CreateParseContext(specContents, cfg)
+	if len(errs) > 0 {
+		errExit("Error analyzing spec: %v", errs[0])
+	}
+
+	if flagLint {
+		for _, w := range codegen.Lint(parseCtx) {
+			_, _ = fmt.Fprintf(os.Stderr, "warning: %s\n", w)
+		}
+	}
+
+	if flagStats {
+		tw := tabwriter.NewWriter(os.Stderr, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(tw, "TYPE\tLOCATION\tFIELDS\tTOTAL FIELDS\tDEPTH\tUNIONS")
+		for _, s := range codegen.AnalyzeTypes(parseCtx) {
+			_, _ = fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%d\t%t\n", s.Name, s.SpecLocation, s.Fields, s.TotalFields, s.Depth, s.HasUnions)
+		}
+		_ = tw.Flush()
+	}
+}
+
+// diff prints the API changes between the spec given with -diff and the current one.
+// It exits with status 1 if any of the changes is breaking.
+func diff(specContents []byte, cfg codegen.Configuration) {
+	oldContents, err := readSpec(flagDiff)
+	if err != nil {
+		errExit("Error reading spec to compare with: %v", err)
+	}
+
+	oldCfg := cfg
+	if !strings.HasPrefix(flagDiff, "http://") && !strings.HasPrefix(flagDiff, "https://") {
+		oldCfg.BaseDir = filepath.Dir(flagDiff)
+	}
+
+	oldCtx, errs := codegen.CreateParseContext(oldContents, oldCfg)
+	if len(errs) > 0 {
+		errExit("Error analyzing spec to compare with: %v", errs[0])
+	}
+	newCtx, errs := codegen.CreateParseContext(specContents, cfg)
+	if len(errs) > 0 {
+		errExit("Error analyzing spec: %v", errs[0])
+	}
+
+	breaking := false
+	for _, c := range codegen.DiffContexts(oldCtx, newCtx) {
+		fmt.Println(c)
+		breaking = breaking || c.Breaking
+	}
+	if breaking {
+		os.Exit(1)
+	}
+}
+
 func errExit(msg string, args ...any) {
 	msg = msg + "\n"
 	_, _ = fmt.Fprintf(os.Stderr, msg, args...)
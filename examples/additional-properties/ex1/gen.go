@@ -334,7 +334,7 @@ func (p Pick1_AdditionalProperties) Validate() error {
 	if p.Pick1_AdditionalProperties_OneOf != nil {
 		if v, ok := any(p.Pick1_AdditionalProperties_OneOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("Pick1_AdditionalProperties_OneOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -406,6 +406,26 @@ func (p *Pick1_AdditionalProperties_OneOf) Validate() error {
 	return nil
 }
 
+// AsString returns the string inside the Pick1_AdditionalProperties_OneOf and whether it holds one
+func (p *Pick1_AdditionalProperties_OneOf) AsString() (string, bool) {
+	return p.A, p.IsA()
+}
+
+// AsFloat32 returns the float32 inside the Pick1_AdditionalProperties_OneOf and whether it holds one
+func (p *Pick1_AdditionalProperties_OneOf) AsFloat32() (float32, bool) {
+	return p.B, p.IsB()
+}
+
+// Match calls the function matching the variant held by the Pick1_AdditionalProperties_OneOf, none for an empty union
+func (p *Pick1_AdditionalProperties_OneOf) Match(onA func(string), onB func(float32)) {
+	switch {
+	case p.IsA():
+		onA(p.A)
+	case p.IsB():
+		onB(p.B)
+	}
+}
+
 var typesValidator *validator.Validate
 
 func init() {
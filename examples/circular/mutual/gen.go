@@ -207,7 +207,7 @@ func (f File_Author) Validate() error {
 	if f.File_Author_AnyOf != nil {
 		if v, ok := any(f.File_Author_AnyOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("File_Author_AnyOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -336,7 +336,7 @@ func (f FileLink_File) Validate() error {
 	if f.FileLink_File_AnyOf != nil {
 		if v, ok := any(f.FileLink_File_AnyOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("FileLink_File_AnyOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -412,7 +412,7 @@ func (u User_Avatar) Validate() error {
 	if u.User_Avatar_AnyOf != nil {
 		if v, ok := any(u.User_Avatar_AnyOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("User_Avatar_AnyOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -485,7 +485,7 @@ func (g GetFiles_Response_Item) Validate() error {
 	if g.GetFiles_Response_OneOf != nil {
 		if v, ok := any(g.GetFiles_Response_OneOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("GetFiles_Response_OneOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -547,6 +547,26 @@ func (f *File_Author_AnyOf) Validate() error {
 	return nil
 }
 
+// AsUser returns the User inside the File_Author_AnyOf and whether it holds one
+func (f *File_Author_AnyOf) AsUser() (User, bool) {
+	return f.A, f.IsA()
+}
+
+// AsString returns the string inside the File_Author_AnyOf and whether it holds one
+func (f *File_Author_AnyOf) AsString() (string, bool) {
+	return f.B, f.IsB()
+}
+
+// Match calls the function matching the variant held by the File_Author_AnyOf, none for an empty union
+func (f *File_Author_AnyOf) Match(onA func(User), onB func(string)) {
+	switch {
+	case f.IsA():
+		onA(f.A)
+	case f.IsB():
+		onB(f.B)
+	}
+}
+
 type FileLink_File_AnyOf struct {
 	runtime.Either[string, File]
 }
@@ -565,6 +585,26 @@ func (f *FileLink_File_AnyOf) Validate() error {
 	return nil
 }
 
+// AsString returns the string inside the FileLink_File_AnyOf and whether it holds one
+func (f *FileLink_File_AnyOf) AsString() (string, bool) {
+	return f.A, f.IsA()
+}
+
+// AsFile returns the File inside the FileLink_File_AnyOf and whether it holds one
+func (f *FileLink_File_AnyOf) AsFile() (File, bool) {
+	return f.B, f.IsB()
+}
+
+// Match calls the function matching the variant held by the FileLink_File_AnyOf, none for an empty union
+func (f *FileLink_File_AnyOf) Match(onA func(string), onB func(File)) {
+	switch {
+	case f.IsA():
+		onA(f.A)
+	case f.IsB():
+		onB(f.B)
+	}
+}
+
 type User_Avatar_AnyOf struct {
 	runtime.Either[File, string]
 }
@@ -583,6 +623,26 @@ func (u *User_Avatar_AnyOf) Validate() error {
 	return nil
 }
 
+// AsFile returns the File inside the User_Avatar_AnyOf and whether it holds one
+func (u *User_Avatar_AnyOf) AsFile() (File, bool) {
+	return u.A, u.IsA()
+}
+
+// AsString returns the string inside the User_Avatar_AnyOf and whether it holds one
+func (u *User_Avatar_AnyOf) AsString() (string, bool) {
+	return u.B, u.IsB()
+}
+
+// Match calls the function matching the variant held by the User_Avatar_AnyOf, none for an empty union
+func (u *User_Avatar_AnyOf) Match(onA func(File), onB func(string)) {
+	switch {
+	case u.IsA():
+		onA(u.A)
+	case u.IsB():
+		onB(u.B)
+	}
+}
+
 type GetFiles_Response_OneOf struct {
 	runtime.Either[string, File]
 }
@@ -601,6 +661,26 @@ func (g *GetFiles_Response_OneOf) Validate() error {
 	return nil
 }
 
+// AsString returns the string inside the GetFiles_Response_OneOf and whether it holds one
+func (g *GetFiles_Response_OneOf) AsString() (string, bool) {
+	return g.A, g.IsA()
+}
+
+// AsFile returns the File inside the GetFiles_Response_OneOf and whether it holds one
+func (g *GetFiles_Response_OneOf) AsFile() (File, bool) {
+	return g.B, g.IsB()
+}
+
+// Match calls the function matching the variant held by the GetFiles_Response_OneOf, none for an empty union
+func (g *GetFiles_Response_OneOf) Match(onA func(string), onB func(File)) {
+	switch {
+	case g.IsA():
+		onA(g.A)
+	case g.IsB():
+		onB(g.B)
+	}
+}
+
 var typesValidator *validator.Validate
 
 func init() {
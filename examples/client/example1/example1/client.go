@@ -33,7 +33,7 @@ func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client,
 type ClientInterface interface {
 	GetClient(ctx context.Context, options *GetClientRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetClientResponse, error)
 
-	UpdateClient(ctx context.Context, options *UpdateClientRequestOptions, reqEditors ...runtime.RequestEditorFn) (*struct{}, error)
+	UpdateClient(ctx context.Context, options *UpdateClientRequestOptions, reqEditors ...runtime.RequestEditorFn) (*runtime.NoContent, error)
 }
 
 func (c *Client) GetClient(ctx context.Context, options *GetClientRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetClientResponse, error) {
@@ -79,7 +79,7 @@ func (c *Client) GetClient(ctx context.Context, options *GetClientRequestOptions
 	return responseParser(ctx, resp)
 }
 
-func (c *Client) UpdateClient(ctx context.Context, options *UpdateClientRequestOptions, reqEditors ...runtime.RequestEditorFn) (*struct{}, error) {
+func (c *Client) UpdateClient(ctx context.Context, options *UpdateClientRequestOptions, reqEditors ...runtime.RequestEditorFn) (*runtime.NoContent, error) {
 	var err error
 	reqParams := runtime.RequestOptionsParameters{
 		RequestURL:  c.apiClient.GetBaseURL() + "/client",
@@ -93,7 +93,7 @@ func (c *Client) UpdateClient(ctx context.Context, options *UpdateClientRequestO
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
 
-	responseParser := func(ctx context.Context, resp *runtime.Response) (*struct{}, error) {
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*runtime.NoContent, error) {
 		bodyBytes := resp.Content
 		if resp.StatusCode != 204 {
 			target := new(UpdateClientErrorResponseJSON)
@@ -108,7 +108,7 @@ func (c *Client) UpdateClient(ctx context.Context, options *UpdateClientRequestO
 			return nil, runtime.NewClientAPIError(fmt.Errorf("API error (status %d): %v", resp.StatusCode, *target),
 				runtime.WithStatusCode(resp.StatusCode))
 		}
-		return nil, nil
+		return &runtime.NoContent{StatusCode: resp.StatusCode, Headers: resp.Headers}, nil
 	}
 
 	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/client")
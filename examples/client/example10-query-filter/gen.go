@@ -0,0 +1,264 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package example10
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+// Requests are sent with an http.Client with a 5s timeout, unless opts set another one.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithHTTPClient(runtime.HTTPClientDoer{
+		Client: &http.Client{Timeout: 5000 * time.Millisecond},
+	})}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// NewClientWithHTTPClient creates a new instance of the Client client sending the requests with httpClient,
+// e.g. an http.Client with a custom transport.
+func NewClientWithHTTPClient(baseURL string, httpClient *http.Client, opts ...runtime.APIClientOption) (*Client, error) {
+	return NewDefaultClient(baseURL, append(opts, runtime.WithHTTPClient(runtime.HTTPClientDoer{Client: httpClient}))...)
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	ListInvoices(ctx context.Context, options *ListInvoicesRequestOptions, reqEditors ...runtime.RequestEditorFn) (*ListInvoicesResponse, error)
+}
+
+func (c *Client) ListInvoices(ctx context.Context, options *ListInvoicesRequestOptions, reqEditors ...runtime.RequestEditorFn) (*ListInvoicesResponse, error) {
+	var err error
+
+	queryEncoding := map[string]runtime.QueryEncoding{
+		"filter": {Style: "deepObject", Explode: &[]bool{true}[0]},
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:    c.apiClient.GetBaseURL() + "/invoices",
+		Method:        "GET",
+		Options:       options,
+		QueryEncoding: queryEncoding,
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*ListInvoicesResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(ListInvoicesResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			err = fmt.Errorf("error decoding response: %w", err)
+			return nil, err
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/invoices")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// ListInvoicesRequestOptions is the options needed to make a request to ListInvoices.
+type ListInvoicesRequestOptions struct {
+	Query *ListInvoicesQuery
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *ListInvoicesRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Query != nil {
+		if v, ok := any(o.Query).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Query", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *ListInvoicesRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *ListInvoicesRequestOptions) GetQuery() (map[string]any, error) {
+	return runtime.AsMap[any](o.Query)
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *ListInvoicesRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *ListInvoicesRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type InvoiceFilterStatus string
+
+const (
+	Draft InvoiceFilterStatus = "draft"
+	Open  InvoiceFilterStatus = "open"
+	Paid  InvoiceFilterStatus = "paid"
+)
+
+// Validate checks if the InvoiceFilterStatus value is valid
+func (i InvoiceFilterStatus) Validate() error {
+	switch i {
+	case Draft, Open, Paid:
+		return nil
+	default:
+		return runtime.NewValidationErrorsFromString("Enum", fmt.Sprintf("must be a valid InvoiceFilterStatus value, got: %v", i))
+	}
+}
+
+// ListInvoicesFilterBuilder builds the filter query parameter of ListInvoices.
+// Conditions on different fields are combined with AND, the values of an array field with OR.
+type ListInvoicesFilterBuilder struct {
+	value InvoiceFilter
+}
+
+// NewListInvoicesFilterBuilder returns an empty ListInvoicesFilterBuilder.
+func NewListInvoicesFilterBuilder() *ListInvoicesFilterBuilder {
+	return &ListInvoicesFilterBuilder{}
+}
+
+// Status adds values to status, matching any of them.
+func (b *ListInvoicesFilterBuilder) Status(values ...InvoiceFilterStatus) *ListInvoicesFilterBuilder {
+	b.value.Status = append(b.value.Status, values...)
+	return b
+}
+
+// CustomerID sets customer_id.
+func (b *ListInvoicesFilterBuilder) CustomerID(value string) *ListInvoicesFilterBuilder {
+	b.value.CustomerID = &value
+	return b
+}
+
+// AmountGte sets amount_gte.
+func (b *ListInvoicesFilterBuilder) AmountGte(value int) *ListInvoicesFilterBuilder {
+	b.value.AmountGte = &value
+	return b
+}
+
+// CreatedAfter sets created_after.
+func (b *ListInvoicesFilterBuilder) CreatedAfter(value time.Time) *ListInvoicesFilterBuilder {
+	b.value.CreatedAfter = &value
+	return b
+}
+
+// Build returns the filter value to set in ListInvoicesQuery.Filter.
+func (b *ListInvoicesFilterBuilder) Build() *InvoiceFilter {
+	value := b.value
+	return &value
+}
+
+// Encode returns the filter query parameter encoded with the deepObject style,
+// e.g. filter[field]=value.
+func (b *ListInvoicesFilterBuilder) Encode() (string, error) {
+	params, err := runtime.AsMap[any](map[string]any{"filter": b.value})
+	if err != nil {
+		return "", err
+	}
+	return runtime.EncodeQueryFields(params, map[string]runtime.QueryEncoding{
+		"filter": {Style: "deepObject"},
+	})
+}
+
+type ListInvoicesQuery struct {
+	Filter *InvoiceFilter `json:"filter,omitempty"`
+	Limit  *int           `json:"limit,omitempty"`
+}
+
+func (l ListInvoicesQuery) Validate() error {
+	var errors runtime.ValidationErrors
+	if l.Filter != nil {
+		if v, ok := any(l.Filter).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Filter", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type ListInvoicesResponse []Invoice
+
+type Invoice struct {
+	ID     string `json:"id" validate:"required"`
+	Status string `json:"status" validate:"required"`
+}
+
+func (i Invoice) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(i))
+}
+
+type InvoiceFilter struct {
+	Status       []InvoiceFilterStatus `json:"status,omitempty"`
+	CustomerID   *string               `json:"customer_id,omitempty"`
+	AmountGte    *int                  `json:"amount_gte,omitempty"`
+	CreatedAfter *time.Time            `json:"created_after,omitempty"`
+}
+
+func (i InvoiceFilter) Validate() error {
+	var errors runtime.ValidationErrors
+	for i, item := range i.Status {
+		if v, ok := any(item).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append(fmt.Sprintf("Status[%d]", i), err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
@@ -0,0 +1,63 @@
+package example10_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	example10 "github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example10-query-filter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newFilter() *example10.ListInvoicesFilterBuilder {
+	return example10.NewListInvoicesFilterBuilder().
+		Status(example10.Open, example10.Paid).
+		CustomerID("cus_123").
+		AmountGte(100).
+		CreatedAfter(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+}
+
+func TestFilterBuilder_Encode(t *testing.T) {
+	query, err := newFilter().Encode()
+	require.NoError(t, err)
+
+	values, err := url.ParseQuery(query)
+	require.NoError(t, err)
+	assert.Equal(t, url.Values{
+		"filter[status][]":      {"open", "paid"},
+		"filter[customer_id]":   {"cus_123"},
+		"filter[amount_gte]":    {"100"},
+		"filter[created_after]": {"2026-01-02T03:04:05Z"},
+	}, values)
+}
+
+func TestFilterBuilder_Client(t *testing.T) {
+	var rawQuery string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rawQuery = r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id": "in_1", "status": "open"}]`))
+	}))
+	defer server.Close()
+
+	client, err := example10.NewDefaultClient(server.URL)
+	require.NoError(t, err)
+
+	limit := 10
+	filter := newFilter()
+	_, err = client.ListInvoices(context.Background(), &example10.ListInvoicesRequestOptions{
+		Query: &example10.ListInvoicesQuery{
+			Filter: filter.Build(),
+			Limit:  &limit,
+		},
+	})
+	require.NoError(t, err)
+
+	encoded, err := filter.Encode()
+	require.NoError(t, err)
+	assert.Equal(t, encoded+"&limit=10", rawQuery)
+}
@@ -0,0 +1,83 @@
+package example11_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	example11 "github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example11-problem-details"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /pets/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if r.PathValue("id") != "rex" {
+			problem := runtime.NewProblemDetails(http.StatusNotFound, "no pet with id "+r.PathValue("id"))
+			problem.Type = "https://example.com/probs/pet-not-found"
+			problem.Extensions = map[string]any{"id": r.PathValue("id")}
+			_ = runtime.WriteProblemDetails(w, problem)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name": "Rex"}`))
+	})
+	mux.HandleFunc("POST /pets", func(w http.ResponseWriter, r *http.Request) {
+		_ = runtime.WriteProblemDetails(w, &runtime.ProblemDetails{
+			Title:  "Invalid pet",
+			Status: http.StatusUnprocessableEntity,
+		})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestProblemDetails(t *testing.T) {
+	server := newServer(t)
+	client, err := example11.NewDefaultClient(server.URL)
+	require.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		pet, err := client.GetPet(context.Background(), &example11.GetPetRequestOptions{
+			PathParams: &example11.GetPetPath{ID: "rex"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Rex", pet.Name)
+	})
+
+	t.Run("problem+json error", func(t *testing.T) {
+		_, err := client.GetPet(context.Background(), &example11.GetPetRequestOptions{
+			PathParams: &example11.GetPetPath{ID: "tom"},
+		})
+		require.Error(t, err)
+
+		var problem *runtime.ProblemDetails
+		require.True(t, errors.As(err, &problem))
+		assert.Equal(t, "https://example.com/probs/pet-not-found", problem.Type)
+		assert.Equal(t, http.StatusNotFound, problem.Status)
+		assert.Equal(t, "no pet with id tom", problem.Detail)
+		assert.Equal(t, map[string]any{"id": "tom"}, problem.Extensions)
+		assert.EqualError(t, err, "Not Found: no pet with id tom")
+
+		var apiErr *runtime.ClientAPIError
+		require.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, http.StatusNotFound, apiErr.StatusCode())
+	})
+
+	t.Run("default problem+json error", func(t *testing.T) {
+		_, err := client.CreatePet(context.Background(), &example11.CreatePetRequestOptions{
+			Body: &example11.CreatePetBody{Name: "Rex"},
+		})
+
+		var problem *runtime.ProblemDetails
+		require.True(t, errors.As(err, &problem))
+		assert.Equal(t, "Invalid pet", problem.Title)
+		assert.Equal(t, http.StatusUnprocessableEntity, problem.Status)
+	})
+}
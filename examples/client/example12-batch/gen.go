@@ -0,0 +1,477 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package example12
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+// Requests are sent with an http.Client with a 5s timeout, unless opts set another one.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithHTTPClient(runtime.HTTPClientDoer{
+		Client: &http.Client{Timeout: 5000 * time.Millisecond},
+	})}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// NewClientWithHTTPClient creates a new instance of the Client client sending the requests with httpClient,
+// e.g. an http.Client with a custom transport.
+func NewClientWithHTTPClient(baseURL string, httpClient *http.Client, opts ...runtime.APIClientOption) (*Client, error) {
+	return NewDefaultClient(baseURL, append(opts, runtime.WithHTTPClient(runtime.HTTPClientDoer{Client: httpClient}))...)
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	GetUser(ctx context.Context, options *GetUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetUserResponse, error)
+
+	CreateUser(ctx context.Context, options *CreateUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreateUserResponse, error)
+
+	SendBatch(ctx context.Context, options *SendBatchRequestOptions, reqEditors ...runtime.RequestEditorFn) (*SendBatchResponse, error)
+}
+
+func (c *Client) GetUser(ctx context.Context, options *GetUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetUserResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/users/{id}",
+		Method:     "GET",
+		Options:    options,
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*GetUserResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			target := new(GetUserErrorResponse)
+			err = json.Unmarshal(bodyBytes, target)
+			if err != nil {
+				return nil, fmt.Errorf("error decoding response: %w", err)
+			}
+
+			if errTarget, ok := any(*target).(error); ok {
+				return nil, runtime.NewClientAPIError(errTarget, runtime.WithStatusCode(resp.StatusCode))
+			}
+			return nil, runtime.NewClientAPIError(fmt.Errorf("API error (status %d): %v", resp.StatusCode, *target),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(GetUserResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			err = fmt.Errorf("error decoding response: %w", err)
+			return nil, err
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/users/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// GetUserBatchCall returns the call of GetUser, to send with Batch.
+func (c *Client) GetUserBatchCall(options *GetUserRequestOptions, reqEditors ...runtime.RequestEditorFn) *runtime.BatchCall[GetUserResponse] {
+	return runtime.NewBatchCall(func(ctx context.Context, apiClient runtime.APIClient) (*GetUserResponse, error) {
+		return NewClient(apiClient).GetUser(ctx, options, reqEditors...)
+	})
+}
+
+func (c *Client) CreateUser(ctx context.Context, options *CreateUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreateUserResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/users",
+		Method:      "POST",
+		Options:     options,
+		ContentType: "application/json",
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*CreateUserResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 201 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(CreateUserResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			err = fmt.Errorf("error decoding response: %w", err)
+			return nil, err
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/users")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// CreateUserBatchCall returns the call of CreateUser, to send with Batch.
+func (c *Client) CreateUserBatchCall(options *CreateUserRequestOptions, reqEditors ...runtime.RequestEditorFn) *runtime.BatchCall[CreateUserResponse] {
+	return runtime.NewBatchCall(func(ctx context.Context, apiClient runtime.APIClient) (*CreateUserResponse, error) {
+		return NewClient(apiClient).CreateUser(ctx, options, reqEditors...)
+	})
+}
+
+func (c *Client) SendBatch(ctx context.Context, options *SendBatchRequestOptions, reqEditors ...runtime.RequestEditorFn) (*SendBatchResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/batch",
+		Method:      "POST",
+		Options:     options,
+		ContentType: "application/json",
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*SendBatchResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(SendBatchResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			err = fmt.Errorf("error decoding response: %w", err)
+			return nil, err
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/batch")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// clientBatchEnvelope names the properties of the sub-requests and sub-responses of SendBatch.
+var clientBatchEnvelope = runtime.BatchEnvelope{
+	ID:      "id",
+	Method:  "method",
+	Path:    "url",
+	Headers: "headers",
+	Body:    "body",
+	Status:  "status",
+}
+
+// Batch sends calls, created by the <OperationID>BatchCall methods, as the sub-requests of a single SendBatch request,
+// then sets the results of the calls from the sub-responses.
+// If the SendBatch request fails, its error is returned and the calls return runtime.ErrBatchNotSent.
+// The Body of options is replaced by the sub-requests, options can be nil.
+func (c *Client) Batch(ctx context.Context, options *SendBatchRequestOptions, calls ...runtime.BatchCaller) error {
+	return runtime.ExecuteBatch(ctx, c.apiClient, clientBatchEnvelope, calls, func(ctx context.Context, subRequests []map[string]any) ([]json.RawMessage, error) {
+		opts := SendBatchRequestOptions{}
+		if options != nil {
+			opts = *options
+		}
+		opts.Body = new(SendBatchBody)
+		if err := runtime.ConvertBatchItems(subRequests, "requests", opts.Body); err != nil {
+			return nil, fmt.Errorf("error creating batch request body: %w", err)
+		}
+
+		resp, err := c.SendBatch(ctx, &opts)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.BatchItems(resp, "responses")
+	})
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// GetUserRequestOptions is the options needed to make a request to GetUser.
+type GetUserRequestOptions struct {
+	PathParams *GetUserPath
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *GetUserRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *GetUserRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *GetUserRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *GetUserRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *GetUserRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// CreateUserRequestOptions is the options needed to make a request to CreateUser.
+type CreateUserRequestOptions struct {
+	Body *CreateUserBody
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *CreateUserRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *CreateUserRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *CreateUserRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *CreateUserRequestOptions) GetBody() any {
+	return o.Body
+}
+
+// GetHeader returns the headers as a map.
+func (o *CreateUserRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// SendBatchRequestOptions is the options needed to make a request to SendBatch.
+type SendBatchRequestOptions struct {
+	Body *SendBatchBody
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *SendBatchRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *SendBatchRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *SendBatchRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *SendBatchRequestOptions) GetBody() any {
+	return o.Body
+}
+
+// GetHeader returns the headers as a map.
+func (o *SendBatchRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type GetUserPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (g GetUserPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(g))
+}
+
+type CreateUserBody = NewUser
+
+type SendBatchBody struct {
+	Requests []SubRequest `json:"requests" validate:"required"`
+}
+
+func (s SendBatchBody) Validate() error {
+	var errors runtime.ValidationErrors
+	for i, item := range s.Requests {
+		if v, ok := any(item).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append(fmt.Sprintf("Requests[%d]", i), err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type GetUserResponse = User
+
+type GetUserErrorResponse = Error
+
+type CreateUserResponse = User
+
+type SendBatchResponse struct {
+	Responses []SubResponse `json:"responses,omitempty"`
+}
+
+type User struct {
+	ID   string `json:"id" validate:"required"`
+	Name string `json:"name" validate:"required"`
+}
+
+func (u User) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(u))
+}
+
+type NewUser struct {
+	Name string `json:"name" validate:"required"`
+}
+
+func (n NewUser) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(n))
+}
+
+type Error struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (s Error) Error() string {
+	return "unmapped client error"
+}
+
+type SubRequest struct {
+	ID      string            `json:"id" validate:"required"`
+	Method  string            `json:"method" validate:"required"`
+	URL     string            `json:"url" validate:"required"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    *any              `json:"body,omitempty"`
+}
+
+func (s SubRequest) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(s.ID, "required"); err != nil {
+		errors = errors.Append("ID", err)
+	}
+	if err := typesValidator.Var(s.Method, "required"); err != nil {
+		errors = errors.Append("Method", err)
+	}
+	if err := typesValidator.Var(s.URL, "required"); err != nil {
+		errors = errors.Append("URL", err)
+	}
+	if s.Body != nil {
+		if v, ok := any(s.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type SubResponse struct {
+	ID      string            `json:"id" validate:"required"`
+	Status  int               `json:"status" validate:"required"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    *any              `json:"body,omitempty"`
+}
+
+func (s SubResponse) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(s.ID, "required"); err != nil {
+		errors = errors.Append("ID", err)
+	}
+	if err := typesValidator.Var(s.Status, "required"); err != nil {
+		errors = errors.Append("Status", err)
+	}
+	if s.Body != nil {
+		if v, ok := any(s.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
@@ -0,0 +1,145 @@
+package example12_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	example12 "github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example12-batch"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newBatchServer serves the users API under /v1, and its batch endpoint running the sub-requests against it.
+// The batch requests received are counted.
+func newBatchServer(t *testing.T) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	users := http.NewServeMux()
+	users.HandleFunc("GET /v1/users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.PathValue("id") != "1" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, `{"message": "no such user"}`)
+			return
+		}
+		_, _ = io.WriteString(w, `{"id": "1", "name": "Alice"}`)
+	})
+	users.HandleFunc("POST /v1/users", func(w http.ResponseWriter, r *http.Request) {
+		var user example12.NewUser
+		if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(example12.User{ID: "2", Name: user.Name})
+	})
+
+	var batches atomic.Int32
+	mux := http.NewServeMux()
+	mux.Handle("/v1/users", users)
+	mux.Handle("/v1/users/", users)
+	mux.HandleFunc("POST /v1/batch", func(w http.ResponseWriter, r *http.Request) {
+		batches.Add(1)
+		var body example12.SendBatchBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		// Answer in reverse order, the sub-responses are matched by id.
+		var resp example12.SendBatchResponse
+		for i := len(body.Requests) - 1; i >= 0; i-- {
+			sub := body.Requests[i]
+			var subBody io.Reader
+			if sub.Body != nil {
+				data, _ := json.Marshal(sub.Body)
+				subBody = strings.NewReader(string(data))
+			}
+			req := httptest.NewRequest(sub.Method, "/v1"+sub.URL, subBody)
+			for name, value := range sub.Headers {
+				req.Header.Set(name, value)
+			}
+			rec := httptest.NewRecorder()
+			users.ServeHTTP(rec, req)
+
+			var subRespBody any
+			_ = json.Unmarshal(rec.Body.Bytes(), &subRespBody)
+			resp.Responses = append(resp.Responses, example12.SubResponse{
+				ID:      sub.ID,
+				Status:  rec.Code,
+				Headers: map[string]string{"Content-Type": rec.Header().Get("Content-Type")},
+				Body:    &subRespBody,
+			})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server, &batches
+}
+
+func TestBatch(t *testing.T) {
+	server, batches := newBatchServer(t)
+	client, err := example12.NewDefaultClient(server.URL + "/v1")
+	require.NoError(t, err)
+
+	getUser := client.GetUserBatchCall(&example12.GetUserRequestOptions{
+		PathParams: &example12.GetUserPath{ID: "1"},
+	})
+	createUser := client.CreateUserBatchCall(&example12.CreateUserRequestOptions{
+		Body: &example12.NewUser{Name: "Bob"},
+	})
+
+	require.NoError(t, client.Batch(context.Background(), nil, getUser, createUser))
+	assert.Equal(t, int32(1), batches.Load(), "both calls are sent in a single request")
+
+	user, err := getUser.Result()
+	require.NoError(t, err)
+	assert.Equal(t, &example12.GetUserResponse{ID: "1", Name: "Alice"}, user)
+
+	created, err := createUser.Result()
+	require.NoError(t, err)
+	assert.Equal(t, &example12.CreateUserResponse{ID: "2", Name: "Bob"}, created)
+}
+
+func TestBatch_ErrorSubResponse(t *testing.T) {
+	server, _ := newBatchServer(t)
+	client, err := example12.NewDefaultClient(server.URL + "/v1")
+	require.NoError(t, err)
+
+	found := client.GetUserBatchCall(&example12.GetUserRequestOptions{PathParams: &example12.GetUserPath{ID: "1"}})
+	missing := client.GetUserBatchCall(&example12.GetUserRequestOptions{PathParams: &example12.GetUserPath{ID: "404"}})
+	require.NoError(t, client.Batch(context.Background(), nil, found, missing))
+
+	_, err = found.Result()
+	require.NoError(t, err)
+
+	_, err = missing.Result()
+	var apiErr *runtime.ClientAPIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode())
+}
+
+func TestBatch_NotSent(t *testing.T) {
+	client, err := example12.NewDefaultClient("http://127.0.0.1:0/v1")
+	require.NoError(t, err)
+
+	getUser := client.GetUserBatchCall(&example12.GetUserRequestOptions{PathParams: &example12.GetUserPath{ID: "1"}})
+	_, err = getUser.Result()
+	assert.ErrorIs(t, err, runtime.ErrBatchNotSent)
+
+	require.Error(t, client.Batch(context.Background(), nil, getUser))
+	_, err = getUser.Result()
+	assert.ErrorIs(t, err, runtime.ErrBatchNotSent)
+}
@@ -0,0 +1,67 @@
+package example13_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	example13 "github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example13-conditional"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newPetServer serves the pet 1, with a "v1" ETag honoring If-None-Match.
+func newPetServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /pets/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if r.PathValue("id") != "1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"name": "Rex"}`)
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestGetPetConditional(t *testing.T) {
+	srv := newPetServer(t)
+	client, err := example13.NewDefaultClient(srv.URL)
+	require.NoError(t, err)
+	ctx := context.Background()
+	options := &example13.GetPetRequestOptions{PathParams: &example13.GetPetPath{ID: "1"}}
+
+	// The first request has no ETag to send, so the pet is returned with its ETag.
+	res, err := client.GetPetConditional(ctx, options, "")
+	require.NoError(t, err)
+	assert.Equal(t, `"v1"`, res.ETag)
+	assert.False(t, res.NotModified)
+	require.NotNil(t, res.Body)
+	assert.Equal(t, "Rex", res.Body.Name)
+
+	// The pet didn't change since, so the server answers 304 Not Modified without a body.
+	res, err = client.GetPetConditional(ctx, options, res.ETag)
+	require.NoError(t, err)
+	assert.True(t, res.NotModified)
+	assert.Equal(t, `"v1"`, res.ETag)
+	assert.Nil(t, res.Body)
+
+	// Errors are returned as usual.
+	_, err = client.GetPetConditional(ctx, &example13.GetPetRequestOptions{PathParams: &example13.GetPetPath{ID: "2"}}, `"v1"`)
+	var apiErr *runtime.ClientAPIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode())
+}
@@ -0,0 +1,108 @@
+package example14_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	example14 "github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example14-decorator"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cachingClient caches the pets returned by GetPet, the other calls going straight to the client.
+type cachingClient struct {
+	*example14.ClientDecorator
+	pets map[string]*example14.GetPetResponse
+}
+
+func (c *cachingClient) GetPet(ctx context.Context, options *example14.GetPetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*example14.GetPetResponse, error) {
+	if pet, ok := c.pets[options.PathParams.ID]; ok {
+		return pet, nil
+	}
+	pet, err := c.ClientDecorator.GetPet(ctx, options, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	c.pets[options.PathParams.ID] = pet
+	return pet, nil
+}
+
+// newPetServer serves the pet 1, counting the requests.
+func newPetServer(t *testing.T, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /pets/{id}", func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"name": "Rex"}`)
+	})
+	mux.HandleFunc("GET /pets", func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `[{"name": "Rex"}, {"name": "Fido"}]`)
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestClientDecorator(t *testing.T) {
+	var requests atomic.Int32
+	srv := newPetServer(t, &requests)
+	client, err := example14.NewDefaultClient(srv.URL)
+	require.NoError(t, err)
+
+	var calls []string
+	decorator := example14.NewClientDecorator(client)
+	decorator.Before = func(ctx context.Context, method string) (context.Context, error) {
+		calls = append(calls, "before "+method)
+		return ctx, nil
+	}
+	decorator.After = func(ctx context.Context, method string, err error) {
+		calls = append(calls, "after "+method)
+	}
+
+	var api example14.ClientInterface = &cachingClient{ClientDecorator: decorator, pets: map[string]*example14.GetPetResponse{}}
+	ctx := context.Background()
+	options := &example14.GetPetRequestOptions{PathParams: &example14.GetPetPath{ID: "1"}}
+
+	// The overridden GetPet only calls the client once, the second pet coming from the cache.
+	for range 2 {
+		pet, err := api.GetPet(ctx, options)
+		require.NoError(t, err)
+		assert.Equal(t, "Rex", pet.Name)
+	}
+	assert.Equal(t, int32(1), requests.Load())
+
+	// ListPets is delegated to the client.
+	pets, err := api.ListPets(ctx)
+	require.NoError(t, err)
+	assert.Len(t, *pets, 2)
+	assert.Equal(t, int32(2), requests.Load())
+
+	assert.Equal(t, []string{"before GetPet", "after GetPet", "before ListPets", "after ListPets"}, calls)
+}
+
+func TestClientDecorator_BeforeAborts(t *testing.T) {
+	var requests atomic.Int32
+	srv := newPetServer(t, &requests)
+	client, err := example14.NewDefaultClient(srv.URL)
+	require.NoError(t, err)
+
+	errNoToken := errors.New("no token")
+	decorator := example14.NewClientDecorator(client)
+	decorator.Before = func(ctx context.Context, method string) (context.Context, error) {
+		return ctx, errNoToken
+	}
+
+	_, err = decorator.ListPets(context.Background())
+	require.ErrorIs(t, err, errNoToken)
+	assert.Zero(t, requests.Load())
+}
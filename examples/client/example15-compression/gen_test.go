@@ -0,0 +1,131 @@
+package example15_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	example15 "github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example15-compression"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// compress encodes body with the Content-Encoding.
+func compress(t *testing.T, encoding string, body []byte) []byte {
+	t.Helper()
+
+	var (
+		buf bytes.Buffer
+		w   io.WriteCloser
+	)
+	switch encoding {
+	case "gzip":
+		w = gzip.NewWriter(&buf)
+	case "br":
+		w = brotli.NewWriter(&buf)
+	case "zstd":
+		zw, err := zstd.NewWriter(&buf)
+		require.NoError(t, err)
+		w = zw
+	default:
+		return body
+	}
+	_, err := w.Write(body)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+// newPetServer serves the pet {id} encoded with the Content-Encoding {id}, recording the Accept-Encoding of the requests.
+func newPetServer(t *testing.T, acceptEncoding *string) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /pets/{id}", func(w http.ResponseWriter, r *http.Request) {
+		*acceptEncoding = r.Header.Get("Accept-Encoding")
+		encoding := r.PathValue("id")
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Encoding", encoding)
+		_, _ = w.Write(compress(t, encoding, []byte(`{"name": "Rex the `+encoding+` dog"}`)))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestResponseDecode(t *testing.T) {
+	var acceptEncoding string
+	srv := newPetServer(t, &acceptEncoding)
+	client, err := example15.NewDefaultClient(srv.URL)
+	require.NoError(t, err)
+
+	for _, encoding := range []string{"gzip", "br", "zstd"} {
+		t.Run(encoding, func(t *testing.T) {
+			pet, err := client.GetPet(context.Background(), &example15.GetPetRequestOptions{
+				PathParams: &example15.GetPetPath{ID: encoding},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, "Rex the "+encoding+" dog", pet.Name)
+			assert.Equal(t, "br, gzip, zstd", acceptEncoding)
+		})
+	}
+
+	t.Run("other encoding", func(t *testing.T) {
+		_, err := client.GetPet(context.Background(), &example15.GetPetRequestOptions{
+			PathParams: &example15.GetPetPath{ID: "deflate"},
+		})
+		require.NoError(t, err, "the body isn't compressed by the server")
+	})
+}
+
+func TestRequestCompression(t *testing.T) {
+	var contentEncoding, body string
+	mux := http.NewServeMux()
+	record := func(w http.ResponseWriter, r *http.Request) {
+		contentEncoding = r.Header.Get("Content-Encoding")
+		reader := io.Reader(r.Body)
+		if contentEncoding == "zstd" {
+			decoder, err := zstd.NewReader(r.Body)
+			require.NoError(t, err)
+			defer decoder.Close()
+			reader = decoder
+		}
+		data, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		body = string(data)
+		w.WriteHeader(http.StatusNoContent)
+	}
+	mux.HandleFunc("PUT /pets/{id}", record)
+	mux.HandleFunc("POST /pets/{id}/tags", record)
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	client, err := example15.NewDefaultClient(srv.URL)
+	require.NoError(t, err)
+
+	t.Run("compressed", func(t *testing.T) {
+		_, err := client.UpdatePet(context.Background(), &example15.UpdatePetRequestOptions{
+			PathParams: &example15.UpdatePetPath{ID: "1"},
+			Body:       &example15.UpdatePetBody{Name: "Rex"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "zstd", contentEncoding)
+		assert.JSONEq(t, `{"name": "Rex"}`, body)
+	})
+
+	t.Run("opted out with x-compress-request", func(t *testing.T) {
+		_, err := client.TagPet(context.Background(), &example15.TagPetRequestOptions{
+			PathParams: &example15.TagPetPath{ID: "1"},
+			Body:       &example15.TagPetBody{"good"},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, contentEncoding)
+		assert.JSONEq(t, `["good"]`, body)
+	})
+}
@@ -0,0 +1,25 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package headers
+
+import (
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+type MSN = string
+
+type GetClientHeaders struct {
+	MerchantSerialNumber MSN `json:"Merchant-Serial-Number" validate:"required,max=7,min=4"`
+}
+
+func (g GetClientHeaders) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(g))
+}
+
+type UpdateClientHeaders struct {
+	MerchantSerialNumber MSN `json:"Merchant-Serial-Number" validate:"required,max=7,min=4"`
+}
+
+func (u UpdateClientHeaders) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(u))
+}
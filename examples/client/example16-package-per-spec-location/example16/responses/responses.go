@@ -0,0 +1,30 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package responses
+
+import (
+	"github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example16-package-per-spec-location/example16/types"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+type GetClientResponse = types.ClientType
+
+type GetClientErrorResponse struct {
+	Code    *string `json:"code,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+func (r GetClientErrorResponse) Error() string {
+	res0 := r.Message
+	if res0 == nil {
+		return "unknown error"
+	}
+	res1 := *res0
+	return res1
+}
+
+func NewGetClientErrorResponse(message string) GetClientErrorResponse {
+	return GetClientErrorResponse{Message: runtime.Ptr(message)}
+}
+
+type UpdateClientErrorResponseJSON = types.UpdateClientErrorResponse
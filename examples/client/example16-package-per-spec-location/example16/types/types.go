@@ -0,0 +1,46 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package types
+
+import (
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+type ClientType struct {
+	Name string          `json:"name" validate:"required"`
+	Type *ClientTypeType `json:"type,omitempty"`
+}
+
+func (c ClientType) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(c.Name, "required"); err != nil {
+		errors = errors.Append("Name", err)
+	}
+	if c.Type != nil {
+		if v, ok := any(c.Type).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Type", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type Error struct {
+	Code    *string `json:"code,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+type UpdateClientErrorResponse struct {
+	Code    *ErrorCode `json:"code,omitempty"`
+	Message *string    `json:"message,omitempty"`
+}
+
+func (s UpdateClientErrorResponse) Error() string {
+	return "unmapped client error"
+}
+
+type ErrorCode = string
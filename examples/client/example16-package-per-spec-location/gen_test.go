@@ -0,0 +1,54 @@
+package example16_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example16-package-per-spec-location/example16"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example16-package-per-spec-location/example16/headers"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example16-package-per-spec-location/example16/payloads"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example16-package-per-spec-location/example16/responses"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example16-package-per-spec-location/example16/types"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCrossPackageReferences(t *testing.T) {
+	// Types of the sub-packages are usable from each other and from the client in the root package
+	var body payloads.UpdateClientBody = types.ClientType{Name: "acme"}
+	var res *responses.GetClientResponse = &body
+	assert.Equal(t, "acme", res.Name)
+
+	_, ok := any(responses.GetClientErrorResponse{}).(error)
+	assert.True(t, ok)
+}
+
+func TestValidateInSubPackages(t *testing.T) {
+	assert.Error(t, types.ClientType{}.Validate())
+	assert.NoError(t, types.ClientType{Name: "acme"}.Validate())
+
+	assert.Error(t, headers.GetClientHeaders{MerchantSerialNumber: "1"}.Validate())
+	assert.NoError(t, headers.GetClientHeaders{MerchantSerialNumber: "12345"}.Validate())
+}
+
+func TestClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "12345", r.Header.Get("Merchant-Serial-Number"))
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(types.ClientType{Name: "acme"})
+	}))
+	defer srv.Close()
+
+	client, err := example16.NewDefaultClient(srv.URL, runtime.WithHTTPClient(runtime.HTTPClientDoer{Client: srv.Client()}))
+	require.NoError(t, err)
+
+	res, err := client.GetClient(context.Background(), &example16.GetClientRequestOptions{
+		Header: &headers.GetClientHeaders{MerchantSerialNumber: "12345"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "acme", res.Name)
+}
@@ -0,0 +1,93 @@
+package example17_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	example17 "github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example17-circuit-breaker"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	var (
+		failing  atomic.Bool
+		requests atomic.Int32
+	)
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /pets/{id}", func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if failing.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name": "Rex"}`))
+	})
+	mux.HandleFunc("GET /pets", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"name": "Rex"}]`))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	var (
+		mu      sync.Mutex
+		changes []runtime.CircuitState
+	)
+	example17.ClientCircuitBreakerPolicy.OnStateChange = func(operationPath string, from, to runtime.CircuitState) {
+		mu.Lock()
+		defer mu.Unlock()
+		changes = append(changes, to)
+	}
+	defer func() { example17.ClientCircuitBreakerPolicy.OnStateChange = nil }()
+
+	client, err := example17.NewDefaultClient(srv.URL)
+	require.NoError(t, err)
+
+	getPet := func() (*example17.GetPetResponse, error) {
+		return client.GetPet(context.Background(), &example17.GetPetRequestOptions{
+			PathParams: &example17.GetPetPath{ID: "1"},
+		})
+	}
+
+	// repeated failures trip the breaker
+	failing.Store(true)
+	for range 3 {
+		_, err := getPet()
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, runtime.ErrCircuitOpen)
+	}
+	state, ok := client.CircuitBreaker("/pets/{id}")
+	require.True(t, ok)
+	assert.Equal(t, runtime.CircuitOpen, state.State)
+
+	// the open breaker fails fast, without sending the request
+	_, err = getPet()
+	require.ErrorIs(t, err, runtime.ErrCircuitOpen)
+	assert.Equal(t, int32(3), requests.Load())
+
+	// the breakers are per operation
+	pets, err := client.ListPets(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, *pets, 1)
+
+	// a trial call after the open window closes the breaker
+	failing.Store(false)
+	time.Sleep(150 * time.Millisecond)
+	pet, err := getPet()
+	require.NoError(t, err)
+	assert.Equal(t, "Rex", pet.Name)
+
+	state, _ = client.CircuitBreaker("/pets/{id}")
+	assert.Equal(t, runtime.CircuitClosed, state.State)
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []runtime.CircuitState{runtime.CircuitOpen, runtime.CircuitHalfOpen, runtime.CircuitClosed}, changes)
+}
@@ -0,0 +1,67 @@
+package example18_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	example18 "github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example18-interceptors"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterceptors(t *testing.T) {
+	var tenants []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /pets/{id}", func(w http.ResponseWriter, r *http.Request) {
+		tenants = append(tenants, r.Header.Get("X-Tenant"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name": "Rex"}`))
+	})
+	mux.HandleFunc("GET /pets", func(w http.ResponseWriter, r *http.Request) {
+		tenants = append(tenants, r.Header.Get("X-Tenant"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"name": "Rex"}]`))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	var calls []string
+	metrics := runtime.InterceptorFunc(func(ctx context.Context, req *http.Request, next runtime.RoundTripFunc) (*runtime.Response, error) {
+		info, _ := runtime.CallInfoFromContext(ctx)
+		resp, err := next(ctx, req)
+		if err == nil {
+			calls = append(calls, info.OperationID+" "+info.OperationPath+" "+http.StatusText(resp.StatusCode))
+		}
+		return resp, err
+	})
+	// the tenant is sent with the request, and the names of the pets returned by GetPet are redacted
+	tenant := runtime.InterceptorFunc(func(ctx context.Context, req *http.Request, next runtime.RoundTripFunc) (*runtime.Response, error) {
+		req.Header.Set("X-Tenant", "acme")
+		resp, err := next(ctx, req)
+		if info, _ := runtime.CallInfoFromContext(ctx); err == nil && info.OperationID == "GetPet" {
+			resp.Content = bytes.Replace(resp.Content, []byte(`"Rex"`), []byte(`"R***"`), 1)
+		}
+		return resp, err
+	})
+
+	client, err := example18.NewDefaultClient(srv.URL, runtime.WithInterceptors(metrics, tenant))
+	require.NoError(t, err)
+
+	pet, err := client.GetPet(context.Background(), &example18.GetPetRequestOptions{
+		PathParams: &example18.GetPetPath{ID: "1"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "R***", pet.Name)
+
+	pets, err := client.ListPets(context.Background())
+	require.NoError(t, err)
+	require.Len(t, *pets, 1)
+	assert.Equal(t, "Rex", (*pets)[0].Name)
+
+	assert.Equal(t, []string{"acme", "acme"}, tenants)
+	assert.Equal(t, []string{"GetPet /pets/{id} OK", "ListPets /pets OK"}, calls)
+}
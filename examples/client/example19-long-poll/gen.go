@@ -0,0 +1,237 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package example19
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+// Requests are sent with an http.Client with a 35s timeout, unless opts set another one.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithHTTPClient(runtime.HTTPClientDoer{
+		Client: &http.Client{Timeout: 35000 * time.Millisecond},
+	})}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// NewClientWithHTTPClient creates a new instance of the Client client sending the requests with httpClient,
+// e.g. an http.Client with a custom transport.
+func NewClientWithHTTPClient(baseURL string, httpClient *http.Client, opts ...runtime.APIClientOption) (*Client, error) {
+	return NewDefaultClient(baseURL, append(opts, runtime.WithHTTPClient(runtime.HTTPClientDoer{Client: httpClient}))...)
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	WaitEvents(ctx context.Context, options *WaitEventsRequestOptions, reqEditors ...runtime.RequestEditorFn) (*WaitEventsResult, error)
+
+	LatestEvent(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*LatestEventResponse, error)
+
+	AckEvents(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*runtime.NoContent, error)
+}
+
+func (c *Client) WaitEvents(ctx context.Context, options *WaitEventsRequestOptions, reqEditors ...runtime.RequestEditorFn) (*WaitEventsResult, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/events",
+		Method:     "GET",
+		Options:    options,
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*WaitEventsResult, error) {
+		bodyBytes := resp.Content
+		switch resp.StatusCode {
+		case 200:
+			target := new(WaitEventsResponse)
+			if err = json.Unmarshal(bodyBytes, target); err != nil {
+				return nil, fmt.Errorf("error decoding response: %w", err)
+			}
+			return &WaitEventsResult{StatusCode: resp.StatusCode, Body200: target}, nil
+		case 204:
+			return &WaitEventsResult{StatusCode: resp.StatusCode}, nil
+		}
+
+		return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+			runtime.WithStatusCode(resp.StatusCode))
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/events")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// WaitEventsResult is the result of WaitEvents.
+// StatusCode tells which of the success responses was received, and the matching body is set.
+type WaitEventsResult struct {
+	StatusCode int `json:"statusCode"`
+
+	// Body200 is set when StatusCode is 200.
+	Body200 *WaitEventsResponse `json:"body200,omitempty"`
+}
+
+// WaitEventsLongPoll sends WaitEvents again until its response has data or ctx is done, a 304 Not Modified
+// or 204 No Content response meaning nothing changed before the server timeout, see runtime.LongPoll.
+func (c *Client) WaitEventsLongPoll(ctx context.Context, options *WaitEventsRequestOptions, reqEditors ...runtime.RequestEditorFn) (*WaitEventsResult, error) {
+	return runtime.LongPoll(ctx, c.apiClient, func(ctx context.Context, apiClient runtime.APIClient) (*WaitEventsResult, error) {
+		return NewClient(apiClient).WaitEvents(ctx, options, reqEditors...)
+	})
+}
+
+func (c *Client) LatestEvent(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*LatestEventResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/events/latest",
+		Method:     "GET",
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*LatestEventResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(LatestEventResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			err = fmt.Errorf("error decoding response: %w", err)
+			return nil, err
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/events/latest")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+func (c *Client) AckEvents(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*runtime.NoContent, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/events/ack",
+		Method:     "POST",
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*runtime.NoContent, error) {
+		if resp.StatusCode != 204 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		return &runtime.NoContent{StatusCode: resp.StatusCode, Headers: resp.Headers}, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/events/ack")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// WaitEventsRequestOptions is the options needed to make a request to WaitEvents.
+type WaitEventsRequestOptions struct {
+	Query *WaitEventsQuery
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *WaitEventsRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Query != nil {
+		if v, ok := any(o.Query).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Query", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *WaitEventsRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *WaitEventsRequestOptions) GetQuery() (map[string]any, error) {
+	return runtime.AsMap[any](o.Query)
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *WaitEventsRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *WaitEventsRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type WaitEventsQuery struct {
+	Since *string `json:"since,omitempty"`
+	Wait  *int    `json:"wait,omitempty"`
+}
+
+type WaitEventsResponse []Event
+
+type LatestEventResponse = Event
+
+type Event struct {
+	ID   string `json:"id" validate:"required"`
+	Type string `json:"type" validate:"required"`
+}
+
+func (e Event) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(e))
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
@@ -0,0 +1,78 @@
+package example19_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	example19 "github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example19-long-poll"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newEventServer answers the long polls with 204 No Content until the third one, which gets an event.
+func newEventServer(t *testing.T) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var polls atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /events", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "30", r.URL.Query().Get("wait"))
+		if polls.Add(1) < 3 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `[{"id": "1", "type": "created"}]`)
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv, &polls
+}
+
+func TestWaitEventsLongPoll(t *testing.T) {
+	srv, polls := newEventServer(t)
+	client, err := example19.NewDefaultClient(srv.URL)
+	require.NoError(t, err)
+	options := &example19.WaitEventsRequestOptions{Query: &example19.WaitEventsQuery{Wait: runtime.Ptr(30)}}
+
+	res, err := client.WaitEventsLongPoll(context.Background(), options)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	require.NotNil(t, res.Body200)
+	assert.Equal(t, example19.WaitEventsResponse{{ID: "1", Type: "created"}}, *res.Body200)
+	assert.Equal(t, int32(3), polls.Load())
+}
+
+func TestWaitEventsLongPoll_ContextDone(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "1")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+	client, err := example19.NewDefaultClient(srv.URL)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = client.WaitEventsLongPoll(ctx, &example19.WaitEventsRequestOptions{})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestWaitEvents(t *testing.T) {
+	srv, _ := newEventServer(t)
+	client, err := example19.NewDefaultClient(srv.URL)
+	require.NoError(t, err)
+	options := &example19.WaitEventsRequestOptions{Query: &example19.WaitEventsQuery{Wait: runtime.Ptr(30)}}
+
+	// the operation itself returns the empty response
+	res, err := client.WaitEvents(context.Background(), options)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+	assert.Nil(t, res.Body200)
+}
@@ -0,0 +1,85 @@
+package example20_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	example20 "github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example20-response-validation"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /pets/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.PathValue("id") {
+		case "rex":
+			_, _ = w.Write([]byte(`{"name": "Rex", "age": 3}`))
+		case "malformed":
+			_, _ = w.Write([]byte(`{"name": "", "age": -1}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "no such pet"}`))
+		}
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func getPet(t *testing.T, client *example20.Client, id string) (*example20.GetPetResponse, error) {
+	t.Helper()
+	return client.GetPet(context.Background(), &example20.GetPetRequestOptions{
+		PathParams: &example20.GetPetPath{ID: id},
+	})
+}
+
+func TestResponseValidation(t *testing.T) {
+	server := newServer(t)
+	client, err := example20.NewDefaultClient(server.URL)
+	require.NoError(t, err)
+
+	t.Run("valid response", func(t *testing.T) {
+		pet, err := getPet(t, client, "rex")
+		require.NoError(t, err)
+		assert.Equal(t, "Rex", pet.Name)
+	})
+
+	t.Run("response violating the spec", func(t *testing.T) {
+		pet, err := getPet(t, client, "malformed")
+		require.Error(t, err)
+
+		var validationErr *runtime.ResponseValidationError
+		require.True(t, errors.As(err, &validationErr))
+		assert.Equal(t, http.StatusOK, validationErr.StatusCode())
+
+		var fieldErrs runtime.ValidationErrors
+		require.True(t, errors.As(err, &fieldErrs))
+		assert.Len(t, fieldErrs, 2)
+
+		// not an API error, and the decoded response is still returned
+		var apiErr *runtime.ClientAPIError
+		assert.False(t, errors.As(err, &apiErr))
+		require.NotNil(t, pet)
+		assert.Equal(t, -1, pet.Age)
+	})
+
+	t.Run("error status", func(t *testing.T) {
+		pet, err := getPet(t, client, "tom")
+		require.Error(t, err)
+		assert.Nil(t, pet)
+
+		var apiErr *runtime.ClientAPIError
+		require.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, http.StatusNotFound, apiErr.StatusCode())
+
+		var validationErr *runtime.ResponseValidationError
+		assert.False(t, errors.As(err, &validationErr))
+	})
+}
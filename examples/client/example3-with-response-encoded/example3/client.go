@@ -65,6 +65,12 @@ func (c *Client) GetUserSingle(ctx context.Context, reqEditors ...runtime.Reques
 			err = fmt.Errorf("error decoding response: %w", err)
 			return nil, err
 		}
+		// The decoded response is returned with the validation error, see runtime.ResponseValidationError.
+		if v, ok := any(target).(runtime.Validator); ok {
+			if err = v.Validate(); err != nil {
+				return target, runtime.NewResponseValidationError(err, resp.StatusCode)
+			}
+		}
 		return target, nil
 	}
 
@@ -100,6 +106,12 @@ func (c *Client) GetUserUnion1(ctx context.Context, reqEditors ...runtime.Reques
 			err = fmt.Errorf("error decoding response: %w", err)
 			return nil, err
 		}
+		// The decoded response is returned with the validation error, see runtime.ResponseValidationError.
+		if v, ok := any(target).(runtime.Validator); ok {
+			if err = v.Validate(); err != nil {
+				return target, runtime.NewResponseValidationError(err, resp.StatusCode)
+			}
+		}
 		return target, nil
 	}
 
@@ -135,6 +147,12 @@ func (c *Client) GetUserUnion2(ctx context.Context, reqEditors ...runtime.Reques
 			err = fmt.Errorf("error decoding response: %w", err)
 			return nil, err
 		}
+		// The decoded response is returned with the validation error, see runtime.ResponseValidationError.
+		if v, ok := any(target).(runtime.Validator); ok {
+			if err = v.Validate(); err != nil {
+				return target, runtime.NewResponseValidationError(err, resp.StatusCode)
+			}
+		}
 		return target, nil
 	}
 
@@ -170,6 +188,12 @@ func (c *Client) GetUserUnion3(ctx context.Context, reqEditors ...runtime.Reques
 			err = fmt.Errorf("error decoding response: %w", err)
 			return nil, err
 		}
+		// The decoded response is returned with the validation error, see runtime.ResponseValidationError.
+		if v, ok := any(target).(runtime.Validator); ok {
+			if err = v.Validate(); err != nil {
+				return target, runtime.NewResponseValidationError(err, resp.StatusCode)
+			}
+		}
 		return target, nil
 	}
 
@@ -26,6 +26,26 @@ func (g *GetUserUnion2_Response_OneOf) Validate() error {
 	return nil
 }
 
+// AsUser returns the User inside the GetUserUnion2_Response_OneOf and whether it holds one
+func (g *GetUserUnion2_Response_OneOf) AsUser() (User, bool) {
+	return g.A, g.IsA()
+}
+
+// AsString returns the string inside the GetUserUnion2_Response_OneOf and whether it holds one
+func (g *GetUserUnion2_Response_OneOf) AsString() (string, bool) {
+	return g.B, g.IsB()
+}
+
+// Match calls the function matching the variant held by the GetUserUnion2_Response_OneOf, none for an empty union
+func (g *GetUserUnion2_Response_OneOf) Match(onA func(User), onB func(string)) {
+	switch {
+	case g.IsA():
+		onA(g.A)
+	case g.IsB():
+		onB(g.B)
+	}
+}
+
 type GetUserUnion3_Response_OneOf struct {
 	union json.RawMessage
 }
@@ -0,0 +1,2 @@
+// Package example6 compares the generated clients using encoding/json and github.com/goccy/go-json.
+package example6
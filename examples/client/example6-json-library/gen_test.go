@@ -0,0 +1,110 @@
+package example6_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yorunikakeru4/oapi-codegen-dd/v3/examples/client/example6-json-library/gojson"
+	"github.com/yorunikakeru4/oapi-codegen-dd/v3/examples/client/example6-json-library/stdlib"
+	"github.com/yorunikakeru4/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// staticDoer returns the same JSON body for every request, so that benchmarks measure the decoding only.
+type staticDoer struct {
+	body []byte
+}
+
+func (d staticDoer) Do(_ context.Context, _ *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(d.body)),
+	}, nil
+}
+
+// productsJSON returns a list of n products, alternating both availability variants.
+func productsJSON(t testing.TB, n int) []byte {
+	t.Helper()
+
+	products := make([]map[string]any, n)
+	for i := range products {
+		availability := map[string]any{"quantity": i}
+		if i%2 == 1 {
+			availability = map[string]any{"availableAt": "2026-01-02T15:04:05Z"}
+		}
+		products[i] = map[string]any{
+			"id":           fmt.Sprintf("product-%d", i),
+			"name":         fmt.Sprintf("Product %d", i),
+			"description":  "A product with a reasonably long description to make the payload realistic.",
+			"price":        float64(i) + 0.99,
+			"tags":         []string{"new", "sale", "popular"},
+			"dimensions":   map[string]any{"width": 10.5, "height": 20.25, "depth": 5},
+			"availability": availability,
+		}
+	}
+
+	data, err := json.Marshal(products)
+	require.NoError(t, err)
+	return data
+}
+
+func TestSameDecoding(t *testing.T) {
+	body := productsJSON(t, 10)
+
+	stdClient, err := stdlib.NewDefaultClient("https://example.com", runtime.WithHTTPClient(staticDoer{body: body}))
+	require.NoError(t, err)
+	goJSONClient, err := gojson.NewDefaultClient("https://example.com", runtime.WithHTTPClient(staticDoer{body: body}))
+	require.NoError(t, err)
+
+	stdRes, err := stdClient.ListProducts(context.Background())
+	require.NoError(t, err)
+	goJSONRes, err := goJSONClient.ListProducts(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, *goJSONRes, 10)
+	assert.Equal(t, 2, (*goJSONRes)[2].Availability.Product_Availability_OneOf.A.Quantity)
+	assert.True(t, (*goJSONRes)[1].Availability.Product_Availability_OneOf.IsB())
+
+	// The union types are decoded by their custom UnmarshalJSON with both libraries.
+	stdJSON, err := json.Marshal(stdRes)
+	require.NoError(t, err)
+	goJSONJSON, err := json.Marshal(goJSONRes)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(stdJSON), string(goJSONJSON))
+}
+
+func BenchmarkListProducts(b *testing.B) {
+	body := productsJSON(b, 5000)
+	doer := runtime.WithHTTPClient(staticDoer{body: body})
+
+	b.Run("stdlib", func(b *testing.B) {
+		client, err := stdlib.NewDefaultClient("https://example.com", doer)
+		require.NoError(b, err)
+
+		b.SetBytes(int64(len(body)))
+		for b.Loop() {
+			if _, err := client.ListProducts(context.Background()); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("gojson", func(b *testing.B) {
+		client, err := gojson.NewDefaultClient("https://example.com", doer)
+		require.NoError(b, err)
+
+		b.SetBytes(int64(len(body)))
+		for b.Loop() {
+			if _, err := client.ListProducts(context.Background()); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,336 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package gojson
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	gojson "github.com/goccy/go-json"
+	"github.com/yorunikakeru4/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithJSONCodec(clientJSON)}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// clientJSON is the runtime.JSONCodec of the Client client, using gojson.
+var clientJSON runtime.JSONCodec = clientJSONCodec{}
+
+type clientJSONCodec struct{}
+
+func (clientJSONCodec) Marshal(v any) ([]byte, error) {
+	return gojson.Marshal(v)
+}
+
+func (clientJSONCodec) Unmarshal(data []byte, v any) error {
+	return gojson.Unmarshal(data, v)
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	ListProducts(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*ListProductsResponse, error)
+
+	CreateProduct(ctx context.Context, options *CreateProductRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreateProductResponse, error)
+}
+
+func (c *Client) ListProducts(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*ListProductsResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/products",
+		Method:     "GET",
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*ListProductsResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(ListProductsResponse)
+		if err = clientJSON.Unmarshal(bodyBytes, target); err != nil {
+			err = fmt.Errorf("error decoding response: %w", err)
+			return nil, err
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/products")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+func (c *Client) CreateProduct(ctx context.Context, options *CreateProductRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreateProductResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/products",
+		Method:      "POST",
+		Options:     options,
+		ContentType: "application/json",
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*CreateProductResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 201 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(CreateProductResponse)
+		if err = clientJSON.Unmarshal(bodyBytes, target); err != nil {
+			err = fmt.Errorf("error decoding response: %w", err)
+			return nil, err
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/products")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// CreateProductRequestOptions is the options needed to make a request to CreateProduct.
+type CreateProductRequestOptions struct {
+	Body *CreateProductBody
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *CreateProductRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *CreateProductRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *CreateProductRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *CreateProductRequestOptions) GetBody() any {
+	return o.Body
+}
+
+// GetHeader returns the headers as a map.
+func (o *CreateProductRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type CreateProductBody = Product
+
+type ListProductsResponse []Product
+
+type CreateProductResponse = Product
+
+type Product struct {
+	ID           string                `json:"id" validate:"required"`
+	Name         string                `json:"name" validate:"required"`
+	Description  *string               `json:"description,omitempty"`
+	Price        float32               `json:"price" validate:"required"`
+	Tags         []string              `json:"tags,omitempty"`
+	Dimensions   *Dimensions           `json:"dimensions,omitempty"`
+	Availability *Product_Availability `json:"availability,omitempty"`
+}
+
+func (p Product) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(p.ID, "required"); err != nil {
+		errors = errors.Append("ID", err)
+	}
+	if err := typesValidator.Var(p.Name, "required"); err != nil {
+		errors = errors.Append("Name", err)
+	}
+	if err := typesValidator.Var(p.Price, "required"); err != nil {
+		errors = errors.Append("Price", err)
+	}
+	if p.Dimensions != nil {
+		if v, ok := any(p.Dimensions).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Dimensions", err)
+			}
+		}
+	}
+	if p.Availability != nil {
+		if v, ok := any(p.Availability).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Availability", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type Product_Availability struct {
+	Product_Availability_OneOf *Product_Availability_OneOf `json:"-"`
+}
+
+func (p Product_Availability) Validate() error {
+	var errors runtime.ValidationErrors
+	if p.Product_Availability_OneOf != nil {
+		if v, ok := any(p.Product_Availability_OneOf).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+func (p Product_Availability) MarshalJSON() ([]byte, error) {
+	var parts []json.RawMessage
+
+	{
+		b, err := runtime.MarshalJSON(p.Product_Availability_OneOf)
+		if err != nil {
+			return nil, fmt.Errorf("Product_Availability_OneOf marshal: %w", err)
+		}
+		parts = append(parts, b)
+	}
+
+	return runtime.CoalesceOrMerge(parts...)
+}
+
+func (p *Product_Availability) UnmarshalJSON(data []byte) error {
+	trim := bytes.TrimSpace(data)
+	if bytes.Equal(trim, []byte("null")) {
+		return nil
+	}
+	if len(trim) == 0 {
+		return fmt.Errorf("empty JSON input")
+	}
+
+	if p.Product_Availability_OneOf == nil {
+		p.Product_Availability_OneOf = &Product_Availability_OneOf{}
+	}
+
+	if err := runtime.UnmarshalJSON(data, p.Product_Availability_OneOf); err != nil {
+		return fmt.Errorf("Product_Availability_OneOf unmarshal: %w", err)
+	}
+
+	return nil
+}
+
+type Dimensions struct {
+	Width  *float32 `json:"width,omitempty"`
+	Height *float32 `json:"height,omitempty"`
+	Depth  *float32 `json:"depth,omitempty"`
+}
+
+type InStock struct {
+	Quantity int `json:"quantity" validate:"required"`
+}
+
+func (i InStock) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(i))
+}
+
+type BackOrder struct {
+	AvailableAt time.Time `json:"availableAt" validate:"required"`
+}
+
+func (b BackOrder) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(b))
+}
+
+type Product_Availability_OneOf struct {
+	runtime.Either[InStock, BackOrder]
+}
+
+func (p *Product_Availability_OneOf) Validate() error {
+	if p.IsA() {
+		if v, ok := any(p.A).(runtime.Validator); ok {
+			return v.Validate()
+		}
+	}
+	if p.IsB() {
+		if v, ok := any(p.B).(runtime.Validator); ok {
+			return v.Validate()
+		}
+	}
+	return nil
+}
+
+// AsInStock returns the InStock inside the Product_Availability_OneOf and whether it holds one
+func (p *Product_Availability_OneOf) AsInStock() (InStock, bool) {
+	return p.A, p.IsA()
+}
+
+// AsBackOrder returns the BackOrder inside the Product_Availability_OneOf and whether it holds one
+func (p *Product_Availability_OneOf) AsBackOrder() (BackOrder, bool) {
+	return p.B, p.IsB()
+}
+
+// Match calls the function matching the variant held by the Product_Availability_OneOf, none for an empty union
+func (p *Product_Availability_OneOf) Match(onA func(InStock), onB func(BackOrder)) {
+	switch {
+	case p.IsA():
+		onA(p.A)
+	case p.IsB():
+		onB(p.B)
+	}
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
@@ -0,0 +1,3 @@
+package gojson
+
+//go:generate go run ../../../../cmd/oapi-codegen -config cfg.yaml ../api.yaml
@@ -0,0 +1,72 @@
+package example7_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	example7 "github.com/yorunikakeru4/oapi-codegen-dd/v3/examples/client/example7-http-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingTransport counts the requests and stamps a header on them before delegating to next.
+type countingTransport struct {
+	next  http.RoundTripper
+	count int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.count++
+	req = req.Clone(req.Context())
+	req.Header.Set("X-Transport", "custom")
+	return t.next.RoundTrip(req)
+}
+
+func newChargeServer(t *testing.T, capturedHeader *string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*capturedHeader = r.Header.Get("X-Transport")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "ch_123", "amount": 1000, "currency": "usd"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestNewClientWithHTTPClient(t *testing.T) {
+	var capturedHeader string
+	server := newChargeServer(t, &capturedHeader)
+
+	transport := &countingTransport{next: server.Client().Transport}
+	client, err := example7.NewClientWithHTTPClient(server.URL, &http.Client{Transport: transport})
+	require.NoError(t, err)
+
+	resp, err := client.GetCharge(context.Background(), &example7.GetChargeRequestOptions{
+		PathParams: &example7.GetChargePath{ID: "ch_123"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, transport.count)
+	assert.Equal(t, "custom", capturedHeader)
+	require.NotNil(t, resp.ID)
+	assert.Equal(t, "ch_123", *resp.ID)
+}
+
+func TestNewDefaultClient(t *testing.T) {
+	var capturedHeader string
+	server := newChargeServer(t, &capturedHeader)
+
+	client, err := example7.NewDefaultClient(server.URL)
+	require.NoError(t, err)
+
+	resp, err := client.GetCharge(context.Background(), &example7.GetChargeRequestOptions{
+		PathParams: &example7.GetChargePath{ID: "ch_123"},
+	})
+	require.NoError(t, err)
+
+	assert.Empty(t, capturedHeader)
+	require.NotNil(t, resp.Amount)
+	assert.Equal(t, 1000, *resp.Amount)
+}
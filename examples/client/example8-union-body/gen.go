@@ -0,0 +1,592 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package example8
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/yorunikakeru4/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	CreatePayment(ctx context.Context, options *CreatePaymentRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreatePaymentResponse, error)
+
+	CreateRefund(ctx context.Context, options *CreateRefundRequestOptions, reqEditors ...runtime.RequestEditorFn) (*runtime.NoContent, error)
+}
+
+func (c *Client) CreatePayment(ctx context.Context, options *CreatePaymentRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreatePaymentResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/payments",
+		Method:      "POST",
+		Options:     options,
+		ContentType: "application/json",
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*CreatePaymentResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 201 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(CreatePaymentResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			err = fmt.Errorf("error decoding response: %w", err)
+			return nil, err
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/payments")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// CreatePaymentWithCreditCard calls CreatePayment with a CreditCardPayment request body.
+// The Body of options is ignored, options can be nil.
+func (c *Client) CreatePaymentWithCreditCard(ctx context.Context, body CreditCardPayment, options *CreatePaymentRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreatePaymentResponse, error) {
+	var union CreatePaymentBody_OneOf
+	if err := union.FromCreditCardPayment(body); err != nil {
+		return nil, fmt.Errorf("error creating request body: %w", err)
+	}
+
+	opts := CreatePaymentRequestOptions{}
+	if options != nil {
+		opts = *options
+	}
+	opts.Body = &CreatePaymentBody{CreatePaymentBody_OneOf: &union}
+	return c.CreatePayment(ctx, &opts, reqEditors...)
+}
+
+// CreatePaymentWithBank calls CreatePayment with a BankPayment request body.
+// The Body of options is ignored, options can be nil.
+func (c *Client) CreatePaymentWithBank(ctx context.Context, body BankPayment, options *CreatePaymentRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreatePaymentResponse, error) {
+	var union CreatePaymentBody_OneOf
+	if err := union.FromBankPayment(body); err != nil {
+		return nil, fmt.Errorf("error creating request body: %w", err)
+	}
+
+	opts := CreatePaymentRequestOptions{}
+	if options != nil {
+		opts = *options
+	}
+	opts.Body = &CreatePaymentBody{CreatePaymentBody_OneOf: &union}
+	return c.CreatePayment(ctx, &opts, reqEditors...)
+}
+
+// CreatePaymentWithWallet calls CreatePayment with a WalletPayment request body.
+// The Body of options is ignored, options can be nil.
+func (c *Client) CreatePaymentWithWallet(ctx context.Context, body WalletPayment, options *CreatePaymentRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreatePaymentResponse, error) {
+	var union CreatePaymentBody_OneOf
+	if err := union.FromWalletPayment(body); err != nil {
+		return nil, fmt.Errorf("error creating request body: %w", err)
+	}
+
+	opts := CreatePaymentRequestOptions{}
+	if options != nil {
+		opts = *options
+	}
+	opts.Body = &CreatePaymentBody{CreatePaymentBody_OneOf: &union}
+	return c.CreatePayment(ctx, &opts, reqEditors...)
+}
+
+func (c *Client) CreateRefund(ctx context.Context, options *CreateRefundRequestOptions, reqEditors ...runtime.RequestEditorFn) (*runtime.NoContent, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/refunds",
+		Method:      "POST",
+		Options:     options,
+		ContentType: "application/json",
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*runtime.NoContent, error) {
+		if resp.StatusCode != 204 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		return &runtime.NoContent{StatusCode: resp.StatusCode, Headers: resp.Headers}, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/refunds")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// CreateRefundWithCreditCard calls CreateRefund with a CreditCardPayment request body.
+// The Body of options is ignored, options can be nil.
+func (c *Client) CreateRefundWithCreditCard(ctx context.Context, body CreditCardPayment, options *CreateRefundRequestOptions, reqEditors ...runtime.RequestEditorFn) (*runtime.NoContent, error) {
+	var union RefundMethod_OneOf
+	union.A = body
+	union.N = 1
+
+	opts := CreateRefundRequestOptions{}
+	if options != nil {
+		opts = *options
+	}
+	opts.Body = &CreateRefundBody{RefundMethod_OneOf: &union}
+	return c.CreateRefund(ctx, &opts, reqEditors...)
+}
+
+// CreateRefundWithBank calls CreateRefund with a BankPayment request body.
+// The Body of options is ignored, options can be nil.
+func (c *Client) CreateRefundWithBank(ctx context.Context, body BankPayment, options *CreateRefundRequestOptions, reqEditors ...runtime.RequestEditorFn) (*runtime.NoContent, error) {
+	var union RefundMethod_OneOf
+	union.B = body
+	union.N = 2
+
+	opts := CreateRefundRequestOptions{}
+	if options != nil {
+		opts = *options
+	}
+	opts.Body = &CreateRefundBody{RefundMethod_OneOf: &union}
+	return c.CreateRefund(ctx, &opts, reqEditors...)
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// CreatePaymentRequestOptions is the options needed to make a request to CreatePayment.
+type CreatePaymentRequestOptions struct {
+	Body *CreatePaymentBody
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *CreatePaymentRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *CreatePaymentRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *CreatePaymentRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *CreatePaymentRequestOptions) GetBody() any {
+	return o.Body
+}
+
+// GetHeader returns the headers as a map.
+func (o *CreatePaymentRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// CreateRefundRequestOptions is the options needed to make a request to CreateRefund.
+type CreateRefundRequestOptions struct {
+	Body *CreateRefundBody
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *CreateRefundRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *CreateRefundRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *CreateRefundRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *CreateRefundRequestOptions) GetBody() any {
+	return o.Body
+}
+
+// GetHeader returns the headers as a map.
+func (o *CreateRefundRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type CreatePaymentBody struct {
+	CreatePaymentBody_OneOf *CreatePaymentBody_OneOf `json:"-"`
+}
+
+func (c CreatePaymentBody) Validate() error {
+	var errors runtime.ValidationErrors
+	if c.CreatePaymentBody_OneOf != nil {
+		if v, ok := any(c.CreatePaymentBody_OneOf).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+func (c CreatePaymentBody) MarshalJSON() ([]byte, error) {
+	var parts []json.RawMessage
+
+	{
+		b, err := runtime.MarshalJSON(c.CreatePaymentBody_OneOf)
+		if err != nil {
+			return nil, fmt.Errorf("CreatePaymentBody_OneOf marshal: %w", err)
+		}
+		parts = append(parts, b)
+	}
+
+	return runtime.CoalesceOrMerge(parts...)
+}
+
+func (c *CreatePaymentBody) UnmarshalJSON(data []byte) error {
+	trim := bytes.TrimSpace(data)
+	if bytes.Equal(trim, []byte("null")) {
+		return nil
+	}
+	if len(trim) == 0 {
+		return fmt.Errorf("empty JSON input")
+	}
+
+	if c.CreatePaymentBody_OneOf == nil {
+		c.CreatePaymentBody_OneOf = &CreatePaymentBody_OneOf{}
+	}
+
+	if err := runtime.UnmarshalJSON(data, c.CreatePaymentBody_OneOf); err != nil {
+		return fmt.Errorf("CreatePaymentBody_OneOf unmarshal: %w", err)
+	}
+
+	return nil
+}
+
+type CreateRefundBody = RefundMethod
+
+type CreatePaymentResponse = Receipt
+
+type RefundMethod struct {
+	RefundMethod_OneOf *RefundMethod_OneOf `json:"-"`
+}
+
+func (r RefundMethod) Validate() error {
+	var errors runtime.ValidationErrors
+	if r.RefundMethod_OneOf != nil {
+		if v, ok := any(r.RefundMethod_OneOf).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+func (r RefundMethod) MarshalJSON() ([]byte, error) {
+	var parts []json.RawMessage
+
+	{
+		b, err := runtime.MarshalJSON(r.RefundMethod_OneOf)
+		if err != nil {
+			return nil, fmt.Errorf("RefundMethod_OneOf marshal: %w", err)
+		}
+		parts = append(parts, b)
+	}
+
+	return runtime.CoalesceOrMerge(parts...)
+}
+
+func (r *RefundMethod) UnmarshalJSON(data []byte) error {
+	trim := bytes.TrimSpace(data)
+	if bytes.Equal(trim, []byte("null")) {
+		return nil
+	}
+	if len(trim) == 0 {
+		return fmt.Errorf("empty JSON input")
+	}
+
+	if r.RefundMethod_OneOf == nil {
+		r.RefundMethod_OneOf = &RefundMethod_OneOf{}
+	}
+
+	if err := runtime.UnmarshalJSON(data, r.RefundMethod_OneOf); err != nil {
+		return fmt.Errorf("RefundMethod_OneOf unmarshal: %w", err)
+	}
+
+	return nil
+}
+
+type CreditCardPayment struct {
+	Number string `json:"number" validate:"required"`
+}
+
+func (c CreditCardPayment) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(c))
+}
+
+type BankPayment struct {
+	Iban string `json:"iban" validate:"required"`
+}
+
+func (b BankPayment) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(b))
+}
+
+type WalletPayment struct {
+	Wallet *string `json:"wallet,omitempty"`
+}
+
+type Receipt struct {
+	ID *string `json:"id,omitempty"`
+}
+
+type RefundMethod_OneOf struct {
+	runtime.Either[CreditCardPayment, BankPayment]
+}
+
+func (r *RefundMethod_OneOf) Validate() error {
+	if r.IsA() {
+		if v, ok := any(r.A).(runtime.Validator); ok {
+			return v.Validate()
+		}
+	}
+	if r.IsB() {
+		if v, ok := any(r.B).(runtime.Validator); ok {
+			return v.Validate()
+		}
+	}
+	return nil
+}
+
+// AsCreditCardPayment returns the CreditCardPayment inside the RefundMethod_OneOf and whether it holds one
+func (r *RefundMethod_OneOf) AsCreditCardPayment() (CreditCardPayment, bool) {
+	return r.A, r.IsA()
+}
+
+// AsBankPayment returns the BankPayment inside the RefundMethod_OneOf and whether it holds one
+func (r *RefundMethod_OneOf) AsBankPayment() (BankPayment, bool) {
+	return r.B, r.IsB()
+}
+
+// Match calls the function matching the variant held by the RefundMethod_OneOf, none for an empty union
+func (r *RefundMethod_OneOf) Match(onA func(CreditCardPayment), onB func(BankPayment)) {
+	switch {
+	case r.IsA():
+		onA(r.A)
+	case r.IsB():
+		onB(r.B)
+	}
+}
+
+type CreatePaymentBody_OneOf struct {
+	union json.RawMessage
+}
+
+func (c *CreatePaymentBody_OneOf) Validate() error {
+	// NOTE: Validation is not supported for unions with more than 2 elements.
+	// Validating would require unmarshaling against each possible type, which is inefficient.
+	// Use AsValidated<Type>() methods to validate after retrieving the specific type.
+	return nil
+}
+
+// Raw returns the union data inside the CreatePaymentBody_OneOf as bytes
+func (c *CreatePaymentBody_OneOf) Raw() json.RawMessage {
+	return c.union
+}
+
+// AsCreditCardPayment returns the union data inside the CreatePaymentBody_OneOf as a CreditCardPayment
+func (c *CreatePaymentBody_OneOf) AsCreditCardPayment() (CreditCardPayment, error) {
+	return runtime.UnmarshalAs[CreditCardPayment](c.union)
+}
+
+// AsValidatedCreditCardPayment returns the union data inside the CreatePaymentBody_OneOf as a validated CreditCardPayment
+func (c *CreatePaymentBody_OneOf) AsValidatedCreditCardPayment() (CreditCardPayment, error) {
+	val, err := c.AsCreditCardPayment()
+	if err != nil {
+		var zero CreditCardPayment
+		return zero, err
+	}
+	if err := c.validateCreditCardPayment(val); err != nil {
+		var zero CreditCardPayment
+		return zero, err
+	}
+	return val, nil
+}
+
+// FromCreditCardPayment overwrites any union data inside the CreatePaymentBody_OneOf as the provided CreditCardPayment
+func (c *CreatePaymentBody_OneOf) FromCreditCardPayment(val CreditCardPayment) error {
+	// Validate before storing
+	if err := c.validateCreditCardPayment(val); err != nil {
+		return err
+	}
+	bts, err := json.Marshal(val)
+	c.union = bts
+	return err
+}
+
+// AsBankPayment returns the union data inside the CreatePaymentBody_OneOf as a BankPayment
+func (c *CreatePaymentBody_OneOf) AsBankPayment() (BankPayment, error) {
+	return runtime.UnmarshalAs[BankPayment](c.union)
+}
+
+// AsValidatedBankPayment returns the union data inside the CreatePaymentBody_OneOf as a validated BankPayment
+func (c *CreatePaymentBody_OneOf) AsValidatedBankPayment() (BankPayment, error) {
+	val, err := c.AsBankPayment()
+	if err != nil {
+		var zero BankPayment
+		return zero, err
+	}
+	if err := c.validateBankPayment(val); err != nil {
+		var zero BankPayment
+		return zero, err
+	}
+	return val, nil
+}
+
+// FromBankPayment overwrites any union data inside the CreatePaymentBody_OneOf as the provided BankPayment
+func (c *CreatePaymentBody_OneOf) FromBankPayment(val BankPayment) error {
+	// Validate before storing
+	if err := c.validateBankPayment(val); err != nil {
+		return err
+	}
+	bts, err := json.Marshal(val)
+	c.union = bts
+	return err
+}
+
+// AsWalletPayment returns the union data inside the CreatePaymentBody_OneOf as a WalletPayment
+func (c *CreatePaymentBody_OneOf) AsWalletPayment() (WalletPayment, error) {
+	return runtime.UnmarshalAs[WalletPayment](c.union)
+}
+
+// AsValidatedWalletPayment returns the union data inside the CreatePaymentBody_OneOf as a validated WalletPayment
+func (c *CreatePaymentBody_OneOf) AsValidatedWalletPayment() (WalletPayment, error) {
+	val, err := c.AsWalletPayment()
+	if err != nil {
+		var zero WalletPayment
+		return zero, err
+	}
+	if err := c.validateWalletPayment(val); err != nil {
+		var zero WalletPayment
+		return zero, err
+	}
+	return val, nil
+}
+
+// FromWalletPayment overwrites any union data inside the CreatePaymentBody_OneOf as the provided WalletPayment
+func (c *CreatePaymentBody_OneOf) FromWalletPayment(val WalletPayment) error {
+	// Validate before storing
+	if err := c.validateWalletPayment(val); err != nil {
+		return err
+	}
+	bts, err := json.Marshal(val)
+	c.union = bts
+	return err
+}
+
+// validateCreditCardPayment validates a CreditCardPayment value
+func (c *CreatePaymentBody_OneOf) validateCreditCardPayment(val CreditCardPayment) error {
+	if v, ok := any(val).(runtime.Validator); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+// validateBankPayment validates a BankPayment value
+func (c *CreatePaymentBody_OneOf) validateBankPayment(val BankPayment) error {
+	if v, ok := any(val).(runtime.Validator); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+// validateWalletPayment validates a WalletPayment value
+func (c *CreatePaymentBody_OneOf) validateWalletPayment(val WalletPayment) error {
+	if v, ok := any(val).(runtime.Validator); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+func (c CreatePaymentBody_OneOf) MarshalJSON() ([]byte, error) {
+	bts, err := c.union.MarshalJSON()
+
+	return bts, err
+}
+
+func (c *CreatePaymentBody_OneOf) UnmarshalJSON(bts []byte) error {
+	err := c.union.UnmarshalJSON(bts)
+
+	return err
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
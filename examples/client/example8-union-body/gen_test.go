@@ -0,0 +1,92 @@
+package example8_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	example8 "github.com/yorunikakeru4/oapi-codegen-dd/v3/examples/client/example8-union-body"
+	"github.com/yorunikakeru4/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPaymentServer(t *testing.T, received *[]string) *example8.Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		*received = append(*received, string(body))
+		if r.URL.Path == "/refunds" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"receipt-1"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := example8.NewDefaultClient(server.URL, runtime.WithHTTPClient(runtime.HTTPClientDoer{Client: server.Client()}))
+	require.NoError(t, err)
+	return client
+}
+
+func TestCreatePaymentWithVariant(t *testing.T) {
+	var received []string
+	client := newPaymentServer(t, &received)
+
+	res, err := client.CreatePaymentWithCreditCard(context.Background(), example8.CreditCardPayment{Number: "4111111111111111"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "receipt-1", *res.ID)
+
+	_, err = client.CreatePaymentWithWallet(context.Background(), example8.WalletPayment{Wallet: runtime.Ptr("apple-pay")}, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{`{"number":"4111111111111111"}`, `{"wallet":"apple-pay"}`}, received)
+}
+
+func TestCreatePaymentWithVariant_SameAsUnionBody(t *testing.T) {
+	var received []string
+	client := newPaymentServer(t, &received)
+	card := example8.CreditCardPayment{Number: "4111111111111111"}
+
+	union := &example8.CreatePaymentBody_OneOf{}
+	require.NoError(t, union.FromCreditCardPayment(card))
+	_, err := client.CreatePayment(context.Background(), &example8.CreatePaymentRequestOptions{
+		Body: &example8.CreatePaymentBody{CreatePaymentBody_OneOf: union},
+	})
+	require.NoError(t, err)
+
+	_, err = client.CreatePaymentWithCreditCard(context.Background(), card, nil)
+	require.NoError(t, err)
+
+	require.Len(t, received, 2)
+	assert.JSONEq(t, received[0], received[1])
+}
+
+func TestCreatePaymentWithVariant_InvalidVariant(t *testing.T) {
+	var received []string
+	client := newPaymentServer(t, &received)
+
+	_, err := client.CreatePaymentWithBank(context.Background(), example8.BankPayment{}, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Iban")
+	assert.Empty(t, received)
+}
+
+func TestCreateRefundWithVariant(t *testing.T) {
+	var received []string
+	client := newPaymentServer(t, &received)
+
+	_, err := client.CreateRefundWithBank(context.Background(), example8.BankPayment{Iban: "DE89370400440532013000"}, nil)
+	require.NoError(t, err)
+
+	require.Len(t, received, 1)
+	var refund example8.RefundMethod
+	require.NoError(t, json.Unmarshal([]byte(received[0]), &refund))
+	assert.True(t, refund.RefundMethod_OneOf.IsB())
+	assert.Equal(t, "DE89370400440532013000", refund.RefundMethod_OneOf.B.Iban)
+}
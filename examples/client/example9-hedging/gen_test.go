@@ -0,0 +1,86 @@
+package example9_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	example9 "github.com/doordash-oss/oapi-codegen-dd/v3/examples/client/example9-hedging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSlowThenFastServer answers the first request of every path after a second and the other ones right away.
+// canceled receives the paths of the slow requests canceled by the client.
+func newSlowThenFastServer(t *testing.T, canceled chan<- string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := calls.Add(1)
+		if n == 1 {
+			select {
+			case <-time.After(time.Second):
+			case <-r.Context().Done():
+				canceled <- r.URL.Path
+				return
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+		}
+		_, _ = fmt.Fprintf(w, `{"id": "ch_123", "amount": %d}`, n)
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func TestGetChargeIsHedged(t *testing.T) {
+	canceled := make(chan string, 1)
+	server, calls := newSlowThenFastServer(t, canceled)
+
+	client, err := example9.NewDefaultClient(server.URL)
+	require.NoError(t, err)
+
+	start := time.Now()
+	resp, err := client.GetCharge(context.Background(), &example9.GetChargeRequestOptions{
+		PathParams: &example9.GetChargePath{ID: "ch_123"},
+	})
+	require.NoError(t, err)
+
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+	assert.Equal(t, int32(2), calls.Load())
+	require.NotNil(t, resp.Amount)
+	assert.Equal(t, 2, *resp.Amount, "the second attempt wins")
+
+	select {
+	case path := <-canceled:
+		assert.Equal(t, "/charges/ch_123", path)
+	case <-time.After(time.Second):
+		t.Fatal("the slow attempt was not canceled")
+	}
+}
+
+func TestCreateChargeIsNotHedged(t *testing.T) {
+	server, calls := newSlowThenFastServer(t, make(chan string, 1))
+
+	client, err := example9.NewDefaultClient(server.URL)
+	require.NoError(t, err)
+
+	resp, err := client.CreateCharge(context.Background(), &example9.CreateChargeRequestOptions{
+		Body: &example9.CreateChargeBody{Amount: ptr(1000)},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(1), calls.Load())
+	require.NotNil(t, resp.Amount)
+	assert.Equal(t, 1, *resp.Amount)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,71 @@
+package shopspring
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayment_RoundTrip(t *testing.T) {
+	data := `{"amount":"1234567890.123456789","currency":"EUR","fee":"0.1"}`
+
+	var payment Payment
+	require.NoError(t, json.Unmarshal([]byte(data), &payment))
+	assert.True(t, payment.Amount.Equal(decimal.RequireFromString("1234567890.123456789")))
+	assert.True(t, payment.Fee.Equal(decimal.RequireFromString("0.1")))
+
+	res, err := json.Marshal(payment)
+	require.NoError(t, err)
+	assert.JSONEq(t, data, string(res))
+}
+
+func TestPayment_NumberFormat(t *testing.T) {
+	// shopspring decimals unmarshal from JSON numbers too, and keep their precision
+	var payment Payment
+	require.NoError(t, json.Unmarshal([]byte(`{"amount":"10","currency":"EUR","fee":0.10000000000000000001}`), &payment))
+	assert.Equal(t, "0.10000000000000000001", payment.Fee.String())
+
+	sum := payment.Amount.Add(*payment.Fee)
+	assert.Equal(t, "10.10000000000000000001", sum.String())
+}
+
+func TestPayment_Validate(t *testing.T) {
+	fee := decimal.RequireFromString("100")
+	valid := Payment{Amount: decimal.RequireFromString("0.01"), Currency: "EUR", Fee: &fee}
+	require.NoError(t, valid.Validate())
+
+	tooLow := valid
+	tooLow.Amount = decimal.RequireFromString("0.001")
+	assert.Error(t, tooLow.Validate())
+
+	tooHigh := valid
+	highFee := decimal.RequireFromString("100.01")
+	tooHigh.Fee = &highFee
+	assert.Error(t, tooHigh.Validate())
+}
+
+func TestClient_CreatePayment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	client, err := NewDefaultClient(server.URL, runtime.WithHTTPClient(runtime.HTTPClientDoer{}))
+	require.NoError(t, err)
+
+	payment := Payment{Amount: decimal.RequireFromString("19.99"), Currency: "USD"}
+	res, err := client.CreatePayment(context.Background(), &CreatePaymentRequestOptions{Body: &payment})
+	require.NoError(t, err)
+	assert.True(t, res.Amount.Equal(payment.Amount))
+}
@@ -0,0 +1,115 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package open
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/yorunikakeru4/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// OrderStatus Status of an order.
+//
+// OrderStatus is an open enum, any value is accepted. The known values are:
+//   - "delivered"
+//   - "pending"
+//   - "shipped"
+type OrderStatus string
+
+// IsValid returns true if the OrderStatus value is one of the known values.
+func (o OrderStatus) IsValid() bool {
+	switch o {
+	case "delivered", "pending", "shipped":
+		return true
+	default:
+		return false
+	}
+}
+
+// Validate accepts any OrderStatus value, use IsValid to check for a known one.
+func (o OrderStatus) Validate() error {
+	return nil
+}
+
+// Priority is an open enum, any value is accepted. The known values are:
+//   - 1
+//   - 2
+//   - 3
+type Priority int
+
+// IsValid returns true if the Priority value is one of the known values.
+func (p Priority) IsValid() bool {
+	switch p {
+	case 1, 2, 3:
+		return true
+	default:
+		return false
+	}
+}
+
+// Validate accepts any Priority value, use IsValid to check for a known one.
+func (p Priority) Validate() error {
+	return nil
+}
+
+// OrderChannel is an open enum, any value is accepted. The known values are:
+//   - "mobile app"
+//   - "web"
+type OrderChannel string
+
+// IsValid returns true if the OrderChannel value is one of the known values.
+func (o OrderChannel) IsValid() bool {
+	switch o {
+	case "mobile app", "web":
+		return true
+	default:
+		return false
+	}
+}
+
+// Validate accepts any OrderChannel value, use IsValid to check for a known one.
+func (o OrderChannel) Validate() error {
+	return nil
+}
+
+type GetOrderResponse = Order
+
+type Order struct {
+	// Status Status of an order.
+	Status   OrderStatus   `json:"status" validate:"required"`
+	Priority *Priority     `json:"priority,omitempty"`
+	Channel  *OrderChannel `json:"channel,omitempty"`
+}
+
+func (o Order) Validate() error {
+	var errors runtime.ValidationErrors
+	if v, ok := any(o.Status).(runtime.Validator); ok {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("Status", err)
+		}
+	}
+	if o.Priority != nil {
+		if v, ok := any(o.Priority).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Priority", err)
+			}
+		}
+	}
+	if o.Channel != nil {
+		if v, ok := any(o.Channel).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Channel", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
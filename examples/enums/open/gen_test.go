@@ -0,0 +1,32 @@
+package open
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenEnums(t *testing.T) {
+	t.Run("known values", func(t *testing.T) {
+		var order Order
+		require.NoError(t, json.Unmarshal([]byte(`{"status": "shipped", "priority": 2, "channel": "mobile app"}`), &order))
+		require.NoError(t, order.Validate())
+
+		assert.True(t, order.Status.IsValid())
+		assert.True(t, order.Priority.IsValid())
+		assert.True(t, order.Channel.IsValid())
+	})
+
+	t.Run("unknown values are accepted", func(t *testing.T) {
+		var order Order
+		require.NoError(t, json.Unmarshal([]byte(`{"status": "returned", "priority": 7, "channel": "kiosk"}`), &order))
+		require.NoError(t, order.Validate())
+
+		assert.Equal(t, OrderStatus("returned"), order.Status)
+		assert.False(t, order.Status.IsValid())
+		assert.False(t, order.Priority.IsValid())
+		assert.False(t, order.Channel.IsValid())
+	})
+}
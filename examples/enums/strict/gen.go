@@ -0,0 +1,183 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package strict
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+// OrderStatus Status of an order.
+type OrderStatus string
+
+const (
+	Delivered OrderStatus = "delivered"
+	Pending   OrderStatus = "pending"
+	Shipped   OrderStatus = "shipped"
+)
+
+// Validate checks if the OrderStatus value is valid
+func (o OrderStatus) Validate() error {
+	switch o {
+	case Delivered, Pending, Shipped:
+		return nil
+	default:
+		return runtime.NewValidationErrorsFromString("Enum", fmt.Sprintf("must be a valid OrderStatus value, got: %v", o))
+	}
+}
+
+// IsValid returns true if the OrderStatus value is one of the known values.
+func (o OrderStatus) IsValid() bool {
+	switch o {
+	case Delivered, Pending, Shipped:
+		return true
+	default:
+		return false
+	}
+}
+
+// UnmarshalJSON unmarshals a OrderStatus value, returning an error wrapping runtime.ErrUnknownEnumValue
+// if it isn't one of the known values.
+func (o *OrderStatus) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	if !OrderStatus(value).IsValid() {
+		return fmt.Errorf("%w: %v is not a valid OrderStatus, must be one of: %s", runtime.ErrUnknownEnumValue, value,
+			"delivered, pending, shipped")
+	}
+	*o = OrderStatus(value)
+	return nil
+}
+
+type Priority int
+
+const (
+	N1 Priority = 1
+	N2 Priority = 2
+	N3 Priority = 3
+)
+
+// Validate checks if the Priority value is valid
+func (p Priority) Validate() error {
+	switch p {
+	case N1, N2, N3:
+		return nil
+	default:
+		return runtime.NewValidationErrorsFromString("Enum", fmt.Sprintf("must be a valid Priority value, got: %v", p))
+	}
+}
+
+// IsValid returns true if the Priority value is one of the known values.
+func (p Priority) IsValid() bool {
+	switch p {
+	case N1, N2, N3:
+		return true
+	default:
+		return false
+	}
+}
+
+// UnmarshalJSON unmarshals a Priority value, returning an error wrapping runtime.ErrUnknownEnumValue
+// if it isn't one of the known values.
+func (p *Priority) UnmarshalJSON(data []byte) error {
+	var value int
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	if !Priority(value).IsValid() {
+		return fmt.Errorf("%w: %v is not a valid Priority, must be one of: %s", runtime.ErrUnknownEnumValue, value,
+			"1, 2, 3")
+	}
+	*p = Priority(value)
+	return nil
+}
+
+type OrderChannel string
+
+const (
+	MobileApp OrderChannel = "mobile app"
+	Web       OrderChannel = "web"
+)
+
+// Validate checks if the OrderChannel value is valid
+func (o OrderChannel) Validate() error {
+	switch o {
+	case MobileApp, Web:
+		return nil
+	default:
+		return runtime.NewValidationErrorsFromString("Enum", fmt.Sprintf("must be a valid OrderChannel value, got: %v", o))
+	}
+}
+
+// IsValid returns true if the OrderChannel value is one of the known values.
+func (o OrderChannel) IsValid() bool {
+	switch o {
+	case MobileApp, Web:
+		return true
+	default:
+		return false
+	}
+}
+
+// UnmarshalJSON unmarshals a OrderChannel value, returning an error wrapping runtime.ErrUnknownEnumValue
+// if it isn't one of the known values.
+func (o *OrderChannel) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	if !OrderChannel(value).IsValid() {
+		return fmt.Errorf("%w: %v is not a valid OrderChannel, must be one of: %s", runtime.ErrUnknownEnumValue, value,
+			"mobile app, web")
+	}
+	*o = OrderChannel(value)
+	return nil
+}
+
+type GetOrderResponse = Order
+
+type Order struct {
+	// Status Status of an order.
+	Status   OrderStatus   `json:"status" validate:"required"`
+	Priority *Priority     `json:"priority,omitempty"`
+	Channel  *OrderChannel `json:"channel,omitempty"`
+}
+
+func (o Order) Validate() error {
+	var errors runtime.ValidationErrors
+	if v, ok := any(o.Status).(runtime.Validator); ok {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("Status", err)
+		}
+	}
+	if o.Priority != nil {
+		if v, ok := any(o.Priority).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Priority", err)
+			}
+		}
+	}
+	if o.Channel != nil {
+		if v, ok := any(o.Channel).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Channel", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
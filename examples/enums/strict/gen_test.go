@@ -0,0 +1,45 @@
+package strict
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrictEnums(t *testing.T) {
+	t.Run("known values", func(t *testing.T) {
+		var order Order
+		require.NoError(t, json.Unmarshal([]byte(`{"status": "shipped", "priority": 2, "channel": "mobile app"}`), &order))
+		require.NoError(t, order.Validate())
+
+		assert.Equal(t, Shipped, order.Status)
+		assert.Equal(t, N2, *order.Priority)
+		assert.Equal(t, MobileApp, *order.Channel)
+	})
+
+	t.Run("unknown values are rejected", func(t *testing.T) {
+		var order Order
+		err := json.Unmarshal([]byte(`{"status": "returned"}`), &order)
+		require.ErrorIs(t, err, runtime.ErrUnknownEnumValue)
+		assert.EqualError(t, err, "unknown enum value: returned is not a valid OrderStatus, must be one of: delivered, pending, shipped")
+
+		err = json.Unmarshal([]byte(`{"status": "shipped", "priority": 7}`), &order)
+		require.ErrorIs(t, err, runtime.ErrUnknownEnumValue)
+		assert.EqualError(t, err, "unknown enum value: 7 is not a valid Priority, must be one of: 1, 2, 3")
+	})
+
+	t.Run("wrong type", func(t *testing.T) {
+		var status OrderStatus
+		err := json.Unmarshal([]byte(`3`), &status)
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, runtime.ErrUnknownEnumValue)
+	})
+
+	t.Run("IsValid", func(t *testing.T) {
+		assert.True(t, Pending.IsValid())
+		assert.False(t, OrderStatus("returned").IsValid())
+	})
+}
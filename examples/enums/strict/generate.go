@@ -0,0 +1,3 @@
+package strict
+
+//go:generate go run github.com/yorunikakeru4/oapi-codegen-dd/v3/cmd/oapi-codegen -config cfg.yaml api.yaml
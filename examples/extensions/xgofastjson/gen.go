@@ -0,0 +1,254 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package xgofastjson
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+type OrderStatus string
+
+const (
+	Pending OrderStatus = "pending"
+	Shipped OrderStatus = "shipped"
+)
+
+// Validate checks if the OrderStatus value is valid
+func (o OrderStatus) Validate() error {
+	switch o {
+	case Pending, Shipped:
+		return nil
+	default:
+		return runtime.NewValidationErrorsFromString("Enum", fmt.Sprintf("must be a valid OrderStatus value, got: %v", o))
+	}
+}
+
+type Order struct {
+	ID         int64       `json:"id" validate:"required"`
+	Total      float64     `json:"total" validate:"required"`
+	Discount   *float64    `json:"discount,omitempty"`
+	Quantity   *int        `json:"quantity,omitempty"`
+	Status     OrderStatus `json:"status" validate:"required"`
+	Note       *string     `json:"note,omitempty"`
+	Paid       bool        `json:"paid"`
+	Items      []OrderItem `json:"items" validate:"required"`
+	Tags       []string    `json:"tags,omitempty"`
+	Shipping   *Address    `json:"shipping,omitempty"`
+	CreatedAt  *time.Time  `json:"created_at,omitempty"`
+	CardNumber *string     `json:"card_number,omitempty" sensitive:""`
+}
+
+func (o Order) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(o.ID, "required"); err != nil {
+		errors = errors.Append("ID", err)
+	}
+	if err := typesValidator.Var(o.Total, "required"); err != nil {
+		errors = errors.Append("Total", err)
+	}
+	if v, ok := any(o.Status).(runtime.Validator); ok {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("Status", err)
+		}
+	}
+	for i, item := range o.Items {
+		if v, ok := any(item).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append(fmt.Sprintf("Items[%d]", i), err)
+			}
+		}
+	}
+	if o.Shipping != nil {
+		if v, ok := any(o.Shipping).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Shipping", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+// Masked returns a copy of the struct with sensitive fields masked.
+func (o Order) Masked() Order {
+	masked := o
+	if masked.CardNumber != nil {
+		v := runtime.MaskSensitiveString(*masked.CardNumber, runtime.SensitiveDataConfig{
+			Type:        runtime.MaskTypePartial,
+			Replacement: "",
+			Pattern:     "",
+			Algorithm:   "",
+			KeepPrefix:  0,
+			KeepSuffix:  4,
+		})
+		masked.CardNumber = &v
+	}
+	return masked
+}
+
+// LogValue implements slog.LogValuer interface for structured logging.
+func (o Order) LogValue() slog.Value {
+	type plain Order
+	return slog.AnyValue(plain(o.Masked()))
+}
+
+// MarshalJSONMasked returns the JSON encoding with sensitive fields masked.
+func (o Order) MarshalJSONMasked() ([]byte, error) {
+	enc := runtime.NewJSONEncoder(397)
+	o.writeJSON(enc, true)
+	return enc.Bytes()
+}
+
+// MarshalJSONUnmasked returns the JSON encoding with sensitive fields left intact.
+// Use it only in trusted contexts, e.g. internal audit logs.
+func (o Order) MarshalJSONUnmasked() ([]byte, error) {
+	return json.Marshal(o)
+}
+
+// Redacted returns a log-safe JSON rendering of the struct, including nested types.
+func (o Order) Redacted() string {
+	return runtime.Redact(o)
+}
+
+// MarshalJSON encodes the fields of Order one by one, without reflection.
+func (o Order) MarshalJSON() ([]byte, error) {
+	enc := runtime.NewJSONEncoder(397)
+	o.writeJSON(enc, false)
+	return enc.Bytes()
+}
+
+// writeJSON writes Order as a JSON object, with the sensitive fields masked if masked is true.
+func (o Order) writeJSON(enc *runtime.JSONEncoder, masked bool) {
+	enc.BeginObject()
+
+	enc.Key("id")
+	enc.Int(int64(o.ID))
+
+	enc.Key("total")
+	enc.Float(o.Total, 64)
+
+	if o.Discount != nil {
+		enc.Key("discount")
+		enc.Float(*o.Discount, 64)
+	}
+
+	if o.Quantity != nil {
+		enc.Key("quantity")
+		enc.Int(int64(*o.Quantity))
+	}
+
+	enc.Key("status")
+	enc.String(string(o.Status))
+
+	if o.Note != nil {
+		enc.Key("note")
+		enc.String(*o.Note)
+	}
+
+	enc.Key("paid")
+	enc.Bool(o.Paid)
+
+	enc.Key("items")
+	if o.Items == nil {
+		enc.Null()
+	} else {
+		enc.BeginArray()
+		for _, item := range o.Items {
+			item.writeJSON(enc, masked)
+		}
+		enc.EndArray()
+	}
+
+	if len(o.Tags) != 0 {
+		enc.Key("tags")
+		if o.Tags == nil {
+			enc.Null()
+		} else {
+			enc.BeginArray()
+			for _, item := range o.Tags {
+				enc.String(item)
+			}
+			enc.EndArray()
+		}
+	}
+
+	if o.Shipping != nil {
+		enc.Key("shipping")
+		enc.Value(*o.Shipping)
+	}
+
+	if o.CreatedAt != nil {
+		enc.Key("created_at")
+		enc.Time(*o.CreatedAt)
+	}
+
+	if o.CardNumber != nil {
+		enc.Key("card_number")
+		if masked {
+			enc.String(runtime.MaskSensitiveString(*o.CardNumber, runtime.SensitiveDataConfig{
+				Type:        runtime.MaskTypePartial,
+				Replacement: "",
+				Pattern:     "",
+				Algorithm:   "",
+				KeepPrefix:  0,
+				KeepSuffix:  4,
+			}))
+		} else {
+			enc.String(*o.CardNumber)
+		}
+	}
+	enc.EndObject()
+}
+
+type OrderItem struct {
+	Sku      string  `json:"sku" validate:"required"`
+	Quantity int32   `json:"quantity" validate:"required"`
+	Price    float32 `json:"price" validate:"required"`
+}
+
+func (o OrderItem) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(o))
+}
+
+// MarshalJSON encodes the fields of OrderItem one by one, without reflection.
+func (o OrderItem) MarshalJSON() ([]byte, error) {
+	enc := runtime.NewJSONEncoder(66)
+	o.writeJSON(enc, false)
+	return enc.Bytes()
+}
+
+// writeJSON writes OrderItem as a JSON object, with the sensitive fields masked if masked is true.
+func (o OrderItem) writeJSON(enc *runtime.JSONEncoder, masked bool) {
+	enc.BeginObject()
+
+	enc.Key("sku")
+	enc.String(o.Sku)
+
+	enc.Key("quantity")
+	enc.Int(int64(o.Quantity))
+
+	enc.Key("price")
+	enc.Float(float64(o.Price), 32)
+
+	enc.EndObject()
+}
+
+type Address struct {
+	City    *string `json:"city,omitempty"`
+	Country *string `json:"country,omitempty"`
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
@@ -0,0 +1,109 @@
+package xgofastjson
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// reflectOrder has the fields of Order without its MarshalJSON method, encoded by reflection.
+type reflectOrder Order
+
+func newOrder() Order {
+	discount := 2.5
+	note := `leave at the door <"back">`
+	shipping := Address{City: ptr("Paris"), Country: ptr("FR")}
+	created := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+	card := "4242424242424242"
+	return Order{
+		ID:         42,
+		Total:      99.99,
+		Discount:   &discount,
+		Status:     Shipped,
+		Note:       &note,
+		Paid:       true,
+		Items:      []OrderItem{{Sku: "A-1", Quantity: 2, Price: 10.5}, {Sku: "B-2", Quantity: 1, Price: 78.99}},
+		Tags:       []string{"gift"},
+		Shipping:   &shipping,
+		CreatedAt:  &created,
+		CardNumber: &card,
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestOrder_MarshalJSON(t *testing.T) {
+	t.Run("same output as encoding/json", func(t *testing.T) {
+		order := newOrder()
+
+		expected, err := json.Marshal(reflectOrder(order))
+		require.NoError(t, err)
+		data, err := json.Marshal(order)
+		require.NoError(t, err)
+
+		assert.Equal(t, string(expected), string(data))
+	})
+
+	t.Run("omitted fields", func(t *testing.T) {
+		order := Order{ID: 1, Status: Pending}
+
+		expected, err := json.Marshal(reflectOrder(order))
+		require.NoError(t, err)
+		data, err := json.Marshal(order)
+		require.NoError(t, err)
+
+		assert.Equal(t, string(expected), string(data))
+		assert.JSONEq(t, `{"id": 1, "total": 0, "status": "pending", "paid": false, "items": null}`, string(data))
+	})
+
+	t.Run("masked", func(t *testing.T) {
+		order := newOrder()
+
+		expected, err := json.Marshal(reflectOrder(order.Masked()))
+		require.NoError(t, err)
+		data, err := order.MarshalJSONMasked()
+		require.NoError(t, err)
+
+		assert.Equal(t, string(expected), string(data))
+		assert.Contains(t, string(data), `"card_number":"********4242"`)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		order := newOrder()
+
+		data, err := json.Marshal(order)
+		require.NoError(t, err)
+		var decoded Order
+		require.NoError(t, json.Unmarshal(data, &decoded))
+
+		assert.Equal(t, order, decoded)
+	})
+}
+
+func BenchmarkOrder_MarshalJSON(b *testing.B) {
+	order := newOrder()
+
+	b.Run("generated", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			if _, err := order.MarshalJSON(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("reflection", func(b *testing.B) {
+		plain := reflectOrder(order)
+		b.ReportAllocs()
+		for b.Loop() {
+			if _, err := json.Marshal(plain); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
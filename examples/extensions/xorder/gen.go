@@ -0,0 +1,28 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package xorder
+
+import (
+	"time"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+type Client struct {
+	ID        *int       `json:"id,omitempty"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	Name      string     `json:"name" validate:"required"`
+	Notes     *string    `json:"notes,omitempty"`
+}
+
+func (c Client) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(c))
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
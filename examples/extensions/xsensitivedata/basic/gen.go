@@ -3,6 +3,7 @@
 package xsensitivedata
 
 import (
+	"encoding/json"
 	"log/slog"
 
 	"github.com/yorunikakeru4/oapi-codegen-dd/v3/pkg/runtime"
@@ -29,41 +30,45 @@ func (u User) Masked() User {
 	masked := u
 	if masked.Email != nil {
 		v := runtime.MaskSensitiveString(*masked.Email, runtime.SensitiveDataConfig{
-			Type:       runtime.MaskTypeFull,
-			Pattern:    "",
-			Algorithm:  "",
-			KeepPrefix: 0,
-			KeepSuffix: 0,
+			Type:        runtime.MaskTypeFull,
+			Replacement: "",
+			Pattern:     "",
+			Algorithm:   "",
+			KeepPrefix:  0,
+			KeepSuffix:  0,
 		})
 		masked.Email = &v
 	}
 	if masked.Ssn != nil {
 		v := runtime.MaskSensitiveString(*masked.Ssn, runtime.SensitiveDataConfig{
-			Type:       runtime.MaskTypeRegex,
-			Pattern:    "\\d{3}-\\d{2}-\\d{4}",
-			Algorithm:  "",
-			KeepPrefix: 0,
-			KeepSuffix: 0,
+			Type:        runtime.MaskTypeRegex,
+			Replacement: "",
+			Pattern:     "\\d{3}-\\d{2}-\\d{4}",
+			Algorithm:   "",
+			KeepPrefix:  0,
+			KeepSuffix:  0,
 		})
 		masked.Ssn = &v
 	}
 	if masked.CreditCard != nil {
 		v := runtime.MaskSensitiveString(*masked.CreditCard, runtime.SensitiveDataConfig{
-			Type:       runtime.MaskTypePartial,
-			Pattern:    "",
-			Algorithm:  "",
-			KeepPrefix: 0,
-			KeepSuffix: 4,
+			Type:        runtime.MaskTypePartial,
+			Replacement: "",
+			Pattern:     "",
+			Algorithm:   "",
+			KeepPrefix:  0,
+			KeepSuffix:  4,
 		})
 		masked.CreditCard = &v
 	}
 	if masked.APIKey != nil {
 		v := runtime.MaskSensitiveString(*masked.APIKey, runtime.SensitiveDataConfig{
-			Type:       runtime.MaskTypeHash,
-			Pattern:    "",
-			Algorithm:  "sha256",
-			KeepPrefix: 0,
-			KeepSuffix: 0,
+			Type:        runtime.MaskTypeHash,
+			Replacement: "",
+			Pattern:     "",
+			Algorithm:   "sha256",
+			KeepPrefix:  0,
+			KeepSuffix:  0,
 		})
 		masked.APIKey = &v
 	}
@@ -76,6 +81,22 @@ func (u User) LogValue() slog.Value {
 	return slog.AnyValue(plain(u.Masked()))
 }
 
+// MarshalJSONMasked returns the JSON encoding with sensitive fields masked.
+func (u User) MarshalJSONMasked() ([]byte, error) {
+	return json.Marshal(u.Masked())
+}
+
+// MarshalJSONUnmasked returns the JSON encoding with sensitive fields left intact.
+// Use it only in trusted contexts, e.g. internal audit logs.
+func (u User) MarshalJSONUnmasked() ([]byte, error) {
+	return json.Marshal(u)
+}
+
+// Redacted returns a log-safe JSON rendering of the struct, including nested types.
+func (u User) Redacted() string {
+	return runtime.Redact(u)
+}
+
 var typesValidator *validator.Validate
 
 func init() {
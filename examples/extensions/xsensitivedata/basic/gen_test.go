@@ -101,3 +101,16 @@ func TestUserUnmarshalJSON(t *testing.T) {
 	require.NotNil(t, user.Email)
 	assert.Equal(t, "user@example.com", *user.Email)
 }
+
+func TestUserMarshalJSONMaskedAndUnmasked(t *testing.T) {
+	ssn := "123-45-6789"
+	user := User{ID: 1, Username: "testuser", Ssn: &ssn}
+
+	masked, err := user.MarshalJSONMasked()
+	require.NoError(t, err)
+	assert.NotContains(t, string(masked), ssn)
+
+	unmasked, err := user.MarshalJSONUnmasked()
+	require.NoError(t, err)
+	assert.Contains(t, string(unmasked), ssn)
+}
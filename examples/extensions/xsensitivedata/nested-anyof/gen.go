@@ -133,7 +133,7 @@ func (p PaymentMethod) Validate() error {
 	if p.PaymentMethod_AnyOf != nil {
 		if v, ok := any(p.PaymentMethod_AnyOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("PaymentMethod_AnyOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -211,19 +211,21 @@ func (c CreditCardPayment) Validate() error {
 func (c CreditCardPayment) Masked() CreditCardPayment {
 	masked := c
 	masked.CardNumber = runtime.MaskSensitiveString(c.CardNumber, runtime.SensitiveDataConfig{
-		Type:       runtime.MaskTypePartial,
-		Pattern:    "",
-		Algorithm:  "",
-		KeepPrefix: 0,
-		KeepSuffix: 4,
+		Type:        runtime.MaskTypePartial,
+		Replacement: "",
+		Pattern:     "",
+		Algorithm:   "",
+		KeepPrefix:  0,
+		KeepSuffix:  4,
 	})
 	if masked.Cvv != nil {
 		v := runtime.MaskSensitiveString(*masked.Cvv, runtime.SensitiveDataConfig{
-			Type:       runtime.MaskTypeFull,
-			Pattern:    "",
-			Algorithm:  "",
-			KeepPrefix: 0,
-			KeepSuffix: 0,
+			Type:        runtime.MaskTypeFull,
+			Replacement: "",
+			Pattern:     "",
+			Algorithm:   "",
+			KeepPrefix:  0,
+			KeepSuffix:  0,
 		})
 		masked.Cvv = &v
 	}
@@ -236,6 +238,22 @@ func (c CreditCardPayment) LogValue() slog.Value {
 	return slog.AnyValue(plain(c.Masked()))
 }
 
+// MarshalJSONMasked returns the JSON encoding with sensitive fields masked.
+func (c CreditCardPayment) MarshalJSONMasked() ([]byte, error) {
+	return json.Marshal(c.Masked())
+}
+
+// MarshalJSONUnmasked returns the JSON encoding with sensitive fields left intact.
+// Use it only in trusted contexts, e.g. internal audit logs.
+func (c CreditCardPayment) MarshalJSONUnmasked() ([]byte, error) {
+	return json.Marshal(c)
+}
+
+// Redacted returns a log-safe JSON rendering of the struct, including nested types.
+func (c CreditCardPayment) Redacted() string {
+	return runtime.Redact(c)
+}
+
 type BankTransferPayment struct {
 	Type           BankTransferPaymentType            `json:"type" validate:"required"`
 	AccountDetails BankTransferPayment_AccountDetails `json:"accountDetails"`
@@ -268,7 +286,7 @@ func (b BankTransferPayment_AccountDetails) Validate() error {
 	if b.BankTransferPayment_AccountDetails_AnyOf != nil {
 		if v, ok := any(b.BankTransferPayment_AccountDetails_AnyOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("BankTransferPayment_AccountDetails_AnyOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -349,18 +367,20 @@ func (d DomesticAccount) Validate() error {
 func (d DomesticAccount) Masked() DomesticAccount {
 	masked := d
 	masked.RoutingNumber = runtime.MaskSensitiveString(d.RoutingNumber, runtime.SensitiveDataConfig{
-		Type:       runtime.MaskTypePartial,
-		Pattern:    "",
-		Algorithm:  "",
-		KeepPrefix: 2,
-		KeepSuffix: 2,
+		Type:        runtime.MaskTypePartial,
+		Replacement: "",
+		Pattern:     "",
+		Algorithm:   "",
+		KeepPrefix:  2,
+		KeepSuffix:  2,
 	})
 	masked.AccountNumber = runtime.MaskSensitiveString(d.AccountNumber, runtime.SensitiveDataConfig{
-		Type:       runtime.MaskTypePartial,
-		Pattern:    "",
-		Algorithm:  "",
-		KeepPrefix: 0,
-		KeepSuffix: 4,
+		Type:        runtime.MaskTypePartial,
+		Replacement: "",
+		Pattern:     "",
+		Algorithm:   "",
+		KeepPrefix:  0,
+		KeepSuffix:  4,
 	})
 	return masked
 }
@@ -371,6 +391,22 @@ func (d DomesticAccount) LogValue() slog.Value {
 	return slog.AnyValue(plain(d.Masked()))
 }
 
+// MarshalJSONMasked returns the JSON encoding with sensitive fields masked.
+func (d DomesticAccount) MarshalJSONMasked() ([]byte, error) {
+	return json.Marshal(d.Masked())
+}
+
+// MarshalJSONUnmasked returns the JSON encoding with sensitive fields left intact.
+// Use it only in trusted contexts, e.g. internal audit logs.
+func (d DomesticAccount) MarshalJSONUnmasked() ([]byte, error) {
+	return json.Marshal(d)
+}
+
+// Redacted returns a log-safe JSON rendering of the struct, including nested types.
+func (d DomesticAccount) Redacted() string {
+	return runtime.Redact(d)
+}
+
 type InternationalAccount struct {
 	AccountType        InternationalAccountAccountType          `json:"accountType" validate:"required"`
 	Iban               string                                   `json:"iban" sensitive:"" validate:"required"`
@@ -416,18 +452,20 @@ func (i InternationalAccount) Validate() error {
 func (i InternationalAccount) Masked() InternationalAccount {
 	masked := i
 	masked.Iban = runtime.MaskSensitiveString(i.Iban, runtime.SensitiveDataConfig{
-		Type:       runtime.MaskTypePartial,
-		Pattern:    "",
-		Algorithm:  "",
-		KeepPrefix: 4,
-		KeepSuffix: 4,
+		Type:        runtime.MaskTypePartial,
+		Replacement: "",
+		Pattern:     "",
+		Algorithm:   "",
+		KeepPrefix:  4,
+		KeepSuffix:  4,
 	})
 	masked.SwiftCode = runtime.MaskSensitiveString(i.SwiftCode, runtime.SensitiveDataConfig{
-		Type:       runtime.MaskTypeFull,
-		Pattern:    "",
-		Algorithm:  "",
-		KeepPrefix: 0,
-		KeepSuffix: 0,
+		Type:        runtime.MaskTypeFull,
+		Replacement: "",
+		Pattern:     "",
+		Algorithm:   "",
+		KeepPrefix:  0,
+		KeepSuffix:  0,
 	})
 	return masked
 }
@@ -438,6 +476,22 @@ func (i InternationalAccount) LogValue() slog.Value {
 	return slog.AnyValue(plain(i.Masked()))
 }
 
+// MarshalJSONMasked returns the JSON encoding with sensitive fields masked.
+func (i InternationalAccount) MarshalJSONMasked() ([]byte, error) {
+	return json.Marshal(i.Masked())
+}
+
+// MarshalJSONUnmasked returns the JSON encoding with sensitive fields left intact.
+// Use it only in trusted contexts, e.g. internal audit logs.
+func (i InternationalAccount) MarshalJSONUnmasked() ([]byte, error) {
+	return json.Marshal(i)
+}
+
+// Redacted returns a log-safe JSON rendering of the struct, including nested types.
+func (i InternationalAccount) Redacted() string {
+	return runtime.Redact(i)
+}
+
 type InternationalAccount_BeneficiaryDetails struct {
 	InternationalAccount_BeneficiaryDetails_AnyOf *InternationalAccount_BeneficiaryDetails_AnyOf `json:"-"`
 }
@@ -447,7 +501,7 @@ func (i InternationalAccount_BeneficiaryDetails) Validate() error {
 	if i.InternationalAccount_BeneficiaryDetails_AnyOf != nil {
 		if v, ok := any(i.InternationalAccount_BeneficiaryDetails_AnyOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("InternationalAccount_BeneficiaryDetails_AnyOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -528,31 +582,34 @@ func (p PersonalBeneficiary) Masked() PersonalBeneficiary {
 	masked := p
 	if masked.Ssn != nil {
 		v := runtime.MaskSensitiveString(*masked.Ssn, runtime.SensitiveDataConfig{
-			Type:       runtime.MaskTypeRegex,
-			Pattern:    "\\d",
-			Algorithm:  "",
-			KeepPrefix: 0,
-			KeepSuffix: 0,
+			Type:        runtime.MaskTypeRegex,
+			Replacement: "",
+			Pattern:     "\\d",
+			Algorithm:   "",
+			KeepPrefix:  0,
+			KeepSuffix:  0,
 		})
 		masked.Ssn = &v
 	}
 	if masked.Email != nil {
 		v := runtime.MaskSensitiveString(*masked.Email, runtime.SensitiveDataConfig{
-			Type:       runtime.MaskTypeFull,
-			Pattern:    "",
-			Algorithm:  "",
-			KeepPrefix: 0,
-			KeepSuffix: 0,
+			Type:        runtime.MaskTypeFull,
+			Replacement: "",
+			Pattern:     "",
+			Algorithm:   "",
+			KeepPrefix:  0,
+			KeepSuffix:  0,
 		})
 		masked.Email = &v
 	}
 	if masked.Phone != nil {
 		v := runtime.MaskSensitiveString(*masked.Phone, runtime.SensitiveDataConfig{
-			Type:       runtime.MaskTypePartial,
-			Pattern:    "",
-			Algorithm:  "",
-			KeepPrefix: 3,
-			KeepSuffix: 4,
+			Type:        runtime.MaskTypePartial,
+			Replacement: "",
+			Pattern:     "",
+			Algorithm:   "",
+			KeepPrefix:  3,
+			KeepSuffix:  4,
 		})
 		masked.Phone = &v
 	}
@@ -565,6 +622,22 @@ func (p PersonalBeneficiary) LogValue() slog.Value {
 	return slog.AnyValue(plain(p.Masked()))
 }
 
+// MarshalJSONMasked returns the JSON encoding with sensitive fields masked.
+func (p PersonalBeneficiary) MarshalJSONMasked() ([]byte, error) {
+	return json.Marshal(p.Masked())
+}
+
+// MarshalJSONUnmasked returns the JSON encoding with sensitive fields left intact.
+// Use it only in trusted contexts, e.g. internal audit logs.
+func (p PersonalBeneficiary) MarshalJSONUnmasked() ([]byte, error) {
+	return json.Marshal(p)
+}
+
+// Redacted returns a log-safe JSON rendering of the struct, including nested types.
+func (p PersonalBeneficiary) Redacted() string {
+	return runtime.Redact(p)
+}
+
 type BusinessBeneficiary struct {
 	BeneficiaryType BusinessBeneficiaryBeneficiaryType `json:"beneficiaryType" validate:"required"`
 	CompanyName     string                             `json:"companyName" validate:"required"`
@@ -592,11 +665,12 @@ func (b BusinessBeneficiary) Masked() BusinessBeneficiary {
 	masked := b
 	if masked.TaxID != nil {
 		v := runtime.MaskSensitiveString(*masked.TaxID, runtime.SensitiveDataConfig{
-			Type:       runtime.MaskTypeHash,
-			Pattern:    "",
-			Algorithm:  "sha256",
-			KeepPrefix: 0,
-			KeepSuffix: 0,
+			Type:        runtime.MaskTypeHash,
+			Replacement: "",
+			Pattern:     "",
+			Algorithm:   "sha256",
+			KeepPrefix:  0,
+			KeepSuffix:  0,
 		})
 		masked.TaxID = &v
 	}
@@ -609,6 +683,22 @@ func (b BusinessBeneficiary) LogValue() slog.Value {
 	return slog.AnyValue(plain(b.Masked()))
 }
 
+// MarshalJSONMasked returns the JSON encoding with sensitive fields masked.
+func (b BusinessBeneficiary) MarshalJSONMasked() ([]byte, error) {
+	return json.Marshal(b.Masked())
+}
+
+// MarshalJSONUnmasked returns the JSON encoding with sensitive fields left intact.
+// Use it only in trusted contexts, e.g. internal audit logs.
+func (b BusinessBeneficiary) MarshalJSONUnmasked() ([]byte, error) {
+	return json.Marshal(b)
+}
+
+// Redacted returns a log-safe JSON rendering of the struct, including nested types.
+func (b BusinessBeneficiary) Redacted() string {
+	return runtime.Redact(b)
+}
+
 type DigitalWalletPayment struct {
 	Type     DigitalWalletPaymentType `json:"type" validate:"required"`
 	WalletID string                   `json:"walletId" sensitive:"" validate:"required"`
@@ -634,11 +724,12 @@ func (d DigitalWalletPayment) Validate() error {
 func (d DigitalWalletPayment) Masked() DigitalWalletPayment {
 	masked := d
 	masked.WalletID = runtime.MaskSensitiveString(d.WalletID, runtime.SensitiveDataConfig{
-		Type:       runtime.MaskTypeHash,
-		Pattern:    "",
-		Algorithm:  "sha256",
-		KeepPrefix: 0,
-		KeepSuffix: 0,
+		Type:        runtime.MaskTypeHash,
+		Replacement: "",
+		Pattern:     "",
+		Algorithm:   "sha256",
+		KeepPrefix:  0,
+		KeepSuffix:  0,
 	})
 	return masked
 }
@@ -649,6 +740,22 @@ func (d DigitalWalletPayment) LogValue() slog.Value {
 	return slog.AnyValue(plain(d.Masked()))
 }
 
+// MarshalJSONMasked returns the JSON encoding with sensitive fields masked.
+func (d DigitalWalletPayment) MarshalJSONMasked() ([]byte, error) {
+	return json.Marshal(d.Masked())
+}
+
+// MarshalJSONUnmasked returns the JSON encoding with sensitive fields left intact.
+// Use it only in trusted contexts, e.g. internal audit logs.
+func (d DigitalWalletPayment) MarshalJSONUnmasked() ([]byte, error) {
+	return json.Marshal(d)
+}
+
+// Redacted returns a log-safe JSON rendering of the struct, including nested types.
+func (d DigitalWalletPayment) Redacted() string {
+	return runtime.Redact(d)
+}
+
 type Address struct {
 	Street  *string `json:"street,omitempty"`
 	City    *string `json:"city,omitempty"`
@@ -665,11 +772,12 @@ func (a AccountHolder) Masked() AccountHolder {
 	masked := a
 	if masked.Email != nil {
 		v := runtime.MaskSensitiveString(*masked.Email, runtime.SensitiveDataConfig{
-			Type:       runtime.MaskTypeFull,
-			Pattern:    "",
-			Algorithm:  "",
-			KeepPrefix: 0,
-			KeepSuffix: 0,
+			Type:        runtime.MaskTypeFull,
+			Replacement: "",
+			Pattern:     "",
+			Algorithm:   "",
+			KeepPrefix:  0,
+			KeepSuffix:  0,
 		})
 		masked.Email = &v
 	}
@@ -682,6 +790,22 @@ func (a AccountHolder) LogValue() slog.Value {
 	return slog.AnyValue(plain(a.Masked()))
 }
 
+// MarshalJSONMasked returns the JSON encoding with sensitive fields masked.
+func (a AccountHolder) MarshalJSONMasked() ([]byte, error) {
+	return json.Marshal(a.Masked())
+}
+
+// MarshalJSONUnmasked returns the JSON encoding with sensitive fields left intact.
+// Use it only in trusted contexts, e.g. internal audit logs.
+func (a AccountHolder) MarshalJSONUnmasked() ([]byte, error) {
+	return json.Marshal(a)
+}
+
+// Redacted returns a log-safe JSON rendering of the struct, including nested types.
+func (a AccountHolder) Redacted() string {
+	return runtime.Redact(a)
+}
+
 type PaymentMethod_AnyOf struct {
 	union json.RawMessage
 }
@@ -842,6 +966,26 @@ func (b *BankTransferPayment_AccountDetails_AnyOf) Validate() error {
 	return nil
 }
 
+// AsDomesticAccount returns the DomesticAccount inside the BankTransferPayment_AccountDetails_AnyOf and whether it holds one
+func (b *BankTransferPayment_AccountDetails_AnyOf) AsDomesticAccount() (DomesticAccount, bool) {
+	return b.A, b.IsA()
+}
+
+// AsInternationalAccount returns the InternationalAccount inside the BankTransferPayment_AccountDetails_AnyOf and whether it holds one
+func (b *BankTransferPayment_AccountDetails_AnyOf) AsInternationalAccount() (InternationalAccount, bool) {
+	return b.B, b.IsB()
+}
+
+// Match calls the function matching the variant held by the BankTransferPayment_AccountDetails_AnyOf, none for an empty union
+func (b *BankTransferPayment_AccountDetails_AnyOf) Match(onA func(DomesticAccount), onB func(InternationalAccount)) {
+	switch {
+	case b.IsA():
+		onA(b.A)
+	case b.IsB():
+		onB(b.B)
+	}
+}
+
 type InternationalAccount_BeneficiaryDetails_AnyOf struct {
 	runtime.Either[PersonalBeneficiary, BusinessBeneficiary]
 }
@@ -860,6 +1004,26 @@ func (i *InternationalAccount_BeneficiaryDetails_AnyOf) Validate() error {
 	return nil
 }
 
+// AsPersonalBeneficiary returns the PersonalBeneficiary inside the InternationalAccount_BeneficiaryDetails_AnyOf and whether it holds one
+func (i *InternationalAccount_BeneficiaryDetails_AnyOf) AsPersonalBeneficiary() (PersonalBeneficiary, bool) {
+	return i.A, i.IsA()
+}
+
+// AsBusinessBeneficiary returns the BusinessBeneficiary inside the InternationalAccount_BeneficiaryDetails_AnyOf and whether it holds one
+func (i *InternationalAccount_BeneficiaryDetails_AnyOf) AsBusinessBeneficiary() (BusinessBeneficiary, bool) {
+	return i.B, i.IsB()
+}
+
+// Match calls the function matching the variant held by the InternationalAccount_BeneficiaryDetails_AnyOf, none for an empty union
+func (i *InternationalAccount_BeneficiaryDetails_AnyOf) Match(onA func(PersonalBeneficiary), onB func(BusinessBeneficiary)) {
+	switch {
+	case i.IsA():
+		onA(i.A)
+	case i.IsB():
+		onB(i.B)
+	}
+}
+
 var typesValidator *validator.Validate
 
 func init() {
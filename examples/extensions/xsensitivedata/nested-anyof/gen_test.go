@@ -443,3 +443,110 @@ func TestNestedAnyOfWithSensitiveData(t *testing.T) {
 		assert.Equal(t, "Acme Corp", businessResult.CompanyName)
 	})
 }
+
+func TestDomesticAccountRedacted(t *testing.T) {
+	name := "John Doe"
+	email := "john@example.com"
+
+	account := DomesticAccount{
+		AccountType:   Domestic,
+		RoutingNumber: "021000021",
+		AccountNumber: "123456789",
+		AccountHolder: &AccountHolder{Name: &name, Email: &email},
+	}
+
+	redacted := account.Redacted()
+
+	// Sensitive fields are masked at every level of the object graph
+	assert.NotContains(t, redacted, "021000021")
+	assert.NotContains(t, redacted, "123456789")
+	assert.NotContains(t, redacted, email)
+
+	// Non-sensitive fields are rendered as-is
+	assert.Contains(t, redacted, `"name":"John Doe"`)
+
+	// The original value is not modified
+	assert.Equal(t, email, *account.AccountHolder.Email)
+}
+
+func TestEitherAccessors(t *testing.T) {
+	domestic := DomesticAccount{
+		AccountType:   Domestic,
+		RoutingNumber: "021000021",
+		AccountNumber: "123456789",
+	}
+	details := &BankTransferPayment_AccountDetails_AnyOf{
+		Either: runtime.NewEitherFromA[DomesticAccount, InternationalAccount](domestic),
+	}
+
+	t.Run("As", func(t *testing.T) {
+		account, ok := details.AsDomesticAccount()
+		require.True(t, ok)
+		assert.Equal(t, "021000021", account.RoutingNumber)
+
+		_, ok = details.AsInternationalAccount()
+		assert.False(t, ok)
+	})
+
+	t.Run("Match", func(t *testing.T) {
+		var matched string
+		details.Match(
+			func(a DomesticAccount) { matched = "domestic:" + a.AccountNumber },
+			func(a InternationalAccount) { matched = "international:" + a.Iban },
+		)
+		assert.Equal(t, "domestic:123456789", matched)
+
+		international := &BankTransferPayment_AccountDetails_AnyOf{
+			Either: runtime.NewEitherFromB[DomesticAccount, InternationalAccount](InternationalAccount{Iban: "DE89370400440532013000"}),
+		}
+		international.Match(
+			func(a DomesticAccount) { matched = "domestic:" + a.AccountNumber },
+			func(a InternationalAccount) { matched = "international:" + a.Iban },
+		)
+		assert.Equal(t, "international:DE89370400440532013000", matched)
+	})
+
+	t.Run("Match on empty union", func(t *testing.T) {
+		var empty BankTransferPayment_AccountDetails_AnyOf
+		empty.Match(
+			func(DomesticAccount) { t.Fatal("unexpected DomesticAccount") },
+			func(InternationalAccount) { t.Fatal("unexpected InternationalAccount") },
+		)
+	})
+}
+
+func TestNestedUnionValidationPath(t *testing.T) {
+	payment := BankTransferPayment{
+		Type: BankTransfer,
+		AccountDetails: BankTransferPayment_AccountDetails{
+			BankTransferPayment_AccountDetails_AnyOf: &BankTransferPayment_AccountDetails_AnyOf{
+				Either: runtime.NewEitherFromB[DomesticAccount, InternationalAccount](InternationalAccount{
+					AccountType: International,
+					Iban:        "DE89370400440532013000",
+					SwiftCode:   "COBADEFFXXX",
+					BeneficiaryDetails: &InternationalAccount_BeneficiaryDetails{
+						InternationalAccount_BeneficiaryDetails_AnyOf: &InternationalAccount_BeneficiaryDetails_AnyOf{
+							// FullName is required
+							Either: runtime.NewEitherFromA[PersonalBeneficiary, BusinessBeneficiary](PersonalBeneficiary{
+								BeneficiaryType: Personal,
+							}),
+						},
+					},
+				}),
+			},
+		},
+	}
+
+	err := payment.Validate()
+	require.Error(t, err)
+
+	var validationErrors runtime.ValidationErrors
+	require.ErrorAs(t, err, &validationErrors)
+	require.Len(t, validationErrors, 1)
+	assert.Equal(t, "AccountDetails.BeneficiaryDetails.FullName", validationErrors[0].Field)
+	assert.Equal(t, "is required", validationErrors[0].Message)
+
+	// Only the active branch is validated
+	var empty InternationalAccount_BeneficiaryDetails_AnyOf
+	assert.NoError(t, empty.Validate())
+}
@@ -0,0 +1,188 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package mapconverters
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/yorunikakeru4/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+type Status string
+
+const (
+	Active   Status = "active"
+	Disabled Status = "disabled"
+)
+
+// Validate checks if the Status value is valid
+func (s Status) Validate() error {
+	switch s {
+	case Active, Disabled:
+		return nil
+	default:
+		return runtime.NewValidationErrorsFromString("Enum", fmt.Sprintf("must be a valid Status value, got: %v", s))
+	}
+}
+
+type Address struct {
+	City *string `json:"city,omitempty"`
+	Zip  *string `json:"zip,omitempty" sensitive:""`
+}
+
+// Masked returns a copy of the struct with sensitive fields masked.
+func (a Address) Masked() Address {
+	masked := a
+	if masked.Zip != nil {
+		v := runtime.MaskSensitiveString(*masked.Zip, runtime.SensitiveDataConfig{
+			Type:        runtime.MaskTypeFull,
+			Replacement: "",
+			Pattern:     "",
+			Algorithm:   "",
+			KeepPrefix:  0,
+			KeepSuffix:  0,
+		})
+		masked.Zip = &v
+	}
+	return masked
+}
+
+// LogValue implements slog.LogValuer interface for structured logging.
+func (a Address) LogValue() slog.Value {
+	type plain Address
+	return slog.AnyValue(plain(a.Masked()))
+}
+
+// MarshalJSONMasked returns the JSON encoding with sensitive fields masked.
+func (a Address) MarshalJSONMasked() ([]byte, error) {
+	return json.Marshal(a.Masked())
+}
+
+// MarshalJSONUnmasked returns the JSON encoding with sensitive fields left intact.
+// Use it only in trusted contexts, e.g. internal audit logs.
+func (a Address) MarshalJSONUnmasked() ([]byte, error) {
+	return json.Marshal(a)
+}
+
+// Redacted returns a log-safe JSON rendering of the struct, including nested types.
+func (a Address) Redacted() string {
+	return runtime.Redact(a)
+}
+
+// ToMap returns the JSON object of Address as a map, with sensitive fields masked.
+// It returns nil if the value can't be encoded.
+func (a Address) ToMap() map[string]any {
+	res, _ := runtime.AsRedactedMap(a)
+	return res
+}
+
+// ToMapUnmasked returns the JSON object of Address as a map, with sensitive fields left intact.
+// It returns nil if the value can't be encoded.
+func (a Address) ToMapUnmasked() map[string]any {
+	res, _ := runtime.AsMap[any](a)
+	return res
+}
+
+// FromMap sets Address from its JSON object as a map.
+func (a *Address) FromMap(values map[string]any) error {
+	return runtime.FromMap(values, a)
+}
+
+type User struct {
+	ID      int      `json:"id" validate:"required"`
+	Status  Status   `json:"status" validate:"required"`
+	Email   *string  `json:"email,omitempty" sensitive:""`
+	Address *Address `json:"address,omitempty"`
+	Tags    []string `json:"tags,omitempty"`
+}
+
+func (u User) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(u.ID, "required"); err != nil {
+		errors = errors.Append("ID", err)
+	}
+	if v, ok := any(u.Status).(runtime.Validator); ok {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("Status", err)
+		}
+	}
+	if u.Address != nil {
+		if v, ok := any(u.Address).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Address", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+// Masked returns a copy of the struct with sensitive fields masked.
+func (u User) Masked() User {
+	masked := u
+	if masked.Email != nil {
+		v := runtime.MaskSensitiveString(*masked.Email, runtime.SensitiveDataConfig{
+			Type:        runtime.MaskTypePartial,
+			Replacement: "",
+			Pattern:     "",
+			Algorithm:   "",
+			KeepPrefix:  0,
+			KeepSuffix:  4,
+		})
+		masked.Email = &v
+	}
+	return masked
+}
+
+// LogValue implements slog.LogValuer interface for structured logging.
+func (u User) LogValue() slog.Value {
+	type plain User
+	return slog.AnyValue(plain(u.Masked()))
+}
+
+// MarshalJSONMasked returns the JSON encoding with sensitive fields masked.
+func (u User) MarshalJSONMasked() ([]byte, error) {
+	return json.Marshal(u.Masked())
+}
+
+// MarshalJSONUnmasked returns the JSON encoding with sensitive fields left intact.
+// Use it only in trusted contexts, e.g. internal audit logs.
+func (u User) MarshalJSONUnmasked() ([]byte, error) {
+	return json.Marshal(u)
+}
+
+// Redacted returns a log-safe JSON rendering of the struct, including nested types.
+func (u User) Redacted() string {
+	return runtime.Redact(u)
+}
+
+// ToMap returns the JSON object of User as a map, with sensitive fields masked.
+// It returns nil if the value can't be encoded.
+func (u User) ToMap() map[string]any {
+	res, _ := runtime.AsRedactedMap(u)
+	return res
+}
+
+// ToMapUnmasked returns the JSON object of User as a map, with sensitive fields left intact.
+// It returns nil if the value can't be encoded.
+func (u User) ToMapUnmasked() map[string]any {
+	res, _ := runtime.AsMap[any](u)
+	return res
+}
+
+// FromMap sets User from its JSON object as a map.
+func (u *User) FromMap(values map[string]any) error {
+	return runtime.FromMap(values, u)
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
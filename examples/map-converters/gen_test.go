@@ -0,0 +1,53 @@
+package mapconverters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func newUser() User {
+	return User{
+		ID:      1,
+		Status:  Active,
+		Email:   ptr("john@example.com"),
+		Address: &Address{City: ptr("NYC"), Zip: ptr("10001")},
+		Tags:    []string{"admin"},
+	}
+}
+
+func TestToMap(t *testing.T) {
+	user := newUser()
+
+	m := user.ToMap()
+	assert.Equal(t, float64(1), m["id"])
+	assert.Equal(t, "active", m["status"])
+	assert.Equal(t, "********.com", m["email"])
+	assert.Equal(t, map[string]any{"city": "NYC", "zip": "********"}, m["address"])
+	assert.Equal(t, []any{"admin"}, m["tags"])
+
+	// The value itself is left untouched.
+	assert.Equal(t, "10001", *user.Address.Zip)
+}
+
+func TestToMapUnmasked(t *testing.T) {
+	m := newUser().ToMapUnmasked()
+	assert.Equal(t, "john@example.com", m["email"])
+	assert.Equal(t, map[string]any{"city": "NYC", "zip": "10001"}, m["address"])
+}
+
+func TestFromMap(t *testing.T) {
+	user := newUser()
+
+	var res User
+	require.NoError(t, res.FromMap(user.ToMapUnmasked()))
+	assert.Equal(t, user, res)
+
+	var invalid User
+	assert.Error(t, invalid.FromMap(map[string]any{"id": "one"}))
+}
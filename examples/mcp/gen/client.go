@@ -44,7 +44,7 @@ type ClientInterface interface {
 	GetUser(ctx context.Context, options *GetUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetUserResponse, error)
 
 	// DeleteUser Delete a user
-	DeleteUser(ctx context.Context, options *DeleteUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*struct{}, error)
+	DeleteUser(ctx context.Context, options *DeleteUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*runtime.NoContent, error)
 
 	// GetMetrics Internal metrics endpoint
 	GetMetrics(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*GetMetricsResponse, error)
@@ -209,7 +209,7 @@ func (c *Client) GetUser(ctx context.Context, options *GetUserRequestOptions, re
 }
 
 // DeleteUser Delete a user
-func (c *Client) DeleteUser(ctx context.Context, options *DeleteUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*struct{}, error) {
+func (c *Client) DeleteUser(ctx context.Context, options *DeleteUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*runtime.NoContent, error) {
 	var err error
 	reqParams := runtime.RequestOptionsParameters{
 		RequestURL: c.apiClient.GetBaseURL() + "/users/{id}",
@@ -222,12 +222,12 @@ func (c *Client) DeleteUser(ctx context.Context, options *DeleteUserRequestOptio
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
 
-	responseParser := func(ctx context.Context, resp *runtime.Response) (*struct{}, error) {
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*runtime.NoContent, error) {
 		if resp.StatusCode != 204 {
 			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
 				runtime.WithStatusCode(resp.StatusCode))
 		}
-		return nil, nil
+		return &runtime.NoContent{StatusCode: resp.StatusCode, Headers: resp.Headers}, nil
 	}
 
 	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/users/{id}")
@@ -271,4 +271,12 @@ func (c *Client) GetMetrics(ctx context.Context, reqEditors ...runtime.RequestEd
 	return responseParser(ctx, resp)
 }
 
+// Ping sends a GET /health request, the health check of the API, without parameters nor body.
+// It returns an error if the response status isn't 2xx.
+func (c *Client) Ping(ctx context.Context, reqEditors ...runtime.RequestEditorFn) error {
+	return runtime.Ping(ctx, c.apiClient, "GET", "/health", reqEditors...)
+}
+
+var _ runtime.Pinger = (*Client)(nil)
+
 var _ ClientInterface = (*Client)(nil)
@@ -41,6 +41,19 @@ func WithClient(client ClientInterface) MCPToolsOption {
 	}
 }
 
+// mcpExample seeds a tool argument with the JSON example from the spec,
+// so the caller has a concrete sample of the expected value.
+func mcpExample(example string) mcp.PropertyOption {
+	return func(schema map[string]any) {
+		var v any
+		if err := json.Unmarshal([]byte(example), &v); err != nil {
+			return
+		}
+		schema["default"] = v
+		schema["examples"] = []any{v}
+	}
+}
+
 func (t *MCPTools) registerAll() {
 	t.registerHealthCheck()
 	t.registerListUsers()
@@ -88,7 +101,7 @@ func (t *MCPTools) handleListUsers(ctx context.Context, req mcp.CallToolRequest)
 func (t *MCPTools) registerCreateUser() {
 	tool := mcp.NewTool("CreateUser",
 		mcp.WithDescription("Create a new user"),
-		mcp.WithObject("body", mcp.Description("Request body")),
+		mcp.WithObject("body", mcp.Description("Request body"), mcpExample("{\"email\":\"alice@example.com\",\"name\":\"Alice\"}")),
 	)
 	t.server.AddTool(tool, t.handleCreateUser)
 }
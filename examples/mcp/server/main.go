@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"log"
+	"net/http"
 
 	"github.com/doordash-oss/oapi-codegen-dd/v3/examples/mcp/gen"
 	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
@@ -70,8 +71,8 @@ func (m *mockClient) GetUser(ctx context.Context, opts *gen.GetUserRequestOption
 	return &gen.GetUserResponse{ID: opts.PathParams.ID, Name: "Unknown", Email: "unknown@example.com"}, nil
 }
 
-func (m *mockClient) DeleteUser(ctx context.Context, opts *gen.DeleteUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*struct{}, error) {
-	return nil, nil
+func (m *mockClient) DeleteUser(ctx context.Context, opts *gen.DeleteUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*runtime.NoContent, error) {
+	return &runtime.NoContent{StatusCode: http.StatusNoContent}, nil
 }
 
 func (m *mockClient) GetMetrics(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*gen.GetMetricsResponse, error) {
@@ -133,6 +133,45 @@ func TestGetUser(t *testing.T) {
 	assert.Equal(t, "Alice", user["name"])
 }
 
+func (c *mcpClient) listTools(t *testing.T) map[string]any {
+	req := map[string]any{
+		"jsonrpc": "2.0",
+		"id":      c.nextID,
+		"method":  "tools/list",
+	}
+	c.nextID++
+
+	require.NoError(t, c.stdin.Encode(req))
+
+	line, err := c.stdout.ReadBytes('\n')
+	require.NoError(t, err)
+
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(line, &resp))
+	return resp
+}
+
+func TestCreateUserToolExample(t *testing.T) {
+	client := startMCPServer(t)
+
+	resp := client.listTools(t)
+
+	result := resp["result"].(map[string]any)
+	var body map[string]any
+	for _, tool := range result["tools"].([]any) {
+		tool := tool.(map[string]any)
+		if tool["name"] == "CreateUser" {
+			props := tool["inputSchema"].(map[string]any)["properties"].(map[string]any)
+			body = props["body"].(map[string]any)
+		}
+	}
+	require.NotNil(t, body)
+
+	example := map[string]any{"name": "Alice", "email": "alice@example.com"}
+	assert.Equal(t, example, body["default"])
+	assert.Equal(t, []any{example}, body["examples"])
+}
+
 func TestCreateUser(t *testing.T) {
 	client := startMCPServer(t)
 
@@ -0,0 +1,222 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package generic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/yorunikakeru4/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	// UpdateUser Update a user, null clears a field and a missing field is left unchanged
+	UpdateUser(ctx context.Context, options *UpdateUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*UpdateUserResponse, error)
+}
+
+// UpdateUser Update a user, null clears a field and a missing field is left unchanged
+func (c *Client) UpdateUser(ctx context.Context, options *UpdateUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*UpdateUserResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/users/{id}",
+		Method:      "PATCH",
+		Options:     options,
+		ContentType: "application/json",
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*UpdateUserResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(UpdateUserResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			err = fmt.Errorf("error decoding response: %w", err)
+			return nil, err
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/users/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// UpdateUserRequestOptions is the options needed to make a request to UpdateUser.
+type UpdateUserRequestOptions struct {
+	PathParams *UpdateUserPath
+	Body       *UpdateUserBody
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *UpdateUserRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *UpdateUserRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *UpdateUserRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *UpdateUserRequestOptions) GetBody() any {
+	return o.Body
+}
+
+// GetHeader returns the headers as a map.
+func (o *UpdateUserRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type UpdateUserPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (u UpdateUserPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(u))
+}
+
+type UpdateUserBody = UserPatch
+
+type UpdateUserResponse = User
+
+type UserPatch struct {
+	Name     runtime.Optional[string]  `json:"name,omitzero"`
+	Nickname runtime.Optional[string]  `json:"nickname,omitzero"`
+	Age      runtime.Optional[int]     `json:"age,omitzero"`
+	Address  runtime.Optional[Address] `json:"address,omitzero"`
+}
+
+func (u UserPatch) Validate() error {
+	var errors runtime.ValidationErrors
+	if v, ok := u.Name.Get(); ok {
+		if err := typesValidator.Var(v, "omitempty,max=20"); err != nil {
+			errors = errors.Append("Name", err)
+		}
+	}
+	if v, ok := u.Age.Get(); ok {
+		if err := typesValidator.Var(v, "omitempty,gte=0"); err != nil {
+			errors = errors.Append("Age", err)
+		}
+	}
+	if v, ok := any(u.Address).(runtime.Validator); ok && v != nil {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("Address", err)
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type User struct {
+	ID       string                    `json:"id" validate:"required"`
+	Name     runtime.Optional[string]  `json:"name,omitzero"`
+	Nickname runtime.Optional[string]  `json:"nickname,omitzero"`
+	Age      runtime.Optional[int]     `json:"age,omitzero"`
+	Address  runtime.Optional[Address] `json:"address,omitzero"`
+}
+
+func (u User) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(u.ID, "required"); err != nil {
+		errors = errors.Append("ID", err)
+	}
+	if v, ok := any(u.Address).(runtime.Validator); ok && v != nil {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("Address", err)
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type Address struct {
+	City string                   `json:"city" validate:"required"`
+	Zip  runtime.Optional[string] `json:"zip,omitzero"`
+}
+
+func (a Address) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(a.City, "required"); err != nil {
+		errors = errors.Append("City", err)
+	}
+	if v, ok := a.Zip.Get(); ok {
+		if err := typesValidator.Var(v, "omitempty,max=5"); err != nil {
+			errors = errors.Append("Zip", err)
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
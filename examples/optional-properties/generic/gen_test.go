@@ -0,0 +1,95 @@
+package generic_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/yorunikakeru4/oapi-codegen-dd/v3/examples/optional-properties/generic"
+	"github.com/yorunikakeru4/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserPatch_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "absent", data: `{}`},
+		{name: "null", data: `{"nickname":null,"address":null}`},
+		{name: "value", data: `{"name":"Jane","nickname":"jj","age":0,"address":{"city":"Paris"}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var patch generic.UserPatch
+			require.NoError(t, json.Unmarshal([]byte(tt.data), &patch))
+			require.NoError(t, patch.Validate())
+
+			res, err := json.Marshal(patch)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.data, string(res))
+		})
+	}
+}
+
+func TestUserPatch_AbsentVsNull(t *testing.T) {
+	var patch generic.UserPatch
+	require.NoError(t, json.Unmarshal([]byte(`{"nickname":null,"age":30}`), &patch))
+
+	assert.True(t, patch.Nickname.IsNull())
+	assert.False(t, patch.Name.Present())
+
+	age, ok := patch.Age.Get()
+	assert.True(t, ok)
+	assert.Equal(t, 30, age)
+}
+
+func TestUserPatch_Validate(t *testing.T) {
+	patch := generic.UserPatch{
+		Age:     runtime.NewOptional(-1),
+		Address: runtime.NewOptional(generic.Address{City: "Paris", Zip: runtime.NewOptional("1234567")}),
+	}
+
+	err := patch.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Age")
+	assert.Contains(t, err.Error(), "Address.Zip")
+
+	// null values are not validated
+	patch.Age.SetNull()
+	patch.Address.SetNull()
+	assert.NoError(t, patch.Validate())
+}
+
+func TestClient_SendsOnlyPresentFields(t *testing.T) {
+	var received string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received = string(body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"1","name":"Jane"}`))
+	}))
+	defer server.Close()
+
+	client, err := generic.NewDefaultClient(server.URL, runtime.WithHTTPClient(runtime.HTTPClientDoer{Client: server.Client()}))
+	require.NoError(t, err)
+
+	body := generic.UserPatch{Name: runtime.NewOptional("Jane")}
+	body.Nickname.SetNull()
+
+	resp, err := client.UpdateUser(context.Background(), &generic.UpdateUserRequestOptions{
+		PathParams: &generic.UpdateUserPath{ID: "1"},
+		Body:       &body,
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"Jane","nickname":null}`, received)
+
+	name, _ := resp.Name.Get()
+	assert.Equal(t, "Jane", name)
+	assert.False(t, resp.Age.Present())
+}
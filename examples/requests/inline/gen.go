@@ -49,7 +49,7 @@ func (p ProcessPaymentBody_C) Validate() error {
 	if p.ProcessPaymentBody_C_OneOf != nil {
 		if v, ok := any(p.ProcessPaymentBody_C_OneOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("ProcessPaymentBody_C_OneOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -102,7 +102,7 @@ func (p ProcessPaymentBody_D) Validate() error {
 	if p.ProcessPaymentBody_D_AllOf0 != nil {
 		if v, ok := any(p.ProcessPaymentBody_D_AllOf0).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("ProcessPaymentBody_D_AllOf0", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -155,7 +155,7 @@ func (p ProcessPaymentBody_D_AllOf0) Validate() error {
 	if p.ProcessPaymentBody_D_AllOf0_OneOf != nil {
 		if v, ok := any(p.ProcessPaymentBody_D_AllOf0_OneOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("ProcessPaymentBody_D_AllOf0_OneOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -208,7 +208,7 @@ func (p ProcessPaymentBody_D_AllOf0_OneOf_0) Validate() error {
 	if p.ProcessPaymentBody_D_AllOf0_OneOf_0_AnyOf != nil {
 		if v, ok := any(p.ProcessPaymentBody_D_AllOf0_OneOf_0_AnyOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("ProcessPaymentBody_D_AllOf0_OneOf_0_AnyOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -272,6 +272,26 @@ func (p *ProcessPaymentBody_C_OneOf) Validate() error {
 	return nil
 }
 
+// AsString returns the string inside the ProcessPaymentBody_C_OneOf and whether it holds one
+func (p *ProcessPaymentBody_C_OneOf) AsString() (string, bool) {
+	return p.A, p.IsA()
+}
+
+// AsBool returns the bool inside the ProcessPaymentBody_C_OneOf and whether it holds one
+func (p *ProcessPaymentBody_C_OneOf) AsBool() (bool, bool) {
+	return p.B, p.IsB()
+}
+
+// Match calls the function matching the variant held by the ProcessPaymentBody_C_OneOf, none for an empty union
+func (p *ProcessPaymentBody_C_OneOf) Match(onA func(string), onB func(bool)) {
+	switch {
+	case p.IsA():
+		onA(p.A)
+	case p.IsB():
+		onB(p.B)
+	}
+}
+
 type ProcessPaymentBody_D_AllOf0_OneOf struct {
 	runtime.Either[ProcessPaymentBody_D_AllOf0_OneOf_0, ProcessPaymentBody_D_AllOf0_OneOf_1]
 }
@@ -290,6 +310,26 @@ func (p *ProcessPaymentBody_D_AllOf0_OneOf) Validate() error {
 	return nil
 }
 
+// AsProcessPaymentBody_D_AllOf0_OneOf_0 returns the ProcessPaymentBody_D_AllOf0_OneOf_0 inside the ProcessPaymentBody_D_AllOf0_OneOf and whether it holds one
+func (p *ProcessPaymentBody_D_AllOf0_OneOf) AsProcessPaymentBody_D_AllOf0_OneOf_0() (ProcessPaymentBody_D_AllOf0_OneOf_0, bool) {
+	return p.A, p.IsA()
+}
+
+// AsProcessPaymentBody_D_AllOf0_OneOf_1 returns the ProcessPaymentBody_D_AllOf0_OneOf_1 inside the ProcessPaymentBody_D_AllOf0_OneOf and whether it holds one
+func (p *ProcessPaymentBody_D_AllOf0_OneOf) AsProcessPaymentBody_D_AllOf0_OneOf_1() (ProcessPaymentBody_D_AllOf0_OneOf_1, bool) {
+	return p.B, p.IsB()
+}
+
+// Match calls the function matching the variant held by the ProcessPaymentBody_D_AllOf0_OneOf, none for an empty union
+func (p *ProcessPaymentBody_D_AllOf0_OneOf) Match(onA func(ProcessPaymentBody_D_AllOf0_OneOf_0), onB func(ProcessPaymentBody_D_AllOf0_OneOf_1)) {
+	switch {
+	case p.IsA():
+		onA(p.A)
+	case p.IsB():
+		onB(p.B)
+	}
+}
+
 type ProcessPaymentBody_D_AllOf0_OneOf_0_AnyOf struct {
 	union json.RawMessage
 }
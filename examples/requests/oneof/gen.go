@@ -20,7 +20,7 @@ func (p ProcessPaymentBody) Validate() error {
 	if p.ProcessPaymentBody_OneOf != nil {
 		if v, ok := any(p.ProcessPaymentBody_OneOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("ProcessPaymentBody_OneOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
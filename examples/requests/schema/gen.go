@@ -22,7 +22,7 @@ func (p Payload) Validate() error {
 	if p.Payload_OneOf != nil {
 		if v, ok := any(p.Payload_OneOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("Payload_OneOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -114,7 +114,7 @@ func (g GetFiles_Response_Item) Validate() error {
 	if g.GetFiles_Response_OneOf != nil {
 		if v, ok := any(g.GetFiles_Response_OneOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("GetFiles_Response_OneOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -181,6 +181,26 @@ func (g *GetFiles_Response_OneOf) Validate() error {
 	return nil
 }
 
+// AsGetFiles_Response_OneOf_0 returns the GetFiles_Response_OneOf_0 inside the GetFiles_Response_OneOf and whether it holds one
+func (g *GetFiles_Response_OneOf) AsGetFiles_Response_OneOf_0() (GetFiles_Response_OneOf_0, bool) {
+	return g.A, g.IsA()
+}
+
+// AsVariantC returns the VariantC inside the GetFiles_Response_OneOf and whether it holds one
+func (g *GetFiles_Response_OneOf) AsVariantC() (VariantC, bool) {
+	return g.B, g.IsB()
+}
+
+// Match calls the function matching the variant held by the GetFiles_Response_OneOf, none for an empty union
+func (g *GetFiles_Response_OneOf) Match(onA func(GetFiles_Response_OneOf_0), onB func(VariantC)) {
+	switch {
+	case g.IsA():
+		onA(g.A)
+	case g.IsB():
+		onB(g.B)
+	}
+}
+
 var typesValidator *validator.Validate
 
 func init() {
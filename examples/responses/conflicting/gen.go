@@ -33,11 +33,11 @@ func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client,
 // ClientInterface is the interface for the API client.
 type ClientInterface interface {
 	// CreatePayment Create a payment
-	CreatePayment(ctx context.Context, options *CreatePaymentRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreatePaymentResponse1, error)
+	CreatePayment(ctx context.Context, options *CreatePaymentRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreatePaymentResult, error)
 }
 
 // CreatePayment Create a payment
-func (c *Client) CreatePayment(ctx context.Context, options *CreatePaymentRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreatePaymentResponse1, error) {
+func (c *Client) CreatePayment(ctx context.Context, options *CreatePaymentRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreatePaymentResult, error) {
 	var err error
 	reqParams := runtime.RequestOptionsParameters{
 		RequestURL:  c.apiClient.GetBaseURL() + "/v1/payments",
@@ -51,18 +51,25 @@ func (c *Client) CreatePayment(ctx context.Context, options *CreatePaymentReques
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
 
-	responseParser := func(ctx context.Context, resp *runtime.Response) (*CreatePaymentResponse1, error) {
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*CreatePaymentResult, error) {
 		bodyBytes := resp.Content
-		if resp.StatusCode != 201 {
-			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
-				runtime.WithStatusCode(resp.StatusCode))
-		}
-		target := new(CreatePaymentResponse1)
-		if err = json.Unmarshal(bodyBytes, target); err != nil {
-			err = fmt.Errorf("error decoding response: %w", err)
-			return nil, err
+		switch resp.StatusCode {
+		case 200:
+			target := new(CreatePaymentResponse0)
+			if err = json.Unmarshal(bodyBytes, target); err != nil {
+				return nil, fmt.Errorf("error decoding response: %w", err)
+			}
+			return &CreatePaymentResult{StatusCode: resp.StatusCode, Body200: target}, nil
+		case 201:
+			target := new(CreatePaymentResponse1)
+			if err = json.Unmarshal(bodyBytes, target); err != nil {
+				return nil, fmt.Errorf("error decoding response: %w", err)
+			}
+			return &CreatePaymentResult{StatusCode: resp.StatusCode, Body201: target}, nil
 		}
-		return target, nil
+
+		return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+			runtime.WithStatusCode(resp.StatusCode))
 	}
 
 	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/v1/payments")
@@ -72,6 +79,18 @@ func (c *Client) CreatePayment(ctx context.Context, options *CreatePaymentReques
 	return responseParser(ctx, resp)
 }
 
+// CreatePaymentResult is the result of CreatePayment.
+// StatusCode tells which of the success responses was received, and the matching body is set.
+type CreatePaymentResult struct {
+	StatusCode int `json:"statusCode"`
+
+	// Body200 is set when StatusCode is 200.
+	Body200 *CreatePaymentResponse0 `json:"body200,omitempty"`
+
+	// Body201 is set when StatusCode is 201.
+	Body201 *CreatePaymentResponse1 `json:"body201,omitempty"`
+}
+
 var _ ClientInterface = (*Client)(nil)
 
 // CreatePaymentRequestOptions is the options needed to make a request to CreatePayment.
@@ -0,0 +1,267 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	GetOrder(ctx context.Context, options *GetOrderRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetOrderResponse, error)
+}
+
+func (c *Client) GetOrder(ctx context.Context, options *GetOrderRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetOrderResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/orders/{id}",
+		Method:     "GET",
+		Options:    options,
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*GetOrderResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			target := new(GetOrderErrorResponse)
+			err = json.Unmarshal(bodyBytes, target)
+			if err != nil {
+				return nil, fmt.Errorf("error decoding response: %w", err)
+			}
+
+			if errTarget, ok := any(*target).(error); ok {
+				return nil, runtime.NewClientAPIError(errTarget, runtime.WithStatusCode(resp.StatusCode))
+			}
+			return nil, runtime.NewClientAPIError(fmt.Errorf("API error (status %d): %v", resp.StatusCode, *target),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(GetOrderResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			err = fmt.Errorf("error decoding response: %w", err)
+			return nil, err
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/orders/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// GetOrderRequestOptions is the options needed to make a request to GetOrder.
+type GetOrderRequestOptions struct {
+	PathParams *GetOrderPath
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *GetOrderRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *GetOrderRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *GetOrderRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *GetOrderRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *GetOrderRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type GetOrderPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (g GetOrderPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(g))
+}
+
+type GetOrderResponse = Order
+
+type GetOrderErrorResponse = Error
+
+type Order struct {
+	ID       string    `json:"id" validate:"required"`
+	Customer *Customer `json:"customer,omitempty"`
+	Items    []Item    `json:"items" validate:"required"`
+}
+
+func (o Order) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(o.ID, "required"); err != nil {
+		errors = errors.Append("ID", err)
+	}
+	if o.Customer != nil {
+		if v, ok := any(o.Customer).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Customer", err)
+			}
+		}
+	}
+	for i, item := range o.Items {
+		if v, ok := any(item).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append(fmt.Sprintf("Items[%d]", i), err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+// ResolveCustomerEmail returns the customer.email field of Order, false if a value on the path is missing.
+func (o Order) ResolveCustomerEmail() (string, bool) {
+	res0 := o.Customer
+	if res0 == nil {
+		var zero string
+		return zero, false
+	}
+	res1 := *res0
+	res2 := res1.Email
+	if res2 == nil {
+		var zero string
+		return zero, false
+	}
+	res3 := *res2
+	return res3, true
+}
+
+// ResolveItemsSku returns the items[].sku field of Order, false if a value on the path is missing.
+func (o Order) ResolveItemsSku() (string, bool) {
+	res0 := o.Items
+	if len(res0) == 0 {
+		var zero string
+		return zero, false
+	}
+	res1 := res0[0]
+	res2 := res1.Sku
+	return res2, true
+}
+
+type Customer struct {
+	Name  *string `json:"name,omitempty"`
+	Email *string `json:"email,omitempty"`
+}
+
+type Item struct {
+	Sku      string `json:"sku" validate:"required"`
+	Quantity *int   `json:"quantity,omitempty"`
+}
+
+func (i Item) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(i))
+}
+
+type Error struct {
+	Errors []ErrorDetail `json:"errors,omitempty"`
+}
+
+func (e Error) Validate() error {
+	var errors runtime.ValidationErrors
+	for i, item := range e.Errors {
+		if v, ok := any(item).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append(fmt.Sprintf("Errors[%d]", i), err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+func (s Error) Error() string {
+	return "unmapped client error"
+}
+
+// ResolveErrorsCode returns the errors[].code field of Error, false if a value on the path is missing.
+func (e Error) ResolveErrorsCode() (string, bool) {
+	res0 := e.Errors
+	if len(res0) == 0 {
+		var zero string
+		return zero, false
+	}
+	res1 := res0[0]
+	res2 := res1.Code
+	if res2 == nil {
+		var zero string
+		return zero, false
+	}
+	res3 := *res2
+	return res3, true
+}
+
+type ErrorDetail struct {
+	Message string  `json:"message" validate:"required"`
+	Code    *string `json:"code,omitempty"`
+}
+
+func (e ErrorDetail) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(e))
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
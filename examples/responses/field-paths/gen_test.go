@@ -0,0 +1,56 @@
+package gen
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldResolvers(t *testing.T) {
+	var order Order
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"id": "1",
+		"customer": {"name": "Ann", "email": "ann@example.com"},
+		"items": [{"sku": "A-1", "quantity": 2}, {"sku": "B-2"}]
+	}`), &order))
+
+	email, ok := order.ResolveCustomerEmail()
+	require.True(t, ok)
+	assert.Equal(t, "ann@example.com", email)
+
+	sku, ok := order.ResolveItemsSku()
+	require.True(t, ok)
+	assert.Equal(t, "A-1", sku)
+
+	t.Run("missing values", func(t *testing.T) {
+		_, ok := Order{ID: "2"}.ResolveCustomerEmail()
+		assert.False(t, ok)
+		_, ok = Order{ID: "2"}.ResolveItemsSku()
+		assert.False(t, ok)
+		_, ok = Error{Errors: []ErrorDetail{{Message: "invalid"}}}.ResolveErrorsCode()
+		assert.False(t, ok)
+	})
+}
+
+func TestResolveField(t *testing.T) {
+	res := Error{Errors: []ErrorDetail{
+		{Message: "invalid id", Code: runtime.Ptr("E_ID")},
+		{Message: "invalid name"},
+	}}
+
+	for path, want := range map[string]any{
+		"errors[].message":  "invalid id",
+		"errors[].code":     "E_ID",
+		"errors[1].message": "invalid name",
+	} {
+		got, ok := runtime.ResolveField(res, path)
+		require.True(t, ok, path)
+		assert.Equal(t, want, got, path)
+	}
+
+	_, ok := runtime.ResolveField(res, "errors[1].code")
+	assert.False(t, ok)
+}
@@ -5,12 +5,13 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 
 	beego "github.com/beego/beego/v2/server/web"
 	beecontext "github.com/beego/beego/v2/server/web/context"
-	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
 	"github.com/go-playground/validator/v10"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
 )
 
 // OapiErrorKind represents the type of error that occurred during request processing.
@@ -39,12 +40,20 @@ type OapiHandlerError struct {
 	Message       string
 	ParamName     string
 	ParamLocation string
+
+	// Err is the underlying error, e.g. the runtime.ValidationErrors of a validation error.
+	Err error
 }
 
 func (e OapiHandlerError) Error() string {
 	return e.Message
 }
 
+// Unwrap returns the underlying error.
+func (e OapiHandlerError) Unwrap() error {
+	return e.Err
+}
+
 // OapiErrorResponse is the default JSON error response structure used by OapiDefaultErrorHandler.
 type OapiErrorResponse struct {
 	Error         string `json:"error"`
@@ -86,18 +95,32 @@ func (h *OapiDefaultErrorHandler) HandleError(w http.ResponseWriter, r *http.Req
 	_ = json.NewEncoder(w).Encode(err)
 }
 
+// OapiWriteValidationError writes err as a 400 JSON response with a
+// {"errors": [{"field": "Items[0].Name", "message": "is required"}]} body.
+// Use it from a custom OapiErrorHandler for OapiErrorKindValidation errors.
+func OapiWriteValidationError(w http.ResponseWriter, err error) {
+	var errs runtime.ValidationErrors
+	if !errors.As(err, &errs) {
+		errs = runtime.NewValidationErrorsFromError(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(errs)
+}
+
 // ServiceInterface defines the service interface for business logic.
 type ServiceInterface interface {
 	// HealthCheck Health check endpoint
-	HealthCheck(ctx context.Context) (*HealthCheckResponseData, error)
+	HealthCheck(ctx *HealthCheckContext) (*HealthCheckResponseData, error)
 	// ListUsers List all users
-	ListUsers(ctx context.Context, opts *ListUsersServiceRequestOptions) (*ListUsersResponseData, error)
+	ListUsers(ctx *ListUsersContext) (*ListUsersResponseData, error)
 	// CreateUser Create a new user
-	CreateUser(ctx context.Context, opts *CreateUserServiceRequestOptions) (*CreateUserResponseData, error)
+	CreateUser(ctx *CreateUserContext) (*CreateUserResponseData, error)
 	// GetUser Get a user by ID
-	GetUser(ctx context.Context, opts *GetUserServiceRequestOptions) (*GetUserResponseData, error)
+	GetUser(ctx *GetUserContext) (*GetUserResponseData, error)
 	// DeleteUser Delete a user
-	DeleteUser(ctx context.Context, opts *DeleteUserServiceRequestOptions) (*DeleteUserResponseData, error)
+	DeleteUser(ctx *DeleteUserContext) (*DeleteUserResponseData, error)
 }
 
 // HTTPAdapter adapts the ServiceInterface to HTTP handlers.
@@ -121,7 +144,7 @@ func (a *HTTPAdapter) HealthCheck(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// Call business logic
-	resp, err := a.svc.HealthCheck(ctx)
+	resp, err := a.svc.HealthCheck(&HealthCheckContext{Context: ctx})
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -149,9 +172,9 @@ func (a *HTTPAdapter) HealthCheck(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ListUsers handles GET /users
-func (a *HTTPAdapter) ListUsers(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// parseListUsersRequest parses the ListUsers request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseListUsersRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *ListUsersServiceRequestOptions {
 	opts := &ListUsersServiceRequestOptions{}
 	opts.RawRequest = r
 
@@ -168,14 +191,25 @@ func (a *HTTPAdapter) ListUsers(w http.ResponseWriter, r *http.Request) {
 				ParamName:     "limit",
 				ParamLocation: "query",
 			})
-			return
+			return nil
 		}
 		queryParams.Limit = &queryParamLimit
 	}
 	opts.Query = queryParams
 
+	return opts
+}
+
+// ListUsers handles GET /users
+func (a *HTTPAdapter) ListUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	opts := a.parseListUsersRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
 	// Call business logic
-	resp, err := a.svc.ListUsers(ctx, opts)
+	resp, err := a.svc.ListUsers(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -203,23 +237,34 @@ func (a *HTTPAdapter) ListUsers(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// CreateUser handles POST /users
-func (a *HTTPAdapter) CreateUser(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// parseCreateUserRequest parses the CreateUser request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseCreateUserRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *CreateUserServiceRequestOptions {
 	opts := &CreateUserServiceRequestOptions{}
 	opts.RawRequest = r
 
 	// Parse request body
-	defer r.Body.Close()
 	var body CreateUserBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		a.errHandler.HandleError(w, r, 400, NewCreateUserErrorResponse(err.Error()))
-		return
+		return nil
 	}
 	opts.Body = &body
 
+	return opts
+}
+
+// CreateUser handles POST /users
+func (a *HTTPAdapter) CreateUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer r.Body.Close()
+	opts := a.parseCreateUserRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
 	// Call business logic
-	resp, err := a.svc.CreateUser(ctx, opts)
+	resp, err := a.svc.CreateUser(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		if _, ok := err.(*CreateUserErrorResponse); ok {
@@ -250,20 +295,34 @@ func (a *HTTPAdapter) CreateUser(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetUser handles GET /users/{id}
-func (a *HTTPAdapter) GetUser(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// parseGetUserRequest parses the GetUser request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseGetUserRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *GetUserServiceRequestOptions {
 	opts := &GetUserServiceRequestOptions{}
 	opts.RawRequest = r
 
 	// Parse path parameters
 	pathParams := &GetUserPath{}
-	pathParamIDStr := r.PathValue("id")
+	pathParamIDStr := pathValues["id"]
 	pathParams.ID = pathParamIDStr
 	opts.PathParams = pathParams
 
+	return opts
+}
+
+// GetUser handles GET /users/{id}
+func (a *HTTPAdapter) GetUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pathValues := map[string]string{
+		"id": r.PathValue("id"),
+	}
+	opts := a.parseGetUserRequest(w, r, pathValues)
+	if opts == nil {
+		return
+	}
+
 	// Call business logic
-	resp, err := a.svc.GetUser(ctx, opts)
+	resp, err := a.svc.GetUser(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -291,20 +350,34 @@ func (a *HTTPAdapter) GetUser(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteUser handles DELETE /users/{id}
-func (a *HTTPAdapter) DeleteUser(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// parseDeleteUserRequest parses the DeleteUser request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseDeleteUserRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *DeleteUserServiceRequestOptions {
 	opts := &DeleteUserServiceRequestOptions{}
 	opts.RawRequest = r
 
 	// Parse path parameters
 	pathParams := &DeleteUserPath{}
-	pathParamIDStr := r.PathValue("id")
+	pathParamIDStr := pathValues["id"]
 	pathParams.ID = pathParamIDStr
 	opts.PathParams = pathParams
 
+	return opts
+}
+
+// DeleteUser handles DELETE /users/{id}
+func (a *HTTPAdapter) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pathValues := map[string]string{
+		"id": r.PathValue("id"),
+	}
+	opts := a.parseDeleteUserRequest(w, r, pathValues)
+	if opts == nil {
+		return
+	}
+
 	// Call business logic
-	resp, err := a.svc.DeleteUser(ctx, opts)
+	resp, err := a.svc.DeleteUser(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -556,6 +629,12 @@ type GetUserResponse = User
 
 type GetUserErrorResponse = Error
 
+// HealthCheckContext is the typed context of the HealthCheck operation, the same for every handler kind:
+// the context of the request, the operation having no parameters and no body.
+type HealthCheckContext struct {
+	context.Context
+}
+
 // ListUsersServiceRequestOptions holds all parameters for the ListUsers operation.
 type ListUsersServiceRequestOptions struct {
 	Query *ListUsersQuery
@@ -581,6 +660,18 @@ func (o *ListUsersServiceRequestOptions) Validate() error {
 	return errors
 }
 
+// ListUsersContext is the typed context of the ListUsers operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type ListUsersContext struct {
+	context.Context
+	*ListUsersServiceRequestOptions
+}
+
+// WithContext returns the typed context of the ListUsers operation, with ctx the context of the request.
+func (o *ListUsersServiceRequestOptions) WithContext(ctx context.Context) *ListUsersContext {
+	return &ListUsersContext{Context: ctx, ListUsersServiceRequestOptions: o}
+}
+
 // CreateUserServiceRequestOptions holds all parameters for the CreateUser operation.
 type CreateUserServiceRequestOptions struct {
 	Body *CreateUserBody
@@ -606,6 +697,18 @@ func (o *CreateUserServiceRequestOptions) Validate() error {
 	return errors
 }
 
+// CreateUserContext is the typed context of the CreateUser operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type CreateUserContext struct {
+	context.Context
+	*CreateUserServiceRequestOptions
+}
+
+// WithContext returns the typed context of the CreateUser operation, with ctx the context of the request.
+func (o *CreateUserServiceRequestOptions) WithContext(ctx context.Context) *CreateUserContext {
+	return &CreateUserContext{Context: ctx, CreateUserServiceRequestOptions: o}
+}
+
 // GetUserServiceRequestOptions holds all parameters for the GetUser operation.
 type GetUserServiceRequestOptions struct {
 	PathParams *GetUserPath
@@ -631,6 +734,18 @@ func (o *GetUserServiceRequestOptions) Validate() error {
 	return errors
 }
 
+// GetUserContext is the typed context of the GetUser operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type GetUserContext struct {
+	context.Context
+	*GetUserServiceRequestOptions
+}
+
+// WithContext returns the typed context of the GetUser operation, with ctx the context of the request.
+func (o *GetUserServiceRequestOptions) WithContext(ctx context.Context) *GetUserContext {
+	return &GetUserContext{Context: ctx, GetUserServiceRequestOptions: o}
+}
+
 // DeleteUserServiceRequestOptions holds all parameters for the DeleteUser operation.
 type DeleteUserServiceRequestOptions struct {
 	PathParams *DeleteUserPath
@@ -656,6 +771,18 @@ func (o *DeleteUserServiceRequestOptions) Validate() error {
 	return errors
 }
 
+// DeleteUserContext is the typed context of the DeleteUser operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type DeleteUserContext struct {
+	context.Context
+	*DeleteUserServiceRequestOptions
+}
+
+// WithContext returns the typed context of the DeleteUser operation, with ctx the context of the request.
+func (o *DeleteUserServiceRequestOptions) WithContext(ctx context.Context) *DeleteUserContext {
+	return &DeleteUserContext{Context: ctx, DeleteUserServiceRequestOptions: o}
+}
+
 type HealthStatus struct {
 	Status string `json:"status" validate:"required"`
 }
@@ -0,0 +1,840 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gobuffalo/buffalo"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// OapiErrorKind represents the type of error that occurred during request processing.
+type OapiErrorKind int
+
+const (
+	// OapiErrorKindParse indicates a parameter parsing error (invalid path/query/header parameter).
+	OapiErrorKindParse OapiErrorKind = iota
+
+	// OapiErrorKindDecode indicates a request body decoding error (invalid JSON, form data, etc.).
+	OapiErrorKindDecode
+
+	// OapiErrorKindValidation indicates a request validation error (failed schema validation).
+	OapiErrorKindValidation
+
+	// OapiErrorKindService indicates a service/business logic error returned by the service implementation.
+	OapiErrorKindService
+)
+
+// OapiHandlerError represents an error that occurred during request handling (parse, decode, validation).
+// When no typed error response is configured in the OpenAPI spec, this error type is used.
+// Custom error handlers can type-assert to this type to access error details.
+type OapiHandlerError struct {
+	Kind          OapiErrorKind
+	OperationID   string
+	Message       string
+	ParamName     string
+	ParamLocation string
+
+	// Err is the underlying error, e.g. the runtime.ValidationErrors of a validation error.
+	Err error
+}
+
+func (e OapiHandlerError) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the underlying error.
+func (e OapiHandlerError) Unwrap() error {
+	return e.Err
+}
+
+// OapiErrorResponse is the default JSON error response structure used by OapiDefaultErrorHandler.
+type OapiErrorResponse struct {
+	Error         string `json:"error"`
+	OperationID   string `json:"operation_id,omitempty"`
+	ParamName     string `json:"param_name,omitempty"`
+	ParamLocation string `json:"param_location,omitempty"`
+}
+
+// OapiErrorHandler handles errors that occur during request processing.
+// Implement this interface to customize error responses, logging, and metrics.
+type OapiErrorHandler interface {
+	// HandleError writes an error response to w with the given status code.
+	// The err is either an OapiHandlerError (for parse/decode/validation errors)
+	// or a typed error matching the OpenAPI spec's error response schema.
+	HandleError(w http.ResponseWriter, r *http.Request, statusCode int, err error)
+}
+
+// OapiDefaultErrorHandler provides the default error handling behavior.
+// It writes JSON error responses. For OapiHandlerError, it uses OapiErrorResponse.
+// For typed errors (from OpenAPI spec), it encodes them directly.
+type OapiDefaultErrorHandler struct{}
+
+// HandleError implements OapiErrorHandler with default JSON error responses.
+func (h *OapiDefaultErrorHandler) HandleError(w http.ResponseWriter, r *http.Request, statusCode int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	if handlerErr, ok := err.(OapiHandlerError); ok {
+		_ = json.NewEncoder(w).Encode(OapiErrorResponse{
+			Error:         handlerErr.Message,
+			OperationID:   handlerErr.OperationID,
+			ParamName:     handlerErr.ParamName,
+			ParamLocation: handlerErr.ParamLocation,
+		})
+		return
+	}
+
+	// Typed error from OpenAPI spec - encode directly
+	_ = json.NewEncoder(w).Encode(err)
+}
+
+// OapiWriteValidationError writes err as a 400 JSON response with a
+// {"errors": [{"field": "Items[0].Name", "message": "is required"}]} body.
+// Use it from a custom OapiErrorHandler for OapiErrorKindValidation errors.
+func OapiWriteValidationError(w http.ResponseWriter, err error) {
+	var errs runtime.ValidationErrors
+	if !errors.As(err, &errs) {
+		errs = runtime.NewValidationErrorsFromError(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(errs)
+}
+
+// ServiceInterface defines the service interface for business logic.
+type ServiceInterface interface {
+	// HealthCheck Health check endpoint
+	HealthCheck(ctx *HealthCheckContext) (*HealthCheckResponseData, error)
+	// ListUsers List all users
+	ListUsers(ctx *ListUsersContext) (*ListUsersResponseData, error)
+	// CreateUser Create a new user
+	CreateUser(ctx *CreateUserContext) (*CreateUserResponseData, error)
+	// GetUser Get a user by ID
+	GetUser(ctx *GetUserContext) (*GetUserResponseData, error)
+	// DeleteUser Delete a user
+	DeleteUser(ctx *DeleteUserContext) (*DeleteUserResponseData, error)
+}
+
+// HTTPAdapter adapts the ServiceInterface to HTTP handlers.
+// This struct is generated and should not be modified.
+type HTTPAdapter struct {
+	svc        ServiceInterface
+	errHandler OapiErrorHandler
+}
+
+// NewHTTPAdapter creates a new HTTPAdapter wrapping the given service.
+// If errHandler is nil, OapiDefaultErrorHandler is used.
+func NewHTTPAdapter(svc ServiceInterface, errHandler OapiErrorHandler) *HTTPAdapter {
+	if errHandler == nil {
+		errHandler = &OapiDefaultErrorHandler{}
+	}
+	return &HTTPAdapter{svc: svc, errHandler: errHandler}
+}
+
+// HealthCheck handles GET /health
+func (a *HTTPAdapter) HealthCheck(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Call business logic
+	resp, err := a.svc.HealthCheck(&HealthCheckContext{Context: ctx})
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// parseListUsersRequest parses the ListUsers request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseListUsersRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *ListUsersServiceRequestOptions {
+	opts := &ListUsersServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse query parameters
+	queryParams := &ListUsersQuery{}
+	query := r.URL.Query()
+	if queryParamLimitStr := query.Get("limit"); queryParamLimitStr != "" {
+		queryParamLimit, err := runtime.ParseString[int](queryParamLimitStr)
+		if err != nil {
+			a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+				Kind:          OapiErrorKindParse,
+				OperationID:   "ListUsers",
+				Message:       err.Error(),
+				ParamName:     "limit",
+				ParamLocation: "query",
+			})
+			return nil
+		}
+		queryParams.Limit = &queryParamLimit
+	}
+	opts.Query = queryParams
+
+	return opts
+}
+
+// ListUsers handles GET /users
+func (a *HTTPAdapter) ListUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	opts := a.parseListUsersRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.ListUsers(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// parseCreateUserRequest parses the CreateUser request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseCreateUserRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *CreateUserServiceRequestOptions {
+	opts := &CreateUserServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse request body
+	var body CreateUserBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		a.errHandler.HandleError(w, r, 400, NewCreateUserErrorResponse(err.Error()))
+		return nil
+	}
+	opts.Body = &body
+
+	return opts
+}
+
+// CreateUser handles POST /users
+func (a *HTTPAdapter) CreateUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer r.Body.Close()
+	opts := a.parseCreateUserRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.CreateUser(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		if _, ok := err.(*CreateUserErrorResponse); ok {
+			code = 400
+		}
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 201
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// parseGetUserRequest parses the GetUser request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseGetUserRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *GetUserServiceRequestOptions {
+	opts := &GetUserServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse path parameters
+	pathParams := &GetUserPath{}
+	pathParamIDStr := pathValues["id"]
+	pathParams.ID = pathParamIDStr
+	opts.PathParams = pathParams
+
+	return opts
+}
+
+// GetUser handles GET /users/{id}
+func (a *HTTPAdapter) GetUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pathValues := map[string]string{
+		"id": r.PathValue("id"),
+	}
+	opts := a.parseGetUserRequest(w, r, pathValues)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.GetUser(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// parseDeleteUserRequest parses the DeleteUser request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseDeleteUserRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *DeleteUserServiceRequestOptions {
+	opts := &DeleteUserServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse path parameters
+	pathParams := &DeleteUserPath{}
+	pathParamIDStr := pathValues["id"]
+	pathParams.ID = pathParamIDStr
+	opts.PathParams = pathParams
+
+	return opts
+}
+
+// DeleteUser handles DELETE /users/{id}
+func (a *HTTPAdapter) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pathValues := map[string]string{
+		"id": r.PathValue("id"),
+	}
+	opts := a.parseDeleteUserRequest(w, r, pathValues)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.DeleteUser(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 204
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.WriteHeader(status)
+}
+
+// RouterOption is a function that configures the router.
+type RouterOption func(*routerConfig)
+
+type routerConfig struct {
+	middlewares []buffalo.MiddlewareFunc
+	errHandler  OapiErrorHandler
+}
+
+// WithMiddleware adds middleware to the registered routes.
+func WithMiddleware(mw buffalo.MiddlewareFunc) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.middlewares = append(cfg.middlewares, mw)
+	}
+}
+
+// WithErrorHandler sets a custom error handler for the router.
+// If not set, OapiDefaultErrorHandler is used.
+func WithErrorHandler(h OapiErrorHandler) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.errHandler = h
+	}
+}
+
+// NewRouter creates a new Buffalo app with all routes registered.
+func NewRouter(svc ServiceInterface, opts ...RouterOption) *buffalo.App {
+	app := buffalo.New(buffalo.Options{})
+	RegisterRoutes(app, svc, opts...)
+	return app
+}
+
+// RegisterRoutes registers routes on the given Buffalo app with the service implementation.
+// Middleware passed with WithMiddleware is applied to the registered routes only.
+func RegisterRoutes(app *buffalo.App, svc ServiceInterface, opts ...RouterOption) {
+	cfg := &routerConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	adapter := NewHTTPAdapter(svc, cfg.errHandler)
+
+	wrap := func(h buffalo.Handler) buffalo.Handler {
+		for i := len(cfg.middlewares) - 1; i >= 0; i-- {
+			h = cfg.middlewares[i](h)
+		}
+		return h
+	}
+	app.GET("/health", wrap(func(c buffalo.Context) error {
+		adapter.HealthCheck(c.Response(), c.Request())
+		return nil
+	}))
+	app.GET("/users", wrap(func(c buffalo.Context) error {
+		adapter.ListUsers(c.Response(), c.Request())
+		return nil
+	}))
+	app.POST("/users", wrap(func(c buffalo.Context) error {
+		adapter.CreateUser(c.Response(), c.Request())
+		return nil
+	}))
+	app.GET("/users/{id}", wrap(func(c buffalo.Context) error {
+		// Copy path params to request for http.Handler compatibility
+		c.Request().SetPathValue("id", c.Param("id"))
+		adapter.GetUser(c.Response(), c.Request())
+		return nil
+	}))
+	app.DELETE("/users/{id}", wrap(func(c buffalo.Context) error {
+		// Copy path params to request for http.Handler compatibility
+		c.Request().SetPathValue("id", c.Param("id"))
+		adapter.DeleteUser(c.Response(), c.Request())
+		return nil
+	}))
+}
+
+type GetUserPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (g GetUserPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(g))
+}
+
+type DeleteUserPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (d DeleteUserPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(d))
+}
+
+type CreateUserBody = CreateUserRequest
+
+type ListUsersQuery struct {
+	Limit *int `json:"limit,omitempty"`
+}
+
+// HealthCheckResponseData wraps the success response with optional headers and status override.
+type HealthCheckResponseData struct {
+	Body    *HealthCheckResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewHealthCheckResponseData creates a new HealthCheckResponseData with the given body.
+func NewHealthCheckResponseData(body *HealthCheckResponse) *HealthCheckResponseData {
+	return &HealthCheckResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *HealthCheckResponseData) WithHeaders(h http.Header) *HealthCheckResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *HealthCheckResponseData) WithStatus(code int) *HealthCheckResponseData {
+	r.Status = code
+	return r
+}
+
+// ListUsersResponseData wraps the success response with optional headers and status override.
+type ListUsersResponseData struct {
+	Body    *ListUsersResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewListUsersResponseData creates a new ListUsersResponseData with the given body.
+func NewListUsersResponseData(body *ListUsersResponse) *ListUsersResponseData {
+	return &ListUsersResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *ListUsersResponseData) WithHeaders(h http.Header) *ListUsersResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *ListUsersResponseData) WithStatus(code int) *ListUsersResponseData {
+	r.Status = code
+	return r
+}
+
+// CreateUserResponseData wraps the success response with optional headers and status override.
+type CreateUserResponseData struct {
+	Body    *CreateUserResponse
+	Headers http.Header
+	Status  int // 0 = use default (201)
+}
+
+// NewCreateUserResponseData creates a new CreateUserResponseData with the given body.
+func NewCreateUserResponseData(body *CreateUserResponse) *CreateUserResponseData {
+	return &CreateUserResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *CreateUserResponseData) WithHeaders(h http.Header) *CreateUserResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *CreateUserResponseData) WithStatus(code int) *CreateUserResponseData {
+	r.Status = code
+	return r
+}
+
+// GetUserResponseData wraps the success response with optional headers and status override.
+type GetUserResponseData struct {
+	Body    *GetUserResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewGetUserResponseData creates a new GetUserResponseData with the given body.
+func NewGetUserResponseData(body *GetUserResponse) *GetUserResponseData {
+	return &GetUserResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *GetUserResponseData) WithHeaders(h http.Header) *GetUserResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *GetUserResponseData) WithStatus(code int) *GetUserResponseData {
+	r.Status = code
+	return r
+}
+
+// DeleteUserResponseData wraps the success response with optional headers and status override.
+type DeleteUserResponseData struct {
+	Body    *struct{}
+	Headers http.Header
+	Status  int // 0 = use default (204)
+}
+
+// NewDeleteUserResponseData creates a new DeleteUserResponseData with the given body.
+func NewDeleteUserResponseData(body *struct{}) *DeleteUserResponseData {
+	return &DeleteUserResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *DeleteUserResponseData) WithHeaders(h http.Header) *DeleteUserResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *DeleteUserResponseData) WithStatus(code int) *DeleteUserResponseData {
+	r.Status = code
+	return r
+}
+
+type HealthCheckResponse = HealthStatus
+
+type ListUsersResponse []User
+
+type CreateUserResponse = User
+
+type CreateUserErrorResponse struct {
+	Code    *string `json:"code,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+func (r CreateUserErrorResponse) Error() string {
+	res0 := r.Message
+	if res0 == nil {
+		return "unknown error"
+	}
+	res1 := *res0
+	return res1
+}
+
+func NewCreateUserErrorResponse(message string) CreateUserErrorResponse {
+	return CreateUserErrorResponse{Message: runtime.Ptr(message)}
+}
+
+type GetUserResponse = User
+
+type GetUserErrorResponse = Error
+
+// HealthCheckContext is the typed context of the HealthCheck operation, the same for every handler kind:
+// the context of the request, the operation having no parameters and no body.
+type HealthCheckContext struct {
+	context.Context
+}
+
+// ListUsersServiceRequestOptions holds all parameters for the ListUsers operation.
+type ListUsersServiceRequestOptions struct {
+	Query *ListUsersQuery
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *ListUsersServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Query != nil {
+		if v, ok := any(o.Query).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Query", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// ListUsersContext is the typed context of the ListUsers operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type ListUsersContext struct {
+	context.Context
+	*ListUsersServiceRequestOptions
+}
+
+// WithContext returns the typed context of the ListUsers operation, with ctx the context of the request.
+func (o *ListUsersServiceRequestOptions) WithContext(ctx context.Context) *ListUsersContext {
+	return &ListUsersContext{Context: ctx, ListUsersServiceRequestOptions: o}
+}
+
+// CreateUserServiceRequestOptions holds all parameters for the CreateUser operation.
+type CreateUserServiceRequestOptions struct {
+	Body *CreateUserBody
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *CreateUserServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// CreateUserContext is the typed context of the CreateUser operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type CreateUserContext struct {
+	context.Context
+	*CreateUserServiceRequestOptions
+}
+
+// WithContext returns the typed context of the CreateUser operation, with ctx the context of the request.
+func (o *CreateUserServiceRequestOptions) WithContext(ctx context.Context) *CreateUserContext {
+	return &CreateUserContext{Context: ctx, CreateUserServiceRequestOptions: o}
+}
+
+// GetUserServiceRequestOptions holds all parameters for the GetUser operation.
+type GetUserServiceRequestOptions struct {
+	PathParams *GetUserPath
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *GetUserServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetUserContext is the typed context of the GetUser operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type GetUserContext struct {
+	context.Context
+	*GetUserServiceRequestOptions
+}
+
+// WithContext returns the typed context of the GetUser operation, with ctx the context of the request.
+func (o *GetUserServiceRequestOptions) WithContext(ctx context.Context) *GetUserContext {
+	return &GetUserContext{Context: ctx, GetUserServiceRequestOptions: o}
+}
+
+// DeleteUserServiceRequestOptions holds all parameters for the DeleteUser operation.
+type DeleteUserServiceRequestOptions struct {
+	PathParams *DeleteUserPath
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *DeleteUserServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// DeleteUserContext is the typed context of the DeleteUser operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type DeleteUserContext struct {
+	context.Context
+	*DeleteUserServiceRequestOptions
+}
+
+// WithContext returns the typed context of the DeleteUser operation, with ctx the context of the request.
+func (o *DeleteUserServiceRequestOptions) WithContext(ctx context.Context) *DeleteUserContext {
+	return &DeleteUserContext{Context: ctx, DeleteUserServiceRequestOptions: o}
+}
+
+type HealthStatus struct {
+	Status string `json:"status" validate:"required"`
+}
+
+func (h HealthStatus) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(h))
+}
+
+type User struct {
+	ID    string `json:"id" validate:"required"`
+	Name  string `json:"name" validate:"required"`
+	Email string `json:"email" validate:"required"`
+}
+
+func (u User) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(u))
+}
+
+type CreateUserRequest struct {
+	Name  string `json:"name" validate:"required"`
+	Email string `json:"email" validate:"required"`
+}
+
+func (c CreateUserRequest) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(c))
+}
+
+type Error struct {
+	Code    *string `json:"code,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+func (s Error) Error() string {
+	return "unmapped client error"
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
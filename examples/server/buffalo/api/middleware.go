@@ -0,0 +1,27 @@
+// Package api This file is generated ONCE as a starting point and will NOT be overwritten.
+// Modify it freely to add your middleware logic.
+// To regenerate, delete this file or set generate.handler.output.overwrite: true in config.
+//
+// Buffalo ships with request logging and panic recovery enabled on every app.
+// More middleware is available from github.com/gobuffalo/mw-* packages (CSRF, i18n, paramlogger, etc.).
+// See: https://gobuffalo.io/documentation/request_handling/middleware/
+//
+// This file shows how to write custom middleware using buffalo.MiddlewareFunc.
+package api
+
+import (
+	"log"
+
+	"github.com/gobuffalo/buffalo"
+)
+
+// ExampleMiddleware demonstrates a custom buffalo.MiddlewareFunc.
+// It logs before and after each request.
+func ExampleMiddleware(next buffalo.Handler) buffalo.Handler {
+	return func(c buffalo.Context) error {
+		log.Printf("before: %s %s", c.Request().Method, c.Request().URL.Path)
+		err := next(c)
+		log.Printf("after: %s %s", c.Request().Method, c.Request().URL.Path)
+		return err
+	}
+}
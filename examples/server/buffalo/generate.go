@@ -0,0 +1,3 @@
+package buffalo
+
+//go:generate go run github.com/doordash-oss/oapi-codegen-dd/v3/cmd/oapi-codegen -config cfg.yml ../api.yml
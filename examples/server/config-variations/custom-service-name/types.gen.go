@@ -5,11 +5,12 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 
-	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-playground/validator/v10"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
 )
 
 // OapiErrorKind represents the type of error that occurred during request processing.
@@ -38,12 +39,20 @@ type OapiHandlerError struct {
 	Message       string
 	ParamName     string
 	ParamLocation string
+
+	// Err is the underlying error, e.g. the runtime.ValidationErrors of a validation error.
+	Err error
 }
 
 func (e OapiHandlerError) Error() string {
 	return e.Message
 }
 
+// Unwrap returns the underlying error.
+func (e OapiHandlerError) Unwrap() error {
+	return e.Err
+}
+
 // OapiErrorResponse is the default JSON error response structure used by OapiDefaultErrorHandler.
 type OapiErrorResponse struct {
 	Error         string `json:"error"`
@@ -85,18 +94,32 @@ func (h *OapiDefaultErrorHandler) HandleError(w http.ResponseWriter, r *http.Req
 	_ = json.NewEncoder(w).Encode(err)
 }
 
+// OapiWriteValidationError writes err as a 400 JSON response with a
+// {"errors": [{"field": "Items[0].Name", "message": "is required"}]} body.
+// Use it from a custom OapiErrorHandler for OapiErrorKindValidation errors.
+func OapiWriteValidationError(w http.ResponseWriter, err error) {
+	var errs runtime.ValidationErrors
+	if !errors.As(err, &errs) {
+		errs = runtime.NewValidationErrorsFromError(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(errs)
+}
+
 // CustomServiceNameInterface defines the service interface for business logic.
 type CustomServiceNameInterface interface {
 	// HealthCheck Health check endpoint
-	HealthCheck(ctx context.Context) (*HealthCheckResponseData, error)
+	HealthCheck(ctx *HealthCheckContext) (*HealthCheckResponseData, error)
 	// ListUsers List all users
-	ListUsers(ctx context.Context, opts *ListUsersServiceRequestOptions) (*ListUsersResponseData, error)
+	ListUsers(ctx *ListUsersContext) (*ListUsersResponseData, error)
 	// CreateUser Create a new user
-	CreateUser(ctx context.Context, opts *CreateUserServiceRequestOptions) (*CreateUserResponseData, error)
+	CreateUser(ctx *CreateUserContext) (*CreateUserResponseData, error)
 	// GetUser Get a user by ID
-	GetUser(ctx context.Context, opts *GetUserServiceRequestOptions) (*GetUserResponseData, error)
+	GetUser(ctx *GetUserContext) (*GetUserResponseData, error)
 	// DeleteUser Delete a user
-	DeleteUser(ctx context.Context, opts *DeleteUserServiceRequestOptions) (*DeleteUserResponseData, error)
+	DeleteUser(ctx *DeleteUserContext) (*DeleteUserResponseData, error)
 }
 
 // HTTPAdapter adapts the CustomServiceNameInterface to HTTP handlers.
@@ -120,7 +143,7 @@ func (a *HTTPAdapter) HealthCheck(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// Call business logic
-	resp, err := a.svc.HealthCheck(ctx)
+	resp, err := a.svc.HealthCheck(&HealthCheckContext{Context: ctx})
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -148,9 +171,9 @@ func (a *HTTPAdapter) HealthCheck(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ListUsers handles GET /users
-func (a *HTTPAdapter) ListUsers(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// parseListUsersRequest parses the ListUsers request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseListUsersRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *ListUsersServiceRequestOptions {
 	opts := &ListUsersServiceRequestOptions{}
 	opts.RawRequest = r
 
@@ -167,14 +190,25 @@ func (a *HTTPAdapter) ListUsers(w http.ResponseWriter, r *http.Request) {
 				ParamName:     "limit",
 				ParamLocation: "query",
 			})
-			return
+			return nil
 		}
 		queryParams.Limit = &queryParamLimit
 	}
 	opts.Query = queryParams
 
+	return opts
+}
+
+// ListUsers handles GET /users
+func (a *HTTPAdapter) ListUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	opts := a.parseListUsersRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
 	// Call business logic
-	resp, err := a.svc.ListUsers(ctx, opts)
+	resp, err := a.svc.ListUsers(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -202,14 +236,13 @@ func (a *HTTPAdapter) ListUsers(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// CreateUser handles POST /users
-func (a *HTTPAdapter) CreateUser(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// parseCreateUserRequest parses the CreateUser request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseCreateUserRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *CreateUserServiceRequestOptions {
 	opts := &CreateUserServiceRequestOptions{}
 	opts.RawRequest = r
 
 	// Parse request body
-	defer r.Body.Close()
 	var body CreateUserBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
@@ -217,12 +250,24 @@ func (a *HTTPAdapter) CreateUser(w http.ResponseWriter, r *http.Request) {
 			OperationID: "CreateUser",
 			Message:     err.Error(),
 		})
-		return
+		return nil
 	}
 	opts.Body = &body
 
+	return opts
+}
+
+// CreateUser handles POST /users
+func (a *HTTPAdapter) CreateUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer r.Body.Close()
+	opts := a.parseCreateUserRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
 	// Call business logic
-	resp, err := a.svc.CreateUser(ctx, opts)
+	resp, err := a.svc.CreateUser(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -250,20 +295,34 @@ func (a *HTTPAdapter) CreateUser(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetUser handles GET /users/{id}
-func (a *HTTPAdapter) GetUser(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// parseGetUserRequest parses the GetUser request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseGetUserRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *GetUserServiceRequestOptions {
 	opts := &GetUserServiceRequestOptions{}
 	opts.RawRequest = r
 
 	// Parse path parameters
 	pathParams := &GetUserPath{}
-	pathParamIDStr := chi.URLParam(r, "id")
+	pathParamIDStr := pathValues["id"]
 	pathParams.ID = pathParamIDStr
 	opts.PathParams = pathParams
 
+	return opts
+}
+
+// GetUser handles GET /users/{id}
+func (a *HTTPAdapter) GetUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pathValues := map[string]string{
+		"id": chi.URLParam(r, "id"),
+	}
+	opts := a.parseGetUserRequest(w, r, pathValues)
+	if opts == nil {
+		return
+	}
+
 	// Call business logic
-	resp, err := a.svc.GetUser(ctx, opts)
+	resp, err := a.svc.GetUser(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -291,20 +350,34 @@ func (a *HTTPAdapter) GetUser(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteUser handles DELETE /users/{id}
-func (a *HTTPAdapter) DeleteUser(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// parseDeleteUserRequest parses the DeleteUser request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseDeleteUserRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *DeleteUserServiceRequestOptions {
 	opts := &DeleteUserServiceRequestOptions{}
 	opts.RawRequest = r
 
 	// Parse path parameters
 	pathParams := &DeleteUserPath{}
-	pathParamIDStr := chi.URLParam(r, "id")
+	pathParamIDStr := pathValues["id"]
 	pathParams.ID = pathParamIDStr
 	opts.PathParams = pathParams
 
+	return opts
+}
+
+// DeleteUser handles DELETE /users/{id}
+func (a *HTTPAdapter) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pathValues := map[string]string{
+		"id": chi.URLParam(r, "id"),
+	}
+	opts := a.parseDeleteUserRequest(w, r, pathValues)
+	if opts == nil {
+		return
+	}
+
 	// Call business logic
-	resp, err := a.svc.DeleteUser(ctx, opts)
+	resp, err := a.svc.DeleteUser(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -332,19 +405,42 @@ func (a *HTTPAdapter) DeleteUser(w http.ResponseWriter, r *http.Request) {
 type RouterOption func(*routerConfig)
 
 type routerConfig struct {
-	middlewares []func(http.Handler) http.Handler
-	errHandler  OapiErrorHandler
+	middlewares          []func(http.Handler) http.Handler
+	operationMiddlewares map[string][]func(http.Handler) http.Handler
+	errHandler           OapiErrorHandler
 }
 
 // WithMiddleware adds middleware to the router.
+// Middlewares are applied in the order they are added, the first one being the outermost.
 func WithMiddleware(mw func(http.Handler) http.Handler) RouterOption {
 	return func(cfg *routerConfig) {
 		cfg.middlewares = append(cfg.middlewares, mw)
 	}
 }
 
+// WithOperationMiddleware adds middleware to the route of a single operation, e.g. to guard it with auth.
+// The operation ID is the Go name of the operation, e.g. "GetUser". The middleware runs inside the ones
+// added with WithMiddleware, in the order it's added, the first one being the outermost.
+func WithOperationMiddleware(operationID string, mw func(http.Handler) http.Handler) RouterOption {
+	return func(cfg *routerConfig) {
+		if cfg.operationMiddlewares == nil {
+			cfg.operationMiddlewares = make(map[string][]func(http.Handler) http.Handler)
+		}
+		cfg.operationMiddlewares[operationID] = append(cfg.operationMiddlewares[operationID], mw)
+	}
+}
+
+// operationHandler wraps the handler of the operation with the given ID with its middlewares.
+func (cfg *routerConfig) operationHandler(operationID string, h http.Handler) http.Handler {
+	middlewares := cfg.operationMiddlewares[operationID]
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+	return h
+}
+
 // WithErrorHandler sets a custom error handler for the router.
-// If not set, OapiOapiDefaultErrorHandler is used.
+// If not set, OapiDefaultErrorHandler is used.
 func WithErrorHandler(h OapiErrorHandler) RouterOption {
 	return func(cfg *routerConfig) {
 		cfg.errHandler = h
@@ -364,11 +460,11 @@ func NewRouter(svc CustomServiceNameInterface, opts ...RouterOption) chi.Router
 	for _, mw := range cfg.middlewares {
 		r.Use(mw)
 	}
-	r.Method("GET", "/health", http.HandlerFunc(adapter.HealthCheck))
-	r.Method("GET", "/users", http.HandlerFunc(adapter.ListUsers))
-	r.Method("POST", "/users", http.HandlerFunc(adapter.CreateUser))
-	r.Method("GET", "/users/{id}", http.HandlerFunc(adapter.GetUser))
-	r.Method("DELETE", "/users/{id}", http.HandlerFunc(adapter.DeleteUser))
+	r.Method("GET", "/health", cfg.operationHandler("HealthCheck", http.HandlerFunc(adapter.HealthCheck)))
+	r.Method("GET", "/users", cfg.operationHandler("ListUsers", http.HandlerFunc(adapter.ListUsers)))
+	r.Method("POST", "/users", cfg.operationHandler("CreateUser", http.HandlerFunc(adapter.CreateUser)))
+	r.Method("GET", "/users/{id}", cfg.operationHandler("GetUser", http.HandlerFunc(adapter.GetUser)))
+	r.Method("DELETE", "/users/{id}", cfg.operationHandler("DeleteUser", http.HandlerFunc(adapter.DeleteUser)))
 
 	return r
 }
@@ -527,6 +623,12 @@ type GetUserResponse = User
 
 type GetUserErrorResponse = Error
 
+// HealthCheckContext is the typed context of the HealthCheck operation, the same for every handler kind:
+// the context of the request, the operation having no parameters and no body.
+type HealthCheckContext struct {
+	context.Context
+}
+
 // ListUsersServiceRequestOptions holds all parameters for the ListUsers operation.
 type ListUsersServiceRequestOptions struct {
 	Query *ListUsersQuery
@@ -552,6 +654,18 @@ func (o *ListUsersServiceRequestOptions) Validate() error {
 	return errors
 }
 
+// ListUsersContext is the typed context of the ListUsers operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type ListUsersContext struct {
+	context.Context
+	*ListUsersServiceRequestOptions
+}
+
+// WithContext returns the typed context of the ListUsers operation, with ctx the context of the request.
+func (o *ListUsersServiceRequestOptions) WithContext(ctx context.Context) *ListUsersContext {
+	return &ListUsersContext{Context: ctx, ListUsersServiceRequestOptions: o}
+}
+
 // CreateUserServiceRequestOptions holds all parameters for the CreateUser operation.
 type CreateUserServiceRequestOptions struct {
 	Body *CreateUserBody
@@ -577,6 +691,18 @@ func (o *CreateUserServiceRequestOptions) Validate() error {
 	return errors
 }
 
+// CreateUserContext is the typed context of the CreateUser operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type CreateUserContext struct {
+	context.Context
+	*CreateUserServiceRequestOptions
+}
+
+// WithContext returns the typed context of the CreateUser operation, with ctx the context of the request.
+func (o *CreateUserServiceRequestOptions) WithContext(ctx context.Context) *CreateUserContext {
+	return &CreateUserContext{Context: ctx, CreateUserServiceRequestOptions: o}
+}
+
 // GetUserServiceRequestOptions holds all parameters for the GetUser operation.
 type GetUserServiceRequestOptions struct {
 	PathParams *GetUserPath
@@ -602,6 +728,18 @@ func (o *GetUserServiceRequestOptions) Validate() error {
 	return errors
 }
 
+// GetUserContext is the typed context of the GetUser operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type GetUserContext struct {
+	context.Context
+	*GetUserServiceRequestOptions
+}
+
+// WithContext returns the typed context of the GetUser operation, with ctx the context of the request.
+func (o *GetUserServiceRequestOptions) WithContext(ctx context.Context) *GetUserContext {
+	return &GetUserContext{Context: ctx, GetUserServiceRequestOptions: o}
+}
+
 // DeleteUserServiceRequestOptions holds all parameters for the DeleteUser operation.
 type DeleteUserServiceRequestOptions struct {
 	PathParams *DeleteUserPath
@@ -627,6 +765,18 @@ func (o *DeleteUserServiceRequestOptions) Validate() error {
 	return errors
 }
 
+// DeleteUserContext is the typed context of the DeleteUser operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type DeleteUserContext struct {
+	context.Context
+	*DeleteUserServiceRequestOptions
+}
+
+// WithContext returns the typed context of the DeleteUser operation, with ctx the context of the request.
+func (o *DeleteUserServiceRequestOptions) WithContext(ctx context.Context) *DeleteUserContext {
+	return &DeleteUserContext{Context: ctx, DeleteUserServiceRequestOptions: o}
+}
+
 type HealthStatus struct {
 	Status string `json:"status" validate:"required"`
 }
@@ -2,7 +2,6 @@
 package models
 
 import (
-	"context"
 	"encoding/json"
 	"net/http"
 
@@ -13,15 +12,15 @@ import (
 // ServiceInterface defines the service interface for business logic.
 type ServiceInterface interface {
 	// HealthCheck Health check endpoint
-	HealthCheck(ctx context.Context) (*HealthCheckResponseData, error)
+	HealthCheck(ctx *HealthCheckContext) (*HealthCheckResponseData, error)
 	// ListUsers List all users
-	ListUsers(ctx context.Context, opts *ListUsersServiceRequestOptions) (*ListUsersResponseData, error)
+	ListUsers(ctx *ListUsersContext) (*ListUsersResponseData, error)
 	// CreateUser Create a new user
-	CreateUser(ctx context.Context, opts *CreateUserServiceRequestOptions) (*CreateUserResponseData, error)
+	CreateUser(ctx *CreateUserContext) (*CreateUserResponseData, error)
 	// GetUser Get a user by ID
-	GetUser(ctx context.Context, opts *GetUserServiceRequestOptions) (*GetUserResponseData, error)
+	GetUser(ctx *GetUserContext) (*GetUserResponseData, error)
 	// DeleteUser Delete a user
-	DeleteUser(ctx context.Context, opts *DeleteUserServiceRequestOptions) (*DeleteUserResponseData, error)
+	DeleteUser(ctx *DeleteUserContext) (*DeleteUserResponseData, error)
 }
 
 // HTTPAdapter adapts the ServiceInterface to HTTP handlers.
@@ -45,7 +44,7 @@ func (a *HTTPAdapter) HealthCheck(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// Call business logic
-	resp, err := a.svc.HealthCheck(ctx)
+	resp, err := a.svc.HealthCheck(&HealthCheckContext{Context: ctx})
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -73,9 +72,9 @@ func (a *HTTPAdapter) HealthCheck(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ListUsers handles GET /users
-func (a *HTTPAdapter) ListUsers(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// parseListUsersRequest parses the ListUsers request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseListUsersRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *ListUsersServiceRequestOptions {
 	opts := &ListUsersServiceRequestOptions{}
 	opts.RawRequest = r
 
@@ -92,14 +91,25 @@ func (a *HTTPAdapter) ListUsers(w http.ResponseWriter, r *http.Request) {
 				ParamName:     "limit",
 				ParamLocation: "query",
 			})
-			return
+			return nil
 		}
 		queryParams.Limit = &queryParamLimit
 	}
 	opts.Query = queryParams
 
+	return opts
+}
+
+// ListUsers handles GET /users
+func (a *HTTPAdapter) ListUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	opts := a.parseListUsersRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
 	// Call business logic
-	resp, err := a.svc.ListUsers(ctx, opts)
+	resp, err := a.svc.ListUsers(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -127,14 +137,13 @@ func (a *HTTPAdapter) ListUsers(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// CreateUser handles POST /users
-func (a *HTTPAdapter) CreateUser(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// parseCreateUserRequest parses the CreateUser request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseCreateUserRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *CreateUserServiceRequestOptions {
 	opts := &CreateUserServiceRequestOptions{}
 	opts.RawRequest = r
 
 	// Parse request body
-	defer r.Body.Close()
 	var body CreateUserBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
@@ -142,12 +151,24 @@ func (a *HTTPAdapter) CreateUser(w http.ResponseWriter, r *http.Request) {
 			OperationID: "CreateUser",
 			Message:     err.Error(),
 		})
-		return
+		return nil
 	}
 	opts.Body = &body
 
+	return opts
+}
+
+// CreateUser handles POST /users
+func (a *HTTPAdapter) CreateUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer r.Body.Close()
+	opts := a.parseCreateUserRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
 	// Call business logic
-	resp, err := a.svc.CreateUser(ctx, opts)
+	resp, err := a.svc.CreateUser(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -175,20 +196,34 @@ func (a *HTTPAdapter) CreateUser(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetUser handles GET /users/{id}
-func (a *HTTPAdapter) GetUser(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// parseGetUserRequest parses the GetUser request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseGetUserRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *GetUserServiceRequestOptions {
 	opts := &GetUserServiceRequestOptions{}
 	opts.RawRequest = r
 
 	// Parse path parameters
 	pathParams := &GetUserPath{}
-	pathParamIDStr := chi.URLParam(r, "id")
+	pathParamIDStr := pathValues["id"]
 	pathParams.ID = pathParamIDStr
 	opts.PathParams = pathParams
 
+	return opts
+}
+
+// GetUser handles GET /users/{id}
+func (a *HTTPAdapter) GetUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pathValues := map[string]string{
+		"id": chi.URLParam(r, "id"),
+	}
+	opts := a.parseGetUserRequest(w, r, pathValues)
+	if opts == nil {
+		return
+	}
+
 	// Call business logic
-	resp, err := a.svc.GetUser(ctx, opts)
+	resp, err := a.svc.GetUser(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -216,20 +251,34 @@ func (a *HTTPAdapter) GetUser(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteUser handles DELETE /users/{id}
-func (a *HTTPAdapter) DeleteUser(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// parseDeleteUserRequest parses the DeleteUser request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseDeleteUserRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *DeleteUserServiceRequestOptions {
 	opts := &DeleteUserServiceRequestOptions{}
 	opts.RawRequest = r
 
 	// Parse path parameters
 	pathParams := &DeleteUserPath{}
-	pathParamIDStr := chi.URLParam(r, "id")
+	pathParamIDStr := pathValues["id"]
 	pathParams.ID = pathParamIDStr
 	opts.PathParams = pathParams
 
+	return opts
+}
+
+// DeleteUser handles DELETE /users/{id}
+func (a *HTTPAdapter) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pathValues := map[string]string{
+		"id": chi.URLParam(r, "id"),
+	}
+	opts := a.parseDeleteUserRequest(w, r, pathValues)
+	if opts == nil {
+		return
+	}
+
 	// Call business logic
-	resp, err := a.svc.DeleteUser(ctx, opts)
+	resp, err := a.svc.DeleteUser(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
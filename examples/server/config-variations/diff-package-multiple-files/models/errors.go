@@ -3,7 +3,10 @@ package models
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
+
+	"github.com/yorunikakeru4/oapi-codegen-dd/v3/pkg/runtime"
 )
 
 // OapiErrorKind represents the type of error that occurred during request processing.
@@ -32,12 +35,20 @@ type OapiHandlerError struct {
 	Message       string
 	ParamName     string
 	ParamLocation string
+
+	// Err is the underlying error, e.g. the runtime.ValidationErrors of a validation error.
+	Err error
 }
 
 func (e OapiHandlerError) Error() string {
 	return e.Message
 }
 
+// Unwrap returns the underlying error.
+func (e OapiHandlerError) Unwrap() error {
+	return e.Err
+}
+
 // OapiErrorResponse is the default JSON error response structure used by OapiDefaultErrorHandler.
 type OapiErrorResponse struct {
 	Error         string `json:"error"`
@@ -78,3 +89,17 @@ func (h *OapiDefaultErrorHandler) HandleError(w http.ResponseWriter, r *http.Req
 	// Typed error from OpenAPI spec - encode directly
 	_ = json.NewEncoder(w).Encode(err)
 }
+
+// OapiWriteValidationError writes err as a 400 JSON response with a
+// {"errors": [{"field": "Items[0].Name", "message": "is required"}]} body.
+// Use it from a custom OapiErrorHandler for OapiErrorKindValidation errors.
+func OapiWriteValidationError(w http.ResponseWriter, err error) {
+	var errs runtime.ValidationErrors
+	if !errors.As(err, &errs) {
+		errs = runtime.NewValidationErrorsFromError(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(errs)
+}
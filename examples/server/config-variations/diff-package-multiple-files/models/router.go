@@ -11,19 +11,42 @@ import (
 type RouterOption func(*routerConfig)
 
 type routerConfig struct {
-	middlewares []func(http.Handler) http.Handler
-	errHandler  OapiErrorHandler
+	middlewares          []func(http.Handler) http.Handler
+	operationMiddlewares map[string][]func(http.Handler) http.Handler
+	errHandler           OapiErrorHandler
 }
 
 // WithMiddleware adds middleware to the router.
+// Middlewares are applied in the order they are added, the first one being the outermost.
 func WithMiddleware(mw func(http.Handler) http.Handler) RouterOption {
 	return func(cfg *routerConfig) {
 		cfg.middlewares = append(cfg.middlewares, mw)
 	}
 }
 
+// WithOperationMiddleware adds middleware to the route of a single operation, e.g. to guard it with auth.
+// The operation ID is the Go name of the operation, e.g. "GetUser". The middleware runs inside the ones
+// added with WithMiddleware, in the order it's added, the first one being the outermost.
+func WithOperationMiddleware(operationID string, mw func(http.Handler) http.Handler) RouterOption {
+	return func(cfg *routerConfig) {
+		if cfg.operationMiddlewares == nil {
+			cfg.operationMiddlewares = make(map[string][]func(http.Handler) http.Handler)
+		}
+		cfg.operationMiddlewares[operationID] = append(cfg.operationMiddlewares[operationID], mw)
+	}
+}
+
+// operationHandler wraps the handler of the operation with the given ID with its middlewares.
+func (cfg *routerConfig) operationHandler(operationID string, h http.Handler) http.Handler {
+	middlewares := cfg.operationMiddlewares[operationID]
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+	return h
+}
+
 // WithErrorHandler sets a custom error handler for the router.
-// If not set, OapiOapiDefaultErrorHandler is used.
+// If not set, OapiDefaultErrorHandler is used.
 func WithErrorHandler(h OapiErrorHandler) RouterOption {
 	return func(cfg *routerConfig) {
 		cfg.errHandler = h
@@ -43,11 +66,11 @@ func NewRouter(svc ServiceInterface, opts ...RouterOption) chi.Router {
 	for _, mw := range cfg.middlewares {
 		r.Use(mw)
 	}
-	r.Method("GET", "/health", http.HandlerFunc(adapter.HealthCheck))
-	r.Method("GET", "/users", http.HandlerFunc(adapter.ListUsers))
-	r.Method("POST", "/users", http.HandlerFunc(adapter.CreateUser))
-	r.Method("GET", "/users/{id}", http.HandlerFunc(adapter.GetUser))
-	r.Method("DELETE", "/users/{id}", http.HandlerFunc(adapter.DeleteUser))
+	r.Method("GET", "/health", cfg.operationHandler("HealthCheck", http.HandlerFunc(adapter.HealthCheck)))
+	r.Method("GET", "/users", cfg.operationHandler("ListUsers", http.HandlerFunc(adapter.ListUsers)))
+	r.Method("POST", "/users", cfg.operationHandler("CreateUser", http.HandlerFunc(adapter.CreateUser)))
+	r.Method("GET", "/users/{id}", cfg.operationHandler("GetUser", http.HandlerFunc(adapter.GetUser)))
+	r.Method("DELETE", "/users/{id}", cfg.operationHandler("DeleteUser", http.HandlerFunc(adapter.DeleteUser)))
 
 	return r
 }
@@ -3,11 +3,18 @@
 package models
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
 )
 
+// HealthCheckContext is the typed context of the HealthCheck operation, the same for every handler kind:
+// the context of the request, the operation having no parameters and no body.
+type HealthCheckContext struct {
+	context.Context
+}
+
 // ListUsersServiceRequestOptions holds all parameters for the ListUsers operation.
 type ListUsersServiceRequestOptions struct {
 	Query *ListUsersQuery
@@ -33,6 +40,18 @@ func (o *ListUsersServiceRequestOptions) Validate() error {
 	return errors
 }
 
+// ListUsersContext is the typed context of the ListUsers operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type ListUsersContext struct {
+	context.Context
+	*ListUsersServiceRequestOptions
+}
+
+// WithContext returns the typed context of the ListUsers operation, with ctx the context of the request.
+func (o *ListUsersServiceRequestOptions) WithContext(ctx context.Context) *ListUsersContext {
+	return &ListUsersContext{Context: ctx, ListUsersServiceRequestOptions: o}
+}
+
 // CreateUserServiceRequestOptions holds all parameters for the CreateUser operation.
 type CreateUserServiceRequestOptions struct {
 	Body *CreateUserBody
@@ -58,6 +77,18 @@ func (o *CreateUserServiceRequestOptions) Validate() error {
 	return errors
 }
 
+// CreateUserContext is the typed context of the CreateUser operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type CreateUserContext struct {
+	context.Context
+	*CreateUserServiceRequestOptions
+}
+
+// WithContext returns the typed context of the CreateUser operation, with ctx the context of the request.
+func (o *CreateUserServiceRequestOptions) WithContext(ctx context.Context) *CreateUserContext {
+	return &CreateUserContext{Context: ctx, CreateUserServiceRequestOptions: o}
+}
+
 // GetUserServiceRequestOptions holds all parameters for the GetUser operation.
 type GetUserServiceRequestOptions struct {
 	PathParams *GetUserPath
@@ -83,6 +114,18 @@ func (o *GetUserServiceRequestOptions) Validate() error {
 	return errors
 }
 
+// GetUserContext is the typed context of the GetUser operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type GetUserContext struct {
+	context.Context
+	*GetUserServiceRequestOptions
+}
+
+// WithContext returns the typed context of the GetUser operation, with ctx the context of the request.
+func (o *GetUserServiceRequestOptions) WithContext(ctx context.Context) *GetUserContext {
+	return &GetUserContext{Context: ctx, GetUserServiceRequestOptions: o}
+}
+
 // DeleteUserServiceRequestOptions holds all parameters for the DeleteUser operation.
 type DeleteUserServiceRequestOptions struct {
 	PathParams *DeleteUserPath
@@ -107,3 +150,15 @@ func (o *DeleteUserServiceRequestOptions) Validate() error {
 
 	return errors
 }
+
+// DeleteUserContext is the typed context of the DeleteUser operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type DeleteUserContext struct {
+	context.Context
+	*DeleteUserServiceRequestOptions
+}
+
+// WithContext returns the typed context of the DeleteUser operation, with ctx the context of the request.
+func (o *DeleteUserServiceRequestOptions) WithContext(ctx context.Context) *DeleteUserContext {
+	return &DeleteUserContext{Context: ctx, DeleteUserServiceRequestOptions: o}
+}
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newMockClient(t *testing.T) (*MockService, *Client) {
+	t.Helper()
+	mock := NewMockService()
+	t.Cleanup(mock.Close)
+
+	client, err := NewDefaultClient(mock.URL, runtime.WithHTTPClient(runtime.HTTPClientDoer{Client: mock.Client()}))
+	require.NoError(t, err)
+	return mock, client
+}
+
+func TestMockService_RecordsRequest(t *testing.T) {
+	mock, client := newMockClient(t)
+	mock.GetPetFunc = func(ctx *GetPetContext) (*GetPetResponseData, error) {
+		return NewGetPetResponseData(&GetPetResponse{ID: ctx.PathParams.ID, Name: "rex"}), nil
+	}
+
+	requestID := "req-1"
+	pet, err := client.GetPet(context.Background(), &GetPetRequestOptions{
+		PathParams: &GetPetPath{ID: 7},
+		Header:     &GetPetHeaders{XRequestID: &requestID},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, GetPetResponse{ID: 7, Name: "rex"}, *pet)
+
+	reqs := mock.RequestsTo("GetPet")
+	require.Len(t, reqs, 1)
+	assert.Equal(t, http.MethodGet, reqs[0].Method)
+	assert.Equal(t, "/pets/7", reqs[0].URL.Path)
+	assert.Equal(t, "req-1", reqs[0].Header.Get("X-Request-Id"))
+
+	opts, ok := reqs[0].Options.(*GetPetServiceRequestOptions)
+	require.True(t, ok)
+	assert.Equal(t, 7, opts.PathParams.ID)
+	assert.Equal(t, "req-1", *opts.Header.XRequestID)
+}
+
+func TestMockService_RecordsBodyAndQuery(t *testing.T) {
+	mock, client := newMockClient(t)
+
+	dryRun := true
+	_, err := client.CreatePet(context.Background(), &CreatePetRequestOptions{
+		Query: &CreatePetQuery{DryRun: &dryRun},
+		Body:  &CreatePetBody{Name: "rex"},
+	})
+	require.NoError(t, err)
+
+	reqs := mock.Requests()
+	require.Len(t, reqs, 1)
+	assert.Equal(t, "CreatePet", reqs[0].Operation)
+	assert.Equal(t, "true", reqs[0].URL.Query().Get("dryRun"))
+	assert.JSONEq(t, `{"name": "rex"}`, string(reqs[0].Body))
+
+	opts, ok := reqs[0].Options.(*CreatePetServiceRequestOptions)
+	require.True(t, ok)
+	assert.Equal(t, "rex", opts.Body.Name)
+	assert.True(t, *opts.Query.DryRun)
+
+	mock.Reset()
+	assert.Empty(t, mock.Requests())
+}
+
+func TestMockService_RecordsRejectedRequest(t *testing.T) {
+	mock, _ := newMockClient(t)
+
+	// the router validates the body, so the request never reaches the service
+	resp, err := mock.Client().Post(mock.URL+"/pets", "application/json", strings.NewReader(`{"name": ""}`))
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	reqs := mock.Requests()
+	require.Len(t, reqs, 1)
+	assert.Empty(t, reqs[0].Operation)
+	assert.Nil(t, reqs[0].Options)
+	assert.JSONEq(t, `{"name": ""}`, string(reqs[0].Body))
+	assert.Empty(t, mock.RequestsTo("CreatePet"))
+}
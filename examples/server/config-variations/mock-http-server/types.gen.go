@@ -0,0 +1,897 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	GetPet(ctx context.Context, options *GetPetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetPetResponse, error)
+
+	CreatePet(ctx context.Context, options *CreatePetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreatePetResponse, error)
+}
+
+func (c *Client) GetPet(ctx context.Context, options *GetPetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetPetResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/pets/{id}",
+		Method:     "GET",
+		Options:    options,
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*GetPetResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(GetPetResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			err = fmt.Errorf("error decoding response: %w", err)
+			return nil, err
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+func (c *Client) CreatePet(ctx context.Context, options *CreatePetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreatePetResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/pets",
+		Method:      "POST",
+		Options:     options,
+		ContentType: "application/json",
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*CreatePetResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 201 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithStatusCode(resp.StatusCode))
+		}
+		target := new(CreatePetResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			err = fmt.Errorf("error decoding response: %w", err)
+			return nil, err
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// GetPetRequestOptions is the options needed to make a request to GetPet.
+type GetPetRequestOptions struct {
+	PathParams *GetPetPath
+	Header     *GetPetHeaders
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *GetPetRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+
+	if o.Header != nil {
+		if v, ok := any(o.Header).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Header", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *GetPetRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *GetPetRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *GetPetRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *GetPetRequestOptions) GetHeader() (map[string]string, error) {
+	return runtime.AsMap[string](o.Header)
+}
+
+// CreatePetRequestOptions is the options needed to make a request to CreatePet.
+type CreatePetRequestOptions struct {
+	Query *CreatePetQuery
+	Body  *CreatePetBody
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *CreatePetRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Query != nil {
+		if v, ok := any(o.Query).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Query", err)
+			}
+		}
+	}
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *CreatePetRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *CreatePetRequestOptions) GetQuery() (map[string]any, error) {
+	return runtime.AsMap[any](o.Query)
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *CreatePetRequestOptions) GetBody() any {
+	return o.Body
+}
+
+// GetHeader returns the headers as a map.
+func (o *CreatePetRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// OapiErrorKind represents the type of error that occurred during request processing.
+type OapiErrorKind int
+
+const (
+	// OapiErrorKindParse indicates a parameter parsing error (invalid path/query/header parameter).
+	OapiErrorKindParse OapiErrorKind = iota
+
+	// OapiErrorKindDecode indicates a request body decoding error (invalid JSON, form data, etc.).
+	OapiErrorKindDecode
+
+	// OapiErrorKindValidation indicates a request validation error (failed schema validation).
+	OapiErrorKindValidation
+
+	// OapiErrorKindService indicates a service/business logic error returned by the service implementation.
+	OapiErrorKindService
+)
+
+// OapiHandlerError represents an error that occurred during request handling (parse, decode, validation).
+// When no typed error response is configured in the OpenAPI spec, this error type is used.
+// Custom error handlers can type-assert to this type to access error details.
+type OapiHandlerError struct {
+	Kind          OapiErrorKind
+	OperationID   string
+	Message       string
+	ParamName     string
+	ParamLocation string
+
+	// Err is the underlying error, e.g. the runtime.ValidationErrors of a validation error.
+	Err error
+}
+
+func (e OapiHandlerError) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the underlying error.
+func (e OapiHandlerError) Unwrap() error {
+	return e.Err
+}
+
+// OapiErrorResponse is the default JSON error response structure used by OapiDefaultErrorHandler.
+type OapiErrorResponse struct {
+	Error         string `json:"error"`
+	OperationID   string `json:"operation_id,omitempty"`
+	ParamName     string `json:"param_name,omitempty"`
+	ParamLocation string `json:"param_location,omitempty"`
+}
+
+// OapiErrorHandler handles errors that occur during request processing.
+// Implement this interface to customize error responses, logging, and metrics.
+type OapiErrorHandler interface {
+	// HandleError writes an error response to w with the given status code.
+	// The err is either an OapiHandlerError (for parse/decode/validation errors)
+	// or a typed error matching the OpenAPI spec's error response schema.
+	HandleError(w http.ResponseWriter, r *http.Request, statusCode int, err error)
+}
+
+// OapiDefaultErrorHandler provides the default error handling behavior.
+// It writes JSON error responses. For OapiHandlerError, it uses OapiErrorResponse.
+// For typed errors (from OpenAPI spec), it encodes them directly.
+type OapiDefaultErrorHandler struct{}
+
+// HandleError implements OapiErrorHandler with default JSON error responses.
+func (h *OapiDefaultErrorHandler) HandleError(w http.ResponseWriter, r *http.Request, statusCode int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	if handlerErr, ok := err.(OapiHandlerError); ok {
+		_ = json.NewEncoder(w).Encode(OapiErrorResponse{
+			Error:         handlerErr.Message,
+			OperationID:   handlerErr.OperationID,
+			ParamName:     handlerErr.ParamName,
+			ParamLocation: handlerErr.ParamLocation,
+		})
+		return
+	}
+
+	// Typed error from OpenAPI spec - encode directly
+	_ = json.NewEncoder(w).Encode(err)
+}
+
+// OapiWriteValidationError writes err as a 400 JSON response with a
+// {"errors": [{"field": "Items[0].Name", "message": "is required"}]} body.
+// Use it from a custom OapiErrorHandler for OapiErrorKindValidation errors.
+func OapiWriteValidationError(w http.ResponseWriter, err error) {
+	var errs runtime.ValidationErrors
+	if !errors.As(err, &errs) {
+		errs = runtime.NewValidationErrorsFromError(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(errs)
+}
+
+// ServiceInterface defines the service interface for business logic.
+type ServiceInterface interface {
+	GetPet(ctx *GetPetContext) (*GetPetResponseData, error)
+
+	CreatePet(ctx *CreatePetContext) (*CreatePetResponseData, error)
+}
+
+// HTTPAdapter adapts the ServiceInterface to HTTP handlers.
+// This struct is generated and should not be modified.
+type HTTPAdapter struct {
+	svc        ServiceInterface
+	errHandler OapiErrorHandler
+}
+
+// NewHTTPAdapter creates a new HTTPAdapter wrapping the given service.
+// If errHandler is nil, OapiDefaultErrorHandler is used.
+func NewHTTPAdapter(svc ServiceInterface, errHandler OapiErrorHandler) *HTTPAdapter {
+	if errHandler == nil {
+		errHandler = &OapiDefaultErrorHandler{}
+	}
+	return &HTTPAdapter{svc: svc, errHandler: errHandler}
+}
+
+// parseGetPetRequest parses the GetPet request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseGetPetRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *GetPetServiceRequestOptions {
+	opts := &GetPetServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse path parameters
+	pathParams := &GetPetPath{}
+	pathParamIDStr := pathValues["id"]
+
+	pathParamID, err := runtime.ParseString[int](pathParamIDStr)
+	if err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:          OapiErrorKindParse,
+			OperationID:   "GetPet",
+			Message:       err.Error(),
+			ParamName:     "id",
+			ParamLocation: "path",
+		})
+		return nil
+	}
+	pathParams.ID = pathParamID
+	opts.PathParams = pathParams
+
+	// Parse header parameters
+	headerParams := &GetPetHeaders{}
+	headers := r.Header
+	if headerValues := headers[http.CanonicalHeaderKey("X-Request-Id")]; len(headerValues) > 0 {
+		headerParamXRequestID := headerValues[0]
+		headerParams.XRequestID = &headerParamXRequestID
+	}
+	opts.Header = headerParams
+
+	return opts
+}
+
+// GetPet handles GET /pets/{id}
+func (a *HTTPAdapter) GetPet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pathValues := map[string]string{
+		"id": r.PathValue("id"),
+	}
+	opts := a.parseGetPetRequest(w, r, pathValues)
+	if opts == nil {
+		return
+	}
+	// Validate request
+	if err := opts.Validate(); err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:        OapiErrorKindValidation,
+			OperationID: "GetPet",
+			Message:     err.Error(),
+			Err:         err,
+		})
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.GetPet(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// parseCreatePetRequest parses the CreatePet request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseCreatePetRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *CreatePetServiceRequestOptions {
+	opts := &CreatePetServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse query parameters
+	queryParams := &CreatePetQuery{}
+	query := r.URL.Query()
+	if queryParamDryRunStr := query.Get("dryRun"); queryParamDryRunStr != "" {
+		queryParamDryRun, err := runtime.ParseString[bool](queryParamDryRunStr)
+		if err != nil {
+			a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+				Kind:          OapiErrorKindParse,
+				OperationID:   "CreatePet",
+				Message:       err.Error(),
+				ParamName:     "dryRun",
+				ParamLocation: "query",
+			})
+			return nil
+		}
+		queryParams.DryRun = &queryParamDryRun
+	}
+	opts.Query = queryParams
+	// Parse request body
+	var body CreatePetBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:        OapiErrorKindDecode,
+			OperationID: "CreatePet",
+			Message:     err.Error(),
+		})
+		return nil
+	}
+	opts.Body = &body
+
+	return opts
+}
+
+// CreatePet handles POST /pets
+func (a *HTTPAdapter) CreatePet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer r.Body.Close()
+	opts := a.parseCreatePetRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+	// Validate request
+	if err := opts.Validate(); err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:        OapiErrorKindValidation,
+			OperationID: "CreatePet",
+			Message:     err.Error(),
+			Err:         err,
+		})
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.CreatePet(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 201
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// RouterOption is a function that configures the router.
+type RouterOption func(*routerConfig)
+
+type routerConfig struct {
+	middlewares          []func(http.Handler) http.Handler
+	operationMiddlewares map[string][]func(http.Handler) http.Handler
+	errHandler           OapiErrorHandler
+}
+
+// WithMiddleware adds middleware to the router.
+// Middlewares are applied in the order they are added, the first one being the outermost.
+func WithMiddleware(mw func(http.Handler) http.Handler) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.middlewares = append(cfg.middlewares, mw)
+	}
+}
+
+// WithOperationMiddleware adds middleware to the route of a single operation, e.g. to guard it with auth.
+// The operation ID is the Go name of the operation, e.g. "GetUser". The middleware runs inside the ones
+// added with WithMiddleware, in the order it's added, the first one being the outermost.
+func WithOperationMiddleware(operationID string, mw func(http.Handler) http.Handler) RouterOption {
+	return func(cfg *routerConfig) {
+		if cfg.operationMiddlewares == nil {
+			cfg.operationMiddlewares = make(map[string][]func(http.Handler) http.Handler)
+		}
+		cfg.operationMiddlewares[operationID] = append(cfg.operationMiddlewares[operationID], mw)
+	}
+}
+
+// operationHandler wraps the handler of the operation with the given ID with its middlewares.
+func (cfg *routerConfig) operationHandler(operationID string, h http.Handler) http.Handler {
+	middlewares := cfg.operationMiddlewares[operationID]
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+	return h
+}
+
+// WithErrorHandler sets a custom error handler for the router.
+// If not set, OapiDefaultErrorHandler is used.
+func WithErrorHandler(h OapiErrorHandler) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.errHandler = h
+	}
+}
+
+// NewRouter creates a new http.ServeMux with the given service implementation.
+func NewRouter(svc ServiceInterface, opts ...RouterOption) *http.ServeMux {
+	mux := http.NewServeMux()
+	RegisterRoutes(mux, svc, opts...)
+	return mux
+}
+
+// RegisterRoutes registers all operations on an existing http.ServeMux using
+// Go 1.22 method and path patterns. Path parameters are read with r.PathValue.
+func RegisterRoutes(mux *http.ServeMux, svc ServiceInterface, opts ...RouterOption) {
+	cfg := &routerConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	adapter := NewHTTPAdapter(svc, cfg.errHandler)
+	mux.Handle("GET /pets/{id}", applyMiddleware(cfg.operationHandler("GetPet", http.HandlerFunc(adapter.GetPet)), cfg.middlewares...))
+	mux.Handle("POST /pets", applyMiddleware(cfg.operationHandler("CreatePet", http.HandlerFunc(adapter.CreatePet)), cfg.middlewares...))
+}
+
+// applyMiddleware wraps a handler with the given middleware chain, the first middleware being the outermost.
+func applyMiddleware(h http.Handler, middlewares ...func(http.Handler) http.Handler) http.Handler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+	return h
+}
+
+type GetPetHeaders struct {
+	XRequestID *string `json:"X-Request-Id,omitempty"`
+}
+
+// MockServiceRequest is a request received by MockService.
+type MockServiceRequest struct {
+	// Operation is the ID of the operation handling the request,
+	// empty if the request didn't reach the service, e.g. when the router rejected it.
+	Operation string
+
+	// Method, URL, Header and Body are the request as received by the server.
+	Method string
+	URL    *url.URL
+	Header http.Header
+	Body   []byte
+
+	// Options are the request options parsed and validated by the router, nil for operations without request options.
+	Options any
+}
+
+// MockService is an httptest server serving the router of ServiceInterface for integration tests,
+// going through HTTP, routing, request parsing and validation. Set the <OperationID>Func fields to program the responses,
+// operations without a function respond with an empty success response. Every received request is recorded.
+type MockService struct {
+	*httptest.Server
+
+	// GetPetFunc handles GetPet when set.
+	GetPetFunc func(ctx *GetPetContext) (*GetPetResponseData, error)
+	// CreatePetFunc handles CreatePet when set.
+	CreatePetFunc func(ctx *CreatePetContext) (*CreatePetResponseData, error)
+
+	mu       sync.Mutex
+	requests []*MockServiceRequest
+}
+
+type mockServiceRequestKey struct{}
+
+// NewMockService starts a MockService serving the router created with the options.
+// Close it when done.
+func NewMockService(opts ...RouterOption) *MockService {
+	m := &MockService{}
+	router := NewRouter(m, opts...)
+	m.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		req := &MockServiceRequest{
+			Method: r.Method,
+			URL:    r.URL,
+			Header: r.Header.Clone(),
+			Body:   body,
+		}
+		m.mu.Lock()
+		m.requests = append(m.requests, req)
+		m.mu.Unlock()
+
+		router.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), mockServiceRequestKey{}, req)))
+	}))
+	return m
+}
+
+// Requests returns the received requests, in order.
+func (m *MockService) Requests() []MockServiceRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	res := make([]MockServiceRequest, 0, len(m.requests))
+	for _, req := range m.requests {
+		res = append(res, *req)
+	}
+	return res
+}
+
+// RequestsTo returns the received requests of the operation, in order.
+func (m *MockService) RequestsTo(operation string) []MockServiceRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var res []MockServiceRequest
+	for _, req := range m.requests {
+		if req.Operation == operation {
+			res = append(res, *req)
+		}
+	}
+	return res
+}
+
+// Reset clears the received requests.
+func (m *MockService) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests = nil
+}
+
+// record sets the operation and the request options of the request being served.
+func (m *MockService) record(ctx context.Context, operation string, options any) {
+	req, ok := ctx.Value(mockServiceRequestKey{}).(*MockServiceRequest)
+	if !ok {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	req.Operation = operation
+	req.Options = options
+}
+
+// GetPet records the request and returns the response of GetPetFunc.
+func (m *MockService) GetPet(ctx *GetPetContext) (*GetPetResponseData, error) {
+	m.record(ctx, "GetPet", ctx.GetPetServiceRequestOptions)
+	if m.GetPetFunc != nil {
+		return m.GetPetFunc(ctx)
+	}
+	return NewGetPetResponseData(new(GetPetResponse)), nil
+}
+
+// CreatePet records the request and returns the response of CreatePetFunc.
+func (m *MockService) CreatePet(ctx *CreatePetContext) (*CreatePetResponseData, error) {
+	m.record(ctx, "CreatePet", ctx.CreatePetServiceRequestOptions)
+	if m.CreatePetFunc != nil {
+		return m.CreatePetFunc(ctx)
+	}
+	return NewCreatePetResponseData(new(CreatePetResponse)), nil
+}
+
+var _ ServiceInterface = (*MockService)(nil)
+
+type GetPetPath struct {
+	ID int `json:"id" validate:"required"`
+}
+
+func (g GetPetPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(g))
+}
+
+type CreatePetBody = NewPet
+
+type CreatePetQuery struct {
+	DryRun *bool `json:"dryRun,omitempty"`
+}
+
+// GetPetResponseData wraps the success response with optional headers and status override.
+type GetPetResponseData struct {
+	Body    *GetPetResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewGetPetResponseData creates a new GetPetResponseData with the given body.
+func NewGetPetResponseData(body *GetPetResponse) *GetPetResponseData {
+	return &GetPetResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *GetPetResponseData) WithHeaders(h http.Header) *GetPetResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *GetPetResponseData) WithStatus(code int) *GetPetResponseData {
+	r.Status = code
+	return r
+}
+
+// CreatePetResponseData wraps the success response with optional headers and status override.
+type CreatePetResponseData struct {
+	Body    *CreatePetResponse
+	Headers http.Header
+	Status  int // 0 = use default (201)
+}
+
+// NewCreatePetResponseData creates a new CreatePetResponseData with the given body.
+func NewCreatePetResponseData(body *CreatePetResponse) *CreatePetResponseData {
+	return &CreatePetResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *CreatePetResponseData) WithHeaders(h http.Header) *CreatePetResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *CreatePetResponseData) WithStatus(code int) *CreatePetResponseData {
+	r.Status = code
+	return r
+}
+
+type GetPetResponse = Pet
+
+type CreatePetResponse = Pet
+
+// GetPetServiceRequestOptions holds all parameters for the GetPet operation.
+type GetPetServiceRequestOptions struct {
+	PathParams *GetPetPath
+	Header     *GetPetHeaders
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *GetPetServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+
+	if o.Header != nil {
+		if v, ok := any(o.Header).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Header", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPetContext is the typed context of the GetPet operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type GetPetContext struct {
+	context.Context
+	*GetPetServiceRequestOptions
+}
+
+// WithContext returns the typed context of the GetPet operation, with ctx the context of the request.
+func (o *GetPetServiceRequestOptions) WithContext(ctx context.Context) *GetPetContext {
+	return &GetPetContext{Context: ctx, GetPetServiceRequestOptions: o}
+}
+
+// CreatePetServiceRequestOptions holds all parameters for the CreatePet operation.
+type CreatePetServiceRequestOptions struct {
+	Query *CreatePetQuery
+	Body  *CreatePetBody
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *CreatePetServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Query != nil {
+		if v, ok := any(o.Query).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Query", err)
+			}
+		}
+	}
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// CreatePetContext is the typed context of the CreatePet operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type CreatePetContext struct {
+	context.Context
+	*CreatePetServiceRequestOptions
+}
+
+// WithContext returns the typed context of the CreatePet operation, with ctx the context of the request.
+func (o *CreatePetServiceRequestOptions) WithContext(ctx context.Context) *CreatePetContext {
+	return &CreatePetContext{Context: ctx, CreatePetServiceRequestOptions: o}
+}
+
+type NewPet struct {
+	Name string `json:"name" validate:"required,min=1"`
+}
+
+func (n NewPet) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(n))
+}
+
+type Pet struct {
+	ID   int    `json:"id" validate:"required"`
+	Name string `json:"name" validate:"required"`
+}
+
+func (p Pet) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(p))
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
@@ -0,0 +1,45 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func postPet(t *testing.T, name string) *httptest.ResponseRecorder {
+	t.Helper()
+	adapter := NewHTTPAdapter(NewService(), nil)
+	r := chi.NewRouter()
+	r.Post("/pets", adapter.CreatePet)
+
+	req := httptest.NewRequest(http.MethodPost, "/pets", strings.NewReader(`{"name": "`+name+`"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+	return rr
+}
+
+func TestCreatePet_ResponseHeaders(t *testing.T) {
+	rr := postPet(t, "rex")
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	assert.Equal(t, "/pets/1", rr.Header().Get("Location"))
+	assert.Equal(t, "100", rr.Header().Get("X-Rate-Limit"))
+}
+
+func TestCreatePet_ResponseHeaders_MissingRequired(t *testing.T) {
+	rr := postPet(t, "forgetful")
+	require.Equal(t, http.StatusInternalServerError, rr.Code)
+	assert.Contains(t, rr.Body.String(), "missing required response header: Location")
+	assert.Empty(t, rr.Header().Get("X-Rate-Limit"))
+}
+
+func TestCreatePet_ResponseHeaders_Unparseable(t *testing.T) {
+	rr := postPet(t, "sloppy")
+	require.Equal(t, http.StatusInternalServerError, rr.Code)
+	assert.Contains(t, rr.Body.String(), "response header X-Rate-Limit")
+}
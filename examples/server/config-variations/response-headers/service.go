@@ -0,0 +1,37 @@
+// Package api This file is generated ONCE as a starting point and will NOT be overwritten.
+// Modify it freely to add your business logic.
+// To regenerate, delete this file or set generate.handler.output.overwrite: true in config.
+package api
+
+import (
+	"net/http"
+)
+
+// Service implements the ServiceInterface.
+// Add your dependencies here (database, clients, etc.)
+type Service struct {
+}
+
+// NewService creates a new Service.
+func NewService() *Service {
+	return &Service{}
+}
+
+// Ensure Service implements ServiceInterface.
+var _ ServiceInterface = (*Service)(nil)
+
+// CreatePet handles POST /pets
+func (s *Service) CreatePet(ctx *CreatePetContext) (*CreatePetResponseData, error) {
+	headers := http.Header{}
+	headers.Set("Location", "/pets/1")
+	headers.Set("X-Rate-Limit", "100")
+	switch ctx.Body.Name {
+	case "forgetful":
+		// violates the spec, Location is a required response header
+		headers.Del("Location")
+	case "sloppy":
+		// violates the spec, X-Rate-Limit is an integer
+		headers.Set("X-Rate-Limit", "unlimited")
+	}
+	return NewCreatePetResponseData(&CreatePetResponse{ID: 1, Name: ctx.Body.Name}).WithHeaders(headers), nil
+}
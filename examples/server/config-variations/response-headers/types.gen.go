@@ -0,0 +1,393 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// OapiErrorKind represents the type of error that occurred during request processing.
+type OapiErrorKind int
+
+const (
+	// OapiErrorKindParse indicates a parameter parsing error (invalid path/query/header parameter).
+	OapiErrorKindParse OapiErrorKind = iota
+
+	// OapiErrorKindDecode indicates a request body decoding error (invalid JSON, form data, etc.).
+	OapiErrorKindDecode
+
+	// OapiErrorKindValidation indicates a request validation error (failed schema validation).
+	OapiErrorKindValidation
+
+	// OapiErrorKindService indicates a service/business logic error returned by the service implementation.
+	OapiErrorKindService
+)
+
+// OapiHandlerError represents an error that occurred during request handling (parse, decode, validation).
+// When no typed error response is configured in the OpenAPI spec, this error type is used.
+// Custom error handlers can type-assert to this type to access error details.
+type OapiHandlerError struct {
+	Kind          OapiErrorKind
+	OperationID   string
+	Message       string
+	ParamName     string
+	ParamLocation string
+
+	// Err is the underlying error, e.g. the runtime.ValidationErrors of a validation error.
+	Err error
+}
+
+func (e OapiHandlerError) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the underlying error.
+func (e OapiHandlerError) Unwrap() error {
+	return e.Err
+}
+
+// OapiErrorResponse is the default JSON error response structure used by OapiDefaultErrorHandler.
+type OapiErrorResponse struct {
+	Error         string `json:"error"`
+	OperationID   string `json:"operation_id,omitempty"`
+	ParamName     string `json:"param_name,omitempty"`
+	ParamLocation string `json:"param_location,omitempty"`
+}
+
+// OapiErrorHandler handles errors that occur during request processing.
+// Implement this interface to customize error responses, logging, and metrics.
+type OapiErrorHandler interface {
+	// HandleError writes an error response to w with the given status code.
+	// The err is either an OapiHandlerError (for parse/decode/validation errors)
+	// or a typed error matching the OpenAPI spec's error response schema.
+	HandleError(w http.ResponseWriter, r *http.Request, statusCode int, err error)
+}
+
+// OapiDefaultErrorHandler provides the default error handling behavior.
+// It writes JSON error responses. For OapiHandlerError, it uses OapiErrorResponse.
+// For typed errors (from OpenAPI spec), it encodes them directly.
+type OapiDefaultErrorHandler struct{}
+
+// HandleError implements OapiErrorHandler with default JSON error responses.
+func (h *OapiDefaultErrorHandler) HandleError(w http.ResponseWriter, r *http.Request, statusCode int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	if handlerErr, ok := err.(OapiHandlerError); ok {
+		_ = json.NewEncoder(w).Encode(OapiErrorResponse{
+			Error:         handlerErr.Message,
+			OperationID:   handlerErr.OperationID,
+			ParamName:     handlerErr.ParamName,
+			ParamLocation: handlerErr.ParamLocation,
+		})
+		return
+	}
+
+	// Typed error from OpenAPI spec - encode directly
+	_ = json.NewEncoder(w).Encode(err)
+}
+
+// OapiWriteValidationError writes err as a 400 JSON response with a
+// {"errors": [{"field": "Items[0].Name", "message": "is required"}]} body.
+// Use it from a custom OapiErrorHandler for OapiErrorKindValidation errors.
+func OapiWriteValidationError(w http.ResponseWriter, err error) {
+	var errs runtime.ValidationErrors
+	if !errors.As(err, &errs) {
+		errs = runtime.NewValidationErrorsFromError(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(errs)
+}
+
+// ServiceInterface defines the service interface for business logic.
+type ServiceInterface interface {
+	CreatePet(ctx *CreatePetContext) (*CreatePetResponseData, error)
+}
+
+// HTTPAdapter adapts the ServiceInterface to HTTP handlers.
+// This struct is generated and should not be modified.
+type HTTPAdapter struct {
+	svc        ServiceInterface
+	errHandler OapiErrorHandler
+}
+
+// NewHTTPAdapter creates a new HTTPAdapter wrapping the given service.
+// If errHandler is nil, OapiDefaultErrorHandler is used.
+func NewHTTPAdapter(svc ServiceInterface, errHandler OapiErrorHandler) *HTTPAdapter {
+	if errHandler == nil {
+		errHandler = &OapiDefaultErrorHandler{}
+	}
+	return &HTTPAdapter{svc: svc, errHandler: errHandler}
+}
+
+// parseCreatePetRequest parses the CreatePet request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseCreatePetRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *CreatePetServiceRequestOptions {
+	opts := &CreatePetServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse request body
+	var body CreatePetBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:        OapiErrorKindDecode,
+			OperationID: "CreatePet",
+			Message:     err.Error(),
+		})
+		return nil
+	}
+	opts.Body = &body
+
+	return opts
+}
+
+// CreatePet handles POST /pets
+func (a *HTTPAdapter) CreatePet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer r.Body.Close()
+	opts := a.parseCreatePetRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.CreatePet(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Validate response
+	if resp != nil && resp.Body != nil {
+		if v, ok := any(resp.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				a.errHandler.HandleError(w, r, http.StatusInternalServerError, OapiHandlerError{
+					Kind:        OapiErrorKindValidation,
+					OperationID: "CreatePet",
+					Message:     fmt.Sprintf("response validation failed: %v", err),
+					Err:         err,
+				})
+				return
+			}
+		}
+	}
+
+	// Validate response headers, declared for the default status code only
+	if resp == nil || resp.Status == 0 || resp.Status == 201 {
+		var respHeaders http.Header
+		if resp != nil {
+			respHeaders = resp.Headers
+		}
+		if err := runtime.ValidateResponseHeader[string](respHeaders, "Location", true); err != nil {
+			a.errHandler.HandleError(w, r, http.StatusInternalServerError, OapiHandlerError{
+				Kind:        OapiErrorKindValidation,
+				OperationID: "CreatePet",
+				Message:     fmt.Sprintf("response validation failed: %v", err),
+				Err:         err,
+			})
+			return
+		}
+		if err := runtime.ValidateResponseHeader[int](respHeaders, "X-Rate-Limit", true); err != nil {
+			a.errHandler.HandleError(w, r, http.StatusInternalServerError, OapiHandlerError{
+				Kind:        OapiErrorKindValidation,
+				OperationID: "CreatePet",
+				Message:     fmt.Sprintf("response validation failed: %v", err),
+				Err:         err,
+			})
+			return
+		}
+		if err := runtime.ValidateResponseHeader[string](respHeaders, "X-Request-Id", false, "uuid"); err != nil {
+			a.errHandler.HandleError(w, r, http.StatusInternalServerError, OapiHandlerError{
+				Kind:        OapiErrorKindValidation,
+				OperationID: "CreatePet",
+				Message:     fmt.Sprintf("response validation failed: %v", err),
+				Err:         err,
+			})
+			return
+		}
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 201
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// RouterOption is a function that configures the router.
+type RouterOption func(*routerConfig)
+
+type routerConfig struct {
+	middlewares          []func(http.Handler) http.Handler
+	operationMiddlewares map[string][]func(http.Handler) http.Handler
+	errHandler           OapiErrorHandler
+}
+
+// WithMiddleware adds middleware to the router.
+// Middlewares are applied in the order they are added, the first one being the outermost.
+func WithMiddleware(mw func(http.Handler) http.Handler) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.middlewares = append(cfg.middlewares, mw)
+	}
+}
+
+// WithOperationMiddleware adds middleware to the route of a single operation, e.g. to guard it with auth.
+// The operation ID is the Go name of the operation, e.g. "GetUser". The middleware runs inside the ones
+// added with WithMiddleware, in the order it's added, the first one being the outermost.
+func WithOperationMiddleware(operationID string, mw func(http.Handler) http.Handler) RouterOption {
+	return func(cfg *routerConfig) {
+		if cfg.operationMiddlewares == nil {
+			cfg.operationMiddlewares = make(map[string][]func(http.Handler) http.Handler)
+		}
+		cfg.operationMiddlewares[operationID] = append(cfg.operationMiddlewares[operationID], mw)
+	}
+}
+
+// operationHandler wraps the handler of the operation with the given ID with its middlewares.
+func (cfg *routerConfig) operationHandler(operationID string, h http.Handler) http.Handler {
+	middlewares := cfg.operationMiddlewares[operationID]
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+	return h
+}
+
+// WithErrorHandler sets a custom error handler for the router.
+// If not set, OapiDefaultErrorHandler is used.
+func WithErrorHandler(h OapiErrorHandler) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.errHandler = h
+	}
+}
+
+// NewRouter creates a new chi.Router with the given service implementation.
+func NewRouter(svc ServiceInterface, opts ...RouterOption) chi.Router {
+	cfg := &routerConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	adapter := NewHTTPAdapter(svc, cfg.errHandler)
+
+	r := chi.NewRouter()
+	for _, mw := range cfg.middlewares {
+		r.Use(mw)
+	}
+	r.Method("POST", "/pets", cfg.operationHandler("CreatePet", http.HandlerFunc(adapter.CreatePet)))
+
+	return r
+}
+
+type CreatePetBody = NewPet
+
+// CreatePetResponseData wraps the success response with optional headers and status override.
+type CreatePetResponseData struct {
+	Body    *CreatePetResponse
+	Headers http.Header
+	Status  int // 0 = use default (201)
+}
+
+// NewCreatePetResponseData creates a new CreatePetResponseData with the given body.
+func NewCreatePetResponseData(body *CreatePetResponse) *CreatePetResponseData {
+	return &CreatePetResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *CreatePetResponseData) WithHeaders(h http.Header) *CreatePetResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *CreatePetResponseData) WithStatus(code int) *CreatePetResponseData {
+	r.Status = code
+	return r
+}
+
+type CreatePetResponse = Pet
+
+// CreatePetServiceRequestOptions holds all parameters for the CreatePet operation.
+type CreatePetServiceRequestOptions struct {
+	Body *CreatePetBody
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *CreatePetServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// CreatePetContext is the typed context of the CreatePet operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type CreatePetContext struct {
+	context.Context
+	*CreatePetServiceRequestOptions
+}
+
+// WithContext returns the typed context of the CreatePet operation, with ctx the context of the request.
+func (o *CreatePetServiceRequestOptions) WithContext(ctx context.Context) *CreatePetContext {
+	return &CreatePetContext{Context: ctx, CreatePetServiceRequestOptions: o}
+}
+
+type NewPet struct {
+	Name string `json:"name" validate:"required"`
+}
+
+func (n NewPet) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(n))
+}
+
+type Pet struct {
+	ID   int    `json:"id" validate:"required"`
+	Name string `json:"name" validate:"required"`
+}
+
+func (p Pet) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(p))
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
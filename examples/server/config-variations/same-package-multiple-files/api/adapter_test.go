@@ -1,7 +1,6 @@
 package api
 
 import (
-	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -16,23 +15,23 @@ import (
 // mockService implements ServiceInterface for testing
 type mockService struct{}
 
-func (m *mockService) HealthCheck(_ context.Context) (*HealthCheckResponseData, error) {
+func (m *mockService) HealthCheck(_ *HealthCheckContext) (*HealthCheckResponseData, error) {
 	return nil, nil
 }
 
-func (m *mockService) ListUsers(_ context.Context, _ *ListUsersServiceRequestOptions) (*ListUsersResponseData, error) {
+func (m *mockService) ListUsers(_ *ListUsersContext) (*ListUsersResponseData, error) {
 	return nil, nil
 }
 
-func (m *mockService) CreateUser(_ context.Context, _ *CreateUserServiceRequestOptions) (*CreateUserResponseData, error) {
+func (m *mockService) CreateUser(_ *CreateUserContext) (*CreateUserResponseData, error) {
 	return nil, nil
 }
 
-func (m *mockService) GetUser(_ context.Context, _ *GetUserServiceRequestOptions) (*GetUserResponseData, error) {
+func (m *mockService) GetUser(_ *GetUserContext) (*GetUserResponseData, error) {
 	return nil, nil
 }
 
-func (m *mockService) DeleteUser(_ context.Context, _ *DeleteUserServiceRequestOptions) (*DeleteUserResponseData, error) {
+func (m *mockService) DeleteUser(_ *DeleteUserContext) (*DeleteUserResponseData, error) {
 	return nil, nil
 }
 
@@ -0,0 +1,65 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRouter() http.Handler {
+	adapter := NewHTTPAdapter(NewService(), nil)
+	r := chi.NewRouter()
+	r.Post("/pets", adapter.CreatePet)
+	return r
+}
+
+func postPet(t *testing.T, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/pets", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+	newTestRouter().ServeHTTP(rr, req)
+	return rr
+}
+
+func TestCreatePet_SchemaValidation_ValidBody(t *testing.T) {
+	rr := postPet(t, `{"name": "rex", "contact": {"email": "rex@example.com"}}`)
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+	var pet Pet
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &pet))
+	assert.Equal(t, Pet{ID: 1, Name: "rex"}, pet)
+}
+
+func TestCreatePet_SchemaValidation_AnyOfViolation(t *testing.T) {
+	// contact must match at least one of the anyOf schemas, which tags can't express
+	rr := postPet(t, `{"name": "rex", "contact": {"fax": "123"}}`)
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+
+	var errResp Error
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &errResp))
+	require.NotNil(t, errResp.Message)
+	assert.Contains(t, *errResp.Message, "contact must match at least one schema in anyOf")
+}
+
+func TestCreatePet_SchemaValidation_MissingRequired(t *testing.T) {
+	rr := postPet(t, `{"contact": {"phone": "123"}}`)
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+
+	var errResp Error
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &errResp))
+	require.NotNil(t, errResp.Message)
+	assert.Contains(t, *errResp.Message, "name is required")
+}
+
+func TestCreatePet_SchemaValidation_InvalidResponse(t *testing.T) {
+	rr := postPet(t, `{"name": "rex", "tag": "unsaved", "contact": {"phone": "123"}}`)
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	assert.Contains(t, rr.Body.String(), "response schema validation failed")
+}
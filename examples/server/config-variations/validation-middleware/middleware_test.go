@@ -0,0 +1,78 @@
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func serve(t *testing.T, svc *Service, method, path, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	var reqBody io.Reader
+	if body != "" {
+		reqBody = strings.NewReader(body)
+	}
+	req := httptest.NewRequest(method, path, reqBody)
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+	NewRouter(svc, WithMiddleware(ValidationMiddleware(nil))).ServeHTTP(rr, req)
+	return rr
+}
+
+func TestValidationMiddleware_ValidBody(t *testing.T) {
+	svc := NewService()
+	rr := serve(t, svc, http.MethodPost, "/pets", `{"name": "rex"}`)
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+	// The handler reads the body buffered by the middleware
+	var pet Pet
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &pet))
+	assert.Equal(t, Pet{ID: 1, Name: "rex"}, pet)
+	assert.Equal(t, 1, svc.Calls)
+}
+
+func TestValidationMiddleware_InvalidBody(t *testing.T) {
+	svc := NewService()
+	rr := serve(t, svc, http.MethodPost, "/pets", `{"name": ""}`)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Contains(t, rr.Body.String(), "Body.Name is required")
+	assert.Equal(t, 0, svc.Calls)
+}
+
+func TestValidationMiddleware_PathParams(t *testing.T) {
+	svc := NewService()
+
+	rr := serve(t, svc, http.MethodGet, "/pets/0", "")
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+	rr = serve(t, svc, http.MethodGet, "/pets/abc", "")
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Equal(t, 0, svc.Calls)
+
+	rr = serve(t, svc, http.MethodGet, "/pets/7", "")
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	assert.Equal(t, 1, svc.Calls)
+}
+
+func TestValidationMiddleware_MostSpecificPath(t *testing.T) {
+	svc := NewService()
+
+	// /pets/mine is not validated as /pets/{id}
+	rr := serve(t, svc, http.MethodGet, "/pets/mine?limit=5", "")
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+	rr = serve(t, svc, http.MethodGet, "/pets/mine?limit=50", "")
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Equal(t, 1, svc.Calls)
+}
+
+func TestValidationMiddleware_UnknownRoute(t *testing.T) {
+	rr := serve(t, NewService(), http.MethodGet, "/owners", "")
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
@@ -0,0 +1,37 @@
+// Package api This file is generated ONCE as a starting point and will NOT be overwritten.
+// Modify it freely to add your business logic.
+// To regenerate, delete this file or set generate.handler.output.overwrite: true in config.
+package api
+
+// Service implements the ServiceInterface.
+// Add your dependencies here (database, clients, etc.)
+type Service struct {
+	// Calls counts the calls of the service, to check that invalid requests don't reach it.
+	Calls int
+}
+
+// NewService creates a new Service.
+func NewService() *Service {
+	return &Service{}
+}
+
+// Ensure Service implements ServiceInterface.
+var _ ServiceInterface = (*Service)(nil)
+
+// CreatePet handles POST /pets
+func (s *Service) CreatePet(ctx *CreatePetContext) (*CreatePetResponseData, error) {
+	s.Calls++
+	return NewCreatePetResponseData(&CreatePetResponse{ID: 1, Name: ctx.Body.Name}), nil
+}
+
+// GetPet handles GET /pets/{id}
+func (s *Service) GetPet(ctx *GetPetContext) (*GetPetResponseData, error) {
+	s.Calls++
+	return NewGetPetResponseData(&GetPetResponse{ID: ctx.PathParams.ID, Name: "rex"}), nil
+}
+
+// ListMyPets handles GET /pets/mine
+func (s *Service) ListMyPets(ctx *ListMyPetsContext) (*ListMyPetsResponseData, error) {
+	s.Calls++
+	return NewListMyPetsResponseData(&ListMyPetsResponse{}), nil
+}
@@ -0,0 +1,762 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// OapiErrorKind represents the type of error that occurred during request processing.
+type OapiErrorKind int
+
+const (
+	// OapiErrorKindParse indicates a parameter parsing error (invalid path/query/header parameter).
+	OapiErrorKindParse OapiErrorKind = iota
+
+	// OapiErrorKindDecode indicates a request body decoding error (invalid JSON, form data, etc.).
+	OapiErrorKindDecode
+
+	// OapiErrorKindValidation indicates a request validation error (failed schema validation).
+	OapiErrorKindValidation
+
+	// OapiErrorKindService indicates a service/business logic error returned by the service implementation.
+	OapiErrorKindService
+)
+
+// OapiHandlerError represents an error that occurred during request handling (parse, decode, validation).
+// When no typed error response is configured in the OpenAPI spec, this error type is used.
+// Custom error handlers can type-assert to this type to access error details.
+type OapiHandlerError struct {
+	Kind          OapiErrorKind
+	OperationID   string
+	Message       string
+	ParamName     string
+	ParamLocation string
+
+	// Err is the underlying error, e.g. the runtime.ValidationErrors of a validation error.
+	Err error
+}
+
+func (e OapiHandlerError) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the underlying error.
+func (e OapiHandlerError) Unwrap() error {
+	return e.Err
+}
+
+// OapiErrorResponse is the default JSON error response structure used by OapiDefaultErrorHandler.
+type OapiErrorResponse struct {
+	Error         string `json:"error"`
+	OperationID   string `json:"operation_id,omitempty"`
+	ParamName     string `json:"param_name,omitempty"`
+	ParamLocation string `json:"param_location,omitempty"`
+}
+
+// OapiErrorHandler handles errors that occur during request processing.
+// Implement this interface to customize error responses, logging, and metrics.
+type OapiErrorHandler interface {
+	// HandleError writes an error response to w with the given status code.
+	// The err is either an OapiHandlerError (for parse/decode/validation errors)
+	// or a typed error matching the OpenAPI spec's error response schema.
+	HandleError(w http.ResponseWriter, r *http.Request, statusCode int, err error)
+}
+
+// OapiDefaultErrorHandler provides the default error handling behavior.
+// It writes JSON error responses. For OapiHandlerError, it uses OapiErrorResponse.
+// For typed errors (from OpenAPI spec), it encodes them directly.
+type OapiDefaultErrorHandler struct{}
+
+// HandleError implements OapiErrorHandler with default JSON error responses.
+func (h *OapiDefaultErrorHandler) HandleError(w http.ResponseWriter, r *http.Request, statusCode int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	if handlerErr, ok := err.(OapiHandlerError); ok {
+		_ = json.NewEncoder(w).Encode(OapiErrorResponse{
+			Error:         handlerErr.Message,
+			OperationID:   handlerErr.OperationID,
+			ParamName:     handlerErr.ParamName,
+			ParamLocation: handlerErr.ParamLocation,
+		})
+		return
+	}
+
+	// Typed error from OpenAPI spec - encode directly
+	_ = json.NewEncoder(w).Encode(err)
+}
+
+// OapiWriteValidationError writes err as a 400 JSON response with a
+// {"errors": [{"field": "Items[0].Name", "message": "is required"}]} body.
+// Use it from a custom OapiErrorHandler for OapiErrorKindValidation errors.
+func OapiWriteValidationError(w http.ResponseWriter, err error) {
+	var errs runtime.ValidationErrors
+	if !errors.As(err, &errs) {
+		errs = runtime.NewValidationErrorsFromError(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(errs)
+}
+
+// ServiceInterface defines the service interface for business logic.
+type ServiceInterface interface {
+	CreatePet(ctx *CreatePetContext) (*CreatePetResponseData, error)
+
+	GetPet(ctx *GetPetContext) (*GetPetResponseData, error)
+
+	ListMyPets(ctx *ListMyPetsContext) (*ListMyPetsResponseData, error)
+}
+
+// HTTPAdapter adapts the ServiceInterface to HTTP handlers.
+// This struct is generated and should not be modified.
+type HTTPAdapter struct {
+	svc        ServiceInterface
+	errHandler OapiErrorHandler
+}
+
+// NewHTTPAdapter creates a new HTTPAdapter wrapping the given service.
+// If errHandler is nil, OapiDefaultErrorHandler is used.
+func NewHTTPAdapter(svc ServiceInterface, errHandler OapiErrorHandler) *HTTPAdapter {
+	if errHandler == nil {
+		errHandler = &OapiDefaultErrorHandler{}
+	}
+	return &HTTPAdapter{svc: svc, errHandler: errHandler}
+}
+
+// parseCreatePetRequest parses the CreatePet request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseCreatePetRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *CreatePetServiceRequestOptions {
+	opts := &CreatePetServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse request body
+	var body CreatePetBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:        OapiErrorKindDecode,
+			OperationID: "CreatePet",
+			Message:     err.Error(),
+		})
+		return nil
+	}
+	opts.Body = &body
+
+	return opts
+}
+
+// CreatePet handles POST /pets
+func (a *HTTPAdapter) CreatePet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer r.Body.Close()
+	opts := a.parseCreatePetRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.CreatePet(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 201
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// parseGetPetRequest parses the GetPet request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseGetPetRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *GetPetServiceRequestOptions {
+	opts := &GetPetServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse path parameters
+	pathParams := &GetPetPath{}
+	pathParamIDStr := pathValues["id"]
+
+	pathParamID, err := runtime.ParseString[int](pathParamIDStr)
+	if err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:          OapiErrorKindParse,
+			OperationID:   "GetPet",
+			Message:       err.Error(),
+			ParamName:     "id",
+			ParamLocation: "path",
+		})
+		return nil
+	}
+	pathParams.ID = pathParamID
+	opts.PathParams = pathParams
+
+	return opts
+}
+
+// GetPet handles GET /pets/{id}
+func (a *HTTPAdapter) GetPet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pathValues := map[string]string{
+		"id": r.PathValue("id"),
+	}
+	opts := a.parseGetPetRequest(w, r, pathValues)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.GetPet(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// parseListMyPetsRequest parses the ListMyPets request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseListMyPetsRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *ListMyPetsServiceRequestOptions {
+	opts := &ListMyPetsServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse query parameters
+	queryParams := &ListMyPetsQuery{}
+	query := r.URL.Query()
+	if queryParamLimitStr := query.Get("limit"); queryParamLimitStr != "" {
+		queryParamLimit, err := runtime.ParseString[int](queryParamLimitStr)
+		if err != nil {
+			a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+				Kind:          OapiErrorKindParse,
+				OperationID:   "ListMyPets",
+				Message:       err.Error(),
+				ParamName:     "limit",
+				ParamLocation: "query",
+			})
+			return nil
+		}
+		queryParams.Limit = &queryParamLimit
+	}
+	opts.Query = queryParams
+
+	return opts
+}
+
+// ListMyPets handles GET /pets/mine
+func (a *HTTPAdapter) ListMyPets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	opts := a.parseListMyPetsRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.ListMyPets(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// validateCreatePetRequest parses and validates the CreatePet request.
+// It writes the error response and returns false if the request is invalid.
+func (a *HTTPAdapter) validateCreatePetRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) bool {
+	opts := a.parseCreatePetRequest(w, r, pathValues)
+	if opts == nil {
+		return false
+	}
+	// Validate request
+	if err := opts.Validate(); err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:        OapiErrorKindValidation,
+			OperationID: "CreatePet",
+			Message:     err.Error(),
+			Err:         err,
+		})
+		return false
+	}
+
+	return true
+}
+
+// validateGetPetRequest parses and validates the GetPet request.
+// It writes the error response and returns false if the request is invalid.
+func (a *HTTPAdapter) validateGetPetRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) bool {
+	opts := a.parseGetPetRequest(w, r, pathValues)
+	if opts == nil {
+		return false
+	}
+	// Validate request
+	if err := opts.Validate(); err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:        OapiErrorKindValidation,
+			OperationID: "GetPet",
+			Message:     err.Error(),
+			Err:         err,
+		})
+		return false
+	}
+
+	return true
+}
+
+// validateListMyPetsRequest parses and validates the ListMyPets request.
+// It writes the error response and returns false if the request is invalid.
+func (a *HTTPAdapter) validateListMyPetsRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) bool {
+	opts := a.parseListMyPetsRequest(w, r, pathValues)
+	if opts == nil {
+		return false
+	}
+	// Validate request
+	if err := opts.Validate(); err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:        OapiErrorKindValidation,
+			OperationID: "ListMyPets",
+			Message:     err.Error(),
+			Err:         err,
+		})
+		return false
+	}
+
+	return true
+}
+
+// oapiRequestValidators are the request validators of the operations, looked up by method and path template.
+var oapiRequestValidators = []struct {
+	operationID string
+	method      string
+	path        string
+	validate    func(a *HTTPAdapter, w http.ResponseWriter, r *http.Request, pathValues map[string]string) bool
+}{
+	{"CreatePet", "POST", "/pets", (*HTTPAdapter).validateCreatePetRequest},
+	{"GetPet", "GET", "/pets/{id}", (*HTTPAdapter).validateGetPetRequest},
+	{"ListMyPets", "GET", "/pets/mine", (*HTTPAdapter).validateListMyPetsRequest},
+}
+
+// ValidationMiddleware validates the requests of the spec operations before passing them to the next handler,
+// the same way handlers do with request validation enabled. The operation is looked up by the method and path
+// of the request, requests of other operations are passed as they are.
+// Invalid requests are rejected with errHandler, or OapiDefaultErrorHandler if it's nil.
+// The request body is buffered, so that the next handler can read it.
+func ValidationMiddleware(errHandler OapiErrorHandler) func(http.Handler) http.Handler {
+	a := NewHTTPAdapter(nil, errHandler)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// The most specific path template wins, e.g. /pets/mine over /pets/{id}
+			matched := -1
+			var pathValues map[string]string
+			for i, v := range oapiRequestValidators {
+				if v.method != r.Method {
+					continue
+				}
+				values, ok := runtime.MatchPathTemplate(v.path, r.URL.EscapedPath())
+				if ok && (matched < 0 || len(values) < len(pathValues)) {
+					matched, pathValues = i, values
+				}
+			}
+			if matched < 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+			v := oapiRequestValidators[matched]
+
+			var body []byte
+			if r.Body != nil && r.Body != http.NoBody {
+				var err error
+				body, err = io.ReadAll(r.Body)
+				_ = r.Body.Close()
+				if err != nil {
+					a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+						Kind:        OapiErrorKindDecode,
+						OperationID: v.operationID,
+						Message:     err.Error(),
+					})
+					return
+				}
+				r.Body = io.NopCloser(bytes.NewReader(body))
+			}
+
+			vr := r.Clone(r.Context())
+			if r.Body != nil && r.Body != http.NoBody {
+				vr.Body = io.NopCloser(bytes.NewReader(body))
+			}
+			if !v.validate(a, w, vr, pathValues) {
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RouterOption is a function that configures the router.
+type RouterOption func(*routerConfig)
+
+type routerConfig struct {
+	middlewares          []func(http.Handler) http.Handler
+	operationMiddlewares map[string][]func(http.Handler) http.Handler
+	errHandler           OapiErrorHandler
+}
+
+// WithMiddleware adds middleware to the router.
+// Middlewares are applied in the order they are added, the first one being the outermost.
+func WithMiddleware(mw func(http.Handler) http.Handler) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.middlewares = append(cfg.middlewares, mw)
+	}
+}
+
+// WithOperationMiddleware adds middleware to the route of a single operation, e.g. to guard it with auth.
+// The operation ID is the Go name of the operation, e.g. "GetUser". The middleware runs inside the ones
+// added with WithMiddleware, in the order it's added, the first one being the outermost.
+func WithOperationMiddleware(operationID string, mw func(http.Handler) http.Handler) RouterOption {
+	return func(cfg *routerConfig) {
+		if cfg.operationMiddlewares == nil {
+			cfg.operationMiddlewares = make(map[string][]func(http.Handler) http.Handler)
+		}
+		cfg.operationMiddlewares[operationID] = append(cfg.operationMiddlewares[operationID], mw)
+	}
+}
+
+// operationHandler wraps the handler of the operation with the given ID with its middlewares.
+func (cfg *routerConfig) operationHandler(operationID string, h http.Handler) http.Handler {
+	middlewares := cfg.operationMiddlewares[operationID]
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+	return h
+}
+
+// WithErrorHandler sets a custom error handler for the router.
+// If not set, OapiDefaultErrorHandler is used.
+func WithErrorHandler(h OapiErrorHandler) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.errHandler = h
+	}
+}
+
+// NewRouter creates a new http.ServeMux with the given service implementation.
+func NewRouter(svc ServiceInterface, opts ...RouterOption) *http.ServeMux {
+	mux := http.NewServeMux()
+	RegisterRoutes(mux, svc, opts...)
+	return mux
+}
+
+// RegisterRoutes registers all operations on an existing http.ServeMux using
+// Go 1.22 method and path patterns. Path parameters are read with r.PathValue.
+func RegisterRoutes(mux *http.ServeMux, svc ServiceInterface, opts ...RouterOption) {
+	cfg := &routerConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	adapter := NewHTTPAdapter(svc, cfg.errHandler)
+	mux.Handle("POST /pets", applyMiddleware(cfg.operationHandler("CreatePet", http.HandlerFunc(adapter.CreatePet)), cfg.middlewares...))
+	mux.Handle("GET /pets/{id}", applyMiddleware(cfg.operationHandler("GetPet", http.HandlerFunc(adapter.GetPet)), cfg.middlewares...))
+	mux.Handle("GET /pets/mine", applyMiddleware(cfg.operationHandler("ListMyPets", http.HandlerFunc(adapter.ListMyPets)), cfg.middlewares...))
+}
+
+// applyMiddleware wraps a handler with the given middleware chain, the first middleware being the outermost.
+func applyMiddleware(h http.Handler, middlewares ...func(http.Handler) http.Handler) http.Handler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+	return h
+}
+
+type GetPetPath struct {
+	ID int `json:"id" validate:"required,gte=1"`
+}
+
+func (g GetPetPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(g))
+}
+
+type CreatePetBody = NewPet
+
+type ListMyPetsQuery struct {
+	Limit *int `json:"limit,omitempty" validate:"omitempty,lte=10"`
+}
+
+func (l ListMyPetsQuery) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(l))
+}
+
+// CreatePetResponseData wraps the success response with optional headers and status override.
+type CreatePetResponseData struct {
+	Body    *CreatePetResponse
+	Headers http.Header
+	Status  int // 0 = use default (201)
+}
+
+// NewCreatePetResponseData creates a new CreatePetResponseData with the given body.
+func NewCreatePetResponseData(body *CreatePetResponse) *CreatePetResponseData {
+	return &CreatePetResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *CreatePetResponseData) WithHeaders(h http.Header) *CreatePetResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *CreatePetResponseData) WithStatus(code int) *CreatePetResponseData {
+	r.Status = code
+	return r
+}
+
+// GetPetResponseData wraps the success response with optional headers and status override.
+type GetPetResponseData struct {
+	Body    *GetPetResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewGetPetResponseData creates a new GetPetResponseData with the given body.
+func NewGetPetResponseData(body *GetPetResponse) *GetPetResponseData {
+	return &GetPetResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *GetPetResponseData) WithHeaders(h http.Header) *GetPetResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *GetPetResponseData) WithStatus(code int) *GetPetResponseData {
+	r.Status = code
+	return r
+}
+
+// ListMyPetsResponseData wraps the success response with optional headers and status override.
+type ListMyPetsResponseData struct {
+	Body    *ListMyPetsResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewListMyPetsResponseData creates a new ListMyPetsResponseData with the given body.
+func NewListMyPetsResponseData(body *ListMyPetsResponse) *ListMyPetsResponseData {
+	return &ListMyPetsResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *ListMyPetsResponseData) WithHeaders(h http.Header) *ListMyPetsResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *ListMyPetsResponseData) WithStatus(code int) *ListMyPetsResponseData {
+	r.Status = code
+	return r
+}
+
+type CreatePetResponse = Pet
+
+type GetPetResponse = Pet
+
+type ListMyPetsResponse []Pet
+
+// CreatePetServiceRequestOptions holds all parameters for the CreatePet operation.
+type CreatePetServiceRequestOptions struct {
+	Body *CreatePetBody
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *CreatePetServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// CreatePetContext is the typed context of the CreatePet operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type CreatePetContext struct {
+	context.Context
+	*CreatePetServiceRequestOptions
+}
+
+// WithContext returns the typed context of the CreatePet operation, with ctx the context of the request.
+func (o *CreatePetServiceRequestOptions) WithContext(ctx context.Context) *CreatePetContext {
+	return &CreatePetContext{Context: ctx, CreatePetServiceRequestOptions: o}
+}
+
+// GetPetServiceRequestOptions holds all parameters for the GetPet operation.
+type GetPetServiceRequestOptions struct {
+	PathParams *GetPetPath
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *GetPetServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPetContext is the typed context of the GetPet operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type GetPetContext struct {
+	context.Context
+	*GetPetServiceRequestOptions
+}
+
+// WithContext returns the typed context of the GetPet operation, with ctx the context of the request.
+func (o *GetPetServiceRequestOptions) WithContext(ctx context.Context) *GetPetContext {
+	return &GetPetContext{Context: ctx, GetPetServiceRequestOptions: o}
+}
+
+// ListMyPetsServiceRequestOptions holds all parameters for the ListMyPets operation.
+type ListMyPetsServiceRequestOptions struct {
+	Query *ListMyPetsQuery
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *ListMyPetsServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Query != nil {
+		if v, ok := any(o.Query).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Query", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// ListMyPetsContext is the typed context of the ListMyPets operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type ListMyPetsContext struct {
+	context.Context
+	*ListMyPetsServiceRequestOptions
+}
+
+// WithContext returns the typed context of the ListMyPets operation, with ctx the context of the request.
+func (o *ListMyPetsServiceRequestOptions) WithContext(ctx context.Context) *ListMyPetsContext {
+	return &ListMyPetsContext{Context: ctx, ListMyPetsServiceRequestOptions: o}
+}
+
+type NewPet struct {
+	Name string `json:"name" validate:"required,min=1"`
+}
+
+func (n NewPet) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(n))
+}
+
+type Pet struct {
+	ID   int    `json:"id" validate:"required"`
+	Name string `json:"name" validate:"required"`
+}
+
+func (p Pet) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(p))
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
@@ -3,10 +3,6 @@
 // To regenerate, delete this file or set generate.handler.output.overwrite: true in config.
 package api
 
-import (
-	"context"
-)
-
 // Service implements the ServiceInterface.
 // Add your dependencies here (database, clients, etc.)
 type Service struct {
@@ -22,35 +18,35 @@ var _ ServiceInterface = (*Service)(nil)
 
 // HealthCheck handles GET /health
 // Health check endpoint
-func (s *Service) HealthCheck(ctx context.Context) (*HealthCheckResponseData, error) {
+func (s *Service) HealthCheck(ctx *HealthCheckContext) (*HealthCheckResponseData, error) {
 	// TODO: Implement your business logic here
 	return NewHealthCheckResponseData(new(HealthCheckResponse)), nil
 }
 
 // ListUsers handles GET /users
 // List all users
-func (s *Service) ListUsers(ctx context.Context, opts *ListUsersServiceRequestOptions) (*ListUsersResponseData, error) {
+func (s *Service) ListUsers(ctx *ListUsersContext) (*ListUsersResponseData, error) {
 	// TODO: Implement your business logic here
 	return NewListUsersResponseData(new(ListUsersResponse)), nil
 }
 
 // CreateUser handles POST /users
 // Create a new user
-func (s *Service) CreateUser(ctx context.Context, opts *CreateUserServiceRequestOptions) (*CreateUserResponseData, error) {
+func (s *Service) CreateUser(ctx *CreateUserContext) (*CreateUserResponseData, error) {
 	// TODO: Implement your business logic here
 	return NewCreateUserResponseData(new(CreateUserResponse)), nil
 }
 
 // GetUser handles GET /users/{id}
 // Get a user by ID
-func (s *Service) GetUser(ctx context.Context, opts *GetUserServiceRequestOptions) (*GetUserResponseData, error) {
+func (s *Service) GetUser(ctx *GetUserContext) (*GetUserResponseData, error) {
 	// TODO: Implement your business logic here
 	return NewGetUserResponseData(new(GetUserResponse)), nil
 }
 
 // DeleteUser handles DELETE /users/{id}
 // Delete a user
-func (s *Service) DeleteUser(ctx context.Context, opts *DeleteUserServiceRequestOptions) (*DeleteUserResponseData, error) {
+func (s *Service) DeleteUser(ctx *DeleteUserContext) (*DeleteUserResponseData, error) {
 	// TODO: Implement your business logic here
 	return NewDeleteUserResponseData(nil), nil
 }
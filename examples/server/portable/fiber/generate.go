@@ -0,0 +1,4 @@
+package api
+
+//go:generate cp ../service.go.src ./service.go
+//go:generate go run github.com/doordash-oss/oapi-codegen-dd/v3/cmd/oapi-codegen -config cfg.yml ../../api.yml
@@ -0,0 +1,79 @@
+// Package api The service of the portable example. It's the one service.go of both the chi and the fiber packages,
+// copied there by go:generate: it only depends on the generated service contract, the same for every handler kind.
+package api
+
+import (
+	"strconv"
+	"sync"
+)
+
+// Service implements the ServiceInterface, keeping the users in memory.
+type Service struct {
+	mu     sync.Mutex
+	users  []User
+	nextID int
+}
+
+// NewService creates a new Service.
+func NewService() *Service {
+	return &Service{}
+}
+
+// Ensure Service implements ServiceInterface.
+var _ ServiceInterface = (*Service)(nil)
+
+// HealthCheck handles GET /health
+func (s *Service) HealthCheck(ctx *HealthCheckContext) (*HealthCheckResponseData, error) {
+	return NewHealthCheckResponseData(&HealthCheckResponse{Status: "ok"}), nil
+}
+
+// ListUsers returns the users in creation order, up to the limit of the query if any.
+func (s *Service) ListUsers(ctx *ListUsersContext) (*ListUsersResponseData, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	users := ListUsersResponse(s.users)
+	if ctx.Query != nil && ctx.Query.Limit != nil && *ctx.Query.Limit < len(users) {
+		users = users[:*ctx.Query.Limit]
+	}
+	return NewListUsersResponseData(&users), nil
+}
+
+// CreateUser stores the user of the request body with a new ID.
+func (s *Service) CreateUser(ctx *CreateUserContext) (*CreateUserResponseData, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextID++
+	user := User{ID: strconv.Itoa(s.nextID), Name: ctx.Body.Name, Email: ctx.Body.Email}
+	s.users = append(s.users, user)
+	return NewCreateUserResponseData(&user).WithStatus(201), nil
+}
+
+// GetUser returns the user with the ID of the path.
+func (s *Service) GetUser(ctx *GetUserContext) (*GetUserResponseData, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, user := range s.users {
+		if user.ID == ctx.PathParams.ID {
+			return NewGetUserResponseData(&user), nil
+		}
+	}
+	message := "user " + ctx.PathParams.ID + " not found"
+	return nil, GetUserErrorResponse{Message: &message}
+}
+
+// DeleteUser removes the user with the ID of the path, if any.
+func (s *Service) DeleteUser(ctx *DeleteUserContext) (*DeleteUserResponseData, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, user := range s.users {
+		if user.ID == ctx.PathParams.ID {
+			s.users = append(s.users[:i], s.users[i+1:]...)
+			break
+		}
+	}
+	return NewDeleteUserResponseData(nil), nil
+}
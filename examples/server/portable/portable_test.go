@@ -0,0 +1,84 @@
+package portable
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	chiapi "github.com/doordash-oss/oapi-codegen-dd/v3/examples/server/portable/chi"
+	fiberapi "github.com/doordash-oss/oapi-codegen-dd/v3/examples/server/portable/fiber"
+	"github.com/gofiber/fiber/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// doFunc sends a request to a router.
+type doFunc func(req *http.Request) (*http.Response, error)
+
+// TestPortableService runs the same requests against the chi and the fiber routers,
+// both serving the service built from the one service.go.src.
+func TestPortableService(t *testing.T) {
+	routers := map[string]func() doFunc{
+		"chi": func() doFunc {
+			router := chiapi.NewRouter(chiapi.NewService())
+			return func(req *http.Request) (*http.Response, error) {
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+				return rr.Result(), nil
+			}
+		},
+		"fiber": func() doFunc {
+			app := fiber.New()
+			fiberapi.NewRouter(app, fiberapi.NewService())
+			return func(req *http.Request) (*http.Response, error) {
+				return app.Test(req)
+			}
+		},
+	}
+
+	for name, newRouter := range routers {
+		t.Run(name, func(t *testing.T) {
+			do := newRouter()
+			send := func(t *testing.T, method, target, body string) (int, string) {
+				t.Helper()
+				var reader io.Reader
+				if body != "" {
+					reader = strings.NewReader(body)
+				}
+				req := httptest.NewRequest(method, target, reader)
+				if body != "" {
+					req.Header.Set("Content-Type", "application/json")
+				}
+				resp, err := do(req)
+				require.NoError(t, err)
+				defer func() { _ = resp.Body.Close() }()
+				data, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+				return resp.StatusCode, strings.TrimSpace(string(data))
+			}
+
+			status, body := send(t, http.MethodPost, "/users", `{"name":"Ada","email":"ada@example.com"}`)
+			assert.Equal(t, http.StatusCreated, status)
+			assert.JSONEq(t, `{"id":"1","name":"Ada","email":"ada@example.com"}`, body)
+
+			_, _ = send(t, http.MethodPost, "/users", `{"name":"Grace","email":"grace@example.com"}`)
+
+			status, body = send(t, http.MethodGet, "/users?limit=1", "")
+			assert.Equal(t, http.StatusOK, status)
+			assert.JSONEq(t, `[{"id":"1","name":"Ada","email":"ada@example.com"}]`, body)
+
+			status, body = send(t, http.MethodGet, "/users/2", "")
+			assert.Equal(t, http.StatusOK, status)
+			assert.JSONEq(t, `{"id":"2","name":"Grace","email":"grace@example.com"}`, body)
+
+			status, _ = send(t, http.MethodDelete, "/users/2", "")
+			assert.Equal(t, http.StatusNoContent, status)
+
+			status, body = send(t, http.MethodGet, "/users/2", "")
+			assert.Equal(t, http.StatusInternalServerError, status)
+			assert.JSONEq(t, `{"message":"user 2 not found"}`, body)
+		})
+	}
+}
@@ -5,12 +5,14 @@ package testcase
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 
 	beego "github.com/beego/beego/v2/server/web"
 	beecontext "github.com/beego/beego/v2/server/web/context"
+	"github.com/google/uuid"
 	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
 )
 
@@ -40,12 +42,20 @@ type OapiHandlerError struct {
 	Message       string
 	ParamName     string
 	ParamLocation string
+
+	// Err is the underlying error, e.g. the runtime.ValidationErrors of a validation error.
+	Err error
 }
 
 func (e OapiHandlerError) Error() string {
 	return e.Message
 }
 
+// Unwrap returns the underlying error.
+func (e OapiHandlerError) Unwrap() error {
+	return e.Err
+}
+
 // OapiErrorResponse is the default JSON error response structure used by OapiDefaultErrorHandler.
 type OapiErrorResponse struct {
 	Error         string `json:"error"`
@@ -87,54 +97,74 @@ func (h *OapiDefaultErrorHandler) HandleError(w http.ResponseWriter, r *http.Req
 	_ = json.NewEncoder(w).Encode(err)
 }
 
+// OapiWriteValidationError writes err as a 400 JSON response with a
+// {"errors": [{"field": "Items[0].Name", "message": "is required"}]} body.
+// Use it from a custom OapiErrorHandler for OapiErrorKindValidation errors.
+func OapiWriteValidationError(w http.ResponseWriter, err error) {
+	var errs runtime.ValidationErrors
+	if !errors.As(err, &errs) {
+		errs = runtime.NewValidationErrorsFromError(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(errs)
+}
+
 // ServiceInterface defines the service interface for business logic.
 type ServiceInterface interface {
 	// HealthCheck Health check endpoint
-	HealthCheck(ctx context.Context) (*HealthCheckResponseData, error)
+	HealthCheck(ctx *HealthCheckContext) (*HealthCheckResponseData, error)
 	// ListUsers List all users
-	ListUsers(ctx context.Context, opts *ListUsersServiceRequestOptions) (*ListUsersResponseData, error)
+	ListUsers(ctx *ListUsersContext) (*ListUsersResponseData, error)
 	// CreateUser Create a new user via JSON
-	CreateUser(ctx context.Context, opts *CreateUserServiceRequestOptions) (*CreateUserResponseData, error)
+	CreateUser(ctx *CreateUserContext) (*CreateUserResponseData, error)
 	// ImportUsers Import users from CSV file
-	ImportUsers(ctx context.Context, opts *ImportUsersServiceRequestOptions) (*ImportUsersResponseData, error)
+	ImportUsers(ctx *ImportUsersContext) (*ImportUsersResponseData, error)
 	// GetUser Get a user by ID
-	GetUser(ctx context.Context, opts *GetUserServiceRequestOptions) (*GetUserResponseData, error)
+	GetUser(ctx *GetUserContext) (*GetUserResponseData, error)
 	// DeleteUser Delete a user
-	DeleteUser(ctx context.Context, opts *DeleteUserServiceRequestOptions) (*DeleteUserResponseData, error)
+	DeleteUser(ctx *DeleteUserContext) (*DeleteUserResponseData, error)
 	// GetUserAvatar Get user avatar image
-	GetUserAvatar(ctx context.Context, opts *GetUserAvatarServiceRequestOptions) (*GetUserAvatarResponseData, error)
+	GetUserAvatar(ctx *GetUserAvatarContext) (*GetUserAvatarResponseData, error)
 	// UploadUserAvatar Upload user avatar
-	UploadUserAvatar(ctx context.Context, opts *UploadUserAvatarServiceRequestOptions) (*UploadUserAvatarResponseData, error)
+	UploadUserAvatar(ctx *UploadUserAvatarContext) (*UploadUserAvatarResponseData, error)
 	// SubmitContactForm Submit contact form
-	SubmitContactForm(ctx context.Context, opts *SubmitContactFormServiceRequestOptions) (*SubmitContactFormResponseData, error)
+	SubmitContactForm(ctx *SubmitContactFormContext) (*SubmitContactFormResponseData, error)
 	// CreateNote Create a note from plain text
-	CreateNote(ctx context.Context, opts *CreateNoteServiceRequestOptions) (*CreateNoteResponseData, error)
+	CreateNote(ctx *CreateNoteContext) (*CreateNoteResponseData, error)
 	// ProcessXMLData Process XML data (demonstrates custom content type handling)
-	ProcessXMLData(ctx context.Context, opts *ProcessXMLDataServiceRequestOptions) (*ProcessXMLDataResponseData, error)
+	ProcessXMLData(ctx *ProcessXMLDataContext) (*ProcessXMLDataResponseData, error)
 	// ExportData Export all data as binary archive
-	ExportData(ctx context.Context) (*ExportDataResponseData, error)
+	ExportData(ctx *ExportDataContext) (*ExportDataResponseData, error)
 	// GetOAuthToken Get OAuth token (form-encoded response)
-	GetOAuthToken(ctx context.Context, opts *GetOAuthTokenServiceRequestOptions) (*GetOAuthTokenResponseData, error)
+	GetOAuthToken(ctx *GetOAuthTokenContext) (*GetOAuthTokenResponseData, error)
 	// GetItemsByType Get items by type (tests reserved Go keyword as path param)
-	GetItemsByType(ctx context.Context, opts *GetItemsByTypeServiceRequestOptions) (*GetItemsByTypeResponseData, error)
+	GetItemsByType(ctx *GetItemsByTypeContext) (*GetItemsByTypeResponseData, error)
 	// Search Search with union type response (oneOf)
-	Search(ctx context.Context, opts *SearchServiceRequestOptions) (*SearchResponseData, error)
+	Search(ctx *SearchContext) (*SearchResponseData, error)
 	// GetStatus Get status (uses reusable response)
-	GetStatus(ctx context.Context) (*GetStatusResponseData, error)
+	GetStatus(ctx *GetStatusContext) (*GetStatusResponseData, error)
 	// UploadImage Upload image (wildcard content type)
-	UploadImage(ctx context.Context, opts *UploadImageServiceRequestOptions) (*UploadImageResponseData, error)
+	UploadImage(ctx *UploadImageContext) (*UploadImageResponseData, error)
 	// ListProducts List products with various query param types
-	ListProducts(ctx context.Context, opts *ListProductsServiceRequestOptions) (*ListProductsResponseData, error)
+	ListProducts(ctx *ListProductsContext) (*ListProductsResponseData, error)
 	// GetCategory Get a category by ID (integer path param)
-	GetCategory(ctx context.Context, opts *GetCategoryServiceRequestOptions) (*GetCategoryResponseData, error)
+	GetCategory(ctx *GetCategoryContext) (*GetCategoryResponseData, error)
+	// ListTags List tags (array header params)
+	ListTags(ctx *ListTagsContext) (*ListTagsResponseData, error)
 	// GetItemsByStatus Get items by type and rating (string + number path params)
-	GetItemsByStatus(ctx context.Context, opts *GetItemsByStatusServiceRequestOptions) (*GetItemsByStatusResponseData, error)
+	GetItemsByStatus(ctx *GetItemsByStatusContext) (*GetItemsByStatusResponseData, error)
 	// GetUserPost Get a specific post by a user
-	GetUserPost(ctx context.Context, opts *GetUserPostServiceRequestOptions) (*GetUserPostResponseData, error)
+	GetUserPost(ctx *GetUserPostContext) (*GetUserPostResponseData, error)
 	// CreateOrder Create an order (demonstrates typed error responses)
-	CreateOrder(ctx context.Context, opts *CreateOrderServiceRequestOptions) (*CreateOrderResponseData, error)
+	CreateOrder(ctx *CreateOrderContext) (*CreateOrderResponseData, error)
 	// CreateCompany Create a company with nested address
-	CreateCompany(ctx context.Context, opts *CreateCompanyServiceRequestOptions) (*CreateCompanyResponseData, error)
+	CreateCompany(ctx *CreateCompanyContext) (*CreateCompanyResponseData, error)
+	// GetReport Get the report of a day (date path param)
+	GetReport(ctx *GetReportContext) (*GetReportResponseData, error)
+	// GetReportEntry Get an entry of a report (date and uuid path params)
+	GetReportEntry(ctx *GetReportEntryContext) (*GetReportEntryResponseData, error)
 }
 
 // HTTPAdapter adapts the ServiceInterface to HTTP handlers.
@@ -158,7 +188,7 @@ func (a *HTTPAdapter) HealthCheck(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// Call business logic
-	resp, err := a.svc.HealthCheck(ctx)
+	resp, err := a.svc.HealthCheck(&HealthCheckContext{Context: ctx})
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -186,9 +216,9 @@ func (a *HTTPAdapter) HealthCheck(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ListUsers handles GET /users
-func (a *HTTPAdapter) ListUsers(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// parseListUsersRequest parses the ListUsers request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseListUsersRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *ListUsersServiceRequestOptions {
 	opts := &ListUsersServiceRequestOptions{}
 	opts.RawRequest = r
 
@@ -205,7 +235,7 @@ func (a *HTTPAdapter) ListUsers(w http.ResponseWriter, r *http.Request) {
 				ParamName:     "limit",
 				ParamLocation: "query",
 			})
-			return
+			return nil
 		}
 		queryParams.Limit = &queryParamLimit
 	}
@@ -220,8 +250,19 @@ func (a *HTTPAdapter) ListUsers(w http.ResponseWriter, r *http.Request) {
 	}
 	opts.Header = headerParams
 
+	return opts
+}
+
+// ListUsers handles GET /users
+func (a *HTTPAdapter) ListUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	opts := a.parseListUsersRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
 	// Call business logic
-	resp, err := a.svc.ListUsers(ctx, opts)
+	resp, err := a.svc.ListUsers(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -249,14 +290,13 @@ func (a *HTTPAdapter) ListUsers(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// CreateUser handles POST /users
-func (a *HTTPAdapter) CreateUser(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// parseCreateUserRequest parses the CreateUser request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseCreateUserRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *CreateUserServiceRequestOptions {
 	opts := &CreateUserServiceRequestOptions{}
 	opts.RawRequest = r
 
 	// Parse request body
-	defer r.Body.Close()
 	var body CreateUserBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
@@ -264,12 +304,24 @@ func (a *HTTPAdapter) CreateUser(w http.ResponseWriter, r *http.Request) {
 			OperationID: "CreateUser",
 			Message:     err.Error(),
 		})
-		return
+		return nil
 	}
 	opts.Body = &body
 
+	return opts
+}
+
+// CreateUser handles POST /users
+func (a *HTTPAdapter) CreateUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer r.Body.Close()
+	opts := a.parseCreateUserRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
 	// Call business logic
-	resp, err := a.svc.CreateUser(ctx, opts)
+	resp, err := a.svc.CreateUser(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -297,21 +349,20 @@ func (a *HTTPAdapter) CreateUser(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ImportUsers handles POST /users/import
-func (a *HTTPAdapter) ImportUsers(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// parseImportUsersRequest parses the ImportUsers request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseImportUsersRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *ImportUsersServiceRequestOptions {
 	opts := &ImportUsersServiceRequestOptions{}
 	opts.RawRequest = r
 
 	// Parse request body
-	defer r.Body.Close()
 	if err := r.ParseMultipartForm(32 << 20); err != nil {
 		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
 			Kind:        OapiErrorKindDecode,
 			OperationID: "ImportUsers",
 			Message:     err.Error(),
 		})
-		return
+		return nil
 	}
 	var body ImportUsersBody
 	if fileHeaders := r.MultipartForm.File["file"]; len(fileHeaders) > 0 {
@@ -325,8 +376,20 @@ func (a *HTTPAdapter) ImportUsers(w http.ResponseWriter, r *http.Request) {
 	}
 	opts.Body = &body
 
+	return opts
+}
+
+// ImportUsers handles POST /users/import
+func (a *HTTPAdapter) ImportUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer r.Body.Close()
+	opts := a.parseImportUsersRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
 	// Call business logic
-	resp, err := a.svc.ImportUsers(ctx, opts)
+	resp, err := a.svc.ImportUsers(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -354,20 +417,34 @@ func (a *HTTPAdapter) ImportUsers(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetUser handles GET /users/{id}
-func (a *HTTPAdapter) GetUser(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// parseGetUserRequest parses the GetUser request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseGetUserRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *GetUserServiceRequestOptions {
 	opts := &GetUserServiceRequestOptions{}
 	opts.RawRequest = r
 
 	// Parse path parameters
 	pathParams := &GetUserPath{}
-	pathParamIDStr := r.PathValue("id")
+	pathParamIDStr := pathValues["id"]
 	pathParams.ID = pathParamIDStr
 	opts.PathParams = pathParams
 
+	return opts
+}
+
+// GetUser handles GET /users/{id}
+func (a *HTTPAdapter) GetUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pathValues := map[string]string{
+		"id": r.PathValue("id"),
+	}
+	opts := a.parseGetUserRequest(w, r, pathValues)
+	if opts == nil {
+		return
+	}
+
 	// Call business logic
-	resp, err := a.svc.GetUser(ctx, opts)
+	resp, err := a.svc.GetUser(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -395,20 +472,34 @@ func (a *HTTPAdapter) GetUser(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteUser handles DELETE /users/{id}
-func (a *HTTPAdapter) DeleteUser(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// parseDeleteUserRequest parses the DeleteUser request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseDeleteUserRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *DeleteUserServiceRequestOptions {
 	opts := &DeleteUserServiceRequestOptions{}
 	opts.RawRequest = r
 
 	// Parse path parameters
 	pathParams := &DeleteUserPath{}
-	pathParamIDStr := r.PathValue("id")
+	pathParamIDStr := pathValues["id"]
 	pathParams.ID = pathParamIDStr
 	opts.PathParams = pathParams
 
+	return opts
+}
+
+// DeleteUser handles DELETE /users/{id}
+func (a *HTTPAdapter) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pathValues := map[string]string{
+		"id": r.PathValue("id"),
+	}
+	opts := a.parseDeleteUserRequest(w, r, pathValues)
+	if opts == nil {
+		return
+	}
+
 	// Call business logic
-	resp, err := a.svc.DeleteUser(ctx, opts)
+	resp, err := a.svc.DeleteUser(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -432,20 +523,34 @@ func (a *HTTPAdapter) DeleteUser(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(status)
 }
 
-// GetUserAvatar handles GET /users/{id}/avatar
-func (a *HTTPAdapter) GetUserAvatar(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// parseGetUserAvatarRequest parses the GetUserAvatar request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseGetUserAvatarRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *GetUserAvatarServiceRequestOptions {
 	opts := &GetUserAvatarServiceRequestOptions{}
 	opts.RawRequest = r
 
 	// Parse path parameters
 	pathParams := &GetUserAvatarPath{}
-	pathParamIDStr := r.PathValue("id")
+	pathParamIDStr := pathValues["id"]
 	pathParams.ID = pathParamIDStr
 	opts.PathParams = pathParams
 
+	return opts
+}
+
+// GetUserAvatar handles GET /users/{id}/avatar
+func (a *HTTPAdapter) GetUserAvatar(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pathValues := map[string]string{
+		"id": r.PathValue("id"),
+	}
+	opts := a.parseGetUserAvatarRequest(w, r, pathValues)
+	if opts == nil {
+		return
+	}
+
 	// Call business logic
-	resp, err := a.svc.GetUserAvatar(ctx, opts)
+	resp, err := a.svc.GetUserAvatar(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		if _, ok := err.(*GetUserAvatarErrorResponse); ok {
@@ -470,33 +575,53 @@ func (a *HTTPAdapter) GetUserAvatar(w http.ResponseWriter, r *http.Request) {
 		status = resp.Status
 	}
 	w.Header().Set("Content-Type", "application/octet-stream")
-	w.WriteHeader(status)
 	if resp != nil && resp.Body != nil {
-		data, err := resp.Body.Bytes()
+		// Binary body is streamed from the file
+		body, err := resp.Body.Reader()
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
-		_, _ = w.Write(data)
+		defer func() { _ = body.Close() }()
+		w.WriteHeader(status)
+		_, _ = io.Copy(w, body)
+		return
 	}
+	w.WriteHeader(status)
 }
 
-// UploadUserAvatar handles PUT /users/{id}/avatar
-func (a *HTTPAdapter) UploadUserAvatar(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// parseUploadUserAvatarRequest parses the UploadUserAvatar request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseUploadUserAvatarRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *UploadUserAvatarServiceRequestOptions {
 	opts := &UploadUserAvatarServiceRequestOptions{}
 	opts.RawRequest = r
 
 	// Parse path parameters
 	pathParams := &UploadUserAvatarPath{}
-	pathParamIDStr := r.PathValue("id")
+	pathParamIDStr := pathValues["id"]
 	pathParams.ID = pathParamIDStr
 	opts.PathParams = pathParams
 	// Parse request body
+	// Binary body is streamed to the service
+	opts.Body = r.Body
+
+	return opts
+}
+
+// UploadUserAvatar handles PUT /users/{id}/avatar
+func (a *HTTPAdapter) UploadUserAvatar(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 	defer r.Body.Close()
+	pathValues := map[string]string{
+		"id": r.PathValue("id"),
+	}
+	opts := a.parseUploadUserAvatarRequest(w, r, pathValues)
+	if opts == nil {
+		return
+	}
 
 	// Call business logic
-	resp, err := a.svc.UploadUserAvatar(ctx, opts)
+	resp, err := a.svc.UploadUserAvatar(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -520,14 +645,13 @@ func (a *HTTPAdapter) UploadUserAvatar(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(status)
 }
 
-// SubmitContactForm handles POST /contact
-func (a *HTTPAdapter) SubmitContactForm(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// parseSubmitContactFormRequest parses the SubmitContactForm request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseSubmitContactFormRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *SubmitContactFormServiceRequestOptions {
 	opts := &SubmitContactFormServiceRequestOptions{}
 	opts.RawRequest = r
 
 	// Parse request body
-	defer r.Body.Close()
 	var body SubmitContactFormBody
 	formBytes, err := io.ReadAll(r.Body)
 	if err != nil {
@@ -536,7 +660,7 @@ func (a *HTTPAdapter) SubmitContactForm(w http.ResponseWriter, r *http.Request)
 			OperationID: "SubmitContactForm",
 			Message:     err.Error(),
 		})
-		return
+		return nil
 	}
 	jsonBytes, err := runtime.ConvertFormFields(formBytes)
 	if err != nil {
@@ -545,7 +669,7 @@ func (a *HTTPAdapter) SubmitContactForm(w http.ResponseWriter, r *http.Request)
 			OperationID: "SubmitContactForm",
 			Message:     err.Error(),
 		})
-		return
+		return nil
 	}
 	if err := json.Unmarshal(jsonBytes, &body); err != nil {
 		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
@@ -553,12 +677,24 @@ func (a *HTTPAdapter) SubmitContactForm(w http.ResponseWriter, r *http.Request)
 			OperationID: "SubmitContactForm",
 			Message:     err.Error(),
 		})
-		return
+		return nil
 	}
 	opts.Body = &body
 
+	return opts
+}
+
+// SubmitContactForm handles POST /contact
+func (a *HTTPAdapter) SubmitContactForm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer r.Body.Close()
+	opts := a.parseSubmitContactFormRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
 	// Call business logic
-	resp, err := a.svc.SubmitContactForm(ctx, opts)
+	resp, err := a.svc.SubmitContactForm(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -586,14 +722,13 @@ func (a *HTTPAdapter) SubmitContactForm(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// CreateNote handles POST /notes
-func (a *HTTPAdapter) CreateNote(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// parseCreateNoteRequest parses the CreateNote request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseCreateNoteRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *CreateNoteServiceRequestOptions {
 	opts := &CreateNoteServiceRequestOptions{}
 	opts.RawRequest = r
 
 	// Parse request body
-	defer r.Body.Close()
 	bodyBytes, err := io.ReadAll(r.Body)
 	if err != nil {
 		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
@@ -601,13 +736,25 @@ func (a *HTTPAdapter) CreateNote(w http.ResponseWriter, r *http.Request) {
 			OperationID: "CreateNote",
 			Message:     err.Error(),
 		})
-		return
+		return nil
 	}
 	body := CreateNoteBody(string(bodyBytes))
 	opts.Body = &body
 
+	return opts
+}
+
+// CreateNote handles POST /notes
+func (a *HTTPAdapter) CreateNote(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer r.Body.Close()
+	opts := a.parseCreateNoteRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
 	// Call business logic
-	resp, err := a.svc.CreateNote(ctx, opts)
+	resp, err := a.svc.CreateNote(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -635,17 +782,28 @@ func (a *HTTPAdapter) CreateNote(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ProcessXMLData handles POST /xml-data
-func (a *HTTPAdapter) ProcessXMLData(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// parseProcessXMLDataRequest parses the ProcessXMLData request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseProcessXMLDataRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *ProcessXMLDataServiceRequestOptions {
 	opts := &ProcessXMLDataServiceRequestOptions{}
 	opts.RawRequest = r
 
 	// Parse request body
+
+	return opts
+}
+
+// ProcessXMLData handles POST /xml-data
+func (a *HTTPAdapter) ProcessXMLData(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 	defer r.Body.Close()
+	opts := a.parseProcessXMLDataRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
 
 	// Call business logic
-	resp, err := a.svc.ProcessXMLData(ctx, opts)
+	resp, err := a.svc.ProcessXMLData(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -679,7 +837,7 @@ func (a *HTTPAdapter) ExportData(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// Call business logic
-	resp, err := a.svc.ExportData(ctx)
+	resp, err := a.svc.ExportData(&ExportDataContext{Context: ctx})
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -701,25 +859,28 @@ func (a *HTTPAdapter) ExportData(w http.ResponseWriter, r *http.Request) {
 		status = resp.Status
 	}
 	w.Header().Set("Content-Type", "application/octet-stream")
-	w.WriteHeader(status)
 	if resp != nil && resp.Body != nil {
-		data, err := resp.Body.Bytes()
+		// Binary body is streamed from the file
+		body, err := resp.Body.Reader()
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
-		_, _ = w.Write(data)
+		defer func() { _ = body.Close() }()
+		w.WriteHeader(status)
+		_, _ = io.Copy(w, body)
+		return
 	}
+	w.WriteHeader(status)
 }
 
-// GetOAuthToken handles POST /oauth/token
-func (a *HTTPAdapter) GetOAuthToken(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// parseGetOAuthTokenRequest parses the GetOAuthToken request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseGetOAuthTokenRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *GetOAuthTokenServiceRequestOptions {
 	opts := &GetOAuthTokenServiceRequestOptions{}
 	opts.RawRequest = r
 
 	// Parse request body
-	defer r.Body.Close()
 	var body GetOAuthTokenBody
 	formBytes, err := io.ReadAll(r.Body)
 	if err != nil {
@@ -728,7 +889,7 @@ func (a *HTTPAdapter) GetOAuthToken(w http.ResponseWriter, r *http.Request) {
 			OperationID: "GetOAuthToken",
 			Message:     err.Error(),
 		})
-		return
+		return nil
 	}
 	jsonBytes, err := runtime.ConvertFormFields(formBytes)
 	if err != nil {
@@ -737,7 +898,7 @@ func (a *HTTPAdapter) GetOAuthToken(w http.ResponseWriter, r *http.Request) {
 			OperationID: "GetOAuthToken",
 			Message:     err.Error(),
 		})
-		return
+		return nil
 	}
 	if err := json.Unmarshal(jsonBytes, &body); err != nil {
 		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
@@ -745,12 +906,24 @@ func (a *HTTPAdapter) GetOAuthToken(w http.ResponseWriter, r *http.Request) {
 			OperationID: "GetOAuthToken",
 			Message:     err.Error(),
 		})
-		return
+		return nil
 	}
 	opts.Body = &body
 
+	return opts
+}
+
+// GetOAuthToken handles POST /oauth/token
+func (a *HTTPAdapter) GetOAuthToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer r.Body.Close()
+	opts := a.parseGetOAuthTokenRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
 	// Call business logic
-	resp, err := a.svc.GetOAuthToken(ctx, opts)
+	resp, err := a.svc.GetOAuthToken(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -781,20 +954,34 @@ func (a *HTTPAdapter) GetOAuthToken(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetItemsByType handles GET /items/{type}
-func (a *HTTPAdapter) GetItemsByType(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// parseGetItemsByTypeRequest parses the GetItemsByType request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseGetItemsByTypeRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *GetItemsByTypeServiceRequestOptions {
 	opts := &GetItemsByTypeServiceRequestOptions{}
 	opts.RawRequest = r
 
 	// Parse path parameters
 	pathParams := &GetItemsByTypePath{}
-	pathParamTypeStr := r.PathValue("type")
+	pathParamTypeStr := pathValues["type"]
 	pathParams.Type = pathParamTypeStr
 	opts.PathParams = pathParams
 
+	return opts
+}
+
+// GetItemsByType handles GET /items/{type}
+func (a *HTTPAdapter) GetItemsByType(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pathValues := map[string]string{
+		"type": r.PathValue("type"),
+	}
+	opts := a.parseGetItemsByTypeRequest(w, r, pathValues)
+	if opts == nil {
+		return
+	}
+
 	// Call business logic
-	resp, err := a.svc.GetItemsByType(ctx, opts)
+	resp, err := a.svc.GetItemsByType(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -822,9 +1009,9 @@ func (a *HTTPAdapter) GetItemsByType(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// Search handles GET /search
-func (a *HTTPAdapter) Search(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// parseSearchRequest parses the Search request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseSearchRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *SearchServiceRequestOptions {
 	opts := &SearchServiceRequestOptions{}
 	opts.RawRequest = r
 
@@ -837,8 +1024,19 @@ func (a *HTTPAdapter) Search(w http.ResponseWriter, r *http.Request) {
 	}
 	opts.Query = queryParams
 
+	return opts
+}
+
+// Search handles GET /search
+func (a *HTTPAdapter) Search(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	opts := a.parseSearchRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
 	// Call business logic
-	resp, err := a.svc.Search(ctx, opts)
+	resp, err := a.svc.Search(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -871,7 +1069,7 @@ func (a *HTTPAdapter) GetStatus(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// Call business logic
-	resp, err := a.svc.GetStatus(ctx)
+	resp, err := a.svc.GetStatus(&GetStatusContext{Context: ctx})
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -899,17 +1097,30 @@ func (a *HTTPAdapter) GetStatus(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// UploadImage handles POST /images
-func (a *HTTPAdapter) UploadImage(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// parseUploadImageRequest parses the UploadImage request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseUploadImageRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *UploadImageServiceRequestOptions {
 	opts := &UploadImageServiceRequestOptions{}
 	opts.RawRequest = r
 
 	// Parse request body
+	// Binary body is streamed to the service
+	opts.Body = r.Body
+
+	return opts
+}
+
+// UploadImage handles POST /images
+func (a *HTTPAdapter) UploadImage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 	defer r.Body.Close()
+	opts := a.parseUploadImageRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
 
 	// Call business logic
-	resp, err := a.svc.UploadImage(ctx, opts)
+	resp, err := a.svc.UploadImage(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -937,9 +1148,9 @@ func (a *HTTPAdapter) UploadImage(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ListProducts handles GET /products
-func (a *HTTPAdapter) ListProducts(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// parseListProductsRequest parses the ListProducts request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseListProductsRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *ListProductsServiceRequestOptions {
 	opts := &ListProductsServiceRequestOptions{}
 	opts.RawRequest = r
 
@@ -965,7 +1176,7 @@ func (a *HTTPAdapter) ListProducts(w http.ResponseWriter, r *http.Request) {
 				ParamName:     "categoryIds",
 				ParamLocation: "query",
 			})
-			return
+			return nil
 		}
 		queryParams.CategoryIds = parsed
 	}
@@ -979,7 +1190,7 @@ func (a *HTTPAdapter) ListProducts(w http.ResponseWriter, r *http.Request) {
 				ParamName:     "minPrice",
 				ParamLocation: "query",
 			})
-			return
+			return nil
 		}
 		queryParams.MinPrice = &queryParamMinPrice
 	}
@@ -993,14 +1204,25 @@ func (a *HTTPAdapter) ListProducts(w http.ResponseWriter, r *http.Request) {
 				ParamName:     "active",
 				ParamLocation: "query",
 			})
-			return
+			return nil
 		}
 		queryParams.Active = &queryParamActive
 	}
 	opts.Query = queryParams
 
+	return opts
+}
+
+// ListProducts handles GET /products
+func (a *HTTPAdapter) ListProducts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	opts := a.parseListProductsRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
 	// Call business logic
-	resp, err := a.svc.ListProducts(ctx, opts)
+	resp, err := a.svc.ListProducts(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -1028,15 +1250,15 @@ func (a *HTTPAdapter) ListProducts(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetCategory handles GET /categories/{categoryId}
-func (a *HTTPAdapter) GetCategory(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// parseGetCategoryRequest parses the GetCategory request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseGetCategoryRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *GetCategoryServiceRequestOptions {
 	opts := &GetCategoryServiceRequestOptions{}
 	opts.RawRequest = r
 
 	// Parse path parameters
 	pathParams := &GetCategoryPath{}
-	pathParamCategoryIDStr := r.PathValue("categoryId")
+	pathParamCategoryIDStr := pathValues["categoryId"]
 
 	pathParamCategoryID, err := runtime.ParseString[int](pathParamCategoryIDStr)
 	if err != nil {
@@ -1047,7 +1269,7 @@ func (a *HTTPAdapter) GetCategory(w http.ResponseWriter, r *http.Request) {
 			ParamName:     "categoryId",
 			ParamLocation: "path",
 		})
-		return
+		return nil
 	}
 	pathParams.CategoryID = pathParamCategoryID
 	opts.PathParams = pathParams
@@ -1065,7 +1287,7 @@ func (a *HTTPAdapter) GetCategory(w http.ResponseWriter, r *http.Request) {
 				ParamName:     "X-Include-Products",
 				ParamLocation: "header",
 			})
-			return
+			return nil
 		}
 		headerParams.XIncludeProducts = &headerParamXIncludeProducts
 	}
@@ -1079,7 +1301,7 @@ func (a *HTTPAdapter) GetCategory(w http.ResponseWriter, r *http.Request) {
 				ParamName:     "X-Max-Depth",
 				ParamLocation: "header",
 			})
-			return
+			return nil
 		}
 		headerParams.XMaxDepth = &headerParamXMaxDepth
 	}
@@ -1093,14 +1315,28 @@ func (a *HTTPAdapter) GetCategory(w http.ResponseWriter, r *http.Request) {
 				ParamName:     "X-Price-Threshold",
 				ParamLocation: "header",
 			})
-			return
+			return nil
 		}
 		headerParams.XPriceThreshold = &headerParamXPriceThreshold
 	}
 	opts.Header = headerParams
 
+	return opts
+}
+
+// GetCategory handles GET /categories/{categoryId}
+func (a *HTTPAdapter) GetCategory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pathValues := map[string]string{
+		"categoryId": r.PathValue("categoryId"),
+	}
+	opts := a.parseGetCategoryRequest(w, r, pathValues)
+	if opts == nil {
+		return
+	}
+
 	// Call business logic
-	resp, err := a.svc.GetCategory(ctx, opts)
+	resp, err := a.svc.GetCategory(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -1128,17 +1364,87 @@ func (a *HTTPAdapter) GetCategory(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetItemsByStatus handles GET /items/{type}/{rating}
-func (a *HTTPAdapter) GetItemsByStatus(w http.ResponseWriter, r *http.Request) {
+// parseListTagsRequest parses the ListTags request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseListTagsRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *ListTagsServiceRequestOptions {
+	opts := &ListTagsServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse header parameters
+	headerParams := &ListTagsHeaders{}
+	headers := r.Header
+	if headerValues := headers[http.CanonicalHeaderKey("X-Tags")]; len(headerValues) > 0 {
+		values := runtime.SplitHeaderValues(headerValues)
+		headerParams.XTags = values
+	}
+	if headerValues := headers[http.CanonicalHeaderKey("X-Tag-Ids")]; len(headerValues) > 0 {
+		values := runtime.SplitHeaderValues(headerValues)
+		parsed, err := runtime.ParseStringSlice[int](values)
+		if err != nil {
+			a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+				Kind:          OapiErrorKindParse,
+				OperationID:   "ListTags",
+				Message:       err.Error(),
+				ParamName:     "X-Tag-Ids",
+				ParamLocation: "header",
+			})
+			return nil
+		}
+		headerParams.XTagIds = parsed
+	}
+	opts.Header = headerParams
+
+	return opts
+}
+
+// ListTags handles GET /tags
+func (a *HTTPAdapter) ListTags(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
+	opts := a.parseListTagsRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.ListTags(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// parseGetItemsByStatusRequest parses the GetItemsByStatus request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseGetItemsByStatusRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *GetItemsByStatusServiceRequestOptions {
 	opts := &GetItemsByStatusServiceRequestOptions{}
 	opts.RawRequest = r
 
 	// Parse path parameters
 	pathParams := &GetItemsByStatusPath{}
-	pathParamTypeStr := r.PathValue("type")
+	pathParamTypeStr := pathValues["type"]
 	pathParams.Type = pathParamTypeStr
-	pathParamRatingStr := r.PathValue("rating")
+	pathParamRatingStr := pathValues["rating"]
 
 	pathParamRating, err := runtime.ParseString[float32](pathParamRatingStr)
 	if err != nil {
@@ -1149,13 +1455,28 @@ func (a *HTTPAdapter) GetItemsByStatus(w http.ResponseWriter, r *http.Request) {
 			ParamName:     "rating",
 			ParamLocation: "path",
 		})
-		return
+		return nil
 	}
 	pathParams.Rating = pathParamRating
 	opts.PathParams = pathParams
 
+	return opts
+}
+
+// GetItemsByStatus handles GET /items/{type}/{rating}
+func (a *HTTPAdapter) GetItemsByStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pathValues := map[string]string{
+		"type":   r.PathValue("type"),
+		"rating": r.PathValue("rating"),
+	}
+	opts := a.parseGetItemsByStatusRequest(w, r, pathValues)
+	if opts == nil {
+		return
+	}
+
 	// Call business logic
-	resp, err := a.svc.GetItemsByStatus(ctx, opts)
+	resp, err := a.svc.GetItemsByStatus(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -1183,22 +1504,37 @@ func (a *HTTPAdapter) GetItemsByStatus(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetUserPost handles GET /users/{id}/posts/{postId}
-func (a *HTTPAdapter) GetUserPost(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// parseGetUserPostRequest parses the GetUserPost request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseGetUserPostRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *GetUserPostServiceRequestOptions {
 	opts := &GetUserPostServiceRequestOptions{}
 	opts.RawRequest = r
 
 	// Parse path parameters
 	pathParams := &GetUserPostPath{}
-	pathParamIDStr := r.PathValue("id")
+	pathParamIDStr := pathValues["id"]
 	pathParams.ID = pathParamIDStr
-	pathParamPostIDStr := r.PathValue("postId")
+	pathParamPostIDStr := pathValues["postId"]
 	pathParams.PostID = pathParamPostIDStr
 	opts.PathParams = pathParams
 
+	return opts
+}
+
+// GetUserPost handles GET /users/{id}/posts/{postId}
+func (a *HTTPAdapter) GetUserPost(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pathValues := map[string]string{
+		"id":     r.PathValue("id"),
+		"postId": r.PathValue("postId"),
+	}
+	opts := a.parseGetUserPostRequest(w, r, pathValues)
+	if opts == nil {
+		return
+	}
+
 	// Call business logic
-	resp, err := a.svc.GetUserPost(ctx, opts)
+	resp, err := a.svc.GetUserPost(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -1226,14 +1562,13 @@ func (a *HTTPAdapter) GetUserPost(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// CreateOrder handles POST /orders
-func (a *HTTPAdapter) CreateOrder(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// parseCreateOrderRequest parses the CreateOrder request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseCreateOrderRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *CreateOrderServiceRequestOptions {
 	opts := &CreateOrderServiceRequestOptions{}
 	opts.RawRequest = r
 
 	// Parse request body
-	defer r.Body.Close()
 	var body CreateOrderBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
@@ -1241,12 +1576,150 @@ func (a *HTTPAdapter) CreateOrder(w http.ResponseWriter, r *http.Request) {
 			OperationID: "CreateOrder",
 			Message:     err.Error(),
 		})
+		return nil
+	}
+	opts.Body = &body
+
+	return opts
+}
+
+// CreateOrder handles POST /orders
+func (a *HTTPAdapter) CreateOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer r.Body.Close()
+	opts := a.parseCreateOrderRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.CreateOrder(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 201
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// parseCreateCompanyRequest parses the CreateCompany request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseCreateCompanyRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *CreateCompanyServiceRequestOptions {
+	opts := &CreateCompanyServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse request body
+	var body CreateCompanyBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:        OapiErrorKindDecode,
+			OperationID: "CreateCompany",
+			Message:     err.Error(),
+		})
+		return nil
+	}
+	opts.Body = &body
+
+	return opts
+}
+
+// CreateCompany handles POST /companies
+func (a *HTTPAdapter) CreateCompany(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer r.Body.Close()
+	opts := a.parseCreateCompanyRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.CreateCompany(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 201
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// parseGetReportRequest parses the GetReport request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseGetReportRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *GetReportServiceRequestOptions {
+	opts := &GetReportServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse path parameters
+	pathParams := &GetReportPath{}
+	pathParamDateStr := pathValues["date"]
+
+	pathParamDate, err := runtime.ParseString[runtime.Date](pathParamDateStr, "date")
+	if err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:          OapiErrorKindParse,
+			OperationID:   "GetReport",
+			Message:       err.Error(),
+			ParamName:     "date",
+			ParamLocation: "path",
+		})
+		return nil
+	}
+	pathParams.Date = pathParamDate
+	opts.PathParams = pathParams
+
+	return opts
+}
+
+// GetReport handles GET /reports/{date}
+func (a *HTTPAdapter) GetReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pathValues := map[string]string{
+		"date": r.PathValue("date"),
+	}
+	opts := a.parseGetReportRequest(w, r, pathValues)
+	if opts == nil {
 		return
 	}
-	opts.Body = &body
 
 	// Call business logic
-	resp, err := a.svc.CreateOrder(ctx, opts)
+	resp, err := a.svc.GetReport(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -1263,7 +1736,7 @@ func (a *HTTPAdapter) CreateOrder(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Determine status code
-	status := 201
+	status := 200
 	if resp != nil && resp.Status != 0 {
 		status = resp.Status
 	}
@@ -1274,27 +1747,61 @@ func (a *HTTPAdapter) CreateOrder(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// CreateCompany handles POST /companies
-func (a *HTTPAdapter) CreateCompany(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-	opts := &CreateCompanyServiceRequestOptions{}
+// parseGetReportEntryRequest parses the GetReportEntry request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseGetReportEntryRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *GetReportEntryServiceRequestOptions {
+	opts := &GetReportEntryServiceRequestOptions{}
 	opts.RawRequest = r
 
-	// Parse request body
-	defer r.Body.Close()
-	var body CreateCompanyBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+	// Parse path parameters
+	pathParams := &GetReportEntryPath{}
+	pathParamDateStr := pathValues["date"]
+
+	pathParamDate, err := runtime.ParseString[runtime.Date](pathParamDateStr, "date")
+	if err != nil {
 		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
-			Kind:        OapiErrorKindDecode,
-			OperationID: "CreateCompany",
-			Message:     err.Error(),
+			Kind:          OapiErrorKindParse,
+			OperationID:   "GetReportEntry",
+			Message:       err.Error(),
+			ParamName:     "date",
+			ParamLocation: "path",
+		})
+		return nil
+	}
+	pathParams.Date = pathParamDate
+	pathParamEntryIDStr := pathValues["entryId"]
+
+	pathParamEntryID, err := runtime.ParseString[uuid.UUID](pathParamEntryIDStr, "uuid")
+	if err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:          OapiErrorKindParse,
+			OperationID:   "GetReportEntry",
+			Message:       err.Error(),
+			ParamName:     "entryId",
+			ParamLocation: "path",
 		})
+		return nil
+	}
+	pathParams.EntryID = pathParamEntryID
+	opts.PathParams = pathParams
+
+	return opts
+}
+
+// GetReportEntry handles GET /reports/{date}/entries/{entryId}
+func (a *HTTPAdapter) GetReportEntry(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pathValues := map[string]string{
+		"date":    r.PathValue("date"),
+		"entryId": r.PathValue("entryId"),
+	}
+	opts := a.parseGetReportEntryRequest(w, r, pathValues)
+	if opts == nil {
 		return
 	}
-	opts.Body = &body
 
 	// Call business logic
-	resp, err := a.svc.CreateCompany(ctx, opts)
+	resp, err := a.svc.GetReportEntry(opts.WithContext(ctx))
 	if err != nil {
 		code := http.StatusInternalServerError
 		a.errHandler.HandleError(w, r, code, err)
@@ -1311,7 +1818,7 @@ func (a *HTTPAdapter) CreateCompany(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Determine status code
-	status := 201
+	status := 200
 	if resp != nil && resp.Status != 0 {
 		status = resp.Status
 	}
@@ -1384,10 +1891,13 @@ func RegisterRoutes(router *beego.ControllerRegister, svc ServiceInterface, opts
 	router.Post("/images", beegoHandler(httpAdapter.UploadImage))
 	router.Get("/products", beegoHandler(httpAdapter.ListProducts))
 	router.Get("/categories/:categoryId", beegoHandler(httpAdapter.GetCategory, "categoryId"))
+	router.Get("/tags", beegoHandler(httpAdapter.ListTags))
 	router.Get("/items/:type/:rating", beegoHandler(httpAdapter.GetItemsByStatus, "type", "rating"))
 	router.Get("/users/:id/posts/:postId", beegoHandler(httpAdapter.GetUserPost, "id", "postId"))
 	router.Post("/orders", beegoHandler(httpAdapter.CreateOrder))
 	router.Post("/companies", beegoHandler(httpAdapter.CreateCompany))
+	router.Get("/reports/:date", beegoHandler(httpAdapter.GetReport, "date"))
+	router.Get("/reports/:date/entries/:entryId", beegoHandler(httpAdapter.GetReportEntry, "date", "entryId"))
 }
 
 // NewRouter creates a new Beego ControllerRegister with routes registered.
@@ -1758,6 +2268,20 @@ func (r *SearchResponseData) WithStatus(code int) *SearchResponseData {
 	return r
 }
 
+// NewSearchResponseDataFromUser creates a new SearchResponseData with the User variant of the body.
+func NewSearchResponseDataFromUser(v User) *SearchResponseData {
+	return NewSearchResponseData(&SearchResponse{
+		Search_Response_OneOf: &Search_Response_OneOf{runtime.NewEitherFromA[User, SearchItem](v)},
+	})
+}
+
+// NewSearchResponseDataFromSearchItem creates a new SearchResponseData with the SearchItem variant of the body.
+func NewSearchResponseDataFromSearchItem(v SearchItem) *SearchResponseData {
+	return NewSearchResponseData(&SearchResponse{
+		Search_Response_OneOf: &Search_Response_OneOf{runtime.NewEitherFromB[User, SearchItem](v)},
+	})
+}
+
 // GetStatusResponseData wraps the success response with optional headers and status override.
 type GetStatusResponseData struct {
 	Body    *GetStatusResponse
@@ -1854,6 +2378,30 @@ func (r *GetCategoryResponseData) WithStatus(code int) *GetCategoryResponseData
 	return r
 }
 
+// ListTagsResponseData wraps the success response with optional headers and status override.
+type ListTagsResponseData struct {
+	Body    *ListTagsResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewListTagsResponseData creates a new ListTagsResponseData with the given body.
+func NewListTagsResponseData(body *ListTagsResponse) *ListTagsResponseData {
+	return &ListTagsResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *ListTagsResponseData) WithHeaders(h http.Header) *ListTagsResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *ListTagsResponseData) WithStatus(code int) *ListTagsResponseData {
+	r.Status = code
+	return r
+}
+
 // GetItemsByStatusResponseData wraps the success response with optional headers and status override.
 type GetItemsByStatusResponseData struct {
 	Body    *GetItemsByStatusResponse
@@ -1950,6 +2498,60 @@ func (r *CreateCompanyResponseData) WithStatus(code int) *CreateCompanyResponseD
 	return r
 }
 
+// GetReportResponseData wraps the success response with optional headers and status override.
+type GetReportResponseData struct {
+	Body    *GetReportResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewGetReportResponseData creates a new GetReportResponseData with the given body.
+func NewGetReportResponseData(body *GetReportResponse) *GetReportResponseData {
+	return &GetReportResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *GetReportResponseData) WithHeaders(h http.Header) *GetReportResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *GetReportResponseData) WithStatus(code int) *GetReportResponseData {
+	r.Status = code
+	return r
+}
+
+// GetReportEntryResponseData wraps the success response with optional headers and status override.
+type GetReportEntryResponseData struct {
+	Body    *GetReportEntryResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewGetReportEntryResponseData creates a new GetReportEntryResponseData with the given body.
+func NewGetReportEntryResponseData(body *GetReportEntryResponse) *GetReportEntryResponseData {
+	return &GetReportEntryResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *GetReportEntryResponseData) WithHeaders(h http.Header) *GetReportEntryResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *GetReportEntryResponseData) WithStatus(code int) *GetReportEntryResponseData {
+	r.Status = code
+	return r
+}
+
+// HealthCheckContext is the typed context of the HealthCheck operation, the same for every handler kind:
+// the context of the request, the operation having no parameters and no body.
+type HealthCheckContext struct {
+	context.Context
+}
+
 // ListUsersServiceRequestOptions holds all parameters for the ListUsers operation.
 type ListUsersServiceRequestOptions struct {
 	Query  *ListUsersQuery
@@ -1984,6 +2586,18 @@ func (o *ListUsersServiceRequestOptions) Validate() error {
 	return errors
 }
 
+// ListUsersContext is the typed context of the ListUsers operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type ListUsersContext struct {
+	context.Context
+	*ListUsersServiceRequestOptions
+}
+
+// WithContext returns the typed context of the ListUsers operation, with ctx the context of the request.
+func (o *ListUsersServiceRequestOptions) WithContext(ctx context.Context) *ListUsersContext {
+	return &ListUsersContext{Context: ctx, ListUsersServiceRequestOptions: o}
+}
+
 // CreateUserServiceRequestOptions holds all parameters for the CreateUser operation.
 type CreateUserServiceRequestOptions struct {
 	Body *CreateUserBody
@@ -2009,6 +2623,18 @@ func (o *CreateUserServiceRequestOptions) Validate() error {
 	return errors
 }
 
+// CreateUserContext is the typed context of the CreateUser operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type CreateUserContext struct {
+	context.Context
+	*CreateUserServiceRequestOptions
+}
+
+// WithContext returns the typed context of the CreateUser operation, with ctx the context of the request.
+func (o *CreateUserServiceRequestOptions) WithContext(ctx context.Context) *CreateUserContext {
+	return &CreateUserContext{Context: ctx, CreateUserServiceRequestOptions: o}
+}
+
 // ImportUsersServiceRequestOptions holds all parameters for the ImportUsers operation.
 type ImportUsersServiceRequestOptions struct {
 	Body *ImportUsersBody
@@ -2034,6 +2660,18 @@ func (o *ImportUsersServiceRequestOptions) Validate() error {
 	return errors
 }
 
+// ImportUsersContext is the typed context of the ImportUsers operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type ImportUsersContext struct {
+	context.Context
+	*ImportUsersServiceRequestOptions
+}
+
+// WithContext returns the typed context of the ImportUsers operation, with ctx the context of the request.
+func (o *ImportUsersServiceRequestOptions) WithContext(ctx context.Context) *ImportUsersContext {
+	return &ImportUsersContext{Context: ctx, ImportUsersServiceRequestOptions: o}
+}
+
 // GetUserServiceRequestOptions holds all parameters for the GetUser operation.
 type GetUserServiceRequestOptions struct {
 	PathParams *GetUserPath
@@ -2059,6 +2697,18 @@ func (o *GetUserServiceRequestOptions) Validate() error {
 	return errors
 }
 
+// GetUserContext is the typed context of the GetUser operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type GetUserContext struct {
+	context.Context
+	*GetUserServiceRequestOptions
+}
+
+// WithContext returns the typed context of the GetUser operation, with ctx the context of the request.
+func (o *GetUserServiceRequestOptions) WithContext(ctx context.Context) *GetUserContext {
+	return &GetUserContext{Context: ctx, GetUserServiceRequestOptions: o}
+}
+
 // DeleteUserServiceRequestOptions holds all parameters for the DeleteUser operation.
 type DeleteUserServiceRequestOptions struct {
 	PathParams *DeleteUserPath
@@ -2084,6 +2734,18 @@ func (o *DeleteUserServiceRequestOptions) Validate() error {
 	return errors
 }
 
+// DeleteUserContext is the typed context of the DeleteUser operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type DeleteUserContext struct {
+	context.Context
+	*DeleteUserServiceRequestOptions
+}
+
+// WithContext returns the typed context of the DeleteUser operation, with ctx the context of the request.
+func (o *DeleteUserServiceRequestOptions) WithContext(ctx context.Context) *DeleteUserContext {
+	return &DeleteUserContext{Context: ctx, DeleteUserServiceRequestOptions: o}
+}
+
 // GetUserAvatarServiceRequestOptions holds all parameters for the GetUserAvatar operation.
 type GetUserAvatarServiceRequestOptions struct {
 	PathParams *GetUserAvatarPath
@@ -2109,9 +2771,22 @@ func (o *GetUserAvatarServiceRequestOptions) Validate() error {
 	return errors
 }
 
+// GetUserAvatarContext is the typed context of the GetUserAvatar operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type GetUserAvatarContext struct {
+	context.Context
+	*GetUserAvatarServiceRequestOptions
+}
+
+// WithContext returns the typed context of the GetUserAvatar operation, with ctx the context of the request.
+func (o *GetUserAvatarServiceRequestOptions) WithContext(ctx context.Context) *GetUserAvatarContext {
+	return &GetUserAvatarContext{Context: ctx, GetUserAvatarServiceRequestOptions: o}
+}
+
 // UploadUserAvatarServiceRequestOptions holds all parameters for the UploadUserAvatar operation.
 type UploadUserAvatarServiceRequestOptions struct {
 	PathParams *UploadUserAvatarPath
+	Body       UploadUserAvatarBody
 	// RawRequest provides access to the underlying HTTP request for custom content type handling.
 	RawRequest *http.Request
 }
@@ -2134,6 +2809,18 @@ func (o *UploadUserAvatarServiceRequestOptions) Validate() error {
 	return errors
 }
 
+// UploadUserAvatarContext is the typed context of the UploadUserAvatar operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type UploadUserAvatarContext struct {
+	context.Context
+	*UploadUserAvatarServiceRequestOptions
+}
+
+// WithContext returns the typed context of the UploadUserAvatar operation, with ctx the context of the request.
+func (o *UploadUserAvatarServiceRequestOptions) WithContext(ctx context.Context) *UploadUserAvatarContext {
+	return &UploadUserAvatarContext{Context: ctx, UploadUserAvatarServiceRequestOptions: o}
+}
+
 // SubmitContactFormServiceRequestOptions holds all parameters for the SubmitContactForm operation.
 type SubmitContactFormServiceRequestOptions struct {
 	Body *SubmitContactFormBody
@@ -2159,6 +2846,18 @@ func (o *SubmitContactFormServiceRequestOptions) Validate() error {
 	return errors
 }
 
+// SubmitContactFormContext is the typed context of the SubmitContactForm operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type SubmitContactFormContext struct {
+	context.Context
+	*SubmitContactFormServiceRequestOptions
+}
+
+// WithContext returns the typed context of the SubmitContactForm operation, with ctx the context of the request.
+func (o *SubmitContactFormServiceRequestOptions) WithContext(ctx context.Context) *SubmitContactFormContext {
+	return &SubmitContactFormContext{Context: ctx, SubmitContactFormServiceRequestOptions: o}
+}
+
 // CreateNoteServiceRequestOptions holds all parameters for the CreateNote operation.
 type CreateNoteServiceRequestOptions struct {
 	Body *CreateNoteBody
@@ -2184,6 +2883,18 @@ func (o *CreateNoteServiceRequestOptions) Validate() error {
 	return errors
 }
 
+// CreateNoteContext is the typed context of the CreateNote operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type CreateNoteContext struct {
+	context.Context
+	*CreateNoteServiceRequestOptions
+}
+
+// WithContext returns the typed context of the CreateNote operation, with ctx the context of the request.
+func (o *CreateNoteServiceRequestOptions) WithContext(ctx context.Context) *CreateNoteContext {
+	return &CreateNoteContext{Context: ctx, CreateNoteServiceRequestOptions: o}
+}
+
 // ProcessXMLDataServiceRequestOptions holds all parameters for the ProcessXMLData operation.
 type ProcessXMLDataServiceRequestOptions struct {
 	// RawRequest provides access to the underlying HTTP request for custom content type handling.
@@ -2201,6 +2912,24 @@ func (o *ProcessXMLDataServiceRequestOptions) Validate() error {
 	return errors
 }
 
+// ProcessXMLDataContext is the typed context of the ProcessXMLData operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type ProcessXMLDataContext struct {
+	context.Context
+	*ProcessXMLDataServiceRequestOptions
+}
+
+// WithContext returns the typed context of the ProcessXMLData operation, with ctx the context of the request.
+func (o *ProcessXMLDataServiceRequestOptions) WithContext(ctx context.Context) *ProcessXMLDataContext {
+	return &ProcessXMLDataContext{Context: ctx, ProcessXMLDataServiceRequestOptions: o}
+}
+
+// ExportDataContext is the typed context of the ExportData operation, the same for every handler kind:
+// the context of the request, the operation having no parameters and no body.
+type ExportDataContext struct {
+	context.Context
+}
+
 // GetOAuthTokenServiceRequestOptions holds all parameters for the GetOAuthToken operation.
 type GetOAuthTokenServiceRequestOptions struct {
 	Body *GetOAuthTokenBody
@@ -2226,6 +2955,18 @@ func (o *GetOAuthTokenServiceRequestOptions) Validate() error {
 	return errors
 }
 
+// GetOAuthTokenContext is the typed context of the GetOAuthToken operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type GetOAuthTokenContext struct {
+	context.Context
+	*GetOAuthTokenServiceRequestOptions
+}
+
+// WithContext returns the typed context of the GetOAuthToken operation, with ctx the context of the request.
+func (o *GetOAuthTokenServiceRequestOptions) WithContext(ctx context.Context) *GetOAuthTokenContext {
+	return &GetOAuthTokenContext{Context: ctx, GetOAuthTokenServiceRequestOptions: o}
+}
+
 // GetItemsByTypeServiceRequestOptions holds all parameters for the GetItemsByType operation.
 type GetItemsByTypeServiceRequestOptions struct {
 	PathParams *GetItemsByTypePath
@@ -2251,6 +2992,18 @@ func (o *GetItemsByTypeServiceRequestOptions) Validate() error {
 	return errors
 }
 
+// GetItemsByTypeContext is the typed context of the GetItemsByType operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type GetItemsByTypeContext struct {
+	context.Context
+	*GetItemsByTypeServiceRequestOptions
+}
+
+// WithContext returns the typed context of the GetItemsByType operation, with ctx the context of the request.
+func (o *GetItemsByTypeServiceRequestOptions) WithContext(ctx context.Context) *GetItemsByTypeContext {
+	return &GetItemsByTypeContext{Context: ctx, GetItemsByTypeServiceRequestOptions: o}
+}
+
 // SearchServiceRequestOptions holds all parameters for the Search operation.
 type SearchServiceRequestOptions struct {
 	Query *SearchQuery
@@ -2276,8 +3029,27 @@ func (o *SearchServiceRequestOptions) Validate() error {
 	return errors
 }
 
+// SearchContext is the typed context of the Search operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type SearchContext struct {
+	context.Context
+	*SearchServiceRequestOptions
+}
+
+// WithContext returns the typed context of the Search operation, with ctx the context of the request.
+func (o *SearchServiceRequestOptions) WithContext(ctx context.Context) *SearchContext {
+	return &SearchContext{Context: ctx, SearchServiceRequestOptions: o}
+}
+
+// GetStatusContext is the typed context of the GetStatus operation, the same for every handler kind:
+// the context of the request, the operation having no parameters and no body.
+type GetStatusContext struct {
+	context.Context
+}
+
 // UploadImageServiceRequestOptions holds all parameters for the UploadImage operation.
 type UploadImageServiceRequestOptions struct {
+	Body UploadImageBody
 	// RawRequest provides access to the underlying HTTP request for custom content type handling.
 	RawRequest *http.Request
 }
@@ -2293,6 +3065,18 @@ func (o *UploadImageServiceRequestOptions) Validate() error {
 	return errors
 }
 
+// UploadImageContext is the typed context of the UploadImage operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type UploadImageContext struct {
+	context.Context
+	*UploadImageServiceRequestOptions
+}
+
+// WithContext returns the typed context of the UploadImage operation, with ctx the context of the request.
+func (o *UploadImageServiceRequestOptions) WithContext(ctx context.Context) *UploadImageContext {
+	return &UploadImageContext{Context: ctx, UploadImageServiceRequestOptions: o}
+}
+
 // ListProductsServiceRequestOptions holds all parameters for the ListProducts operation.
 type ListProductsServiceRequestOptions struct {
 	Query *ListProductsQuery
@@ -2318,6 +3102,18 @@ func (o *ListProductsServiceRequestOptions) Validate() error {
 	return errors
 }
 
+// ListProductsContext is the typed context of the ListProducts operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type ListProductsContext struct {
+	context.Context
+	*ListProductsServiceRequestOptions
+}
+
+// WithContext returns the typed context of the ListProducts operation, with ctx the context of the request.
+func (o *ListProductsServiceRequestOptions) WithContext(ctx context.Context) *ListProductsContext {
+	return &ListProductsContext{Context: ctx, ListProductsServiceRequestOptions: o}
+}
+
 // GetCategoryServiceRequestOptions holds all parameters for the GetCategory operation.
 type GetCategoryServiceRequestOptions struct {
 	PathParams *GetCategoryPath
@@ -2352,6 +3148,55 @@ func (o *GetCategoryServiceRequestOptions) Validate() error {
 	return errors
 }
 
+// GetCategoryContext is the typed context of the GetCategory operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type GetCategoryContext struct {
+	context.Context
+	*GetCategoryServiceRequestOptions
+}
+
+// WithContext returns the typed context of the GetCategory operation, with ctx the context of the request.
+func (o *GetCategoryServiceRequestOptions) WithContext(ctx context.Context) *GetCategoryContext {
+	return &GetCategoryContext{Context: ctx, GetCategoryServiceRequestOptions: o}
+}
+
+// ListTagsServiceRequestOptions holds all parameters for the ListTags operation.
+type ListTagsServiceRequestOptions struct {
+	Header *ListTagsHeaders
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *ListTagsServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Header != nil {
+		if v, ok := any(o.Header).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Header", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// ListTagsContext is the typed context of the ListTags operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type ListTagsContext struct {
+	context.Context
+	*ListTagsServiceRequestOptions
+}
+
+// WithContext returns the typed context of the ListTags operation, with ctx the context of the request.
+func (o *ListTagsServiceRequestOptions) WithContext(ctx context.Context) *ListTagsContext {
+	return &ListTagsContext{Context: ctx, ListTagsServiceRequestOptions: o}
+}
+
 // GetItemsByStatusServiceRequestOptions holds all parameters for the GetItemsByStatus operation.
 type GetItemsByStatusServiceRequestOptions struct {
 	PathParams *GetItemsByStatusPath
@@ -2377,6 +3222,18 @@ func (o *GetItemsByStatusServiceRequestOptions) Validate() error {
 	return errors
 }
 
+// GetItemsByStatusContext is the typed context of the GetItemsByStatus operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type GetItemsByStatusContext struct {
+	context.Context
+	*GetItemsByStatusServiceRequestOptions
+}
+
+// WithContext returns the typed context of the GetItemsByStatus operation, with ctx the context of the request.
+func (o *GetItemsByStatusServiceRequestOptions) WithContext(ctx context.Context) *GetItemsByStatusContext {
+	return &GetItemsByStatusContext{Context: ctx, GetItemsByStatusServiceRequestOptions: o}
+}
+
 // GetUserPostServiceRequestOptions holds all parameters for the GetUserPost operation.
 type GetUserPostServiceRequestOptions struct {
 	PathParams *GetUserPostPath
@@ -2402,6 +3259,18 @@ func (o *GetUserPostServiceRequestOptions) Validate() error {
 	return errors
 }
 
+// GetUserPostContext is the typed context of the GetUserPost operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type GetUserPostContext struct {
+	context.Context
+	*GetUserPostServiceRequestOptions
+}
+
+// WithContext returns the typed context of the GetUserPost operation, with ctx the context of the request.
+func (o *GetUserPostServiceRequestOptions) WithContext(ctx context.Context) *GetUserPostContext {
+	return &GetUserPostContext{Context: ctx, GetUserPostServiceRequestOptions: o}
+}
+
 // CreateOrderServiceRequestOptions holds all parameters for the CreateOrder operation.
 type CreateOrderServiceRequestOptions struct {
 	Body *CreateOrderBody
@@ -2427,6 +3296,18 @@ func (o *CreateOrderServiceRequestOptions) Validate() error {
 	return errors
 }
 
+// CreateOrderContext is the typed context of the CreateOrder operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type CreateOrderContext struct {
+	context.Context
+	*CreateOrderServiceRequestOptions
+}
+
+// WithContext returns the typed context of the CreateOrder operation, with ctx the context of the request.
+func (o *CreateOrderServiceRequestOptions) WithContext(ctx context.Context) *CreateOrderContext {
+	return &CreateOrderContext{Context: ctx, CreateOrderServiceRequestOptions: o}
+}
+
 // CreateCompanyServiceRequestOptions holds all parameters for the CreateCompany operation.
 type CreateCompanyServiceRequestOptions struct {
 	Body *CreateCompanyBody
@@ -2451,3 +3332,89 @@ func (o *CreateCompanyServiceRequestOptions) Validate() error {
 
 	return errors
 }
+
+// CreateCompanyContext is the typed context of the CreateCompany operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type CreateCompanyContext struct {
+	context.Context
+	*CreateCompanyServiceRequestOptions
+}
+
+// WithContext returns the typed context of the CreateCompany operation, with ctx the context of the request.
+func (o *CreateCompanyServiceRequestOptions) WithContext(ctx context.Context) *CreateCompanyContext {
+	return &CreateCompanyContext{Context: ctx, CreateCompanyServiceRequestOptions: o}
+}
+
+// GetReportServiceRequestOptions holds all parameters for the GetReport operation.
+type GetReportServiceRequestOptions struct {
+	PathParams *GetReportPath
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *GetReportServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetReportContext is the typed context of the GetReport operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type GetReportContext struct {
+	context.Context
+	*GetReportServiceRequestOptions
+}
+
+// WithContext returns the typed context of the GetReport operation, with ctx the context of the request.
+func (o *GetReportServiceRequestOptions) WithContext(ctx context.Context) *GetReportContext {
+	return &GetReportContext{Context: ctx, GetReportServiceRequestOptions: o}
+}
+
+// GetReportEntryServiceRequestOptions holds all parameters for the GetReportEntry operation.
+type GetReportEntryServiceRequestOptions struct {
+	PathParams *GetReportEntryPath
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *GetReportEntryServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetReportEntryContext is the typed context of the GetReportEntry operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type GetReportEntryContext struct {
+	context.Context
+	*GetReportEntryServiceRequestOptions
+}
+
+// WithContext returns the typed context of the GetReportEntry operation, with ctx the context of the request.
+func (o *GetReportEntryServiceRequestOptions) WithContext(ctx context.Context) *GetReportEntryContext {
+	return &GetReportEntryContext{Context: ctx, GetReportEntryServiceRequestOptions: o}
+}
@@ -0,0 +1,3515 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package testcase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gobuffalo/buffalo"
+	"github.com/google/uuid"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// OapiErrorKind represents the type of error that occurred during request processing.
+type OapiErrorKind int
+
+const (
+	// OapiErrorKindParse indicates a parameter parsing error (invalid path/query/header parameter).
+	OapiErrorKindParse OapiErrorKind = iota
+
+	// OapiErrorKindDecode indicates a request body decoding error (invalid JSON, form data, etc.).
+	OapiErrorKindDecode
+
+	// OapiErrorKindValidation indicates a request validation error (failed schema validation).
+	OapiErrorKindValidation
+
+	// OapiErrorKindService indicates a service/business logic error returned by the service implementation.
+	OapiErrorKindService
+)
+
+// OapiHandlerError represents an error that occurred during request handling (parse, decode, validation).
+// When no typed error response is configured in the OpenAPI spec, this error type is used.
+// Custom error handlers can type-assert to this type to access error details.
+type OapiHandlerError struct {
+	Kind          OapiErrorKind
+	OperationID   string
+	Message       string
+	ParamName     string
+	ParamLocation string
+
+	// Err is the underlying error, e.g. the runtime.ValidationErrors of a validation error.
+	Err error
+}
+
+func (e OapiHandlerError) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the underlying error.
+func (e OapiHandlerError) Unwrap() error {
+	return e.Err
+}
+
+// OapiErrorResponse is the default JSON error response structure used by OapiDefaultErrorHandler.
+type OapiErrorResponse struct {
+	Error         string `json:"error"`
+	OperationID   string `json:"operation_id,omitempty"`
+	ParamName     string `json:"param_name,omitempty"`
+	ParamLocation string `json:"param_location,omitempty"`
+}
+
+// OapiErrorHandler handles errors that occur during request processing.
+// Implement this interface to customize error responses, logging, and metrics.
+type OapiErrorHandler interface {
+	// HandleError writes an error response to w with the given status code.
+	// The err is either an OapiHandlerError (for parse/decode/validation errors)
+	// or a typed error matching the OpenAPI spec's error response schema.
+	HandleError(w http.ResponseWriter, r *http.Request, statusCode int, err error)
+}
+
+// OapiDefaultErrorHandler provides the default error handling behavior.
+// It writes JSON error responses. For OapiHandlerError, it uses OapiErrorResponse.
+// For typed errors (from OpenAPI spec), it encodes them directly.
+type OapiDefaultErrorHandler struct{}
+
+// HandleError implements OapiErrorHandler with default JSON error responses.
+func (h *OapiDefaultErrorHandler) HandleError(w http.ResponseWriter, r *http.Request, statusCode int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	if handlerErr, ok := err.(OapiHandlerError); ok {
+		_ = json.NewEncoder(w).Encode(OapiErrorResponse{
+			Error:         handlerErr.Message,
+			OperationID:   handlerErr.OperationID,
+			ParamName:     handlerErr.ParamName,
+			ParamLocation: handlerErr.ParamLocation,
+		})
+		return
+	}
+
+	// Typed error from OpenAPI spec - encode directly
+	_ = json.NewEncoder(w).Encode(err)
+}
+
+// OapiWriteValidationError writes err as a 400 JSON response with a
+// {"errors": [{"field": "Items[0].Name", "message": "is required"}]} body.
+// Use it from a custom OapiErrorHandler for OapiErrorKindValidation errors.
+func OapiWriteValidationError(w http.ResponseWriter, err error) {
+	var errs runtime.ValidationErrors
+	if !errors.As(err, &errs) {
+		errs = runtime.NewValidationErrorsFromError(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(errs)
+}
+
+// ServiceInterface defines the service interface for business logic.
+type ServiceInterface interface {
+	// HealthCheck Health check endpoint
+	HealthCheck(ctx *HealthCheckContext) (*HealthCheckResponseData, error)
+	// ListUsers List all users
+	ListUsers(ctx *ListUsersContext) (*ListUsersResponseData, error)
+	// CreateUser Create a new user via JSON
+	CreateUser(ctx *CreateUserContext) (*CreateUserResponseData, error)
+	// ImportUsers Import users from CSV file
+	ImportUsers(ctx *ImportUsersContext) (*ImportUsersResponseData, error)
+	// GetUser Get a user by ID
+	GetUser(ctx *GetUserContext) (*GetUserResponseData, error)
+	// DeleteUser Delete a user
+	DeleteUser(ctx *DeleteUserContext) (*DeleteUserResponseData, error)
+	// GetUserAvatar Get user avatar image
+	GetUserAvatar(ctx *GetUserAvatarContext) (*GetUserAvatarResponseData, error)
+	// UploadUserAvatar Upload user avatar
+	UploadUserAvatar(ctx *UploadUserAvatarContext) (*UploadUserAvatarResponseData, error)
+	// SubmitContactForm Submit contact form
+	SubmitContactForm(ctx *SubmitContactFormContext) (*SubmitContactFormResponseData, error)
+	// CreateNote Create a note from plain text
+	CreateNote(ctx *CreateNoteContext) (*CreateNoteResponseData, error)
+	// ProcessXMLData Process XML data (demonstrates custom content type handling)
+	ProcessXMLData(ctx *ProcessXMLDataContext) (*ProcessXMLDataResponseData, error)
+	// ExportData Export all data as binary archive
+	ExportData(ctx *ExportDataContext) (*ExportDataResponseData, error)
+	// GetOAuthToken Get OAuth token (form-encoded response)
+	GetOAuthToken(ctx *GetOAuthTokenContext) (*GetOAuthTokenResponseData, error)
+	// GetItemsByType Get items by type (tests reserved Go keyword as path param)
+	GetItemsByType(ctx *GetItemsByTypeContext) (*GetItemsByTypeResponseData, error)
+	// Search Search with union type response (oneOf)
+	Search(ctx *SearchContext) (*SearchResponseData, error)
+	// GetStatus Get status (uses reusable response)
+	GetStatus(ctx *GetStatusContext) (*GetStatusResponseData, error)
+	// UploadImage Upload image (wildcard content type)
+	UploadImage(ctx *UploadImageContext) (*UploadImageResponseData, error)
+	// ListProducts List products with various query param types
+	ListProducts(ctx *ListProductsContext) (*ListProductsResponseData, error)
+	// GetCategory Get a category by ID (integer path param)
+	GetCategory(ctx *GetCategoryContext) (*GetCategoryResponseData, error)
+	// ListTags List tags (array header params)
+	ListTags(ctx *ListTagsContext) (*ListTagsResponseData, error)
+	// GetItemsByStatus Get items by type and rating (string + number path params)
+	GetItemsByStatus(ctx *GetItemsByStatusContext) (*GetItemsByStatusResponseData, error)
+	// GetUserPost Get a specific post by a user
+	GetUserPost(ctx *GetUserPostContext) (*GetUserPostResponseData, error)
+	// CreateOrder Create an order (demonstrates typed error responses)
+	CreateOrder(ctx *CreateOrderContext) (*CreateOrderResponseData, error)
+	// CreateCompany Create a company with nested address
+	CreateCompany(ctx *CreateCompanyContext) (*CreateCompanyResponseData, error)
+	// GetReport Get the report of a day (date path param)
+	GetReport(ctx *GetReportContext) (*GetReportResponseData, error)
+	// GetReportEntry Get an entry of a report (date and uuid path params)
+	GetReportEntry(ctx *GetReportEntryContext) (*GetReportEntryResponseData, error)
+}
+
+// HTTPAdapter adapts the ServiceInterface to HTTP handlers.
+// This struct is generated and should not be modified.
+type HTTPAdapter struct {
+	svc        ServiceInterface
+	errHandler OapiErrorHandler
+}
+
+// NewHTTPAdapter creates a new HTTPAdapter wrapping the given service.
+// If errHandler is nil, OapiDefaultErrorHandler is used.
+func NewHTTPAdapter(svc ServiceInterface, errHandler OapiErrorHandler) *HTTPAdapter {
+	if errHandler == nil {
+		errHandler = &OapiDefaultErrorHandler{}
+	}
+	return &HTTPAdapter{svc: svc, errHandler: errHandler}
+}
+
+// HealthCheck handles GET /health
+func (a *HTTPAdapter) HealthCheck(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Call business logic
+	resp, err := a.svc.HealthCheck(&HealthCheckContext{Context: ctx})
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_, _ = fmt.Fprintf(w, "%v", *resp.Body)
+	}
+}
+
+// parseListUsersRequest parses the ListUsers request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseListUsersRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *ListUsersServiceRequestOptions {
+	opts := &ListUsersServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse query parameters
+	queryParams := &ListUsersQuery{}
+	query := r.URL.Query()
+	if queryParamLimitStr := query.Get("limit"); queryParamLimitStr != "" {
+		queryParamLimit, err := runtime.ParseString[int](queryParamLimitStr)
+		if err != nil {
+			a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+				Kind:          OapiErrorKindParse,
+				OperationID:   "ListUsers",
+				Message:       err.Error(),
+				ParamName:     "limit",
+				ParamLocation: "query",
+			})
+			return nil
+		}
+		queryParams.Limit = &queryParamLimit
+	}
+	opts.Query = queryParams
+
+	// Parse header parameters
+	headerParams := &ListUsersHeaders{}
+	headers := r.Header
+	if headerValues := headers[http.CanonicalHeaderKey("X-Request-ID")]; len(headerValues) > 0 {
+		headerParamXRequestID := headerValues[0]
+		headerParams.XRequestID = headerParamXRequestID
+	}
+	opts.Header = headerParams
+
+	return opts
+}
+
+// ListUsers handles GET /users
+func (a *HTTPAdapter) ListUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	opts := a.parseListUsersRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.ListUsers(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// parseCreateUserRequest parses the CreateUser request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseCreateUserRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *CreateUserServiceRequestOptions {
+	opts := &CreateUserServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse request body
+	var body CreateUserBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:        OapiErrorKindDecode,
+			OperationID: "CreateUser",
+			Message:     err.Error(),
+		})
+		return nil
+	}
+	opts.Body = &body
+
+	return opts
+}
+
+// CreateUser handles POST /users
+func (a *HTTPAdapter) CreateUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer r.Body.Close()
+	opts := a.parseCreateUserRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.CreateUser(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 201
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// parseImportUsersRequest parses the ImportUsers request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseImportUsersRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *ImportUsersServiceRequestOptions {
+	opts := &ImportUsersServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse request body
+	if err := r.ParseMultipartForm(32 << 20); err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:        OapiErrorKindDecode,
+			OperationID: "ImportUsers",
+			Message:     err.Error(),
+		})
+		return nil
+	}
+	var body ImportUsersBody
+	if fileHeaders := r.MultipartForm.File["file"]; len(fileHeaders) > 0 {
+		body.File.InitFromMultipart(fileHeaders[0])
+	}
+	if values := r.MultipartForm.Value["overwrite"]; len(values) > 0 {
+
+		if v, err := runtime.ParseString[bool](values[0]); err == nil {
+			body.Overwrite = &v
+		}
+	}
+	opts.Body = &body
+
+	return opts
+}
+
+// ImportUsers handles POST /users/import
+func (a *HTTPAdapter) ImportUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer r.Body.Close()
+	opts := a.parseImportUsersRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.ImportUsers(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// parseGetUserRequest parses the GetUser request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseGetUserRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *GetUserServiceRequestOptions {
+	opts := &GetUserServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse path parameters
+	pathParams := &GetUserPath{}
+	pathParamIDStr := pathValues["id"]
+	pathParams.ID = pathParamIDStr
+	opts.PathParams = pathParams
+
+	return opts
+}
+
+// GetUser handles GET /users/{id}
+func (a *HTTPAdapter) GetUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pathValues := map[string]string{
+		"id": r.PathValue("id"),
+	}
+	opts := a.parseGetUserRequest(w, r, pathValues)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.GetUser(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// parseDeleteUserRequest parses the DeleteUser request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseDeleteUserRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *DeleteUserServiceRequestOptions {
+	opts := &DeleteUserServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse path parameters
+	pathParams := &DeleteUserPath{}
+	pathParamIDStr := pathValues["id"]
+	pathParams.ID = pathParamIDStr
+	opts.PathParams = pathParams
+
+	return opts
+}
+
+// DeleteUser handles DELETE /users/{id}
+func (a *HTTPAdapter) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pathValues := map[string]string{
+		"id": r.PathValue("id"),
+	}
+	opts := a.parseDeleteUserRequest(w, r, pathValues)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.DeleteUser(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 204
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.WriteHeader(status)
+}
+
+// parseGetUserAvatarRequest parses the GetUserAvatar request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseGetUserAvatarRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *GetUserAvatarServiceRequestOptions {
+	opts := &GetUserAvatarServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse path parameters
+	pathParams := &GetUserAvatarPath{}
+	pathParamIDStr := pathValues["id"]
+	pathParams.ID = pathParamIDStr
+	opts.PathParams = pathParams
+
+	return opts
+}
+
+// GetUserAvatar handles GET /users/{id}/avatar
+func (a *HTTPAdapter) GetUserAvatar(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pathValues := map[string]string{
+		"id": r.PathValue("id"),
+	}
+	opts := a.parseGetUserAvatarRequest(w, r, pathValues)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.GetUserAvatar(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		if _, ok := err.(*GetUserAvatarErrorResponse); ok {
+			code = 404
+		}
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if resp != nil && resp.Body != nil {
+		// Binary body is streamed from the file
+		body, err := resp.Body.Reader()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		defer func() { _ = body.Close() }()
+		w.WriteHeader(status)
+		_, _ = io.Copy(w, body)
+		return
+	}
+	w.WriteHeader(status)
+}
+
+// parseUploadUserAvatarRequest parses the UploadUserAvatar request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseUploadUserAvatarRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *UploadUserAvatarServiceRequestOptions {
+	opts := &UploadUserAvatarServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse path parameters
+	pathParams := &UploadUserAvatarPath{}
+	pathParamIDStr := pathValues["id"]
+	pathParams.ID = pathParamIDStr
+	opts.PathParams = pathParams
+	// Parse request body
+	// Binary body is streamed to the service
+	opts.Body = r.Body
+
+	return opts
+}
+
+// UploadUserAvatar handles PUT /users/{id}/avatar
+func (a *HTTPAdapter) UploadUserAvatar(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer r.Body.Close()
+	pathValues := map[string]string{
+		"id": r.PathValue("id"),
+	}
+	opts := a.parseUploadUserAvatarRequest(w, r, pathValues)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.UploadUserAvatar(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 204
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.WriteHeader(status)
+}
+
+// parseSubmitContactFormRequest parses the SubmitContactForm request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseSubmitContactFormRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *SubmitContactFormServiceRequestOptions {
+	opts := &SubmitContactFormServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse request body
+	var body SubmitContactFormBody
+	formBytes, err := io.ReadAll(r.Body)
+	if err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:        OapiErrorKindDecode,
+			OperationID: "SubmitContactForm",
+			Message:     err.Error(),
+		})
+		return nil
+	}
+	jsonBytes, err := runtime.ConvertFormFields(formBytes)
+	if err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:        OapiErrorKindDecode,
+			OperationID: "SubmitContactForm",
+			Message:     err.Error(),
+		})
+		return nil
+	}
+	if err := json.Unmarshal(jsonBytes, &body); err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:        OapiErrorKindDecode,
+			OperationID: "SubmitContactForm",
+			Message:     err.Error(),
+		})
+		return nil
+	}
+	opts.Body = &body
+
+	return opts
+}
+
+// SubmitContactForm handles POST /contact
+func (a *HTTPAdapter) SubmitContactForm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer r.Body.Close()
+	opts := a.parseSubmitContactFormRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.SubmitContactForm(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// parseCreateNoteRequest parses the CreateNote request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseCreateNoteRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *CreateNoteServiceRequestOptions {
+	opts := &CreateNoteServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse request body
+	bodyBytes, err := io.ReadAll(r.Body)
+	if err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:        OapiErrorKindDecode,
+			OperationID: "CreateNote",
+			Message:     err.Error(),
+		})
+		return nil
+	}
+	body := CreateNoteBody(string(bodyBytes))
+	opts.Body = &body
+
+	return opts
+}
+
+// CreateNote handles POST /notes
+func (a *HTTPAdapter) CreateNote(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer r.Body.Close()
+	opts := a.parseCreateNoteRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.CreateNote(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 201
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_, _ = fmt.Fprintf(w, "%v", *resp.Body)
+	}
+}
+
+// parseProcessXMLDataRequest parses the ProcessXMLData request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseProcessXMLDataRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *ProcessXMLDataServiceRequestOptions {
+	opts := &ProcessXMLDataServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse request body
+
+	return opts
+}
+
+// ProcessXMLData handles POST /xml-data
+func (a *HTTPAdapter) ProcessXMLData(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer r.Body.Close()
+	opts := a.parseProcessXMLDataRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.ProcessXMLData(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/xml")
+
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_, _ = w.Write(resp.Body)
+	}
+}
+
+// ExportData handles GET /export
+func (a *HTTPAdapter) ExportData(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Call business logic
+	resp, err := a.svc.ExportData(&ExportDataContext{Context: ctx})
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if resp != nil && resp.Body != nil {
+		// Binary body is streamed from the file
+		body, err := resp.Body.Reader()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		defer func() { _ = body.Close() }()
+		w.WriteHeader(status)
+		_, _ = io.Copy(w, body)
+		return
+	}
+	w.WriteHeader(status)
+}
+
+// parseGetOAuthTokenRequest parses the GetOAuthToken request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseGetOAuthTokenRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *GetOAuthTokenServiceRequestOptions {
+	opts := &GetOAuthTokenServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse request body
+	var body GetOAuthTokenBody
+	formBytes, err := io.ReadAll(r.Body)
+	if err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:        OapiErrorKindDecode,
+			OperationID: "GetOAuthToken",
+			Message:     err.Error(),
+		})
+		return nil
+	}
+	jsonBytes, err := runtime.ConvertFormFields(formBytes)
+	if err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:        OapiErrorKindDecode,
+			OperationID: "GetOAuthToken",
+			Message:     err.Error(),
+		})
+		return nil
+	}
+	if err := json.Unmarshal(jsonBytes, &body); err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:        OapiErrorKindDecode,
+			OperationID: "GetOAuthToken",
+			Message:     err.Error(),
+		})
+		return nil
+	}
+	opts.Body = &body
+
+	return opts
+}
+
+// GetOAuthToken handles POST /oauth/token
+func (a *HTTPAdapter) GetOAuthToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer r.Body.Close()
+	opts := a.parseGetOAuthTokenRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.GetOAuthToken(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/x-www-form-urlencoded")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		formData, err := runtime.EncodeFormFields(resp.Body, nil)
+		if err == nil {
+			_, _ = w.Write([]byte(formData))
+		}
+	}
+}
+
+// parseGetItemsByTypeRequest parses the GetItemsByType request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseGetItemsByTypeRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *GetItemsByTypeServiceRequestOptions {
+	opts := &GetItemsByTypeServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse path parameters
+	pathParams := &GetItemsByTypePath{}
+	pathParamTypeStr := pathValues["type"]
+	pathParams.Type = pathParamTypeStr
+	opts.PathParams = pathParams
+
+	return opts
+}
+
+// GetItemsByType handles GET /items/{type}
+func (a *HTTPAdapter) GetItemsByType(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pathValues := map[string]string{
+		"type": r.PathValue("type"),
+	}
+	opts := a.parseGetItemsByTypeRequest(w, r, pathValues)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.GetItemsByType(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// parseSearchRequest parses the Search request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseSearchRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *SearchServiceRequestOptions {
+	opts := &SearchServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse query parameters
+	queryParams := &SearchQuery{}
+	query := r.URL.Query()
+	if queryParamQStr := query.Get("q"); queryParamQStr != "" {
+		queryParamQ := queryParamQStr
+		queryParams.Q = queryParamQ
+	}
+	opts.Query = queryParams
+
+	return opts
+}
+
+// Search handles GET /search
+func (a *HTTPAdapter) Search(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	opts := a.parseSearchRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.Search(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// GetStatus handles GET /status
+func (a *HTTPAdapter) GetStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Call business logic
+	resp, err := a.svc.GetStatus(&GetStatusContext{Context: ctx})
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// parseUploadImageRequest parses the UploadImage request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseUploadImageRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *UploadImageServiceRequestOptions {
+	opts := &UploadImageServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse request body
+	// Binary body is streamed to the service
+	opts.Body = r.Body
+
+	return opts
+}
+
+// UploadImage handles POST /images
+func (a *HTTPAdapter) UploadImage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer r.Body.Close()
+	opts := a.parseUploadImageRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.UploadImage(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 201
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// parseListProductsRequest parses the ListProducts request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseListProductsRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *ListProductsServiceRequestOptions {
+	opts := &ListProductsServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse query parameters
+	queryParams := &ListProductsQuery{}
+	query := r.URL.Query()
+
+	if values, ok := query["ids"]; ok {
+		queryParams.Ids = values
+	}
+
+	if values, ok := query["tags"]; ok {
+		queryParams.Tags = values
+	}
+
+	if values, ok := query["categoryIds"]; ok {
+		parsed, err := runtime.ParseStringSlice[int](values)
+		if err != nil {
+			a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+				Kind:          OapiErrorKindParse,
+				OperationID:   "ListProducts",
+				Message:       err.Error(),
+				ParamName:     "categoryIds",
+				ParamLocation: "query",
+			})
+			return nil
+		}
+		queryParams.CategoryIds = parsed
+	}
+	if queryParamMinPriceStr := query.Get("minPrice"); queryParamMinPriceStr != "" {
+		queryParamMinPrice, err := runtime.ParseString[float32](queryParamMinPriceStr)
+		if err != nil {
+			a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+				Kind:          OapiErrorKindParse,
+				OperationID:   "ListProducts",
+				Message:       err.Error(),
+				ParamName:     "minPrice",
+				ParamLocation: "query",
+			})
+			return nil
+		}
+		queryParams.MinPrice = &queryParamMinPrice
+	}
+	if queryParamActiveStr := query.Get("active"); queryParamActiveStr != "" {
+		queryParamActive, err := runtime.ParseString[bool](queryParamActiveStr)
+		if err != nil {
+			a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+				Kind:          OapiErrorKindParse,
+				OperationID:   "ListProducts",
+				Message:       err.Error(),
+				ParamName:     "active",
+				ParamLocation: "query",
+			})
+			return nil
+		}
+		queryParams.Active = &queryParamActive
+	}
+	opts.Query = queryParams
+
+	return opts
+}
+
+// ListProducts handles GET /products
+func (a *HTTPAdapter) ListProducts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	opts := a.parseListProductsRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.ListProducts(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// parseGetCategoryRequest parses the GetCategory request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseGetCategoryRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *GetCategoryServiceRequestOptions {
+	opts := &GetCategoryServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse path parameters
+	pathParams := &GetCategoryPath{}
+	pathParamCategoryIDStr := pathValues["categoryId"]
+
+	pathParamCategoryID, err := runtime.ParseString[int](pathParamCategoryIDStr)
+	if err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:          OapiErrorKindParse,
+			OperationID:   "GetCategory",
+			Message:       err.Error(),
+			ParamName:     "categoryId",
+			ParamLocation: "path",
+		})
+		return nil
+	}
+	pathParams.CategoryID = pathParamCategoryID
+	opts.PathParams = pathParams
+
+	// Parse header parameters
+	headerParams := &GetCategoryHeaders{}
+	headers := r.Header
+	if headerValues := headers[http.CanonicalHeaderKey("X-Include-Products")]; len(headerValues) > 0 {
+		headerParamXIncludeProducts, err := runtime.ParseString[bool](headerValues[0])
+		if err != nil {
+			a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+				Kind:          OapiErrorKindParse,
+				OperationID:   "GetCategory",
+				Message:       err.Error(),
+				ParamName:     "X-Include-Products",
+				ParamLocation: "header",
+			})
+			return nil
+		}
+		headerParams.XIncludeProducts = &headerParamXIncludeProducts
+	}
+	if headerValues := headers[http.CanonicalHeaderKey("X-Max-Depth")]; len(headerValues) > 0 {
+		headerParamXMaxDepth, err := runtime.ParseString[int](headerValues[0])
+		if err != nil {
+			a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+				Kind:          OapiErrorKindParse,
+				OperationID:   "GetCategory",
+				Message:       err.Error(),
+				ParamName:     "X-Max-Depth",
+				ParamLocation: "header",
+			})
+			return nil
+		}
+		headerParams.XMaxDepth = &headerParamXMaxDepth
+	}
+	if headerValues := headers[http.CanonicalHeaderKey("X-Price-Threshold")]; len(headerValues) > 0 {
+		headerParamXPriceThreshold, err := runtime.ParseString[float32](headerValues[0])
+		if err != nil {
+			a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+				Kind:          OapiErrorKindParse,
+				OperationID:   "GetCategory",
+				Message:       err.Error(),
+				ParamName:     "X-Price-Threshold",
+				ParamLocation: "header",
+			})
+			return nil
+		}
+		headerParams.XPriceThreshold = &headerParamXPriceThreshold
+	}
+	opts.Header = headerParams
+
+	return opts
+}
+
+// GetCategory handles GET /categories/{categoryId}
+func (a *HTTPAdapter) GetCategory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pathValues := map[string]string{
+		"categoryId": r.PathValue("categoryId"),
+	}
+	opts := a.parseGetCategoryRequest(w, r, pathValues)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.GetCategory(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// parseListTagsRequest parses the ListTags request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseListTagsRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *ListTagsServiceRequestOptions {
+	opts := &ListTagsServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse header parameters
+	headerParams := &ListTagsHeaders{}
+	headers := r.Header
+	if headerValues := headers[http.CanonicalHeaderKey("X-Tags")]; len(headerValues) > 0 {
+		values := runtime.SplitHeaderValues(headerValues)
+		headerParams.XTags = values
+	}
+	if headerValues := headers[http.CanonicalHeaderKey("X-Tag-Ids")]; len(headerValues) > 0 {
+		values := runtime.SplitHeaderValues(headerValues)
+		parsed, err := runtime.ParseStringSlice[int](values)
+		if err != nil {
+			a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+				Kind:          OapiErrorKindParse,
+				OperationID:   "ListTags",
+				Message:       err.Error(),
+				ParamName:     "X-Tag-Ids",
+				ParamLocation: "header",
+			})
+			return nil
+		}
+		headerParams.XTagIds = parsed
+	}
+	opts.Header = headerParams
+
+	return opts
+}
+
+// ListTags handles GET /tags
+func (a *HTTPAdapter) ListTags(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	opts := a.parseListTagsRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.ListTags(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// parseGetItemsByStatusRequest parses the GetItemsByStatus request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseGetItemsByStatusRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *GetItemsByStatusServiceRequestOptions {
+	opts := &GetItemsByStatusServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse path parameters
+	pathParams := &GetItemsByStatusPath{}
+	pathParamTypeStr := pathValues["type"]
+	pathParams.Type = pathParamTypeStr
+	pathParamRatingStr := pathValues["rating"]
+
+	pathParamRating, err := runtime.ParseString[float32](pathParamRatingStr)
+	if err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:          OapiErrorKindParse,
+			OperationID:   "GetItemsByStatus",
+			Message:       err.Error(),
+			ParamName:     "rating",
+			ParamLocation: "path",
+		})
+		return nil
+	}
+	pathParams.Rating = pathParamRating
+	opts.PathParams = pathParams
+
+	return opts
+}
+
+// GetItemsByStatus handles GET /items/{type}/{rating}
+func (a *HTTPAdapter) GetItemsByStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pathValues := map[string]string{
+		"type":   r.PathValue("type"),
+		"rating": r.PathValue("rating"),
+	}
+	opts := a.parseGetItemsByStatusRequest(w, r, pathValues)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.GetItemsByStatus(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// parseGetUserPostRequest parses the GetUserPost request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseGetUserPostRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *GetUserPostServiceRequestOptions {
+	opts := &GetUserPostServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse path parameters
+	pathParams := &GetUserPostPath{}
+	pathParamIDStr := pathValues["id"]
+	pathParams.ID = pathParamIDStr
+	pathParamPostIDStr := pathValues["postId"]
+	pathParams.PostID = pathParamPostIDStr
+	opts.PathParams = pathParams
+
+	return opts
+}
+
+// GetUserPost handles GET /users/{id}/posts/{postId}
+func (a *HTTPAdapter) GetUserPost(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pathValues := map[string]string{
+		"id":     r.PathValue("id"),
+		"postId": r.PathValue("postId"),
+	}
+	opts := a.parseGetUserPostRequest(w, r, pathValues)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.GetUserPost(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// parseCreateOrderRequest parses the CreateOrder request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseCreateOrderRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *CreateOrderServiceRequestOptions {
+	opts := &CreateOrderServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse request body
+	var body CreateOrderBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:        OapiErrorKindDecode,
+			OperationID: "CreateOrder",
+			Message:     err.Error(),
+		})
+		return nil
+	}
+	opts.Body = &body
+
+	return opts
+}
+
+// CreateOrder handles POST /orders
+func (a *HTTPAdapter) CreateOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer r.Body.Close()
+	opts := a.parseCreateOrderRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.CreateOrder(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 201
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// parseCreateCompanyRequest parses the CreateCompany request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseCreateCompanyRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *CreateCompanyServiceRequestOptions {
+	opts := &CreateCompanyServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse request body
+	var body CreateCompanyBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:        OapiErrorKindDecode,
+			OperationID: "CreateCompany",
+			Message:     err.Error(),
+		})
+		return nil
+	}
+	opts.Body = &body
+
+	return opts
+}
+
+// CreateCompany handles POST /companies
+func (a *HTTPAdapter) CreateCompany(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer r.Body.Close()
+	opts := a.parseCreateCompanyRequest(w, r, nil)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.CreateCompany(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 201
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// parseGetReportRequest parses the GetReport request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseGetReportRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *GetReportServiceRequestOptions {
+	opts := &GetReportServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse path parameters
+	pathParams := &GetReportPath{}
+	pathParamDateStr := pathValues["date"]
+
+	pathParamDate, err := runtime.ParseString[runtime.Date](pathParamDateStr, "date")
+	if err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:          OapiErrorKindParse,
+			OperationID:   "GetReport",
+			Message:       err.Error(),
+			ParamName:     "date",
+			ParamLocation: "path",
+		})
+		return nil
+	}
+	pathParams.Date = pathParamDate
+	opts.PathParams = pathParams
+
+	return opts
+}
+
+// GetReport handles GET /reports/{date}
+func (a *HTTPAdapter) GetReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pathValues := map[string]string{
+		"date": r.PathValue("date"),
+	}
+	opts := a.parseGetReportRequest(w, r, pathValues)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.GetReport(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// parseGetReportEntryRequest parses the GetReportEntry request into the service request options,
+// with the path parameters from pathValues. It writes the error response and returns nil if the request can't be parsed.
+func (a *HTTPAdapter) parseGetReportEntryRequest(w http.ResponseWriter, r *http.Request, pathValues map[string]string) *GetReportEntryServiceRequestOptions {
+	opts := &GetReportEntryServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse path parameters
+	pathParams := &GetReportEntryPath{}
+	pathParamDateStr := pathValues["date"]
+
+	pathParamDate, err := runtime.ParseString[runtime.Date](pathParamDateStr, "date")
+	if err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:          OapiErrorKindParse,
+			OperationID:   "GetReportEntry",
+			Message:       err.Error(),
+			ParamName:     "date",
+			ParamLocation: "path",
+		})
+		return nil
+	}
+	pathParams.Date = pathParamDate
+	pathParamEntryIDStr := pathValues["entryId"]
+
+	pathParamEntryID, err := runtime.ParseString[uuid.UUID](pathParamEntryIDStr, "uuid")
+	if err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:          OapiErrorKindParse,
+			OperationID:   "GetReportEntry",
+			Message:       err.Error(),
+			ParamName:     "entryId",
+			ParamLocation: "path",
+		})
+		return nil
+	}
+	pathParams.EntryID = pathParamEntryID
+	opts.PathParams = pathParams
+
+	return opts
+}
+
+// GetReportEntry handles GET /reports/{date}/entries/{entryId}
+func (a *HTTPAdapter) GetReportEntry(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	pathValues := map[string]string{
+		"date":    r.PathValue("date"),
+		"entryId": r.PathValue("entryId"),
+	}
+	opts := a.parseGetReportEntryRequest(w, r, pathValues)
+	if opts == nil {
+		return
+	}
+
+	// Call business logic
+	resp, err := a.svc.GetReportEntry(opts.WithContext(ctx))
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// RouterOption is a function that configures the router.
+type RouterOption func(*routerConfig)
+
+type routerConfig struct {
+	middlewares []buffalo.MiddlewareFunc
+	errHandler  OapiErrorHandler
+}
+
+// WithMiddleware adds middleware to the registered routes.
+func WithMiddleware(mw buffalo.MiddlewareFunc) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.middlewares = append(cfg.middlewares, mw)
+	}
+}
+
+// WithErrorHandler sets a custom error handler for the router.
+// If not set, OapiDefaultErrorHandler is used.
+func WithErrorHandler(h OapiErrorHandler) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.errHandler = h
+	}
+}
+
+// NewRouter creates a new Buffalo app with all routes registered.
+func NewRouter(svc ServiceInterface, opts ...RouterOption) *buffalo.App {
+	app := buffalo.New(buffalo.Options{})
+	RegisterRoutes(app, svc, opts...)
+	return app
+}
+
+// RegisterRoutes registers routes on the given Buffalo app with the service implementation.
+// Middleware passed with WithMiddleware is applied to the registered routes only.
+func RegisterRoutes(app *buffalo.App, svc ServiceInterface, opts ...RouterOption) {
+	cfg := &routerConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	adapter := NewHTTPAdapter(svc, cfg.errHandler)
+
+	wrap := func(h buffalo.Handler) buffalo.Handler {
+		for i := len(cfg.middlewares) - 1; i >= 0; i-- {
+			h = cfg.middlewares[i](h)
+		}
+		return h
+	}
+	app.GET("/health", wrap(func(c buffalo.Context) error {
+		adapter.HealthCheck(c.Response(), c.Request())
+		return nil
+	}))
+	app.GET("/users", wrap(func(c buffalo.Context) error {
+		adapter.ListUsers(c.Response(), c.Request())
+		return nil
+	}))
+	app.POST("/users", wrap(func(c buffalo.Context) error {
+		adapter.CreateUser(c.Response(), c.Request())
+		return nil
+	}))
+	app.POST("/users/import", wrap(func(c buffalo.Context) error {
+		adapter.ImportUsers(c.Response(), c.Request())
+		return nil
+	}))
+	app.GET("/users/{id}", wrap(func(c buffalo.Context) error {
+		// Copy path params to request for http.Handler compatibility
+		c.Request().SetPathValue("id", c.Param("id"))
+		adapter.GetUser(c.Response(), c.Request())
+		return nil
+	}))
+	app.DELETE("/users/{id}", wrap(func(c buffalo.Context) error {
+		// Copy path params to request for http.Handler compatibility
+		c.Request().SetPathValue("id", c.Param("id"))
+		adapter.DeleteUser(c.Response(), c.Request())
+		return nil
+	}))
+	app.GET("/users/{id}/avatar", wrap(func(c buffalo.Context) error {
+		// Copy path params to request for http.Handler compatibility
+		c.Request().SetPathValue("id", c.Param("id"))
+		adapter.GetUserAvatar(c.Response(), c.Request())
+		return nil
+	}))
+	app.PUT("/users/{id}/avatar", wrap(func(c buffalo.Context) error {
+		// Copy path params to request for http.Handler compatibility
+		c.Request().SetPathValue("id", c.Param("id"))
+		adapter.UploadUserAvatar(c.Response(), c.Request())
+		return nil
+	}))
+	app.POST("/contact", wrap(func(c buffalo.Context) error {
+		adapter.SubmitContactForm(c.Response(), c.Request())
+		return nil
+	}))
+	app.POST("/notes", wrap(func(c buffalo.Context) error {
+		adapter.CreateNote(c.Response(), c.Request())
+		return nil
+	}))
+	app.POST("/xml-data", wrap(func(c buffalo.Context) error {
+		adapter.ProcessXMLData(c.Response(), c.Request())
+		return nil
+	}))
+	app.GET("/export", wrap(func(c buffalo.Context) error {
+		adapter.ExportData(c.Response(), c.Request())
+		return nil
+	}))
+	app.POST("/oauth/token", wrap(func(c buffalo.Context) error {
+		adapter.GetOAuthToken(c.Response(), c.Request())
+		return nil
+	}))
+	app.GET("/items/{type}", wrap(func(c buffalo.Context) error {
+		// Copy path params to request for http.Handler compatibility
+		c.Request().SetPathValue("type", c.Param("type"))
+		adapter.GetItemsByType(c.Response(), c.Request())
+		return nil
+	}))
+	app.GET("/search", wrap(func(c buffalo.Context) error {
+		adapter.Search(c.Response(), c.Request())
+		return nil
+	}))
+	app.GET("/status", wrap(func(c buffalo.Context) error {
+		adapter.GetStatus(c.Response(), c.Request())
+		return nil
+	}))
+	app.POST("/images", wrap(func(c buffalo.Context) error {
+		adapter.UploadImage(c.Response(), c.Request())
+		return nil
+	}))
+	app.GET("/products", wrap(func(c buffalo.Context) error {
+		adapter.ListProducts(c.Response(), c.Request())
+		return nil
+	}))
+	app.GET("/categories/{categoryId}", wrap(func(c buffalo.Context) error {
+		// Copy path params to request for http.Handler compatibility
+		c.Request().SetPathValue("categoryId", c.Param("categoryId"))
+		adapter.GetCategory(c.Response(), c.Request())
+		return nil
+	}))
+	app.GET("/tags", wrap(func(c buffalo.Context) error {
+		adapter.ListTags(c.Response(), c.Request())
+		return nil
+	}))
+	app.GET("/items/{type}/{rating}", wrap(func(c buffalo.Context) error {
+		// Copy path params to request for http.Handler compatibility
+		c.Request().SetPathValue("type", c.Param("type"))
+		c.Request().SetPathValue("rating", c.Param("rating"))
+		adapter.GetItemsByStatus(c.Response(), c.Request())
+		return nil
+	}))
+	app.GET("/users/{id}/posts/{postId}", wrap(func(c buffalo.Context) error {
+		// Copy path params to request for http.Handler compatibility
+		c.Request().SetPathValue("id", c.Param("id"))
+		c.Request().SetPathValue("postId", c.Param("postId"))
+		adapter.GetUserPost(c.Response(), c.Request())
+		return nil
+	}))
+	app.POST("/orders", wrap(func(c buffalo.Context) error {
+		adapter.CreateOrder(c.Response(), c.Request())
+		return nil
+	}))
+	app.POST("/companies", wrap(func(c buffalo.Context) error {
+		adapter.CreateCompany(c.Response(), c.Request())
+		return nil
+	}))
+	app.GET("/reports/{date}", wrap(func(c buffalo.Context) error {
+		// Copy path params to request for http.Handler compatibility
+		c.Request().SetPathValue("date", c.Param("date"))
+		adapter.GetReport(c.Response(), c.Request())
+		return nil
+	}))
+	app.GET("/reports/{date}/entries/{entryId}", wrap(func(c buffalo.Context) error {
+		// Copy path params to request for http.Handler compatibility
+		c.Request().SetPathValue("date", c.Param("date"))
+		c.Request().SetPathValue("entryId", c.Param("entryId"))
+		adapter.GetReportEntry(c.Response(), c.Request())
+		return nil
+	}))
+}
+
+// HealthCheckResponseData wraps the success response with optional headers and status override.
+type HealthCheckResponseData struct {
+	Body    *HealthCheckResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewHealthCheckResponseData creates a new HealthCheckResponseData with the given body.
+func NewHealthCheckResponseData(body *HealthCheckResponse) *HealthCheckResponseData {
+	return &HealthCheckResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *HealthCheckResponseData) WithHeaders(h http.Header) *HealthCheckResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *HealthCheckResponseData) WithStatus(code int) *HealthCheckResponseData {
+	r.Status = code
+	return r
+}
+
+// ListUsersResponseData wraps the success response with optional headers and status override.
+type ListUsersResponseData struct {
+	Body    *ListUsersResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewListUsersResponseData creates a new ListUsersResponseData with the given body.
+func NewListUsersResponseData(body *ListUsersResponse) *ListUsersResponseData {
+	return &ListUsersResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *ListUsersResponseData) WithHeaders(h http.Header) *ListUsersResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *ListUsersResponseData) WithStatus(code int) *ListUsersResponseData {
+	r.Status = code
+	return r
+}
+
+// CreateUserResponseData wraps the success response with optional headers and status override.
+type CreateUserResponseData struct {
+	Body    *CreateUserResponse
+	Headers http.Header
+	Status  int // 0 = use default (201)
+}
+
+// NewCreateUserResponseData creates a new CreateUserResponseData with the given body.
+func NewCreateUserResponseData(body *CreateUserResponse) *CreateUserResponseData {
+	return &CreateUserResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *CreateUserResponseData) WithHeaders(h http.Header) *CreateUserResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *CreateUserResponseData) WithStatus(code int) *CreateUserResponseData {
+	r.Status = code
+	return r
+}
+
+// ImportUsersResponseData wraps the success response with optional headers and status override.
+type ImportUsersResponseData struct {
+	Body    *ImportUsersResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewImportUsersResponseData creates a new ImportUsersResponseData with the given body.
+func NewImportUsersResponseData(body *ImportUsersResponse) *ImportUsersResponseData {
+	return &ImportUsersResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *ImportUsersResponseData) WithHeaders(h http.Header) *ImportUsersResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *ImportUsersResponseData) WithStatus(code int) *ImportUsersResponseData {
+	r.Status = code
+	return r
+}
+
+// GetUserResponseData wraps the success response with optional headers and status override.
+type GetUserResponseData struct {
+	Body    *GetUserResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewGetUserResponseData creates a new GetUserResponseData with the given body.
+func NewGetUserResponseData(body *GetUserResponse) *GetUserResponseData {
+	return &GetUserResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *GetUserResponseData) WithHeaders(h http.Header) *GetUserResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *GetUserResponseData) WithStatus(code int) *GetUserResponseData {
+	r.Status = code
+	return r
+}
+
+// DeleteUserResponseData wraps the success response with optional headers and status override.
+type DeleteUserResponseData struct {
+	Body    *struct{}
+	Headers http.Header
+	Status  int // 0 = use default (204)
+}
+
+// NewDeleteUserResponseData creates a new DeleteUserResponseData with the given body.
+func NewDeleteUserResponseData(body *struct{}) *DeleteUserResponseData {
+	return &DeleteUserResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *DeleteUserResponseData) WithHeaders(h http.Header) *DeleteUserResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *DeleteUserResponseData) WithStatus(code int) *DeleteUserResponseData {
+	r.Status = code
+	return r
+}
+
+// GetUserAvatarResponseData wraps the success response with optional headers and status override.
+type GetUserAvatarResponseData struct {
+	Body    *GetUserAvatarResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewGetUserAvatarResponseData creates a new GetUserAvatarResponseData with the given body.
+func NewGetUserAvatarResponseData(body *GetUserAvatarResponse) *GetUserAvatarResponseData {
+	return &GetUserAvatarResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *GetUserAvatarResponseData) WithHeaders(h http.Header) *GetUserAvatarResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *GetUserAvatarResponseData) WithStatus(code int) *GetUserAvatarResponseData {
+	r.Status = code
+	return r
+}
+
+// UploadUserAvatarResponseData wraps the success response with optional headers and status override.
+type UploadUserAvatarResponseData struct {
+	Body    *struct{}
+	Headers http.Header
+	Status  int // 0 = use default (204)
+}
+
+// NewUploadUserAvatarResponseData creates a new UploadUserAvatarResponseData with the given body.
+func NewUploadUserAvatarResponseData(body *struct{}) *UploadUserAvatarResponseData {
+	return &UploadUserAvatarResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *UploadUserAvatarResponseData) WithHeaders(h http.Header) *UploadUserAvatarResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *UploadUserAvatarResponseData) WithStatus(code int) *UploadUserAvatarResponseData {
+	r.Status = code
+	return r
+}
+
+// SubmitContactFormResponseData wraps the success response with optional headers and status override.
+type SubmitContactFormResponseData struct {
+	Body    *SubmitContactFormResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewSubmitContactFormResponseData creates a new SubmitContactFormResponseData with the given body.
+func NewSubmitContactFormResponseData(body *SubmitContactFormResponse) *SubmitContactFormResponseData {
+	return &SubmitContactFormResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *SubmitContactFormResponseData) WithHeaders(h http.Header) *SubmitContactFormResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *SubmitContactFormResponseData) WithStatus(code int) *SubmitContactFormResponseData {
+	r.Status = code
+	return r
+}
+
+// CreateNoteResponseData wraps the success response with optional headers and status override.
+type CreateNoteResponseData struct {
+	Body    *CreateNoteResponse
+	Headers http.Header
+	Status  int // 0 = use default (201)
+}
+
+// NewCreateNoteResponseData creates a new CreateNoteResponseData with the given body.
+func NewCreateNoteResponseData(body *CreateNoteResponse) *CreateNoteResponseData {
+	return &CreateNoteResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *CreateNoteResponseData) WithHeaders(h http.Header) *CreateNoteResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *CreateNoteResponseData) WithStatus(code int) *CreateNoteResponseData {
+	r.Status = code
+	return r
+}
+
+// ProcessXMLDataResponseData wraps the success response with optional headers and status override.
+type ProcessXMLDataResponseData struct {
+	Body    []byte
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewProcessXMLDataResponseData creates a new ProcessXMLDataResponseData with the given body.
+func NewProcessXMLDataResponseData(body []byte) *ProcessXMLDataResponseData {
+	return &ProcessXMLDataResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *ProcessXMLDataResponseData) WithHeaders(h http.Header) *ProcessXMLDataResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *ProcessXMLDataResponseData) WithStatus(code int) *ProcessXMLDataResponseData {
+	r.Status = code
+	return r
+}
+
+// ExportDataResponseData wraps the success response with optional headers and status override.
+type ExportDataResponseData struct {
+	Body    *ExportDataResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewExportDataResponseData creates a new ExportDataResponseData with the given body.
+func NewExportDataResponseData(body *ExportDataResponse) *ExportDataResponseData {
+	return &ExportDataResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *ExportDataResponseData) WithHeaders(h http.Header) *ExportDataResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *ExportDataResponseData) WithStatus(code int) *ExportDataResponseData {
+	r.Status = code
+	return r
+}
+
+// GetOAuthTokenResponseData wraps the success response with optional headers and status override.
+type GetOAuthTokenResponseData struct {
+	Body    *GetOAuthTokenResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewGetOAuthTokenResponseData creates a new GetOAuthTokenResponseData with the given body.
+func NewGetOAuthTokenResponseData(body *GetOAuthTokenResponse) *GetOAuthTokenResponseData {
+	return &GetOAuthTokenResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *GetOAuthTokenResponseData) WithHeaders(h http.Header) *GetOAuthTokenResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *GetOAuthTokenResponseData) WithStatus(code int) *GetOAuthTokenResponseData {
+	r.Status = code
+	return r
+}
+
+// GetItemsByTypeResponseData wraps the success response with optional headers and status override.
+type GetItemsByTypeResponseData struct {
+	Body    *GetItemsByTypeResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewGetItemsByTypeResponseData creates a new GetItemsByTypeResponseData with the given body.
+func NewGetItemsByTypeResponseData(body *GetItemsByTypeResponse) *GetItemsByTypeResponseData {
+	return &GetItemsByTypeResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *GetItemsByTypeResponseData) WithHeaders(h http.Header) *GetItemsByTypeResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *GetItemsByTypeResponseData) WithStatus(code int) *GetItemsByTypeResponseData {
+	r.Status = code
+	return r
+}
+
+// SearchResponseData wraps the success response with optional headers and status override.
+type SearchResponseData struct {
+	Body    *SearchResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewSearchResponseData creates a new SearchResponseData with the given body.
+func NewSearchResponseData(body *SearchResponse) *SearchResponseData {
+	return &SearchResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *SearchResponseData) WithHeaders(h http.Header) *SearchResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *SearchResponseData) WithStatus(code int) *SearchResponseData {
+	r.Status = code
+	return r
+}
+
+// NewSearchResponseDataFromUser creates a new SearchResponseData with the User variant of the body.
+func NewSearchResponseDataFromUser(v User) *SearchResponseData {
+	return NewSearchResponseData(&SearchResponse{
+		Search_Response_OneOf: &Search_Response_OneOf{runtime.NewEitherFromA[User, SearchItem](v)},
+	})
+}
+
+// NewSearchResponseDataFromSearchItem creates a new SearchResponseData with the SearchItem variant of the body.
+func NewSearchResponseDataFromSearchItem(v SearchItem) *SearchResponseData {
+	return NewSearchResponseData(&SearchResponse{
+		Search_Response_OneOf: &Search_Response_OneOf{runtime.NewEitherFromB[User, SearchItem](v)},
+	})
+}
+
+// GetStatusResponseData wraps the success response with optional headers and status override.
+type GetStatusResponseData struct {
+	Body    *GetStatusResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewGetStatusResponseData creates a new GetStatusResponseData with the given body.
+func NewGetStatusResponseData(body *GetStatusResponse) *GetStatusResponseData {
+	return &GetStatusResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *GetStatusResponseData) WithHeaders(h http.Header) *GetStatusResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *GetStatusResponseData) WithStatus(code int) *GetStatusResponseData {
+	r.Status = code
+	return r
+}
+
+// UploadImageResponseData wraps the success response with optional headers and status override.
+type UploadImageResponseData struct {
+	Body    *UploadImageResponse
+	Headers http.Header
+	Status  int // 0 = use default (201)
+}
+
+// NewUploadImageResponseData creates a new UploadImageResponseData with the given body.
+func NewUploadImageResponseData(body *UploadImageResponse) *UploadImageResponseData {
+	return &UploadImageResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *UploadImageResponseData) WithHeaders(h http.Header) *UploadImageResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *UploadImageResponseData) WithStatus(code int) *UploadImageResponseData {
+	r.Status = code
+	return r
+}
+
+// ListProductsResponseData wraps the success response with optional headers and status override.
+type ListProductsResponseData struct {
+	Body    *ListProductsResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewListProductsResponseData creates a new ListProductsResponseData with the given body.
+func NewListProductsResponseData(body *ListProductsResponse) *ListProductsResponseData {
+	return &ListProductsResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *ListProductsResponseData) WithHeaders(h http.Header) *ListProductsResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *ListProductsResponseData) WithStatus(code int) *ListProductsResponseData {
+	r.Status = code
+	return r
+}
+
+// GetCategoryResponseData wraps the success response with optional headers and status override.
+type GetCategoryResponseData struct {
+	Body    *GetCategoryResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewGetCategoryResponseData creates a new GetCategoryResponseData with the given body.
+func NewGetCategoryResponseData(body *GetCategoryResponse) *GetCategoryResponseData {
+	return &GetCategoryResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *GetCategoryResponseData) WithHeaders(h http.Header) *GetCategoryResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *GetCategoryResponseData) WithStatus(code int) *GetCategoryResponseData {
+	r.Status = code
+	return r
+}
+
+// ListTagsResponseData wraps the success response with optional headers and status override.
+type ListTagsResponseData struct {
+	Body    *ListTagsResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewListTagsResponseData creates a new ListTagsResponseData with the given body.
+func NewListTagsResponseData(body *ListTagsResponse) *ListTagsResponseData {
+	return &ListTagsResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *ListTagsResponseData) WithHeaders(h http.Header) *ListTagsResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *ListTagsResponseData) WithStatus(code int) *ListTagsResponseData {
+	r.Status = code
+	return r
+}
+
+// GetItemsByStatusResponseData wraps the success response with optional headers and status override.
+type GetItemsByStatusResponseData struct {
+	Body    *GetItemsByStatusResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewGetItemsByStatusResponseData creates a new GetItemsByStatusResponseData with the given body.
+func NewGetItemsByStatusResponseData(body *GetItemsByStatusResponse) *GetItemsByStatusResponseData {
+	return &GetItemsByStatusResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *GetItemsByStatusResponseData) WithHeaders(h http.Header) *GetItemsByStatusResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *GetItemsByStatusResponseData) WithStatus(code int) *GetItemsByStatusResponseData {
+	r.Status = code
+	return r
+}
+
+// GetUserPostResponseData wraps the success response with optional headers and status override.
+type GetUserPostResponseData struct {
+	Body    *GetUserPostResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewGetUserPostResponseData creates a new GetUserPostResponseData with the given body.
+func NewGetUserPostResponseData(body *GetUserPostResponse) *GetUserPostResponseData {
+	return &GetUserPostResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *GetUserPostResponseData) WithHeaders(h http.Header) *GetUserPostResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *GetUserPostResponseData) WithStatus(code int) *GetUserPostResponseData {
+	r.Status = code
+	return r
+}
+
+// CreateOrderResponseData wraps the success response with optional headers and status override.
+type CreateOrderResponseData struct {
+	Body    *CreateOrderResponse
+	Headers http.Header
+	Status  int // 0 = use default (201)
+}
+
+// NewCreateOrderResponseData creates a new CreateOrderResponseData with the given body.
+func NewCreateOrderResponseData(body *CreateOrderResponse) *CreateOrderResponseData {
+	return &CreateOrderResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *CreateOrderResponseData) WithHeaders(h http.Header) *CreateOrderResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *CreateOrderResponseData) WithStatus(code int) *CreateOrderResponseData {
+	r.Status = code
+	return r
+}
+
+// CreateCompanyResponseData wraps the success response with optional headers and status override.
+type CreateCompanyResponseData struct {
+	Body    *CreateCompanyResponse
+	Headers http.Header
+	Status  int // 0 = use default (201)
+}
+
+// NewCreateCompanyResponseData creates a new CreateCompanyResponseData with the given body.
+func NewCreateCompanyResponseData(body *CreateCompanyResponse) *CreateCompanyResponseData {
+	return &CreateCompanyResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *CreateCompanyResponseData) WithHeaders(h http.Header) *CreateCompanyResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *CreateCompanyResponseData) WithStatus(code int) *CreateCompanyResponseData {
+	r.Status = code
+	return r
+}
+
+// GetReportResponseData wraps the success response with optional headers and status override.
+type GetReportResponseData struct {
+	Body    *GetReportResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewGetReportResponseData creates a new GetReportResponseData with the given body.
+func NewGetReportResponseData(body *GetReportResponse) *GetReportResponseData {
+	return &GetReportResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *GetReportResponseData) WithHeaders(h http.Header) *GetReportResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *GetReportResponseData) WithStatus(code int) *GetReportResponseData {
+	r.Status = code
+	return r
+}
+
+// GetReportEntryResponseData wraps the success response with optional headers and status override.
+type GetReportEntryResponseData struct {
+	Body    *GetReportEntryResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewGetReportEntryResponseData creates a new GetReportEntryResponseData with the given body.
+func NewGetReportEntryResponseData(body *GetReportEntryResponse) *GetReportEntryResponseData {
+	return &GetReportEntryResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *GetReportEntryResponseData) WithHeaders(h http.Header) *GetReportEntryResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *GetReportEntryResponseData) WithStatus(code int) *GetReportEntryResponseData {
+	r.Status = code
+	return r
+}
+
+// HealthCheckContext is the typed context of the HealthCheck operation, the same for every handler kind:
+// the context of the request, the operation having no parameters and no body.
+type HealthCheckContext struct {
+	context.Context
+}
+
+// ListUsersServiceRequestOptions holds all parameters for the ListUsers operation.
+type ListUsersServiceRequestOptions struct {
+	Query  *ListUsersQuery
+	Header *ListUsersHeaders
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *ListUsersServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Query != nil {
+		if v, ok := any(o.Query).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Query", err)
+			}
+		}
+	}
+
+	if o.Header != nil {
+		if v, ok := any(o.Header).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Header", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// ListUsersContext is the typed context of the ListUsers operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type ListUsersContext struct {
+	context.Context
+	*ListUsersServiceRequestOptions
+}
+
+// WithContext returns the typed context of the ListUsers operation, with ctx the context of the request.
+func (o *ListUsersServiceRequestOptions) WithContext(ctx context.Context) *ListUsersContext {
+	return &ListUsersContext{Context: ctx, ListUsersServiceRequestOptions: o}
+}
+
+// CreateUserServiceRequestOptions holds all parameters for the CreateUser operation.
+type CreateUserServiceRequestOptions struct {
+	Body *CreateUserBody
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *CreateUserServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// CreateUserContext is the typed context of the CreateUser operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type CreateUserContext struct {
+	context.Context
+	*CreateUserServiceRequestOptions
+}
+
+// WithContext returns the typed context of the CreateUser operation, with ctx the context of the request.
+func (o *CreateUserServiceRequestOptions) WithContext(ctx context.Context) *CreateUserContext {
+	return &CreateUserContext{Context: ctx, CreateUserServiceRequestOptions: o}
+}
+
+// ImportUsersServiceRequestOptions holds all parameters for the ImportUsers operation.
+type ImportUsersServiceRequestOptions struct {
+	Body *ImportUsersBody
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *ImportUsersServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// ImportUsersContext is the typed context of the ImportUsers operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type ImportUsersContext struct {
+	context.Context
+	*ImportUsersServiceRequestOptions
+}
+
+// WithContext returns the typed context of the ImportUsers operation, with ctx the context of the request.
+func (o *ImportUsersServiceRequestOptions) WithContext(ctx context.Context) *ImportUsersContext {
+	return &ImportUsersContext{Context: ctx, ImportUsersServiceRequestOptions: o}
+}
+
+// GetUserServiceRequestOptions holds all parameters for the GetUser operation.
+type GetUserServiceRequestOptions struct {
+	PathParams *GetUserPath
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *GetUserServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetUserContext is the typed context of the GetUser operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type GetUserContext struct {
+	context.Context
+	*GetUserServiceRequestOptions
+}
+
+// WithContext returns the typed context of the GetUser operation, with ctx the context of the request.
+func (o *GetUserServiceRequestOptions) WithContext(ctx context.Context) *GetUserContext {
+	return &GetUserContext{Context: ctx, GetUserServiceRequestOptions: o}
+}
+
+// DeleteUserServiceRequestOptions holds all parameters for the DeleteUser operation.
+type DeleteUserServiceRequestOptions struct {
+	PathParams *DeleteUserPath
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *DeleteUserServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// DeleteUserContext is the typed context of the DeleteUser operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type DeleteUserContext struct {
+	context.Context
+	*DeleteUserServiceRequestOptions
+}
+
+// WithContext returns the typed context of the DeleteUser operation, with ctx the context of the request.
+func (o *DeleteUserServiceRequestOptions) WithContext(ctx context.Context) *DeleteUserContext {
+	return &DeleteUserContext{Context: ctx, DeleteUserServiceRequestOptions: o}
+}
+
+// GetUserAvatarServiceRequestOptions holds all parameters for the GetUserAvatar operation.
+type GetUserAvatarServiceRequestOptions struct {
+	PathParams *GetUserAvatarPath
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *GetUserAvatarServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetUserAvatarContext is the typed context of the GetUserAvatar operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type GetUserAvatarContext struct {
+	context.Context
+	*GetUserAvatarServiceRequestOptions
+}
+
+// WithContext returns the typed context of the GetUserAvatar operation, with ctx the context of the request.
+func (o *GetUserAvatarServiceRequestOptions) WithContext(ctx context.Context) *GetUserAvatarContext {
+	return &GetUserAvatarContext{Context: ctx, GetUserAvatarServiceRequestOptions: o}
+}
+
+// UploadUserAvatarServiceRequestOptions holds all parameters for the UploadUserAvatar operation.
+type UploadUserAvatarServiceRequestOptions struct {
+	PathParams *UploadUserAvatarPath
+	Body       UploadUserAvatarBody
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *UploadUserAvatarServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// UploadUserAvatarContext is the typed context of the UploadUserAvatar operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type UploadUserAvatarContext struct {
+	context.Context
+	*UploadUserAvatarServiceRequestOptions
+}
+
+// WithContext returns the typed context of the UploadUserAvatar operation, with ctx the context of the request.
+func (o *UploadUserAvatarServiceRequestOptions) WithContext(ctx context.Context) *UploadUserAvatarContext {
+	return &UploadUserAvatarContext{Context: ctx, UploadUserAvatarServiceRequestOptions: o}
+}
+
+// SubmitContactFormServiceRequestOptions holds all parameters for the SubmitContactForm operation.
+type SubmitContactFormServiceRequestOptions struct {
+	Body *SubmitContactFormBody
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *SubmitContactFormServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// SubmitContactFormContext is the typed context of the SubmitContactForm operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type SubmitContactFormContext struct {
+	context.Context
+	*SubmitContactFormServiceRequestOptions
+}
+
+// WithContext returns the typed context of the SubmitContactForm operation, with ctx the context of the request.
+func (o *SubmitContactFormServiceRequestOptions) WithContext(ctx context.Context) *SubmitContactFormContext {
+	return &SubmitContactFormContext{Context: ctx, SubmitContactFormServiceRequestOptions: o}
+}
+
+// CreateNoteServiceRequestOptions holds all parameters for the CreateNote operation.
+type CreateNoteServiceRequestOptions struct {
+	Body *CreateNoteBody
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *CreateNoteServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// CreateNoteContext is the typed context of the CreateNote operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type CreateNoteContext struct {
+	context.Context
+	*CreateNoteServiceRequestOptions
+}
+
+// WithContext returns the typed context of the CreateNote operation, with ctx the context of the request.
+func (o *CreateNoteServiceRequestOptions) WithContext(ctx context.Context) *CreateNoteContext {
+	return &CreateNoteContext{Context: ctx, CreateNoteServiceRequestOptions: o}
+}
+
+// ProcessXMLDataServiceRequestOptions holds all parameters for the ProcessXMLData operation.
+type ProcessXMLDataServiceRequestOptions struct {
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *ProcessXMLDataServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// ProcessXMLDataContext is the typed context of the ProcessXMLData operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type ProcessXMLDataContext struct {
+	context.Context
+	*ProcessXMLDataServiceRequestOptions
+}
+
+// WithContext returns the typed context of the ProcessXMLData operation, with ctx the context of the request.
+func (o *ProcessXMLDataServiceRequestOptions) WithContext(ctx context.Context) *ProcessXMLDataContext {
+	return &ProcessXMLDataContext{Context: ctx, ProcessXMLDataServiceRequestOptions: o}
+}
+
+// ExportDataContext is the typed context of the ExportData operation, the same for every handler kind:
+// the context of the request, the operation having no parameters and no body.
+type ExportDataContext struct {
+	context.Context
+}
+
+// GetOAuthTokenServiceRequestOptions holds all parameters for the GetOAuthToken operation.
+type GetOAuthTokenServiceRequestOptions struct {
+	Body *GetOAuthTokenBody
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *GetOAuthTokenServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetOAuthTokenContext is the typed context of the GetOAuthToken operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type GetOAuthTokenContext struct {
+	context.Context
+	*GetOAuthTokenServiceRequestOptions
+}
+
+// WithContext returns the typed context of the GetOAuthToken operation, with ctx the context of the request.
+func (o *GetOAuthTokenServiceRequestOptions) WithContext(ctx context.Context) *GetOAuthTokenContext {
+	return &GetOAuthTokenContext{Context: ctx, GetOAuthTokenServiceRequestOptions: o}
+}
+
+// GetItemsByTypeServiceRequestOptions holds all parameters for the GetItemsByType operation.
+type GetItemsByTypeServiceRequestOptions struct {
+	PathParams *GetItemsByTypePath
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *GetItemsByTypeServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetItemsByTypeContext is the typed context of the GetItemsByType operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type GetItemsByTypeContext struct {
+	context.Context
+	*GetItemsByTypeServiceRequestOptions
+}
+
+// WithContext returns the typed context of the GetItemsByType operation, with ctx the context of the request.
+func (o *GetItemsByTypeServiceRequestOptions) WithContext(ctx context.Context) *GetItemsByTypeContext {
+	return &GetItemsByTypeContext{Context: ctx, GetItemsByTypeServiceRequestOptions: o}
+}
+
+// SearchServiceRequestOptions holds all parameters for the Search operation.
+type SearchServiceRequestOptions struct {
+	Query *SearchQuery
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *SearchServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Query != nil {
+		if v, ok := any(o.Query).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Query", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// SearchContext is the typed context of the Search operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type SearchContext struct {
+	context.Context
+	*SearchServiceRequestOptions
+}
+
+// WithContext returns the typed context of the Search operation, with ctx the context of the request.
+func (o *SearchServiceRequestOptions) WithContext(ctx context.Context) *SearchContext {
+	return &SearchContext{Context: ctx, SearchServiceRequestOptions: o}
+}
+
+// GetStatusContext is the typed context of the GetStatus operation, the same for every handler kind:
+// the context of the request, the operation having no parameters and no body.
+type GetStatusContext struct {
+	context.Context
+}
+
+// UploadImageServiceRequestOptions holds all parameters for the UploadImage operation.
+type UploadImageServiceRequestOptions struct {
+	Body UploadImageBody
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *UploadImageServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// UploadImageContext is the typed context of the UploadImage operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type UploadImageContext struct {
+	context.Context
+	*UploadImageServiceRequestOptions
+}
+
+// WithContext returns the typed context of the UploadImage operation, with ctx the context of the request.
+func (o *UploadImageServiceRequestOptions) WithContext(ctx context.Context) *UploadImageContext {
+	return &UploadImageContext{Context: ctx, UploadImageServiceRequestOptions: o}
+}
+
+// ListProductsServiceRequestOptions holds all parameters for the ListProducts operation.
+type ListProductsServiceRequestOptions struct {
+	Query *ListProductsQuery
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *ListProductsServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Query != nil {
+		if v, ok := any(o.Query).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Query", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// ListProductsContext is the typed context of the ListProducts operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type ListProductsContext struct {
+	context.Context
+	*ListProductsServiceRequestOptions
+}
+
+// WithContext returns the typed context of the ListProducts operation, with ctx the context of the request.
+func (o *ListProductsServiceRequestOptions) WithContext(ctx context.Context) *ListProductsContext {
+	return &ListProductsContext{Context: ctx, ListProductsServiceRequestOptions: o}
+}
+
+// GetCategoryServiceRequestOptions holds all parameters for the GetCategory operation.
+type GetCategoryServiceRequestOptions struct {
+	PathParams *GetCategoryPath
+	Header     *GetCategoryHeaders
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *GetCategoryServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+
+	if o.Header != nil {
+		if v, ok := any(o.Header).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Header", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetCategoryContext is the typed context of the GetCategory operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type GetCategoryContext struct {
+	context.Context
+	*GetCategoryServiceRequestOptions
+}
+
+// WithContext returns the typed context of the GetCategory operation, with ctx the context of the request.
+func (o *GetCategoryServiceRequestOptions) WithContext(ctx context.Context) *GetCategoryContext {
+	return &GetCategoryContext{Context: ctx, GetCategoryServiceRequestOptions: o}
+}
+
+// ListTagsServiceRequestOptions holds all parameters for the ListTags operation.
+type ListTagsServiceRequestOptions struct {
+	Header *ListTagsHeaders
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *ListTagsServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Header != nil {
+		if v, ok := any(o.Header).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Header", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// ListTagsContext is the typed context of the ListTags operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type ListTagsContext struct {
+	context.Context
+	*ListTagsServiceRequestOptions
+}
+
+// WithContext returns the typed context of the ListTags operation, with ctx the context of the request.
+func (o *ListTagsServiceRequestOptions) WithContext(ctx context.Context) *ListTagsContext {
+	return &ListTagsContext{Context: ctx, ListTagsServiceRequestOptions: o}
+}
+
+// GetItemsByStatusServiceRequestOptions holds all parameters for the GetItemsByStatus operation.
+type GetItemsByStatusServiceRequestOptions struct {
+	PathParams *GetItemsByStatusPath
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *GetItemsByStatusServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetItemsByStatusContext is the typed context of the GetItemsByStatus operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type GetItemsByStatusContext struct {
+	context.Context
+	*GetItemsByStatusServiceRequestOptions
+}
+
+// WithContext returns the typed context of the GetItemsByStatus operation, with ctx the context of the request.
+func (o *GetItemsByStatusServiceRequestOptions) WithContext(ctx context.Context) *GetItemsByStatusContext {
+	return &GetItemsByStatusContext{Context: ctx, GetItemsByStatusServiceRequestOptions: o}
+}
+
+// GetUserPostServiceRequestOptions holds all parameters for the GetUserPost operation.
+type GetUserPostServiceRequestOptions struct {
+	PathParams *GetUserPostPath
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *GetUserPostServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetUserPostContext is the typed context of the GetUserPost operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type GetUserPostContext struct {
+	context.Context
+	*GetUserPostServiceRequestOptions
+}
+
+// WithContext returns the typed context of the GetUserPost operation, with ctx the context of the request.
+func (o *GetUserPostServiceRequestOptions) WithContext(ctx context.Context) *GetUserPostContext {
+	return &GetUserPostContext{Context: ctx, GetUserPostServiceRequestOptions: o}
+}
+
+// CreateOrderServiceRequestOptions holds all parameters for the CreateOrder operation.
+type CreateOrderServiceRequestOptions struct {
+	Body *CreateOrderBody
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *CreateOrderServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// CreateOrderContext is the typed context of the CreateOrder operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type CreateOrderContext struct {
+	context.Context
+	*CreateOrderServiceRequestOptions
+}
+
+// WithContext returns the typed context of the CreateOrder operation, with ctx the context of the request.
+func (o *CreateOrderServiceRequestOptions) WithContext(ctx context.Context) *CreateOrderContext {
+	return &CreateOrderContext{Context: ctx, CreateOrderServiceRequestOptions: o}
+}
+
+// CreateCompanyServiceRequestOptions holds all parameters for the CreateCompany operation.
+type CreateCompanyServiceRequestOptions struct {
+	Body *CreateCompanyBody
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *CreateCompanyServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// CreateCompanyContext is the typed context of the CreateCompany operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type CreateCompanyContext struct {
+	context.Context
+	*CreateCompanyServiceRequestOptions
+}
+
+// WithContext returns the typed context of the CreateCompany operation, with ctx the context of the request.
+func (o *CreateCompanyServiceRequestOptions) WithContext(ctx context.Context) *CreateCompanyContext {
+	return &CreateCompanyContext{Context: ctx, CreateCompanyServiceRequestOptions: o}
+}
+
+// GetReportServiceRequestOptions holds all parameters for the GetReport operation.
+type GetReportServiceRequestOptions struct {
+	PathParams *GetReportPath
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *GetReportServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetReportContext is the typed context of the GetReport operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type GetReportContext struct {
+	context.Context
+	*GetReportServiceRequestOptions
+}
+
+// WithContext returns the typed context of the GetReport operation, with ctx the context of the request.
+func (o *GetReportServiceRequestOptions) WithContext(ctx context.Context) *GetReportContext {
+	return &GetReportContext{Context: ctx, GetReportServiceRequestOptions: o}
+}
+
+// GetReportEntryServiceRequestOptions holds all parameters for the GetReportEntry operation.
+type GetReportEntryServiceRequestOptions struct {
+	PathParams *GetReportEntryPath
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *GetReportEntryServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetReportEntryContext is the typed context of the GetReportEntry operation, the same for every handler kind:
+// the context of the request, with the parameters and the body parsed by the adapter.
+type GetReportEntryContext struct {
+	context.Context
+	*GetReportEntryServiceRequestOptions
+}
+
+// WithContext returns the typed context of the GetReportEntry operation, with ctx the context of the request.
+func (o *GetReportEntryServiceRequestOptions) WithContext(ctx context.Context) *GetReportEntryContext {
+	return &GetReportEntryContext{Context: ctx, GetReportEntryServiceRequestOptions: o}
+}
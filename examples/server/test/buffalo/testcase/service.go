@@ -0,0 +1,220 @@
+// Package testcase implements test service logic for all frameworks.
+// This file is hand-written and copied to each framework's testcase package.
+package testcase
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// Service implements the ServiceInterface with test logic.
+type Service struct {
+	avatars map[string][]byte
+}
+
+// NewService creates a new Service.
+func NewService() *Service {
+	return &Service{avatars: make(map[string][]byte)}
+}
+
+// Ensure Service implements ServiceInterface.
+var _ ServiceInterface = (*Service)(nil)
+
+func ptr[T any](v T) *T { return &v }
+
+// HealthCheck handles GET /health
+func (s *Service) HealthCheck(ctx *HealthCheckContext) (*HealthCheckResponseData, error) {
+	resp := HealthCheckResponse("OK")
+	return NewHealthCheckResponseData(&resp), nil
+}
+
+// ListUsers handles GET /users
+func (s *Service) ListUsers(ctx *ListUsersContext) (*ListUsersResponseData, error) {
+	users := ListUsersResponse{
+		{ID: "1", Name: "Alice", Email: "alice@example.com"},
+		{ID: "2", Name: "Bob", Email: "bob@example.com"},
+		{ID: "3", Name: "Charlie", Email: "charlie@example.com"},
+	}
+	resp := NewListUsersResponseData(&users)
+	resp.Headers = http.Header{}
+	resp.Headers.Set("X-Total-Count", "3")
+	resp.Headers.Set("X-Page-Token", "next-page-token")
+	return resp, nil
+}
+
+// CreateUser handles POST /users
+func (s *Service) CreateUser(ctx *CreateUserContext) (*CreateUserResponseData, error) {
+	user := User{ID: "new-user-id", Name: ctx.Body.Name, Email: ctx.Body.Email}
+	resp := NewCreateUserResponseData(&user)
+	resp.Status = http.StatusCreated
+	return resp, nil
+}
+
+// ImportUsers handles POST /users/import
+func (s *Service) ImportUsers(ctx *ImportUsersContext) (*ImportUsersResponseData, error) {
+	result := ImportResult{Imported: ptr(5), Skipped: ptr(0)}
+	return NewImportUsersResponseData(&result), nil
+}
+
+// GetUser handles GET /users/{id}
+func (s *Service) GetUser(ctx *GetUserContext) (*GetUserResponseData, error) {
+	user := User{ID: ctx.PathParams.ID, Name: "Test User", Email: "test@example.com"}
+	return NewGetUserResponseData(&user), nil
+}
+
+// DeleteUser handles DELETE /users/{id}
+func (s *Service) DeleteUser(ctx *DeleteUserContext) (*DeleteUserResponseData, error) {
+	resp := NewDeleteUserResponseData(nil)
+	resp.Status = http.StatusNoContent
+	return resp, nil
+}
+
+// GetUserAvatar handles GET /users/{id}/avatar
+func (s *Service) GetUserAvatar(ctx *GetUserAvatarContext) (*GetUserAvatarResponseData, error) {
+	if data, ok := s.avatars[ctx.PathParams.ID]; ok {
+		var file runtime.File
+		file.InitFromReader(bytes.NewReader(data), "avatar")
+		return NewGetUserAvatarResponseData(&file), nil
+	}
+	return nil, fmt.Errorf("avatar not found")
+}
+
+// UploadUserAvatar handles PUT /users/{id}/avatar
+func (s *Service) UploadUserAvatar(ctx *UploadUserAvatarContext) (*UploadUserAvatarResponseData, error) {
+	body, err := io.ReadAll(ctx.Body)
+	if err != nil {
+		return nil, err
+	}
+	s.avatars[ctx.PathParams.ID] = body
+	resp := NewUploadUserAvatarResponseData(nil)
+	resp.Status = http.StatusNoContent
+	return resp, nil
+}
+
+// SubmitContactForm handles POST /contact
+func (s *Service) SubmitContactForm(ctx *SubmitContactFormContext) (*SubmitContactFormResponseData, error) {
+	result := SubmitContactFormResponse{"success": true}
+	return NewSubmitContactFormResponseData(&result), nil
+}
+
+// CreateNote handles POST /notes
+func (s *Service) CreateNote(ctx *CreateNoteContext) (*CreateNoteResponseData, error) {
+	noteID := CreateNoteResponse(1) // Return note ID as integer
+	resp := NewCreateNoteResponseData(&noteID)
+	resp.Status = http.StatusCreated
+	return resp, nil
+}
+
+// ProcessXMLData handles POST /xml-data
+func (s *Service) ProcessXMLData(ctx *ProcessXMLDataContext) (*ProcessXMLDataResponseData, error) {
+	return NewProcessXMLDataResponseData([]byte("<result>OK</result>")), nil
+}
+
+// ExportData handles GET /export
+func (s *Service) ExportData(ctx *ExportDataContext) (*ExportDataResponseData, error) {
+	var data runtime.File
+	data.InitFromBytes([]byte("export-data"), "export.bin")
+	return NewExportDataResponseData(&data), nil
+}
+
+// GetOAuthToken handles POST /oauth/token
+func (s *Service) GetOAuthToken(ctx *GetOAuthTokenContext) (*GetOAuthTokenResponseData, error) {
+	token := TokenResponse{AccessToken: "test-token", TokenType: "Bearer", ExpiresIn: ptr(3600)}
+	return NewGetOAuthTokenResponseData(&token), nil
+}
+
+// GetItemsByType handles GET /items/{type}
+func (s *Service) GetItemsByType(ctx *GetItemsByTypeContext) (*GetItemsByTypeResponseData, error) {
+	items := GetItemsByTypeResponse{fmt.Sprintf("item-%s-1", ctx.PathParams.Type)}
+	return NewGetItemsByTypeResponseData(&items), nil
+}
+
+// Search handles GET /search
+func (s *Service) Search(ctx *SearchContext) (*SearchResponseData, error) {
+	q := ctx.Query.Q
+	if name, ok := strings.CutPrefix(q, "user:"); ok {
+		user := User{ID: "user-1", Name: name, Email: name + "@example.com"}
+		return NewSearchResponseDataFromUser(user), nil
+	}
+	item := SearchItem{ID: "item-1", Title: q, Description: ptr("Search result")}
+	return NewSearchResponseDataFromSearchItem(item), nil
+}
+
+// GetStatus handles GET /status
+func (s *Service) GetStatus(ctx *GetStatusContext) (*GetStatusResponseData, error) {
+	status := StatusResponse{Status: ptr("healthy"), Uptime: ptr(3600)}
+	return NewGetStatusResponseData(&status), nil
+}
+
+// UploadImage handles POST /images
+func (s *Service) UploadImage(ctx *UploadImageContext) (*UploadImageResponseData, error) {
+	result := UploadImageResponse{ID: ptr("img-123"), URL: ptr("http://example.com/img-123")}
+	resp := NewUploadImageResponseData(&result)
+	resp.Status = http.StatusCreated
+	return resp, nil
+}
+
+// ListProducts handles GET /products
+func (s *Service) ListProducts(ctx *ListProductsContext) (*ListProductsResponseData, error) {
+	products := ListProductsResponse{{ID: "prod-1", Name: "Product 1", Price: 9.99}}
+	return NewListProductsResponseData(&products), nil
+}
+
+// GetCategory handles GET /categories/{categoryId}
+func (s *Service) GetCategory(ctx *GetCategoryContext) (*GetCategoryResponseData, error) {
+	category := Category{ID: ctx.PathParams.CategoryID, Name: "Test Category"}
+	return NewGetCategoryResponseData(&category), nil
+}
+
+// ListTags handles GET /tags
+func (s *Service) ListTags(ctx *ListTagsContext) (*ListTagsResponseData, error) {
+	tags := ListTagsResponse{}
+	for _, tag := range ctx.Header.XTags {
+		tags = append(tags, "tag-"+tag)
+	}
+	for _, id := range ctx.Header.XTagIds {
+		tags = append(tags, fmt.Sprintf("id-%d", id))
+	}
+	return NewListTagsResponseData(&tags), nil
+}
+
+// GetItemsByStatus handles GET /items/{type}/{rating}
+func (s *Service) GetItemsByStatus(ctx *GetItemsByStatusContext) (*GetItemsByStatusResponseData, error) {
+	items := GetItemsByStatusResponse{fmt.Sprintf("type-%s-rating-%v", ctx.PathParams.Type, ctx.PathParams.Rating)}
+	return NewGetItemsByStatusResponseData(&items), nil
+}
+
+// GetUserPost handles GET /users/{id}/posts/{postId}
+func (s *Service) GetUserPost(ctx *GetUserPostContext) (*GetUserPostResponseData, error) {
+	post := Post{ID: ctx.PathParams.PostID, UserID: ctx.PathParams.ID, Title: "Test Post", Content: "Post content"}
+	return NewGetUserPostResponseData(&post), nil
+}
+
+// CreateOrder handles POST /orders
+func (s *Service) CreateOrder(ctx *CreateOrderContext) (*CreateOrderResponseData, error) {
+	order := Order{ID: "order-1", Status: Pending}
+	return NewCreateOrderResponseData(&order), nil
+}
+
+// CreateCompany handles POST /companies
+func (s *Service) CreateCompany(ctx *CreateCompanyContext) (*CreateCompanyResponseData, error) {
+	company := Company{ID: "company-1", Name: ctx.Body.Name, Address: ctx.Body.Address}
+	return NewCreateCompanyResponseData(&company), nil
+}
+
+// GetReport handles GET /reports/{date}
+func (s *Service) GetReport(ctx *GetReportContext) (*GetReportResponseData, error) {
+	report := Report{Date: ctx.PathParams.Date, Weekday: ctx.PathParams.Date.Weekday().String()}
+	return NewGetReportResponseData(&report), nil
+}
+
+// GetReportEntry handles GET /reports/{date}/entries/{entryId}
+func (s *Service) GetReportEntry(ctx *GetReportEntryContext) (*GetReportEntryResponseData, error) {
+	entry := ReportEntry{Date: ctx.PathParams.Date, ID: ctx.PathParams.EntryID}
+	return NewGetReportEntryResponseData(&entry), nil
+}
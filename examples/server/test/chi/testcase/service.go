@@ -3,7 +3,7 @@
 package testcase
 
 import (
-	"context"
+	"bytes"
 	"fmt"
 	"io"
 	"net/http"
@@ -28,13 +28,13 @@ var _ ServiceInterface = (*Service)(nil)
 func ptr[T any](v T) *T { return &v }
 
 // HealthCheck handles GET /health
-func (s *Service) HealthCheck(ctx context.Context) (*HealthCheckResponseData, error) {
+func (s *Service) HealthCheck(ctx *HealthCheckContext) (*HealthCheckResponseData, error) {
 	resp := HealthCheckResponse("OK")
 	return NewHealthCheckResponseData(&resp), nil
 }
 
 // ListUsers handles GET /users
-func (s *Service) ListUsers(ctx context.Context, opts *ListUsersServiceRequestOptions) (*ListUsersResponseData, error) {
+func (s *Service) ListUsers(ctx *ListUsersContext) (*ListUsersResponseData, error) {
 	users := ListUsersResponse{
 		{ID: "1", Name: "Alice", Email: "alice@example.com"},
 		{ID: "2", Name: "Bob", Email: "bob@example.com"},
@@ -48,59 +48,62 @@ func (s *Service) ListUsers(ctx context.Context, opts *ListUsersServiceRequestOp
 }
 
 // CreateUser handles POST /users
-func (s *Service) CreateUser(ctx context.Context, opts *CreateUserServiceRequestOptions) (*CreateUserResponseData, error) {
-	user := User{ID: "new-user-id", Name: opts.Body.Name, Email: opts.Body.Email}
+func (s *Service) CreateUser(ctx *CreateUserContext) (*CreateUserResponseData, error) {
+	user := User{ID: "new-user-id", Name: ctx.Body.Name, Email: ctx.Body.Email}
 	resp := NewCreateUserResponseData(&user)
 	resp.Status = http.StatusCreated
 	return resp, nil
 }
 
 // ImportUsers handles POST /users/import
-func (s *Service) ImportUsers(ctx context.Context, opts *ImportUsersServiceRequestOptions) (*ImportUsersResponseData, error) {
+func (s *Service) ImportUsers(ctx *ImportUsersContext) (*ImportUsersResponseData, error) {
 	result := ImportResult{Imported: ptr(5), Skipped: ptr(0)}
 	return NewImportUsersResponseData(&result), nil
 }
 
 // GetUser handles GET /users/{id}
-func (s *Service) GetUser(ctx context.Context, opts *GetUserServiceRequestOptions) (*GetUserResponseData, error) {
-	user := User{ID: opts.PathParams.ID, Name: "Test User", Email: "test@example.com"}
+func (s *Service) GetUser(ctx *GetUserContext) (*GetUserResponseData, error) {
+	user := User{ID: ctx.PathParams.ID, Name: "Test User", Email: "test@example.com"}
 	return NewGetUserResponseData(&user), nil
 }
 
 // DeleteUser handles DELETE /users/{id}
-func (s *Service) DeleteUser(ctx context.Context, opts *DeleteUserServiceRequestOptions) (*DeleteUserResponseData, error) {
+func (s *Service) DeleteUser(ctx *DeleteUserContext) (*DeleteUserResponseData, error) {
 	resp := NewDeleteUserResponseData(nil)
 	resp.Status = http.StatusNoContent
 	return resp, nil
 }
 
 // GetUserAvatar handles GET /users/{id}/avatar
-func (s *Service) GetUserAvatar(ctx context.Context, opts *GetUserAvatarServiceRequestOptions) (*GetUserAvatarResponseData, error) {
-	if data, ok := s.avatars[opts.PathParams.ID]; ok {
+func (s *Service) GetUserAvatar(ctx *GetUserAvatarContext) (*GetUserAvatarResponseData, error) {
+	if data, ok := s.avatars[ctx.PathParams.ID]; ok {
 		var file runtime.File
-		file.InitFromBytes(data, "avatar")
+		file.InitFromReader(bytes.NewReader(data), "avatar")
 		return NewGetUserAvatarResponseData(&file), nil
 	}
 	return nil, fmt.Errorf("avatar not found")
 }
 
 // UploadUserAvatar handles PUT /users/{id}/avatar
-func (s *Service) UploadUserAvatar(ctx context.Context, opts *UploadUserAvatarServiceRequestOptions) (*UploadUserAvatarResponseData, error) {
-	body, _ := io.ReadAll(opts.RawRequest.Body)
-	s.avatars[opts.PathParams.ID] = body
+func (s *Service) UploadUserAvatar(ctx *UploadUserAvatarContext) (*UploadUserAvatarResponseData, error) {
+	body, err := io.ReadAll(ctx.Body)
+	if err != nil {
+		return nil, err
+	}
+	s.avatars[ctx.PathParams.ID] = body
 	resp := NewUploadUserAvatarResponseData(nil)
 	resp.Status = http.StatusNoContent
 	return resp, nil
 }
 
 // SubmitContactForm handles POST /contact
-func (s *Service) SubmitContactForm(ctx context.Context, opts *SubmitContactFormServiceRequestOptions) (*SubmitContactFormResponseData, error) {
+func (s *Service) SubmitContactForm(ctx *SubmitContactFormContext) (*SubmitContactFormResponseData, error) {
 	result := SubmitContactFormResponse{"success": true}
 	return NewSubmitContactFormResponseData(&result), nil
 }
 
 // CreateNote handles POST /notes
-func (s *Service) CreateNote(ctx context.Context, opts *CreateNoteServiceRequestOptions) (*CreateNoteResponseData, error) {
+func (s *Service) CreateNote(ctx *CreateNoteContext) (*CreateNoteResponseData, error) {
 	noteID := CreateNoteResponse(1) // Return note ID as integer
 	resp := NewCreateNoteResponseData(&noteID)
 	resp.Status = http.StatusCreated
@@ -108,52 +111,48 @@ func (s *Service) CreateNote(ctx context.Context, opts *CreateNoteServiceRequest
 }
 
 // ProcessXMLData handles POST /xml-data
-func (s *Service) ProcessXMLData(ctx context.Context, opts *ProcessXMLDataServiceRequestOptions) (*ProcessXMLDataResponseData, error) {
+func (s *Service) ProcessXMLData(ctx *ProcessXMLDataContext) (*ProcessXMLDataResponseData, error) {
 	return NewProcessXMLDataResponseData([]byte("<result>OK</result>")), nil
 }
 
 // ExportData handles GET /export
-func (s *Service) ExportData(ctx context.Context) (*ExportDataResponseData, error) {
+func (s *Service) ExportData(ctx *ExportDataContext) (*ExportDataResponseData, error) {
 	var data runtime.File
 	data.InitFromBytes([]byte("export-data"), "export.bin")
 	return NewExportDataResponseData(&data), nil
 }
 
 // GetOAuthToken handles POST /oauth/token
-func (s *Service) GetOAuthToken(ctx context.Context, opts *GetOAuthTokenServiceRequestOptions) (*GetOAuthTokenResponseData, error) {
+func (s *Service) GetOAuthToken(ctx *GetOAuthTokenContext) (*GetOAuthTokenResponseData, error) {
 	token := TokenResponse{AccessToken: "test-token", TokenType: "Bearer", ExpiresIn: ptr(3600)}
 	return NewGetOAuthTokenResponseData(&token), nil
 }
 
 // GetItemsByType handles GET /items/{type}
-func (s *Service) GetItemsByType(ctx context.Context, opts *GetItemsByTypeServiceRequestOptions) (*GetItemsByTypeResponseData, error) {
-	items := GetItemsByTypeResponse{fmt.Sprintf("item-%s-1", opts.PathParams.Type)}
+func (s *Service) GetItemsByType(ctx *GetItemsByTypeContext) (*GetItemsByTypeResponseData, error) {
+	items := GetItemsByTypeResponse{fmt.Sprintf("item-%s-1", ctx.PathParams.Type)}
 	return NewGetItemsByTypeResponseData(&items), nil
 }
 
 // Search handles GET /search
-func (s *Service) Search(ctx context.Context, opts *SearchServiceRequestOptions) (*SearchResponseData, error) {
-	q := opts.Query.Q
-	var result SearchResponse
-	if strings.HasPrefix(q, "user:") {
-		name := strings.TrimPrefix(q, "user:")
+func (s *Service) Search(ctx *SearchContext) (*SearchResponseData, error) {
+	q := ctx.Query.Q
+	if name, ok := strings.CutPrefix(q, "user:"); ok {
 		user := User{ID: "user-1", Name: name, Email: name + "@example.com"}
-		result.Search_Response_OneOf = &Search_Response_OneOf{runtime.NewEitherFromA[User, SearchItem](user)}
-	} else {
-		item := SearchItem{ID: "item-1", Title: q, Description: ptr("Search result")}
-		result.Search_Response_OneOf = &Search_Response_OneOf{runtime.NewEitherFromB[User, SearchItem](item)}
+		return NewSearchResponseDataFromUser(user), nil
 	}
-	return NewSearchResponseData(&result), nil
+	item := SearchItem{ID: "item-1", Title: q, Description: ptr("Search result")}
+	return NewSearchResponseDataFromSearchItem(item), nil
 }
 
 // GetStatus handles GET /status
-func (s *Service) GetStatus(ctx context.Context) (*GetStatusResponseData, error) {
+func (s *Service) GetStatus(ctx *GetStatusContext) (*GetStatusResponseData, error) {
 	status := StatusResponse{Status: ptr("healthy"), Uptime: ptr(3600)}
 	return NewGetStatusResponseData(&status), nil
 }
 
 // UploadImage handles POST /images
-func (s *Service) UploadImage(ctx context.Context, opts *UploadImageServiceRequestOptions) (*UploadImageResponseData, error) {
+func (s *Service) UploadImage(ctx *UploadImageContext) (*UploadImageResponseData, error) {
 	result := UploadImageResponse{ID: ptr("img-123"), URL: ptr("http://example.com/img-123")}
 	resp := NewUploadImageResponseData(&result)
 	resp.Status = http.StatusCreated
@@ -161,37 +160,61 @@ func (s *Service) UploadImage(ctx context.Context, opts *UploadImageServiceReque
 }
 
 // ListProducts handles GET /products
-func (s *Service) ListProducts(ctx context.Context, opts *ListProductsServiceRequestOptions) (*ListProductsResponseData, error) {
+func (s *Service) ListProducts(ctx *ListProductsContext) (*ListProductsResponseData, error) {
 	products := ListProductsResponse{{ID: "prod-1", Name: "Product 1", Price: 9.99}}
 	return NewListProductsResponseData(&products), nil
 }
 
 // GetCategory handles GET /categories/{categoryId}
-func (s *Service) GetCategory(ctx context.Context, opts *GetCategoryServiceRequestOptions) (*GetCategoryResponseData, error) {
-	category := Category{ID: opts.PathParams.CategoryID, Name: "Test Category"}
+func (s *Service) GetCategory(ctx *GetCategoryContext) (*GetCategoryResponseData, error) {
+	category := Category{ID: ctx.PathParams.CategoryID, Name: "Test Category"}
 	return NewGetCategoryResponseData(&category), nil
 }
 
+// ListTags handles GET /tags
+func (s *Service) ListTags(ctx *ListTagsContext) (*ListTagsResponseData, error) {
+	tags := ListTagsResponse{}
+	for _, tag := range ctx.Header.XTags {
+		tags = append(tags, "tag-"+tag)
+	}
+	for _, id := range ctx.Header.XTagIds {
+		tags = append(tags, fmt.Sprintf("id-%d", id))
+	}
+	return NewListTagsResponseData(&tags), nil
+}
+
 // GetItemsByStatus handles GET /items/{type}/{rating}
-func (s *Service) GetItemsByStatus(ctx context.Context, opts *GetItemsByStatusServiceRequestOptions) (*GetItemsByStatusResponseData, error) {
-	items := GetItemsByStatusResponse{fmt.Sprintf("type-%s-rating-%v", opts.PathParams.Type, opts.PathParams.Rating)}
+func (s *Service) GetItemsByStatus(ctx *GetItemsByStatusContext) (*GetItemsByStatusResponseData, error) {
+	items := GetItemsByStatusResponse{fmt.Sprintf("type-%s-rating-%v", ctx.PathParams.Type, ctx.PathParams.Rating)}
 	return NewGetItemsByStatusResponseData(&items), nil
 }
 
 // GetUserPost handles GET /users/{id}/posts/{postId}
-func (s *Service) GetUserPost(ctx context.Context, opts *GetUserPostServiceRequestOptions) (*GetUserPostResponseData, error) {
-	post := Post{ID: opts.PathParams.PostID, UserID: opts.PathParams.ID, Title: "Test Post", Content: "Post content"}
+func (s *Service) GetUserPost(ctx *GetUserPostContext) (*GetUserPostResponseData, error) {
+	post := Post{ID: ctx.PathParams.PostID, UserID: ctx.PathParams.ID, Title: "Test Post", Content: "Post content"}
 	return NewGetUserPostResponseData(&post), nil
 }
 
 // CreateOrder handles POST /orders
-func (s *Service) CreateOrder(ctx context.Context, opts *CreateOrderServiceRequestOptions) (*CreateOrderResponseData, error) {
+func (s *Service) CreateOrder(ctx *CreateOrderContext) (*CreateOrderResponseData, error) {
 	order := Order{ID: "order-1", Status: Pending}
 	return NewCreateOrderResponseData(&order), nil
 }
 
 // CreateCompany handles POST /companies
-func (s *Service) CreateCompany(ctx context.Context, opts *CreateCompanyServiceRequestOptions) (*CreateCompanyResponseData, error) {
-	company := Company{ID: "company-1", Name: opts.Body.Name, Address: opts.Body.Address}
+func (s *Service) CreateCompany(ctx *CreateCompanyContext) (*CreateCompanyResponseData, error) {
+	company := Company{ID: "company-1", Name: ctx.Body.Name, Address: ctx.Body.Address}
 	return NewCreateCompanyResponseData(&company), nil
 }
+
+// GetReport handles GET /reports/{date}
+func (s *Service) GetReport(ctx *GetReportContext) (*GetReportResponseData, error) {
+	report := Report{Date: ctx.PathParams.Date, Weekday: ctx.PathParams.Date.Weekday().String()}
+	return NewGetReportResponseData(&report), nil
+}
+
+// GetReportEntry handles GET /reports/{date}/entries/{entryId}
+func (s *Service) GetReportEntry(ctx *GetReportEntryContext) (*GetReportEntryResponseData, error) {
+	entry := ReportEntry{Date: ctx.PathParams.Date, ID: ctx.PathParams.EntryID}
+	return NewGetReportEntryResponseData(&entry), nil
+}
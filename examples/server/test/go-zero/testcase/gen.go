@@ -6,10 +6,12 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"time"
 
-	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
 	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
 )
 
 type OrderStatus string
@@ -51,6 +53,14 @@ type GetCategoryHeaders struct {
 	XPriceThreshold *float32 `json:"X-Price-Threshold,omitempty"`
 }
 
+type ListTagsHeaders struct {
+	// XTags Tag names (string array header)
+	XTags []string `json:"X-Tags,omitempty"`
+
+	// XTagIds Tag IDs (integer array header)
+	XTagIds []int `json:"X-Tag-Ids,omitempty"`
+}
+
 type GetUserPath struct {
 	ID string `json:"id" validate:"required"`
 }
@@ -117,6 +127,46 @@ func (g GetUserPostPath) Validate() error {
 	return runtime.ConvertValidatorError(typesValidator.Struct(g))
 }
 
+type GetReportPath struct {
+	Date runtime.Date `json:"date" validate:"required"`
+}
+
+func (g GetReportPath) Validate() error {
+	var errors runtime.ValidationErrors
+	if v, ok := any(g.Date).(runtime.Validator); ok {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("Date", err)
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type GetReportEntryPath struct {
+	Date    runtime.Date `json:"date" validate:"required"`
+	EntryID uuid.UUID    `json:"entryId" validate:"required"`
+}
+
+func (g GetReportEntryPath) Validate() error {
+	var errors runtime.ValidationErrors
+	if v, ok := any(g.Date).(runtime.Validator); ok {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("Date", err)
+		}
+	}
+	if v, ok := any(g.EntryID).(runtime.Validator); ok {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("EntryID", err)
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
 type CreateUserBody = CreateUserRequest
 
 type ImportUsersBody struct {
@@ -140,7 +190,7 @@ func (i ImportUsersBody) Validate() error {
 	return errors
 }
 
-type UploadUserAvatarBody = runtime.File
+type UploadUserAvatarBody = io.Reader
 
 type SubmitContactFormBody struct {
 	Name    string `json:"name" validate:"required"`
@@ -166,7 +216,7 @@ func (g GetOAuthTokenBody) Validate() error {
 	return runtime.ConvertValidatorError(typesValidator.Struct(g))
 }
 
-type UploadImageBody = runtime.File
+type UploadImageBody = io.Reader
 
 type CreateOrderBody = CreateOrderRequest
 
@@ -289,6 +339,8 @@ type ListProductsResponse []Product
 
 type GetCategoryResponse = Category
 
+type ListTagsResponse []string
+
 type GetItemsByStatusResponse []string
 
 type GetUserPostResponse = Post
@@ -303,6 +355,10 @@ type CreateOrderErrorResponseJSON = ConflictError
 
 type CreateCompanyResponse = Company
 
+type GetReportResponse = Report
+
+type GetReportEntryResponse = ReportEntry
+
 type SearchItem struct {
 	ID          string  `json:"id" validate:"required"`
 	Title       string  `json:"title" validate:"required"`
@@ -362,6 +418,50 @@ type XMLPayload struct {
 	Value *string `json:"value,omitempty"`
 }
 
+type Report struct {
+	Date    runtime.Date `json:"date" validate:"required"`
+	Weekday string       `json:"weekday" validate:"required"`
+}
+
+func (r Report) Validate() error {
+	var errors runtime.ValidationErrors
+	if v, ok := any(r.Date).(runtime.Validator); ok {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("Date", err)
+		}
+	}
+	if err := typesValidator.Var(r.Weekday, "required"); err != nil {
+		errors = errors.Append("Weekday", err)
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type ReportEntry struct {
+	Date runtime.Date `json:"date" validate:"required"`
+	ID   uuid.UUID    `json:"id" validate:"required"`
+}
+
+func (r ReportEntry) Validate() error {
+	var errors runtime.ValidationErrors
+	if v, ok := any(r.Date).(runtime.Validator); ok {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("Date", err)
+		}
+	}
+	if v, ok := any(r.ID).(runtime.Validator); ok {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("ID", err)
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
 type Category struct {
 	ID          int     `json:"id" validate:"required"`
 	Name        string  `json:"name" validate:"required"`
@@ -623,6 +723,26 @@ func (s *Search_Response_OneOf) Validate() error {
 	return nil
 }
 
+// AsUser returns the User inside the Search_Response_OneOf and whether it holds one
+func (s *Search_Response_OneOf) AsUser() (User, bool) {
+	return s.A, s.IsA()
+}
+
+// AsSearchItem returns the SearchItem inside the Search_Response_OneOf and whether it holds one
+func (s *Search_Response_OneOf) AsSearchItem() (SearchItem, bool) {
+	return s.B, s.IsB()
+}
+
+// Match calls the function matching the variant held by the Search_Response_OneOf, none for an empty union
+func (s *Search_Response_OneOf) Match(onA func(User), onB func(SearchItem)) {
+	switch {
+	case s.IsA():
+		onA(s.A)
+	case s.IsB():
+		onB(s.B)
+	}
+}
+
 var typesValidator *validator.Validate
 
 func init() {
@@ -12,6 +12,7 @@ import (
 
 	"github.com/beego/beego/v2/server/web"
 	beegoapi "github.com/doordash-oss/oapi-codegen-dd/v3/examples/server/test/beego/testcase"
+	buffaloapi "github.com/doordash-oss/oapi-codegen-dd/v3/examples/server/test/buffalo/testcase"
 	chiapi "github.com/doordash-oss/oapi-codegen-dd/v3/examples/server/test/chi/testcase"
 	echoapi "github.com/doordash-oss/oapi-codegen-dd/v3/examples/server/test/echo/testcase"
 	fasthttpapi "github.com/doordash-oss/oapi-codegen-dd/v3/examples/server/test/fasthttp/testcase"
@@ -25,11 +26,14 @@ import (
 	kratosapi "github.com/doordash-oss/oapi-codegen-dd/v3/examples/server/test/kratos/testcase"
 	stdhttpapi "github.com/doordash-oss/oapi-codegen-dd/v3/examples/server/test/std-http/testcase"
 	"github.com/gin-gonic/gin"
+	"github.com/gobuffalo/buffalo"
 	"github.com/gofiber/fiber/v3"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/valyala/fasthttp"
+	"github.com/zeromicro/go-zero/rest"
+	"github.com/zeromicro/go-zero/rest/router"
 )
 
 type serverTestCase struct {
@@ -116,6 +120,11 @@ func testServers() []serverTestCase {
 		{"beego", httpHandler{beegoapi.NewRouter(beegoapi.NewService())}},
 		{"chi", httpHandler{chiapi.NewRouter(chiapi.NewService())}},
 		{"std-http", httpHandler{stdhttpapi.NewRouter(stdhttpapi.NewService())}},
+		{"std-http-register", httpHandler{func() http.Handler {
+			mux := http.NewServeMux()
+			stdhttpapi.RegisterRoutes(mux, stdhttpapi.NewService())
+			return mux
+		}()}},
 		{"echo", httpHandler{func() http.Handler {
 			e := echo.New()
 			echoapi.NewRouter(e, echoapi.NewService())
@@ -133,12 +142,27 @@ func testServers() []serverTestCase {
 			return app
 		}()}},
 		{"go-zero", httpHandler{gozeroapi.NewRouter(gozeroapi.NewService())}},
+		{"go-zero-register", httpHandler{func() http.Handler {
+			// The routes registered with the server, bound to a go-zero router like rest.Server does on start.
+			server := rest.MustNewServer(rest.RestConf{Host: "localhost", Port: 8080})
+			gozeroapi.RegisterRoutes(server, gozeroapi.NewService())
+			r := router.NewRouter()
+			for _, route := range server.Routes() {
+				_ = r.Handle(route.Method, route.Path, route.Handler)
+			}
+			return r
+		}()}},
 		{"goframe", httpHandler{goframeapi.Handler(goframeapi.NewService())}},
 		{"gorilla-mux", httpHandler{gorillamuxapi.NewRouter(gorillamuxapi.NewService())}},
 		{"hertz", httpHandler{hertzapi.Handler(hertzapi.NewService())}},
 		{"iris", httpHandler{irisapi.Handler(irisapi.NewService())}},
 		{"kratos", httpHandler{kratosapi.NewRouter(kratosapi.NewService())}},
 		{"fasthttp", fasthttpHandler{fasthttpapi.Handler(fasthttpapi.NewService())}},
+		{"buffalo", httpHandler{func() http.Handler {
+			app := buffalo.New(buffalo.Options{})
+			buffaloapi.RegisterRoutes(app, buffaloapi.NewService())
+			return app
+		}()}},
 	}
 }
 
@@ -316,50 +340,62 @@ func TestListUsersResponseHeaders(t *testing.T) {
 }
 
 func TestSearch_UnionTypeResponse(t *testing.T) {
-	// Only chi implementation has full union type handling
-	h := chiapi.NewRouter(chiapi.NewService())
-
-	// Test returning a SearchItem
-	req := httptest.NewRequest("GET", "/search?q=test-query", nil)
-	rr := httptest.NewRecorder()
-	h.ServeHTTP(rr, req)
-
-	assert.Equal(t, http.StatusOK, rr.Code)
-	var result map[string]any
-	err := json.NewDecoder(rr.Body).Decode(&result)
-	require.NoError(t, err)
-	assert.Equal(t, "test-query", result["title"])
-
-	// Test returning a User (query starts with "user:")
-	req = httptest.NewRequest("GET", "/search?q=user:Alice", nil)
-	rr = httptest.NewRecorder()
-	h.ServeHTTP(rr, req)
+	tests := []struct {
+		name  string
+		query string
+		field string
+		want  string
+	}{
+		{name: "search item variant", query: "test-query", field: "title", want: "test-query"},
+		{name: "user variant", query: "user:Alice", field: "name", want: "Alice"},
+	}
 
-	assert.Equal(t, http.StatusOK, rr.Code)
-	err = json.NewDecoder(rr.Body).Decode(&result)
-	require.NoError(t, err)
-	assert.Equal(t, "Alice", result["name"])
+	for _, tc := range testServers() {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					req := httptest.NewRequest("GET", "/search?q="+url.QueryEscape(tt.query), nil)
+					resp, err := tc.handler.Do(req)
+					require.NoError(t, err)
+					defer func() { _ = resp.Body.Close() }()
+
+					assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+					var result map[string]any
+					err = json.NewDecoder(resp.Body).Decode(&result)
+					require.NoError(t, err)
+					assert.Equal(t, tt.want, result[tt.field])
+				})
+			}
+		})
+	}
 }
 
 func TestUploadAndGetAvatar(t *testing.T) {
-	// Only chi implementation has full avatar handling
-	h := chiapi.NewRouter(chiapi.NewService())
-
-	avatarData := []byte("fake-image-data")
-	req := httptest.NewRequest("PUT", "/users/1/avatar", bytes.NewReader(avatarData))
-	req.Header.Set("Content-Type", "application/octet-stream")
-	rr := httptest.NewRecorder()
-	h.ServeHTTP(rr, req)
+	for _, tc := range testServers() {
+		t.Run(tc.name, func(t *testing.T) {
+			avatarData := []byte("fake-image-data")
+			req := httptest.NewRequest("PUT", "/users/1/avatar", bytes.NewReader(avatarData))
+			req.Header.Set("Content-Type", "application/octet-stream")
+			resp, err := tc.handler.Do(req)
+			require.NoError(t, err)
+			_ = resp.Body.Close()
 
-	assert.Equal(t, http.StatusNoContent, rr.Code)
+			assert.Equal(t, http.StatusNoContent, resp.StatusCode)
 
-	// Get avatar
-	req = httptest.NewRequest("GET", "/users/1/avatar", nil)
-	rr = httptest.NewRecorder()
-	h.ServeHTTP(rr, req)
+			// Get avatar
+			req = httptest.NewRequest("GET", "/users/1/avatar", nil)
+			resp, err = tc.handler.Do(req)
+			require.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
 
-	assert.Equal(t, http.StatusOK, rr.Code)
-	assert.Equal(t, avatarData, rr.Body.Bytes())
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, "application/octet-stream", resp.Header.Get("Content-Type"))
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.Equal(t, avatarData, body)
+		})
+	}
 }
 
 func TestGetOAuthToken(t *testing.T) {
@@ -440,3 +476,178 @@ func TestGetItemsByStatus_TypeAndRatingPathParams(t *testing.T) {
 		})
 	}
 }
+
+func TestGetReport_DatePathParam(t *testing.T) {
+	for _, tc := range testServers() {
+		t.Run(tc.name+"/valid", func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/reports/2026-03-14", nil)
+			resp, err := tc.handler.Do(req)
+			require.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
+
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			var report map[string]any
+			body, _ := io.ReadAll(resp.Body)
+			require.NoError(t, json.Unmarshal(body, &report))
+			assert.Equal(t, "2026-03-14", report["date"])
+			assert.Equal(t, "Saturday", report["weekday"])
+		})
+
+		t.Run(tc.name+"/invalid", func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/reports/2026-13-40", nil)
+			resp, err := tc.handler.Do(req)
+			require.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
+
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		})
+	}
+}
+
+func TestGetReportEntry_DateAndUUIDPathParams(t *testing.T) {
+	for _, tc := range testServers() {
+		t.Run(tc.name+"/valid", func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/reports/2026-03-14/entries/9b2f5c1e-4d3a-4f6b-8a7c-1e2d3c4b5a69", nil)
+			resp, err := tc.handler.Do(req)
+			require.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
+
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			var entry map[string]any
+			body, _ := io.ReadAll(resp.Body)
+			require.NoError(t, json.Unmarshal(body, &entry))
+			assert.Equal(t, "2026-03-14", entry["date"])
+			assert.Equal(t, "9b2f5c1e-4d3a-4f6b-8a7c-1e2d3c4b5a69", entry["id"])
+		})
+
+		t.Run(tc.name+"/invalid uuid", func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/reports/2026-03-14/entries/not-a-uuid", nil)
+			resp, err := tc.handler.Do(req)
+			require.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
+
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		})
+	}
+}
+
+func TestListTags_ArrayHeaderParams(t *testing.T) {
+	for _, tc := range testServers() {
+		t.Run(tc.name+"/repeated", func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/tags", nil)
+			req.Header.Add("X-Tags", "red")
+			req.Header.Add("X-Tags", "blue")
+			req.Header.Add("X-Tag-Ids", "1")
+			req.Header.Add("X-Tag-Ids", "2")
+			resp, err := tc.handler.Do(req)
+			require.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
+
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			var tags []string
+			body, _ := io.ReadAll(resp.Body)
+			require.NoError(t, json.Unmarshal(body, &tags))
+			assert.Equal(t, []string{"tag-red", "tag-blue", "id-1", "id-2"}, tags)
+		})
+
+		t.Run(tc.name+"/comma-separated", func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/tags", nil)
+			req.Header.Set("X-Tags", "red,blue")
+			req.Header.Set("X-Tag-Ids", "1, 2")
+			resp, err := tc.handler.Do(req)
+			require.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
+
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			var tags []string
+			body, _ := io.ReadAll(resp.Body)
+			require.NoError(t, json.Unmarshal(body, &tags))
+			assert.Equal(t, []string{"tag-red", "tag-blue", "id-1", "id-2"}, tags)
+		})
+
+		t.Run(tc.name+"/invalid", func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/tags", nil)
+			req.Header.Set("X-Tag-Ids", "1,abc")
+			resp, err := tc.handler.Do(req)
+			require.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
+
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		})
+	}
+}
+
+func TestWithMiddleware_NetHTTPAdapters(t *testing.T) {
+	// orderMiddleware appends its name to the X-Middleware response header.
+	orderMiddleware := func(name string) func(http.Handler) http.Handler {
+		return func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Add("X-Middleware", name)
+				next.ServeHTTP(w, r)
+			})
+		}
+	}
+	first, second := orderMiddleware("first"), orderMiddleware("second")
+
+	handlers := []struct {
+		name    string
+		handler http.Handler
+	}{
+		{"chi", chiapi.NewRouter(chiapi.NewService(), chiapi.WithMiddleware(first), chiapi.WithMiddleware(second))},
+		{"std-http", stdhttpapi.NewRouter(stdhttpapi.NewService(), stdhttpapi.WithMiddleware(first), stdhttpapi.WithMiddleware(second))},
+		{"go-zero", gozeroapi.NewRouter(gozeroapi.NewService(), gozeroapi.WithMiddleware(first), gozeroapi.WithMiddleware(second))},
+		{"gorilla-mux", gorillamuxapi.NewRouter(gorillamuxapi.NewService(), gorillamuxapi.WithMiddleware(first), gorillamuxapi.WithMiddleware(second))},
+		{"kratos", kratosapi.NewRouter(kratosapi.NewService(), kratosapi.WithMiddleware(first), kratosapi.WithMiddleware(second))},
+	}
+
+	for _, tc := range handlers {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			tc.handler.ServeHTTP(rr, httptest.NewRequest("GET", "/health", nil))
+
+			assert.Equal(t, http.StatusOK, rr.Code)
+			assert.Equal(t, []string{"first", "second"}, rr.Header().Values("X-Middleware"))
+		})
+	}
+}
+
+func TestWithOperationMiddleware_NetHTTPAdapters(t *testing.T) {
+	// deny rejects the requests with a 401 before they reach the handler.
+	deny := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		})
+	}
+	// global appends to the X-Middleware response header.
+	global := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Add("X-Middleware", "global")
+			next.ServeHTTP(w, r)
+		})
+	}
+
+	handlers := []struct {
+		name    string
+		handler http.Handler
+	}{
+		{"chi", chiapi.NewRouter(chiapi.NewService(), chiapi.WithMiddleware(global), chiapi.WithOperationMiddleware("ListUsers", deny))},
+		{"std-http", stdhttpapi.NewRouter(stdhttpapi.NewService(), stdhttpapi.WithMiddleware(global), stdhttpapi.WithOperationMiddleware("ListUsers", deny))},
+		{"go-zero", gozeroapi.NewRouter(gozeroapi.NewService(), gozeroapi.WithMiddleware(global), gozeroapi.WithOperationMiddleware("ListUsers", deny))},
+		{"gorilla-mux", gorillamuxapi.NewRouter(gorillamuxapi.NewService(), gorillamuxapi.WithMiddleware(global), gorillamuxapi.WithOperationMiddleware("ListUsers", deny))},
+		{"kratos", kratosapi.NewRouter(kratosapi.NewService(), kratosapi.WithMiddleware(global), kratosapi.WithOperationMiddleware("ListUsers", deny))},
+	}
+
+	for _, tc := range handlers {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			tc.handler.ServeHTTP(rr, httptest.NewRequest("GET", "/users", nil))
+			assert.Equal(t, http.StatusUnauthorized, rr.Code)
+			assert.Equal(t, []string{"global"}, rr.Header().Values("X-Middleware"))
+
+			rr = httptest.NewRecorder()
+			tc.handler.ServeHTTP(rr, httptest.NewRequest("GET", "/health", nil))
+			assert.Equal(t, http.StatusOK, rr.Code)
+			assert.Equal(t, []string{"global"}, rr.Header().Values("X-Middleware"))
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package typesonly_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/examples/types-only/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPetRoundTrip(t *testing.T) {
+	data := []byte(`{"name":"Tom","kind":"cat","owner":{"firstName":"Jane"}}`)
+
+	var pet models.Pet
+	require.NoError(t, json.Unmarshal(data, &pet))
+	require.NoError(t, pet.Validate())
+
+	assert.Equal(t, "Tom", pet.Name)
+	assert.Equal(t, models.Cat, pet.Kind)
+	require.NotNil(t, pet.Owner)
+	require.NotNil(t, pet.Owner.Owner_OneOf)
+	assert.True(t, pet.Owner.Owner_OneOf.IsA())
+	assert.Equal(t, "Jane", *pet.Owner.Owner_OneOf.A.FirstName)
+
+	res, err := json.Marshal(pet)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), string(res))
+}
+
+func TestPetKindValidate(t *testing.T) {
+	assert.NoError(t, models.Dog.Validate())
+	assert.Error(t, models.PetKind("bird").Validate())
+}
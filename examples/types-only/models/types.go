@@ -0,0 +1,101 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+type Pet struct {
+	Name  string  `json:"name" validate:"required"`
+	Kind  PetKind `json:"kind" validate:"required"`
+	Owner *Owner  `json:"owner,omitempty"`
+}
+
+func (p Pet) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(p.Name, "required"); err != nil {
+		errors = errors.Append("Name", err)
+	}
+	if v, ok := any(p.Kind).(runtime.Validator); ok {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("Kind", err)
+		}
+	}
+	if p.Owner != nil {
+		if v, ok := any(p.Owner).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Owner", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type Owner struct {
+	Owner_OneOf *Owner_OneOf `json:"-"`
+}
+
+func (o Owner) Validate() error {
+	var errors runtime.ValidationErrors
+	if o.Owner_OneOf != nil {
+		if v, ok := any(o.Owner_OneOf).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+func (o Owner) MarshalJSON() ([]byte, error) {
+	var parts []json.RawMessage
+
+	{
+		b, err := runtime.MarshalJSON(o.Owner_OneOf)
+		if err != nil {
+			return nil, fmt.Errorf("Owner_OneOf marshal: %w", err)
+		}
+		parts = append(parts, b)
+	}
+
+	return runtime.CoalesceOrMerge(parts...)
+}
+
+func (o *Owner) UnmarshalJSON(data []byte) error {
+	trim := bytes.TrimSpace(data)
+	if bytes.Equal(trim, []byte("null")) {
+		return nil
+	}
+	if len(trim) == 0 {
+		return fmt.Errorf("empty JSON input")
+	}
+
+	if o.Owner_OneOf == nil {
+		o.Owner_OneOf = &Owner_OneOf{}
+	}
+
+	if err := runtime.UnmarshalJSON(data, o.Owner_OneOf); err != nil {
+		return fmt.Errorf("Owner_OneOf unmarshal: %w", err)
+	}
+
+	return nil
+}
+
+type Person struct {
+	FirstName *string `json:"firstName,omitempty"`
+}
+
+type Company struct {
+	LegalName *string `json:"legalName,omitempty"`
+}
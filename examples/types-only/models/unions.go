@@ -0,0 +1,45 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package models
+
+import (
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+type Owner_OneOf struct {
+	runtime.Either[Person, Company]
+}
+
+func (o *Owner_OneOf) Validate() error {
+	if o.IsA() {
+		if v, ok := any(o.A).(runtime.Validator); ok {
+			return v.Validate()
+		}
+	}
+	if o.IsB() {
+		if v, ok := any(o.B).(runtime.Validator); ok {
+			return v.Validate()
+		}
+	}
+	return nil
+}
+
+// AsPerson returns the Person inside the Owner_OneOf and whether it holds one
+func (o *Owner_OneOf) AsPerson() (Person, bool) {
+	return o.A, o.IsA()
+}
+
+// AsCompany returns the Company inside the Owner_OneOf and whether it holds one
+func (o *Owner_OneOf) AsCompany() (Company, bool) {
+	return o.B, o.IsB()
+}
+
+// Match calls the function matching the variant held by the Owner_OneOf, none for an empty union
+func (o *Owner_OneOf) Match(onA func(Person), onB func(Company)) {
+	switch {
+	case o.IsA():
+		onA(o.A)
+	case o.IsB():
+		onB(o.B)
+	}
+}
@@ -258,6 +258,26 @@ func (t *Target_AllOf1_AnyOf) Validate() error {
 	return nil
 }
 
+// AsEmailTarget returns the EmailTarget inside the Target_AllOf1_AnyOf and whether it holds one
+func (t *Target_AllOf1_AnyOf) AsEmailTarget() (EmailTarget, bool) {
+	return t.A, t.IsA()
+}
+
+// AsWebhookTarget returns the WebhookTarget inside the Target_AllOf1_AnyOf and whether it holds one
+func (t *Target_AllOf1_AnyOf) AsWebhookTarget() (WebhookTarget, bool) {
+	return t.B, t.IsB()
+}
+
+// Match calls the function matching the variant held by the Target_AllOf1_AnyOf, none for an empty union
+func (t *Target_AllOf1_AnyOf) Match(onA func(EmailTarget), onB func(WebhookTarget)) {
+	switch {
+	case t.IsA():
+		onA(t.A)
+	case t.IsB():
+		onB(t.B)
+	}
+}
+
 type TargetWithExtra_AnyOf struct {
 	runtime.Either[EmailTarget, WebhookTarget]
 }
@@ -275,3 +295,23 @@ func (t *TargetWithExtra_AnyOf) Validate() error {
 	}
 	return nil
 }
+
+// AsEmailTarget returns the EmailTarget inside the TargetWithExtra_AnyOf and whether it holds one
+func (t *TargetWithExtra_AnyOf) AsEmailTarget() (EmailTarget, bool) {
+	return t.A, t.IsA()
+}
+
+// AsWebhookTarget returns the WebhookTarget inside the TargetWithExtra_AnyOf and whether it holds one
+func (t *TargetWithExtra_AnyOf) AsWebhookTarget() (WebhookTarget, bool) {
+	return t.B, t.IsB()
+}
+
+// Match calls the function matching the variant held by the TargetWithExtra_AnyOf, none for an empty union
+func (t *TargetWithExtra_AnyOf) Match(onA func(EmailTarget), onB func(WebhookTarget)) {
+	switch {
+	case t.IsA():
+		onA(t.A)
+	case t.IsB():
+		onB(t.B)
+	}
+}
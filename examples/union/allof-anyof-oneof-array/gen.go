@@ -93,14 +93,14 @@ func (c CreateUserBody_Pages_Item) Validate() error {
 	if c.CreateUserBody_Pages_AnyOf != nil {
 		if v, ok := any(c.CreateUserBody_Pages_AnyOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("CreateUserBody_Pages_AnyOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
 	if c.CreateUserBody_Pages_OneOf != nil {
 		if v, ok := any(c.CreateUserBody_Pages_OneOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("CreateUserBody_Pages_OneOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -227,6 +227,26 @@ func (c *CreateUserBody_Pages_AnyOf) Validate() error {
 	return nil
 }
 
+// AsCreateUserBody_Pages_AnyOf_0 returns the CreateUserBody_Pages_AnyOf_0 inside the CreateUserBody_Pages_AnyOf and whether it holds one
+func (c *CreateUserBody_Pages_AnyOf) AsCreateUserBody_Pages_AnyOf_0() (CreateUserBody_Pages_AnyOf_0, bool) {
+	return c.A, c.IsA()
+}
+
+// AsCreateUserBody_Pages_AnyOf_1 returns the CreateUserBody_Pages_AnyOf_1 inside the CreateUserBody_Pages_AnyOf and whether it holds one
+func (c *CreateUserBody_Pages_AnyOf) AsCreateUserBody_Pages_AnyOf_1() (CreateUserBody_Pages_AnyOf_1, bool) {
+	return c.B, c.IsB()
+}
+
+// Match calls the function matching the variant held by the CreateUserBody_Pages_AnyOf, none for an empty union
+func (c *CreateUserBody_Pages_AnyOf) Match(onA func(CreateUserBody_Pages_AnyOf_0), onB func(CreateUserBody_Pages_AnyOf_1)) {
+	switch {
+	case c.IsA():
+		onA(c.A)
+	case c.IsB():
+		onB(c.B)
+	}
+}
+
 type CreateUserBody_Pages_OneOf struct {
 	runtime.Either[CreateUserBody_Pages_OneOf_0, CreateUserBody_Pages_OneOf_1]
 }
@@ -245,6 +265,26 @@ func (c *CreateUserBody_Pages_OneOf) Validate() error {
 	return nil
 }
 
+// AsCreateUserBody_Pages_OneOf_0 returns the CreateUserBody_Pages_OneOf_0 inside the CreateUserBody_Pages_OneOf and whether it holds one
+func (c *CreateUserBody_Pages_OneOf) AsCreateUserBody_Pages_OneOf_0() (CreateUserBody_Pages_OneOf_0, bool) {
+	return c.A, c.IsA()
+}
+
+// AsCreateUserBody_Pages_OneOf_1 returns the CreateUserBody_Pages_OneOf_1 inside the CreateUserBody_Pages_OneOf and whether it holds one
+func (c *CreateUserBody_Pages_OneOf) AsCreateUserBody_Pages_OneOf_1() (CreateUserBody_Pages_OneOf_1, bool) {
+	return c.B, c.IsB()
+}
+
+// Match calls the function matching the variant held by the CreateUserBody_Pages_OneOf, none for an empty union
+func (c *CreateUserBody_Pages_OneOf) Match(onA func(CreateUserBody_Pages_OneOf_0), onB func(CreateUserBody_Pages_OneOf_1)) {
+	switch {
+	case c.IsA():
+		onA(c.A)
+	case c.IsB():
+		onB(c.B)
+	}
+}
+
 var typesValidator *validator.Validate
 
 func init() {
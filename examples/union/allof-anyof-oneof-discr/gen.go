@@ -116,7 +116,7 @@ func (c ClientAndMaybeIdentity_Entity) Validate() error {
 	if c.ClientAndMaybeIdentity_Entity_AnyOf != nil {
 		if v, ok := any(c.ClientAndMaybeIdentity_Entity_AnyOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("ClientAndMaybeIdentity_Entity_AnyOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -169,7 +169,7 @@ func (c ClientOrID) Validate() error {
 	if c.ClientOrID_OneOf != nil {
 		if v, ok := any(c.ClientOrID_OneOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("ClientOrID_OneOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -222,7 +222,7 @@ func (c ClientOrIdentityWithDiscriminator) Validate() error {
 	if c.ClientOrIdentityWithDiscriminator_OneOf != nil {
 		if v, ok := any(c.ClientOrIdentityWithDiscriminator_OneOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("ClientOrIdentityWithDiscriminator_OneOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -319,7 +319,7 @@ func (p Pet) Validate() error {
 	if p.Pet_OneOf != nil {
 		if v, ok := any(p.Pet_OneOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("Pet_OneOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -381,6 +381,26 @@ func (c *ClientAndMaybeIdentity_Entity_AnyOf) Validate() error {
 	return nil
 }
 
+// AsClient returns the Client inside the ClientAndMaybeIdentity_Entity_AnyOf and whether it holds one
+func (c *ClientAndMaybeIdentity_Entity_AnyOf) AsClient() (Client, bool) {
+	return c.A, c.IsA()
+}
+
+// AsIdentity returns the Identity inside the ClientAndMaybeIdentity_Entity_AnyOf and whether it holds one
+func (c *ClientAndMaybeIdentity_Entity_AnyOf) AsIdentity() (Identity, bool) {
+	return c.B, c.IsB()
+}
+
+// Match calls the function matching the variant held by the ClientAndMaybeIdentity_Entity_AnyOf, none for an empty union
+func (c *ClientAndMaybeIdentity_Entity_AnyOf) Match(onA func(Client), onB func(Identity)) {
+	switch {
+	case c.IsA():
+		onA(c.A)
+	case c.IsB():
+		onB(c.B)
+	}
+}
+
 type ClientOrID_OneOf struct {
 	runtime.Either[Client, string]
 }
@@ -399,6 +419,26 @@ func (c *ClientOrID_OneOf) Validate() error {
 	return nil
 }
 
+// AsClient returns the Client inside the ClientOrID_OneOf and whether it holds one
+func (c *ClientOrID_OneOf) AsClient() (Client, bool) {
+	return c.A, c.IsA()
+}
+
+// AsString returns the string inside the ClientOrID_OneOf and whether it holds one
+func (c *ClientOrID_OneOf) AsString() (string, bool) {
+	return c.B, c.IsB()
+}
+
+// Match calls the function matching the variant held by the ClientOrID_OneOf, none for an empty union
+func (c *ClientOrID_OneOf) Match(onA func(Client), onB func(string)) {
+	switch {
+	case c.IsA():
+		onA(c.A)
+	case c.IsB():
+		onB(c.B)
+	}
+}
+
 type ClientOrIdentityWithDiscriminator_OneOf struct {
 	runtime.Either[Client, Identity]
 }
@@ -417,6 +457,26 @@ func (c *ClientOrIdentityWithDiscriminator_OneOf) Validate() error {
 	return nil
 }
 
+// AsClient returns the Client inside the ClientOrIdentityWithDiscriminator_OneOf and whether it holds one
+func (c *ClientOrIdentityWithDiscriminator_OneOf) AsClient() (Client, bool) {
+	return c.A, c.IsA()
+}
+
+// AsIdentity returns the Identity inside the ClientOrIdentityWithDiscriminator_OneOf and whether it holds one
+func (c *ClientOrIdentityWithDiscriminator_OneOf) AsIdentity() (Identity, bool) {
+	return c.B, c.IsB()
+}
+
+// Match calls the function matching the variant held by the ClientOrIdentityWithDiscriminator_OneOf, none for an empty union
+func (c *ClientOrIdentityWithDiscriminator_OneOf) Match(onA func(Client), onB func(Identity)) {
+	switch {
+	case c.IsA():
+		onA(c.A)
+	case c.IsB():
+		onB(c.B)
+	}
+}
+
 func (c ClientOrIdentityWithDiscriminator_OneOf) discriminator(data []byte) (string, error) {
 	var discriminator struct {
 		Value string `json:"type"`
@@ -492,6 +552,26 @@ func (p *Pet_OneOf) Validate() error {
 	return nil
 }
 
+// AsDog returns the Dog inside the Pet_OneOf and whether it holds one
+func (p *Pet_OneOf) AsDog() (Dog, bool) {
+	return p.A, p.IsA()
+}
+
+// AsCat returns the Cat inside the Pet_OneOf and whether it holds one
+func (p *Pet_OneOf) AsCat() (Cat, bool) {
+	return p.B, p.IsB()
+}
+
+// Match calls the function matching the variant held by the Pet_OneOf, none for an empty union
+func (p *Pet_OneOf) Match(onA func(Dog), onB func(Cat)) {
+	switch {
+	case p.IsA():
+		onA(p.A)
+	case p.IsB():
+		onB(p.B)
+	}
+}
+
 func (p Pet_OneOf) discriminator(data []byte) (string, error) {
 	var discriminator struct {
 		Value string `json:"type"`
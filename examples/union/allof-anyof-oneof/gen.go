@@ -76,14 +76,14 @@ func (o Order_Client) Validate() error {
 	if o.Order_Client_AnyOf != nil {
 		if v, ok := any(o.Order_Client_AnyOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("Order_Client_AnyOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
 	if o.Order_Client_OneOf != nil {
 		if v, ok := any(o.Order_Client_OneOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("Order_Client_OneOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -206,6 +206,26 @@ func (o *Order_Client_AnyOf) Validate() error {
 	return nil
 }
 
+// AsIdentity returns the Identity inside the Order_Client_AnyOf and whether it holds one
+func (o *Order_Client_AnyOf) AsIdentity() (Identity, bool) {
+	return o.A, o.IsA()
+}
+
+// AsVerification returns the Verification inside the Order_Client_AnyOf and whether it holds one
+func (o *Order_Client_AnyOf) AsVerification() (Verification, bool) {
+	return o.B, o.IsB()
+}
+
+// Match calls the function matching the variant held by the Order_Client_AnyOf, none for an empty union
+func (o *Order_Client_AnyOf) Match(onA func(Identity), onB func(Verification)) {
+	switch {
+	case o.IsA():
+		onA(o.A)
+	case o.IsB():
+		onB(o.B)
+	}
+}
+
 type Order_Client_OneOf struct {
 	runtime.Either[Address, Location]
 }
@@ -224,6 +244,26 @@ func (o *Order_Client_OneOf) Validate() error {
 	return nil
 }
 
+// AsAddress returns the Address inside the Order_Client_OneOf and whether it holds one
+func (o *Order_Client_OneOf) AsAddress() (Address, bool) {
+	return o.A, o.IsA()
+}
+
+// AsLocation returns the Location inside the Order_Client_OneOf and whether it holds one
+func (o *Order_Client_OneOf) AsLocation() (Location, bool) {
+	return o.B, o.IsB()
+}
+
+// Match calls the function matching the variant held by the Order_Client_OneOf, none for an empty union
+func (o *Order_Client_OneOf) Match(onA func(Address), onB func(Location)) {
+	switch {
+	case o.IsA():
+		onA(o.A)
+	case o.IsB():
+		onB(o.B)
+	}
+}
+
 var typesValidator *validator.Validate
 
 func init() {
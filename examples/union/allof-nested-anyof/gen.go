@@ -42,13 +42,13 @@ func (o Order_Product) Validate() error {
 	if o.Order_Product_AllOf0 != nil {
 		if v, ok := any(o.Order_Product_AllOf0).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("Order_Product_AllOf0", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
 	if v, ok := any(o.Base).(runtime.Validator); ok {
 		if err := v.Validate(); err != nil {
-			errors = errors.Append("Base", err)
+			errors = errors.Append("", err)
 		}
 	}
 	if len(errors) == 0 {
@@ -128,7 +128,7 @@ func (o Order_Product_AllOf0) Validate() error {
 	if o.Order_Product_AllOf0_AnyOf != nil {
 		if v, ok := any(o.Order_Product_AllOf0_AnyOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("Order_Product_AllOf0_AnyOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -190,6 +190,26 @@ func (o *Order_Product_AllOf0_AnyOf) Validate() error {
 	return nil
 }
 
+// AsVariantA returns the VariantA inside the Order_Product_AllOf0_AnyOf and whether it holds one
+func (o *Order_Product_AllOf0_AnyOf) AsVariantA() (VariantA, bool) {
+	return o.A, o.IsA()
+}
+
+// AsVariantB returns the VariantB inside the Order_Product_AllOf0_AnyOf and whether it holds one
+func (o *Order_Product_AllOf0_AnyOf) AsVariantB() (VariantB, bool) {
+	return o.B, o.IsB()
+}
+
+// Match calls the function matching the variant held by the Order_Product_AllOf0_AnyOf, none for an empty union
+func (o *Order_Product_AllOf0_AnyOf) Match(onA func(VariantA), onB func(VariantB)) {
+	switch {
+	case o.IsA():
+		onA(o.A)
+	case o.IsB():
+		onB(o.B)
+	}
+}
+
 var typesValidator *validator.Validate
 
 func init() {
@@ -185,7 +185,7 @@ func (c Collaboration_Item) Validate() error {
 	if c.Collaboration_Item_AllOf0 != nil {
 		if v, ok := any(c.Collaboration_Item_AllOf0).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("Collaboration_Item_AllOf0", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -238,7 +238,7 @@ func (c Collaboration_Item_AllOf0) Validate() error {
 	if c.Collaboration_Item_AllOf0_OneOf != nil {
 		if v, ok := any(c.Collaboration_Item_AllOf0_OneOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("Collaboration_Item_AllOf0_OneOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -281,7 +281,7 @@ func (s SpecificError_Issues_Item) Validate() error {
 	if s.SpecificError_Issues_AnyOf != nil {
 		if v, ok := any(s.SpecificError_Issues_AnyOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("SpecificError_Issues_AnyOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -375,7 +375,7 @@ func (c CombinedError_Issues_Item) Validate() error {
 	if c.CombinedError_Issues_AnyOf != nil {
 		if v, ok := any(c.CombinedError_Issues_AnyOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("CombinedError_Issues_AnyOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -59,7 +59,7 @@ func (r Rendering_Options) Validate() error {
 	if r.Rendering_Options_AnyOf != nil {
 		if v, ok := any(r.Rendering_Options_AnyOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("Rendering_Options_AnyOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -146,6 +146,26 @@ func (r *Rendering_Options_AnyOf) Validate() error {
 	return nil
 }
 
+// AsRendering_Options_AnyOf_0 returns the Rendering_Options_AnyOf_0 inside the Rendering_Options_AnyOf and whether it holds one
+func (r *Rendering_Options_AnyOf) AsRendering_Options_AnyOf_0() (Rendering_Options_AnyOf_0, bool) {
+	return r.A, r.IsA()
+}
+
+// AsString returns the string inside the Rendering_Options_AnyOf and whether it holds one
+func (r *Rendering_Options_AnyOf) AsString() (string, bool) {
+	return r.B, r.IsB()
+}
+
+// Match calls the function matching the variant held by the Rendering_Options_AnyOf, none for an empty union
+func (r *Rendering_Options_AnyOf) Match(onA func(Rendering_Options_AnyOf_0), onB func(string)) {
+	switch {
+	case r.IsA():
+		onA(r.A)
+	case r.IsB():
+		onB(r.B)
+	}
+}
+
 var typesValidator *validator.Validate
 
 func init() {
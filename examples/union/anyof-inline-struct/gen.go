@@ -76,7 +76,7 @@ func (g GetConfig_Response_Config) Validate() error {
 	if g.GetConfig_Response_Config_AnyOf != nil {
 		if v, ok := any(g.GetConfig_Response_Config_AnyOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("GetConfig_Response_Config_AnyOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -129,7 +129,7 @@ func (u UpdateConfigBody_Config) Validate() error {
 	if u.UpdateConfigBody_Config_AnyOf != nil {
 		if v, ok := any(u.UpdateConfigBody_Config_AnyOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("UpdateConfigBody_Config_AnyOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -64,7 +64,7 @@ func (t Test_Response_Items_Item) Validate() error {
 	if t.Test_Response_Items_AnyOf != nil {
 		if v, ok := any(t.Test_Response_Items_AnyOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("Test_Response_Items_AnyOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -137,7 +137,7 @@ func (t Test_ErrorResponse_Items_Item) Validate() error {
 	if t.Test_ErrorResponse_Items_AnyOf != nil {
 		if v, ok := any(t.Test_ErrorResponse_Items_AnyOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("Test_ErrorResponse_Items_AnyOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -210,7 +210,7 @@ func (t Test_ErrorResponse_422_Items_Item) Validate() error {
 	if t.Test_ErrorResponse_422_Items_AnyOf != nil {
 		if v, ok := any(t.Test_ErrorResponse_422_Items_AnyOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("Test_ErrorResponse_422_Items_AnyOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -272,6 +272,26 @@ func (t *Test_Response_Items_AnyOf) Validate() error {
 	return nil
 }
 
+// AsTypeA returns the TypeA inside the Test_Response_Items_AnyOf and whether it holds one
+func (t *Test_Response_Items_AnyOf) AsTypeA() (TypeA, bool) {
+	return t.A, t.IsA()
+}
+
+// AsTypeB returns the TypeB inside the Test_Response_Items_AnyOf and whether it holds one
+func (t *Test_Response_Items_AnyOf) AsTypeB() (TypeB, bool) {
+	return t.B, t.IsB()
+}
+
+// Match calls the function matching the variant held by the Test_Response_Items_AnyOf, none for an empty union
+func (t *Test_Response_Items_AnyOf) Match(onA func(TypeA), onB func(TypeB)) {
+	switch {
+	case t.IsA():
+		onA(t.A)
+	case t.IsB():
+		onB(t.B)
+	}
+}
+
 type Test_ErrorResponse_Items_AnyOf struct {
 	runtime.Either[TypeA, TypeB]
 }
@@ -290,6 +310,26 @@ func (t *Test_ErrorResponse_Items_AnyOf) Validate() error {
 	return nil
 }
 
+// AsTypeA returns the TypeA inside the Test_ErrorResponse_Items_AnyOf and whether it holds one
+func (t *Test_ErrorResponse_Items_AnyOf) AsTypeA() (TypeA, bool) {
+	return t.A, t.IsA()
+}
+
+// AsTypeB returns the TypeB inside the Test_ErrorResponse_Items_AnyOf and whether it holds one
+func (t *Test_ErrorResponse_Items_AnyOf) AsTypeB() (TypeB, bool) {
+	return t.B, t.IsB()
+}
+
+// Match calls the function matching the variant held by the Test_ErrorResponse_Items_AnyOf, none for an empty union
+func (t *Test_ErrorResponse_Items_AnyOf) Match(onA func(TypeA), onB func(TypeB)) {
+	switch {
+	case t.IsA():
+		onA(t.A)
+	case t.IsB():
+		onB(t.B)
+	}
+}
+
 type Test_ErrorResponse_422_Items_AnyOf struct {
 	runtime.Either[TypeA, TypeB]
 }
@@ -308,6 +348,26 @@ func (t *Test_ErrorResponse_422_Items_AnyOf) Validate() error {
 	return nil
 }
 
+// AsTypeA returns the TypeA inside the Test_ErrorResponse_422_Items_AnyOf and whether it holds one
+func (t *Test_ErrorResponse_422_Items_AnyOf) AsTypeA() (TypeA, bool) {
+	return t.A, t.IsA()
+}
+
+// AsTypeB returns the TypeB inside the Test_ErrorResponse_422_Items_AnyOf and whether it holds one
+func (t *Test_ErrorResponse_422_Items_AnyOf) AsTypeB() (TypeB, bool) {
+	return t.B, t.IsB()
+}
+
+// Match calls the function matching the variant held by the Test_ErrorResponse_422_Items_AnyOf, none for an empty union
+func (t *Test_ErrorResponse_422_Items_AnyOf) Match(onA func(TypeA), onB func(TypeB)) {
+	switch {
+	case t.IsA():
+		onA(t.A)
+	case t.IsB():
+		onB(t.B)
+	}
+}
+
 var typesValidator *validator.Validate
 
 func init() {
@@ -20,7 +20,7 @@ func (c ClientWithExtra) Validate() error {
 	if c.ClientWithExtra_AnyOf != nil {
 		if v, ok := any(c.ClientWithExtra_AnyOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("ClientWithExtra_AnyOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -122,6 +122,26 @@ func (c *ClientWithExtra_AnyOf) Validate() error {
 	return nil
 }
 
+// AsString returns the string inside the ClientWithExtra_AnyOf and whether it holds one
+func (c *ClientWithExtra_AnyOf) AsString() (string, bool) {
+	return c.A, c.IsA()
+}
+
+// AsBool returns the bool inside the ClientWithExtra_AnyOf and whether it holds one
+func (c *ClientWithExtra_AnyOf) AsBool() (bool, bool) {
+	return c.B, c.IsB()
+}
+
+// Match calls the function matching the variant held by the ClientWithExtra_AnyOf, none for an empty union
+func (c *ClientWithExtra_AnyOf) Match(onA func(string), onB func(bool)) {
+	switch {
+	case c.IsA():
+		onA(c.A)
+	case c.IsB():
+		onB(c.B)
+	}
+}
+
 var typesValidator *validator.Validate
 
 func init() {
@@ -0,0 +1,434 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package union
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+type Personal struct {
+	AccountType string `json:"account_type" validate:"required"`
+	Name        string `json:"name" validate:"required"`
+}
+
+func (p Personal) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(p))
+}
+
+type Business struct {
+	AccountType string `json:"account_type" validate:"required"`
+	CompanyID   string `json:"company_id" validate:"required"`
+}
+
+func (b Business) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(b))
+}
+
+type Joint struct {
+	AccountType string   `json:"account_type" validate:"required"`
+	Holders     []string `json:"holders" validate:"required"`
+}
+
+func (j Joint) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(j))
+}
+
+type Account struct {
+	AccountType   *string        `json:"account_type,omitempty"`
+	Account_OneOf *Account_OneOf `json:"-"`
+}
+
+func (a Account) Validate() error {
+	var errors runtime.ValidationErrors
+	if a.Account_OneOf != nil {
+		if v, ok := any(a.Account_OneOf).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+func (a Account) MarshalJSON() ([]byte, error) {
+	var parts []json.RawMessage
+
+	type _Alias_Account Account
+	baseJSON, err := json.Marshal((_Alias_Account)(a))
+	if err != nil {
+		return nil, err
+	}
+	parts = append(parts, baseJSON)
+
+	{
+		b, err := runtime.MarshalJSON(a.Account_OneOf)
+		if err != nil {
+			return nil, fmt.Errorf("Account_OneOf marshal: %w", err)
+		}
+		parts = append(parts, b)
+	}
+
+	return runtime.CoalesceOrMerge(parts...)
+}
+
+func (a *Account) UnmarshalJSON(data []byte) error {
+	trim := bytes.TrimSpace(data)
+	if bytes.Equal(trim, []byte("null")) {
+		return nil
+	}
+	if len(trim) == 0 {
+		return fmt.Errorf("empty JSON input")
+	}
+
+	if len(trim) > 0 {
+		type _Alias_Account Account
+		var tmp _Alias_Account
+		if err := json.Unmarshal(data, &tmp); err != nil {
+			return err
+		}
+		*a = Account(tmp)
+	}
+
+	if a.Account_OneOf == nil {
+		a.Account_OneOf = &Account_OneOf{}
+	}
+
+	if err := runtime.UnmarshalJSON(data, a.Account_OneOf); err != nil {
+		return fmt.Errorf("Account_OneOf unmarshal: %w", err)
+	}
+
+	return nil
+}
+
+type AnyAccount struct {
+	AnyAccount_OneOf *AnyAccount_OneOf `json:"-"`
+}
+
+func (a AnyAccount) Validate() error {
+	var errors runtime.ValidationErrors
+	if a.AnyAccount_OneOf != nil {
+		if v, ok := any(a.AnyAccount_OneOf).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+func (a AnyAccount) MarshalJSON() ([]byte, error) {
+	var parts []json.RawMessage
+
+	{
+		b, err := runtime.MarshalJSON(a.AnyAccount_OneOf)
+		if err != nil {
+			return nil, fmt.Errorf("AnyAccount_OneOf marshal: %w", err)
+		}
+		parts = append(parts, b)
+	}
+
+	return runtime.CoalesceOrMerge(parts...)
+}
+
+func (a *AnyAccount) UnmarshalJSON(data []byte) error {
+	trim := bytes.TrimSpace(data)
+	if bytes.Equal(trim, []byte("null")) {
+		return nil
+	}
+	if len(trim) == 0 {
+		return fmt.Errorf("empty JSON input")
+	}
+
+	if a.AnyAccount_OneOf == nil {
+		a.AnyAccount_OneOf = &AnyAccount_OneOf{}
+	}
+
+	if err := runtime.UnmarshalJSON(data, a.AnyAccount_OneOf); err != nil {
+		return fmt.Errorf("AnyAccount_OneOf unmarshal: %w", err)
+	}
+
+	return nil
+}
+
+type Account_OneOf struct {
+	runtime.Either[Personal, Business]
+}
+
+func (a *Account_OneOf) Validate() error {
+	if a.IsA() {
+		if v, ok := any(a.A).(runtime.Validator); ok {
+			return v.Validate()
+		}
+	}
+	if a.IsB() {
+		if v, ok := any(a.B).(runtime.Validator); ok {
+			return v.Validate()
+		}
+	}
+	return nil
+}
+
+// AsPersonal returns the Personal inside the Account_OneOf and whether it holds one
+func (a *Account_OneOf) AsPersonal() (Personal, bool) {
+	return a.A, a.IsA()
+}
+
+// AsBusiness returns the Business inside the Account_OneOf and whether it holds one
+func (a *Account_OneOf) AsBusiness() (Business, bool) {
+	return a.B, a.IsB()
+}
+
+// Match calls the function matching the variant held by the Account_OneOf, none for an empty union
+func (a *Account_OneOf) Match(onA func(Personal), onB func(Business)) {
+	switch {
+	case a.IsA():
+		onA(a.A)
+	case a.IsB():
+		onB(a.B)
+	}
+}
+
+func (a Account_OneOf) discriminator(data []byte) (string, error) {
+	var discriminator struct {
+		Value string `json:"account_type"`
+	}
+	if err := json.Unmarshal(data, &discriminator); err != nil {
+		return "", err
+	}
+	return discriminator.Value, nil
+}
+
+func (a *Account_OneOf) MarshalJSON() ([]byte, error) {
+	data := a.Value()
+	if data == nil {
+		return []byte("null"), nil
+	}
+
+	obj, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	disc, err := a.discriminator(obj)
+	if err != nil {
+		return nil, err
+	}
+	return runtime.MarshalEitherWithDiscriminator(obj, "account_type", disc)
+}
+
+func (a *Account_OneOf) UnmarshalJSON(data []byte) error {
+	discriminator, err := a.discriminator(data)
+	if err != nil {
+		return err
+	}
+
+	switch discriminator {
+	case "Business":
+		var res Business
+		if err = json.Unmarshal(data, &res); err != nil {
+			return err
+		}
+
+		a.B = res
+		a.N = 2
+	case "PERSONAL":
+		var res Personal
+		if err = json.Unmarshal(data, &res); err != nil {
+			return err
+		}
+
+		a.A = res
+		a.N = 1
+	default:
+		return errors.New("unknown discriminator value: " + discriminator)
+	}
+	return nil
+}
+
+type AnyAccount_OneOf struct {
+	union json.RawMessage
+}
+
+func (a *AnyAccount_OneOf) Validate() error {
+	// NOTE: Validation is not supported for unions with more than 2 elements.
+	// Validating would require unmarshaling against each possible type, which is inefficient.
+	// Use AsValidated<Type>() methods to validate after retrieving the specific type.
+	return nil
+}
+
+// Raw returns the union data inside the AnyAccount_OneOf as bytes
+func (a *AnyAccount_OneOf) Raw() json.RawMessage {
+	return a.union
+}
+
+// AsPersonal returns the union data inside the AnyAccount_OneOf as a Personal
+func (a *AnyAccount_OneOf) AsPersonal() (Personal, error) {
+	return runtime.UnmarshalAs[Personal](a.union)
+}
+
+// AsValidatedPersonal returns the union data inside the AnyAccount_OneOf as a validated Personal
+func (a *AnyAccount_OneOf) AsValidatedPersonal() (Personal, error) {
+	val, err := a.AsPersonal()
+	if err != nil {
+		var zero Personal
+		return zero, err
+	}
+	if err := a.validatePersonal(val); err != nil {
+		var zero Personal
+		return zero, err
+	}
+	return val, nil
+}
+
+// FromPersonal overwrites any union data inside the AnyAccount_OneOf as the provided Personal
+func (a *AnyAccount_OneOf) FromPersonal(val Personal) error {
+	// Validate before storing
+	if err := a.validatePersonal(val); err != nil {
+		return err
+	}
+	bts, err := json.Marshal(val)
+	a.union = bts
+	return err
+}
+
+// AsBusiness returns the union data inside the AnyAccount_OneOf as a Business
+func (a *AnyAccount_OneOf) AsBusiness() (Business, error) {
+	return runtime.UnmarshalAs[Business](a.union)
+}
+
+// AsValidatedBusiness returns the union data inside the AnyAccount_OneOf as a validated Business
+func (a *AnyAccount_OneOf) AsValidatedBusiness() (Business, error) {
+	val, err := a.AsBusiness()
+	if err != nil {
+		var zero Business
+		return zero, err
+	}
+	if err := a.validateBusiness(val); err != nil {
+		var zero Business
+		return zero, err
+	}
+	return val, nil
+}
+
+// FromBusiness overwrites any union data inside the AnyAccount_OneOf as the provided Business
+func (a *AnyAccount_OneOf) FromBusiness(val Business) error {
+	// Validate before storing
+	if err := a.validateBusiness(val); err != nil {
+		return err
+	}
+	bts, err := json.Marshal(val)
+	a.union = bts
+	return err
+}
+
+// AsJoint returns the union data inside the AnyAccount_OneOf as a Joint
+func (a *AnyAccount_OneOf) AsJoint() (Joint, error) {
+	return runtime.UnmarshalAs[Joint](a.union)
+}
+
+// AsValidatedJoint returns the union data inside the AnyAccount_OneOf as a validated Joint
+func (a *AnyAccount_OneOf) AsValidatedJoint() (Joint, error) {
+	val, err := a.AsJoint()
+	if err != nil {
+		var zero Joint
+		return zero, err
+	}
+	if err := a.validateJoint(val); err != nil {
+		var zero Joint
+		return zero, err
+	}
+	return val, nil
+}
+
+// FromJoint overwrites any union data inside the AnyAccount_OneOf as the provided Joint
+func (a *AnyAccount_OneOf) FromJoint(val Joint) error {
+	// Validate before storing
+	if err := a.validateJoint(val); err != nil {
+		return err
+	}
+	bts, err := json.Marshal(val)
+	a.union = bts
+	return err
+}
+
+// validatePersonal validates a Personal value
+func (a *AnyAccount_OneOf) validatePersonal(val Personal) error {
+	if v, ok := any(val).(runtime.Validator); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+// validateBusiness validates a Business value
+func (a *AnyAccount_OneOf) validateBusiness(val Business) error {
+	if v, ok := any(val).(runtime.Validator); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+// validateJoint validates a Joint value
+func (a *AnyAccount_OneOf) validateJoint(val Joint) error {
+	if v, ok := any(val).(runtime.Validator); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+func (a AnyAccount_OneOf) discriminator(data []byte) (string, error) {
+	var discriminator struct {
+		Value string `json:"account_type"`
+	}
+	if err := json.Unmarshal(data, &discriminator); err != nil {
+		return "", err
+	}
+	return discriminator.Value, nil
+}
+
+func (a AnyAccount_OneOf) ValueByDiscriminator() (any, error) {
+	discriminator, err := a.discriminator(a.union)
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "business":
+		return a.AsBusiness()
+	case "joint":
+		return a.AsJoint()
+	case "personal":
+		return a.AsPersonal()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (a AnyAccount_OneOf) MarshalJSON() ([]byte, error) {
+	bts, err := a.union.MarshalJSON()
+
+	return bts, err
+}
+
+func (a *AnyAccount_OneOf) UnmarshalJSON(bts []byte) error {
+	err := a.union.UnmarshalJSON(bts)
+
+	return err
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
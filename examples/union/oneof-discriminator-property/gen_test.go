@@ -0,0 +1,60 @@
+package union
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The discriminator property is account_type, while the Go field is AccountType:
+// dispatch must read the raw JSON key and match the raw mapping keys.
+
+func TestAccount_UnmarshalByDiscriminator(t *testing.T) {
+	var account Account
+	err := json.Unmarshal([]byte(`{"account_type": "PERSONAL", "name": "Ann"}`), &account)
+	require.NoError(t, err)
+
+	require.NotNil(t, account.AccountType)
+	assert.Equal(t, "PERSONAL", *account.AccountType)
+	require.True(t, account.Account_OneOf.IsA())
+	assert.Equal(t, Personal{AccountType: "PERSONAL", Name: "Ann"}, account.Account_OneOf.A)
+
+	err = json.Unmarshal([]byte(`{"account_type": "Business", "company_id": "c1"}`), &account)
+	require.NoError(t, err)
+	require.True(t, account.Account_OneOf.IsB())
+	assert.Equal(t, Business{AccountType: "Business", CompanyID: "c1"}, account.Account_OneOf.B)
+}
+
+func TestAccount_MappingKeysAreCaseSensitive(t *testing.T) {
+	var account Account
+	err := json.Unmarshal([]byte(`{"account_type": "personal", "name": "Ann"}`), &account)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown discriminator value: personal")
+}
+
+func TestAccount_RoundTrip(t *testing.T) {
+	var account Account
+	require.NoError(t, json.Unmarshal([]byte(`{"account_type": "Business", "company_id": "c1"}`), &account))
+
+	data, err := json.Marshal(account)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"account_type": "Business", "company_id": "c1"}`, string(data))
+}
+
+func TestAnyAccount_ValueByDiscriminator(t *testing.T) {
+	var account AnyAccount
+	err := json.Unmarshal([]byte(`{"account_type": "joint", "holders": ["a", "b"]}`), &account)
+	require.NoError(t, err)
+
+	value, err := account.AnyAccount_OneOf.ValueByDiscriminator()
+	require.NoError(t, err)
+	assert.Equal(t, Joint{AccountType: "joint", Holders: []string{"a", "b"}}, value)
+
+	var business AnyAccount_OneOf
+	require.NoError(t, business.FromBusiness(Business{AccountType: "business", CompanyID: "c1"}))
+	value, err = business.ValueByDiscriminator()
+	require.NoError(t, err)
+	assert.Equal(t, Business{AccountType: "business", CompanyID: "c1"}, value)
+}
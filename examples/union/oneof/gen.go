@@ -55,7 +55,7 @@ func (o Order_Product) Validate() error {
 	if o.Order_Product_OneOf != nil {
 		if v, ok := any(o.Order_Product_OneOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("Order_Product_OneOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -108,7 +108,7 @@ func (o Order_Product_OneOf_3_Description) Validate() error {
 	if o.Order_Product_OneOf_3_Description_OneOf != nil {
 		if v, ok := any(o.Order_Product_OneOf_3_Description_OneOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("Order_Product_OneOf_3_Description_OneOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -161,7 +161,7 @@ func (o Order_Description) Validate() error {
 	if o.Order_Description_OneOf != nil {
 		if v, ok := any(o.Order_Description_OneOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("Order_Description_OneOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -234,7 +234,7 @@ func (o Order_Images_Item) Validate() error {
 	if o.Order_Images_OneOf != nil {
 		if v, ok := any(o.Order_Images_OneOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("Order_Images_OneOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -505,6 +505,26 @@ func (o *Order_Product_OneOf_3_Description_OneOf) Validate() error {
 	return nil
 }
 
+// AsVersionC returns the VersionC inside the Order_Product_OneOf_3_Description_OneOf and whether it holds one
+func (o *Order_Product_OneOf_3_Description_OneOf) AsVersionC() (VersionC, bool) {
+	return o.A, o.IsA()
+}
+
+// AsVersionD returns the VersionD inside the Order_Product_OneOf_3_Description_OneOf and whether it holds one
+func (o *Order_Product_OneOf_3_Description_OneOf) AsVersionD() (VersionD, bool) {
+	return o.B, o.IsB()
+}
+
+// Match calls the function matching the variant held by the Order_Product_OneOf_3_Description_OneOf, none for an empty union
+func (o *Order_Product_OneOf_3_Description_OneOf) Match(onA func(VersionC), onB func(VersionD)) {
+	switch {
+	case o.IsA():
+		onA(o.A)
+	case o.IsB():
+		onB(o.B)
+	}
+}
+
 type Order_Description_OneOf struct {
 	runtime.Either[bool, string]
 }
@@ -523,6 +543,26 @@ func (o *Order_Description_OneOf) Validate() error {
 	return nil
 }
 
+// AsBool returns the bool inside the Order_Description_OneOf and whether it holds one
+func (o *Order_Description_OneOf) AsBool() (bool, bool) {
+	return o.A, o.IsA()
+}
+
+// AsString returns the string inside the Order_Description_OneOf and whether it holds one
+func (o *Order_Description_OneOf) AsString() (string, bool) {
+	return o.B, o.IsB()
+}
+
+// Match calls the function matching the variant held by the Order_Description_OneOf, none for an empty union
+func (o *Order_Description_OneOf) Match(onA func(bool), onB func(string)) {
+	switch {
+	case o.IsA():
+		onA(o.A)
+	case o.IsB():
+		onB(o.B)
+	}
+}
+
 type Order_Images_OneOf struct {
 	runtime.Either[string, VersionE]
 }
@@ -541,6 +581,26 @@ func (o *Order_Images_OneOf) Validate() error {
 	return nil
 }
 
+// AsString returns the string inside the Order_Images_OneOf and whether it holds one
+func (o *Order_Images_OneOf) AsString() (string, bool) {
+	return o.A, o.IsA()
+}
+
+// AsVersionE returns the VersionE inside the Order_Images_OneOf and whether it holds one
+func (o *Order_Images_OneOf) AsVersionE() (VersionE, bool) {
+	return o.B, o.IsB()
+}
+
+// Match calls the function matching the variant held by the Order_Images_OneOf, none for an empty union
+func (o *Order_Images_OneOf) Match(onA func(string), onB func(VersionE)) {
+	switch {
+	case o.IsA():
+		onA(o.A)
+	case o.IsB():
+		onB(o.B)
+	}
+}
+
 var typesValidator *validator.Validate
 
 func init() {
@@ -66,6 +66,26 @@ func (m *Measurement_Value) Validate() error {
 	return nil
 }
 
+// AsString returns the string inside the Measurement_Value and whether it holds one
+func (m *Measurement_Value) AsString() (string, bool) {
+	return m.A, m.IsA()
+}
+
+// AsFloat32 returns the float32 inside the Measurement_Value and whether it holds one
+func (m *Measurement_Value) AsFloat32() (float32, bool) {
+	return m.B, m.IsB()
+}
+
+// Match calls the function matching the variant held by the Measurement_Value, none for an empty union
+func (m *Measurement_Value) Match(onA func(string), onB func(float32)) {
+	switch {
+	case m.IsA():
+		onA(m.A)
+	case m.IsB():
+		onB(m.B)
+	}
+}
+
 type Measurement_Value_AdditionalProperties struct {
 	runtime.Either[string, float32]
 }
@@ -84,6 +104,26 @@ func (m *Measurement_Value_AdditionalProperties) Validate() error {
 	return nil
 }
 
+// AsString returns the string inside the Measurement_Value_AdditionalProperties and whether it holds one
+func (m *Measurement_Value_AdditionalProperties) AsString() (string, bool) {
+	return m.A, m.IsA()
+}
+
+// AsFloat32 returns the float32 inside the Measurement_Value_AdditionalProperties and whether it holds one
+func (m *Measurement_Value_AdditionalProperties) AsFloat32() (float32, bool) {
+	return m.B, m.IsB()
+}
+
+// Match calls the function matching the variant held by the Measurement_Value_AdditionalProperties, none for an empty union
+func (m *Measurement_Value_AdditionalProperties) Match(onA func(string), onB func(float32)) {
+	switch {
+	case m.IsA():
+		onA(m.A)
+	case m.IsB():
+		onB(m.B)
+	}
+}
+
 type Measurement_Count struct {
 	union json.RawMessage
 }
@@ -386,6 +426,26 @@ func (m *Measurement_Flag) Validate() error {
 	return nil
 }
 
+// AsBool returns the bool inside the Measurement_Flag and whether it holds one
+func (m *Measurement_Flag) AsBool() (bool, bool) {
+	return m.A, m.IsA()
+}
+
+// AsString returns the string inside the Measurement_Flag and whether it holds one
+func (m *Measurement_Flag) AsString() (string, bool) {
+	return m.B, m.IsB()
+}
+
+// Match calls the function matching the variant held by the Measurement_Flag, none for an empty union
+func (m *Measurement_Flag) Match(onA func(bool), onB func(string)) {
+	switch {
+	case m.IsA():
+		onA(m.A)
+	case m.IsB():
+		onB(m.B)
+	}
+}
+
 type Measurement_Flag_AdditionalProperties struct {
 	runtime.Either[bool, string]
 }
@@ -404,6 +464,26 @@ func (m *Measurement_Flag_AdditionalProperties) Validate() error {
 	return nil
 }
 
+// AsBool returns the bool inside the Measurement_Flag_AdditionalProperties and whether it holds one
+func (m *Measurement_Flag_AdditionalProperties) AsBool() (bool, bool) {
+	return m.A, m.IsA()
+}
+
+// AsString returns the string inside the Measurement_Flag_AdditionalProperties and whether it holds one
+func (m *Measurement_Flag_AdditionalProperties) AsString() (string, bool) {
+	return m.B, m.IsB()
+}
+
+// Match calls the function matching the variant held by the Measurement_Flag_AdditionalProperties, none for an empty union
+func (m *Measurement_Flag_AdditionalProperties) Match(onA func(bool), onB func(string)) {
+	switch {
+	case m.IsA():
+		onA(m.A)
+	case m.IsB():
+		onB(m.B)
+	}
+}
+
 // FlexibleID An ID that can be either a string or an integer
 type FlexibleID struct {
 	runtime.Either[string, int]
@@ -423,6 +503,26 @@ func (f *FlexibleID) Validate() error {
 	return nil
 }
 
+// AsString returns the string inside the FlexibleID and whether it holds one
+func (f *FlexibleID) AsString() (string, bool) {
+	return f.A, f.IsA()
+}
+
+// AsInt returns the int inside the FlexibleID and whether it holds one
+func (f *FlexibleID) AsInt() (int, bool) {
+	return f.B, f.IsB()
+}
+
+// Match calls the function matching the variant held by the FlexibleID, none for an empty union
+func (f *FlexibleID) Match(onA func(string), onB func(int)) {
+	switch {
+	case f.IsA():
+		onA(f.A)
+	case f.IsB():
+		onB(f.B)
+	}
+}
+
 var typesValidator *validator.Validate
 
 func init() {
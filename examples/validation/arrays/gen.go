@@ -43,7 +43,7 @@ func (u Users_Item) Validate() error {
 	if u.Users_OneOf != nil {
 		if v, ok := any(u.Users_OneOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("Users_OneOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -115,7 +115,7 @@ func (n Nested_Entity) Validate() error {
 	if n.Nested_Entity_OneOf != nil {
 		if v, ok := any(n.Nested_Entity_OneOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("Nested_Entity_OneOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -168,7 +168,7 @@ func (n Nested_Entity_OneOf_1_Name) Validate() error {
 	if n.Nested_Entity_OneOf_1_Name_OneOf != nil {
 		if v, ok := any(n.Nested_Entity_OneOf_1_Name_OneOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("Nested_Entity_OneOf_1_Name_OneOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -254,6 +254,26 @@ func (u *Users_OneOf) Validate() error {
 	return nil
 }
 
+// AsUser returns the User inside the Users_OneOf and whether it holds one
+func (u *Users_OneOf) AsUser() (User, bool) {
+	return u.A, u.IsA()
+}
+
+// AsString returns the string inside the Users_OneOf and whether it holds one
+func (u *Users_OneOf) AsString() (string, bool) {
+	return u.B, u.IsB()
+}
+
+// Match calls the function matching the variant held by the Users_OneOf, none for an empty union
+func (u *Users_OneOf) Match(onA func(User), onB func(string)) {
+	switch {
+	case u.IsA():
+		onA(u.A)
+	case u.IsB():
+		onB(u.B)
+	}
+}
+
 type Nested_Entity_OneOf struct {
 	runtime.Either[User, Nested_Entity_OneOf_1]
 }
@@ -272,6 +292,26 @@ func (n *Nested_Entity_OneOf) Validate() error {
 	return nil
 }
 
+// AsUser returns the User inside the Nested_Entity_OneOf and whether it holds one
+func (n *Nested_Entity_OneOf) AsUser() (User, bool) {
+	return n.A, n.IsA()
+}
+
+// AsNested_Entity_OneOf_1 returns the Nested_Entity_OneOf_1 inside the Nested_Entity_OneOf and whether it holds one
+func (n *Nested_Entity_OneOf) AsNested_Entity_OneOf_1() (Nested_Entity_OneOf_1, bool) {
+	return n.B, n.IsB()
+}
+
+// Match calls the function matching the variant held by the Nested_Entity_OneOf, none for an empty union
+func (n *Nested_Entity_OneOf) Match(onA func(User), onB func(Nested_Entity_OneOf_1)) {
+	switch {
+	case n.IsA():
+		onA(n.A)
+	case n.IsB():
+		onB(n.B)
+	}
+}
+
 type Nested_Entity_OneOf_1_Name_OneOf struct {
 	union json.RawMessage
 }
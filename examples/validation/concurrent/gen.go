@@ -0,0 +1,101 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package concurrent
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/yorunikakeru4/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+type Product struct {
+	Sku   string   `json:"sku" validate:"required,min=8"`
+	Name  string   `json:"name" validate:"required,max=50,min=1"`
+	Price float32  `json:"price" validate:"required,gte=0"`
+	Tags  []string `json:"tags,omitempty"`
+}
+
+func (p Product) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(p.Sku, "required,min=8"); err != nil {
+		errors = errors.Append("Sku", err)
+	}
+	if err := typesValidator.Var(p.Name, "required,max=50,min=1"); err != nil {
+		errors = errors.Append("Name", err)
+	}
+	if err := typesValidator.Var(p.Price, "required,gte=0"); err != nil {
+		errors = errors.Append("Price", err)
+	}
+	for i, err := range runtime.ValidateConcurrently(len(p.Tags), func(idx int) error {
+		return typesValidator.Var(p.Tags[idx], "omitempty,min=2")
+	}) {
+		if err != nil {
+			errors = errors.Append(fmt.Sprintf("Tags[%d]", i), err)
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type Products []Product
+
+func (p Products) Validate() error {
+	if p == nil {
+		return nil
+	}
+	var errors runtime.ValidationErrors
+	for i, err := range runtime.ValidateConcurrently(len(p), func(idx int) error {
+		if v, ok := any(p[idx]).(runtime.Validator); ok {
+			return v.Validate()
+		}
+		return nil
+	}) {
+		if err != nil {
+			errors = errors.Append(fmt.Sprintf("[%d]", i), err)
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type Catalog struct {
+	Products []Product `json:"products" validate:"required"`
+	Codes    []string  `json:"codes,omitempty"`
+}
+
+func (c Catalog) Validate() error {
+	var errors runtime.ValidationErrors
+	for i, err := range runtime.ValidateConcurrently(len(c.Products), func(idx int) error {
+		if v, ok := any(c.Products[idx]).(runtime.Validator); ok {
+			return v.Validate()
+		}
+		return nil
+	}) {
+		if err != nil {
+			errors = errors.Append(fmt.Sprintf("Products[%d]", i), err)
+		}
+	}
+	for i, err := range runtime.ValidateConcurrently(len(c.Codes), func(idx int) error {
+		return typesValidator.Var(c.Codes[idx], "omitempty,min=2")
+	}) {
+		if err != nil {
+			errors = errors.Append(fmt.Sprintf("Codes[%d]", i), err)
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
@@ -0,0 +1,90 @@
+package concurrent
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/examples/validation/concurrent/sequential"
+)
+
+func largeCatalog(n int) Catalog {
+	catalog := Catalog{Products: make([]Product, n), Codes: make([]string, n)}
+	for i := range n {
+		catalog.Products[i] = Product{
+			Sku:   fmt.Sprintf("SKU-%06d", i),
+			Name:  "product",
+			Price: 9.99,
+			Tags:  []string{"new", "sale"},
+		}
+		catalog.Codes[i] = "code"
+	}
+	return catalog
+}
+
+// toSequential converts the catalog to the type generated without the concurrent validation.
+func toSequential(t testing.TB, catalog Catalog) sequential.Catalog {
+	data, err := json.Marshal(catalog)
+	require.NoError(t, err)
+
+	var res sequential.Catalog
+	require.NoError(t, json.Unmarshal(data, &res))
+	return res
+}
+
+func TestValidate_SameErrorsAsSequential(t *testing.T) {
+	t.Run("valid catalog", func(t *testing.T) {
+		catalog := largeCatalog(1000)
+		require.NoError(t, catalog.Validate())
+		require.NoError(t, toSequential(t, catalog).Validate())
+	})
+
+	t.Run("invalid items", func(t *testing.T) {
+		catalog := largeCatalog(1000)
+		catalog.Products[3].Name = ""
+		catalog.Products[500].Sku = "short"
+		catalog.Products[500].Tags[1] = "x"
+		catalog.Products[999].Price = -1
+		catalog.Codes[42] = "c"
+
+		err := catalog.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Products[500]")
+		assert.Equal(t, toSequential(t, catalog).Validate().Error(), err.Error())
+	})
+
+	t.Run("small slices", func(t *testing.T) {
+		products := Products(largeCatalog(3).Products)
+		products[1].Name = ""
+
+		var seq sequential.Products
+		data, err := json.Marshal(products)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(data, &seq))
+
+		require.Error(t, products.Validate())
+		assert.Equal(t, seq.Validate().Error(), products.Validate().Error())
+	})
+}
+
+func BenchmarkValidate(b *testing.B) {
+	catalog := largeCatalog(100_000)
+	seq := toSequential(b, catalog)
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			_ = seq.Validate()
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			_ = catalog.Validate()
+		}
+	})
+}
@@ -0,0 +1,91 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package sequential
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/yorunikakeru4/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+type Product struct {
+	Sku   string   `json:"sku" validate:"required,min=8"`
+	Name  string   `json:"name" validate:"required,max=50,min=1"`
+	Price float32  `json:"price" validate:"required,gte=0"`
+	Tags  []string `json:"tags,omitempty"`
+}
+
+func (p Product) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(p.Sku, "required,min=8"); err != nil {
+		errors = errors.Append("Sku", err)
+	}
+	if err := typesValidator.Var(p.Name, "required,max=50,min=1"); err != nil {
+		errors = errors.Append("Name", err)
+	}
+	if err := typesValidator.Var(p.Price, "required,gte=0"); err != nil {
+		errors = errors.Append("Price", err)
+	}
+	for i, item := range p.Tags {
+		if err := typesValidator.Var(item, "omitempty,min=2"); err != nil {
+			errors = errors.Append(fmt.Sprintf("Tags[%d]", i), err)
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type Products []Product
+
+func (p Products) Validate() error {
+	if p == nil {
+		return nil
+	}
+	var errors runtime.ValidationErrors
+	for i, item := range p {
+		if v, ok := any(item).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append(fmt.Sprintf("[%d]", i), err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type Catalog struct {
+	Products []Product `json:"products" validate:"required"`
+	Codes    []string  `json:"codes,omitempty"`
+}
+
+func (c Catalog) Validate() error {
+	var errors runtime.ValidationErrors
+	for i, item := range c.Products {
+		if v, ok := any(item).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append(fmt.Sprintf("Products[%d]", i), err)
+			}
+		}
+	}
+	for i, item := range c.Codes {
+		if err := typesValidator.Var(item, "omitempty,min=2"); err != nil {
+			errors = errors.Append(fmt.Sprintf("Codes[%d]", i), err)
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
@@ -0,0 +1,3 @@
+package sequential
+
+//go:generate go run ../../../../cmd/oapi-codegen --config=cfg.yaml ../api.yaml
@@ -0,0 +1,233 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package cancellation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/yorunikakeru4/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+type Item struct {
+	Name string `json:"name" validate:"required,min=1"`
+}
+
+func (i Item) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(i))
+}
+
+func (i Item) ValidateContext(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return i.Validate()
+}
+
+type Items []Item
+
+func (i Items) Validate() error {
+	if i == nil {
+		return nil
+	}
+	var errors runtime.ValidationErrors
+	for i, item := range i {
+		if v, ok := any(item).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append(fmt.Sprintf("[%d]", i), err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+func (i Items) ValidateContext(ctx context.Context) error {
+	if i == nil {
+		return nil
+	}
+	var errors runtime.ValidationErrors
+	for i, item := range i {
+		if err := runtime.CheckValidationContext(ctx, i); err != nil {
+			return err
+		}
+		if err := runtime.ValidateContext(ctx, item); err != nil {
+			errors = errors.Append(fmt.Sprintf("[%d]", i), err)
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type Codes []string
+
+func (c Codes) Validate() error {
+	if c == nil {
+		return nil
+	}
+	var errors runtime.ValidationErrors
+	for i, item := range c {
+		if err := typesValidator.Var(item, "omitempty,min=2"); err != nil {
+			errors = errors.Append(fmt.Sprintf("[%d]", i), err)
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+func (c Codes) ValidateContext(ctx context.Context) error {
+	if c == nil {
+		return nil
+	}
+	var errors runtime.ValidationErrors
+	for i, item := range c {
+		if err := runtime.CheckValidationContext(ctx, i); err != nil {
+			return err
+		}
+		if err := typesValidator.Var(item, "omitempty,min=2"); err != nil {
+			errors = errors.Append(fmt.Sprintf("[%d]", i), err)
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type ItemsByName map[string]Item
+
+func (i ItemsByName) Validate() error {
+	var errors runtime.ValidationErrors
+	for k, v := range i {
+		if validator, ok := any(v).(runtime.Validator); ok {
+			if err := validator.Validate(); err != nil {
+				errors = errors.Append(k, err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+func (i ItemsByName) ValidateContext(ctx context.Context) error {
+	var errors runtime.ValidationErrors
+	n := 0
+	for k, v := range i {
+		if err := runtime.CheckValidationContext(ctx, n); err != nil {
+			return err
+		}
+		n++
+		if err := runtime.ValidateContext(ctx, v); err != nil {
+			errors = errors.Append(k, err)
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type Catalog struct {
+	Items    []Item          `json:"items,omitempty"`
+	ByName   map[string]Item `json:"byName,omitempty"`
+	Featured *Item           `json:"featured,omitempty"`
+	Title    *string         `json:"title,omitempty" validate:"omitempty,max=20"`
+}
+
+func (c Catalog) Validate() error {
+	var errors runtime.ValidationErrors
+	for i, item := range c.Items {
+		if v, ok := any(item).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append(fmt.Sprintf("Items[%d]", i), err)
+			}
+		}
+	}
+	for k, v := range c.ByName {
+		if validator, ok := any(v).(runtime.Validator); ok {
+			if err := validator.Validate(); err != nil {
+				errors = errors.Append(fmt.Sprintf("ByName[%s]", k), err)
+			}
+		}
+	}
+	if c.Featured != nil {
+		if v, ok := any(c.Featured).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Featured", err)
+			}
+		}
+	}
+	if c.Title != nil {
+		if err := typesValidator.Var(c.Title, "omitempty,max=20"); err != nil {
+			errors = errors.Append("Title", err)
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+func (c Catalog) ValidateContext(ctx context.Context) error {
+	var errors runtime.ValidationErrors
+	for i, item := range c.Items {
+		if err := runtime.CheckValidationContext(ctx, i); err != nil {
+			return err
+		}
+		if err := runtime.ValidateContext(ctx, item); err != nil {
+			errors = errors.Append(fmt.Sprintf("Items[%d]", i), err)
+		}
+	}
+	nByName := 0
+	for k, v := range c.ByName {
+		if err := runtime.CheckValidationContext(ctx, nByName); err != nil {
+			return err
+		}
+		nByName++
+		if err := runtime.ValidateContext(ctx, v); err != nil {
+			errors = errors.Append(fmt.Sprintf("ByName[%s]", k), err)
+		}
+	}
+	if c.Featured != nil {
+		if err := runtime.ValidateContext(ctx, c.Featured); err != nil {
+			errors = errors.Append("Featured", err)
+		}
+	}
+	if c.Title != nil {
+		if err := typesValidator.Var(c.Title, "omitempty,max=20"); err != nil {
+			errors = errors.Append("Title", err)
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
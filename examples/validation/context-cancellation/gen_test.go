@@ -0,0 +1,70 @@
+package cancellation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func hugeItems(n int) Items {
+	items := make(Items, n)
+	for i := range items {
+		items[i] = Item{Name: "item"}
+	}
+	return items
+}
+
+func TestValidateContext_HugeSlice(t *testing.T) {
+	items := hugeItems(1_000_000)
+
+	t.Run("cancelled context aborts validation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := items.ValidateContext(ctx)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("valid items", func(t *testing.T) {
+		require.NoError(t, items.ValidateContext(context.Background()))
+	})
+
+	t.Run("invalid item", func(t *testing.T) {
+		invalid := hugeItems(1000)
+		invalid[500].Name = ""
+
+		err := invalid.ValidateContext(context.Background())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "[500]")
+		assert.Equal(t, err.Error(), invalid.Validate().Error())
+	})
+}
+
+func TestValidateContext_Map(t *testing.T) {
+	byName := ItemsByName{"a": {Name: "a"}, "b": {}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, byName.ValidateContext(ctx), context.Canceled)
+
+	err := byName.ValidateContext(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, byName.Validate().Error(), err.Error())
+}
+
+func TestValidateContext_Properties(t *testing.T) {
+	catalog := Catalog{
+		Items:    hugeItems(10_000),
+		Featured: &Item{},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, catalog.ValidateContext(ctx), context.Canceled)
+
+	err := catalog.ValidateContext(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Featured")
+}
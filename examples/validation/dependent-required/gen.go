@@ -0,0 +1,92 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package dependentrequired
+
+import (
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/go-playground/validator/v10"
+)
+
+type Payment struct {
+	Amount         int     `json:"amount" validate:"required"`
+	CardNumber     *string `json:"card_number,omitempty"`
+	Expiry         *string `json:"expiry,omitempty"`
+	Cvv            *string `json:"cvv,omitempty"`
+	BillingAddress *string `json:"billing_address,omitempty"`
+}
+
+func (p Payment) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(p.Amount, "required"); err != nil {
+		errors = errors.Append("Amount", err)
+	}
+	if p.CardNumber != nil && p.Expiry == nil {
+		errors = errors.Add("Expiry", "is required when CardNumber is present")
+	}
+	if p.CardNumber != nil && p.Cvv == nil {
+		errors = errors.Add("Cvv", "is required when CardNumber is present")
+	}
+	if p.Cvv != nil && p.CardNumber == nil {
+		errors = errors.Add("CardNumber", "is required when Cvv is present")
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type Refund struct {
+	Amount         int     `json:"amount" validate:"required"`
+	CardNumber     *string `json:"card_number,omitempty"`
+	Expiry         *string `json:"expiry,omitempty"`
+	Cvv            *string `json:"cvv,omitempty"`
+	BillingAddress *string `json:"billing_address,omitempty"`
+	Reason         *string `json:"reason,omitempty"`
+	ReasonDetails  *string `json:"reason_details,omitempty"`
+}
+
+func (r Refund) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(r.Amount, "required"); err != nil {
+		errors = errors.Append("Amount", err)
+	}
+	if r.CardNumber != nil && r.Expiry == nil {
+		errors = errors.Add("Expiry", "is required when CardNumber is present")
+	}
+	if r.CardNumber != nil && r.Cvv == nil {
+		errors = errors.Add("Cvv", "is required when CardNumber is present")
+	}
+	if r.Cvv != nil && r.CardNumber == nil {
+		errors = errors.Add("CardNumber", "is required when Cvv is present")
+	}
+	if r.ReasonDetails != nil && r.Reason == nil {
+		errors = errors.Add("Reason", "is required when ReasonDetails is present")
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type Subscription struct {
+	Trial     bool `json:"trial"`
+	TrialDays *int `json:"trial_days,omitempty"`
+}
+
+func (s Subscription) Validate() error {
+	var errors runtime.ValidationErrors
+	if !runtime.IsZero(s.Trial) && s.TrialDays == nil {
+		errors = errors.Add("TrialDays", "is required when Trial is present")
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
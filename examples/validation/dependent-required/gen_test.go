@@ -0,0 +1,59 @@
+package dependentrequired
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/doordash-oss/oapi-codegen-dd/v3/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentDependentRequired(t *testing.T) {
+	require.NoError(t, Payment{Amount: 10}.Validate())
+	require.NoError(t, Payment{
+		Amount:     10,
+		CardNumber: runtime.Ptr("4242424242424242"),
+		Expiry:     runtime.Ptr("12/30"),
+		Cvv:        runtime.Ptr("123"),
+	}.Validate())
+
+	err := Payment{Amount: 10, CardNumber: runtime.Ptr("4242424242424242")}.Validate()
+	require.Error(t, err)
+	var errs runtime.ValidationErrors
+	require.ErrorAs(t, err, &errs)
+	require.Len(t, errs, 2)
+	assert.Equal(t, "Expiry", errs[0].Field)
+	assert.Equal(t, "is required when CardNumber is present", errs[0].Message)
+	assert.Equal(t, "Cvv", errs[1].Field)
+
+	err = Payment{Amount: 10, Cvv: runtime.Ptr("123")}.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "CardNumber is required when Cvv is present")
+}
+
+func TestRefundDependentRequired(t *testing.T) {
+	require.NoError(t, Refund{Amount: 10, Reason: runtime.Ptr("duplicate")}.Validate())
+
+	err := Refund{Amount: 10, ReasonDetails: runtime.Ptr("charged twice")}.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Reason is required when ReasonDetails is present")
+
+	// constraints of the allOf elements are merged
+	err = Refund{Amount: 10, CardNumber: runtime.Ptr("4242424242424242")}.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Expiry is required when CardNumber is present")
+}
+
+func TestSubscriptionDependentRequired(t *testing.T) {
+	require.NoError(t, Subscription{Trial: true, TrialDays: runtime.Ptr(14)}.Validate())
+
+	// a property generated without a pointer is present even with its zero value
+	var sub Subscription
+	require.NoError(t, json.Unmarshal([]byte(`{"trial": false}`), &sub))
+	err := sub.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "TrialDays is required when Trial is present")
+
+	require.NoError(t, Subscription{Trial: false, TrialDays: runtime.Ptr(0)}.Validate())
+}
@@ -42,7 +42,7 @@ func (p PointRequestOneOf) Validate() error {
 	if p.PointRequestOneOf_OneOf != nil {
 		if v, ok := any(p.PointRequestOneOf_OneOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("PointRequestOneOf_OneOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -137,7 +137,7 @@ func (t TimeIntervalType) Validate() error {
 	if t.TimeIntervalType_OneOf != nil {
 		if v, ok := any(t.TimeIntervalType_OneOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("TimeIntervalType_OneOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -216,6 +216,26 @@ func (p *PointRequestOneOf_OneOf) Validate() error {
 	return nil
 }
 
+// AsTimeBasedLocation returns the TimeBasedLocation inside the PointRequestOneOf_OneOf and whether it holds one
+func (p *PointRequestOneOf_OneOf) AsTimeBasedLocation() (TimeBasedLocation, bool) {
+	return p.A, p.IsA()
+}
+
+// AsDistanceBasedLocation returns the DistanceBasedLocation inside the PointRequestOneOf_OneOf and whether it holds one
+func (p *PointRequestOneOf_OneOf) AsDistanceBasedLocation() (DistanceBasedLocation, bool) {
+	return p.B, p.IsB()
+}
+
+// Match calls the function matching the variant held by the PointRequestOneOf_OneOf, none for an empty union
+func (p *PointRequestOneOf_OneOf) Match(onA func(TimeBasedLocation), onB func(DistanceBasedLocation)) {
+	switch {
+	case p.IsA():
+		onA(p.A)
+	case p.IsB():
+		onB(p.B)
+	}
+}
+
 type TimeIntervalType_OneOf struct {
 	runtime.Either[AbsoluteTimeRange, RelativeTimeDuration]
 }
@@ -234,6 +254,26 @@ func (t *TimeIntervalType_OneOf) Validate() error {
 	return nil
 }
 
+// AsAbsoluteTimeRange returns the AbsoluteTimeRange inside the TimeIntervalType_OneOf and whether it holds one
+func (t *TimeIntervalType_OneOf) AsAbsoluteTimeRange() (AbsoluteTimeRange, bool) {
+	return t.A, t.IsA()
+}
+
+// AsRelativeTimeDuration returns the RelativeTimeDuration inside the TimeIntervalType_OneOf and whether it holds one
+func (t *TimeIntervalType_OneOf) AsRelativeTimeDuration() (RelativeTimeDuration, bool) {
+	return t.B, t.IsB()
+}
+
+// Match calls the function matching the variant held by the TimeIntervalType_OneOf, none for an empty union
+func (t *TimeIntervalType_OneOf) Match(onA func(AbsoluteTimeRange), onB func(RelativeTimeDuration)) {
+	switch {
+	case t.IsA():
+		onA(t.A)
+	case t.IsB():
+		onB(t.B)
+	}
+}
+
 var typesValidator *validator.Validate
 
 func init() {
@@ -46,7 +46,7 @@ func (r Response_User) Validate() error {
 	if r.Response_User_OneOf != nil {
 		if v, ok := any(r.Response_User_OneOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("Response_User_OneOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -99,7 +99,7 @@ func (r Response_Friend) Validate() error {
 	if r.Response_Friend_AnyOf != nil {
 		if v, ok := any(r.Response_Friend_AnyOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("Response_Friend_AnyOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -174,7 +174,7 @@ func (p Payload_User) Validate() error {
 	if p.Payload_User_OneOf != nil {
 		if v, ok := any(p.Payload_User_OneOf).(runtime.Validator); ok {
 			if err := v.Validate(); err != nil {
-				errors = errors.Append("Payload_User_OneOf", err)
+				errors = errors.Append("", err)
 			}
 		}
 	}
@@ -241,6 +241,26 @@ func (r *Response_User_OneOf) Validate() error {
 	return nil
 }
 
+// AsUser returns the User inside the Response_User_OneOf and whether it holds one
+func (r *Response_User_OneOf) AsUser() (User, bool) {
+	return r.A, r.IsA()
+}
+
+// AsString returns the string inside the Response_User_OneOf and whether it holds one
+func (r *Response_User_OneOf) AsString() (string, bool) {
+	return r.B, r.IsB()
+}
+
+// Match calls the function matching the variant held by the Response_User_OneOf, none for an empty union
+func (r *Response_User_OneOf) Match(onA func(User), onB func(string)) {
+	switch {
+	case r.IsA():
+		onA(r.A)
+	case r.IsB():
+		onB(r.B)
+	}
+}
+
 type Response_Friend_AnyOf struct {
 	union json.RawMessage
 }
@@ -395,6 +415,26 @@ func (p *Payload_User_OneOf) Validate() error {
 	return nil
 }
 
+// AsUser returns the User inside the Payload_User_OneOf and whether it holds one
+func (p *Payload_User_OneOf) AsUser() (User, bool) {
+	return p.A, p.IsA()
+}
+
+// AsString returns the string inside the Payload_User_OneOf and whether it holds one
+func (p *Payload_User_OneOf) AsString() (string, bool) {
+	return p.B, p.IsB()
+}
+
+// Match calls the function matching the variant held by the Payload_User_OneOf, none for an empty union
+func (p *Payload_User_OneOf) Match(onA func(User), onB func(string)) {
+	switch {
+	case p.IsA():
+		onA(p.A)
+	case p.IsB():
+		onB(p.B)
+	}
+}
+
 var typesValidator *validator.Validate
 
 func init() {
@@ -0,0 +1,60 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package uniqueitems
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/yorunikakeru4/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+type Tags []string
+
+func (t Tags) Validate() error {
+	if t == nil {
+		return nil
+	}
+	if first, second, ok := runtime.DuplicateItem(t); ok {
+		return runtime.NewValidationError("Array", fmt.Sprintf("must have unique items, [%d] duplicates [%d]", second, first))
+	}
+	return nil
+}
+
+type Points []Point
+
+func (p Points) Validate() error {
+	if p == nil {
+		return nil
+	}
+	var errors runtime.ValidationErrors
+	if len(p) > 10 {
+		errors = errors.Add("Array", fmt.Sprintf("must have at most 10 items, got %d", len(p)))
+	}
+	if first, second, ok := runtime.DuplicateItemJSON(p); ok {
+		errors = errors.Add("Array", fmt.Sprintf("must have unique items, [%d] duplicates [%d]", second, first))
+	}
+	for i, item := range p {
+		if v, ok := any(item).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append(fmt.Sprintf("[%d]", i), err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type Point struct {
+	X *int `json:"x,omitempty"`
+	Y *int `json:"y,omitempty"`
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
@@ -0,0 +1,28 @@
+package uniqueitems
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagsUniqueItems(t *testing.T) {
+	require.NoError(t, Tags{"a", "b", "c"}.Validate())
+	require.NoError(t, Tags(nil).Validate())
+
+	err := Tags{"a", "b", "a"}.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "must have unique items, [2] duplicates [0]")
+}
+
+func TestPointsUniqueItems(t *testing.T) {
+	one, two := 1, 2
+	require.NoError(t, Points{{X: &one, Y: &two}, {X: &two, Y: &one}}.Validate())
+
+	// Equal points behind different pointers are duplicates.
+	otherOne, otherTwo := 1, 2
+	err := Points{{X: &one, Y: &two}, {X: &two}, {X: &otherOne, Y: &otherTwo}}.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "must have unique items, [2] duplicates [0]")
+}
@@ -0,0 +1,3 @@
+package uniqueitems
+
+//go:generate go run ../../../cmd/oapi-codegen --config=cfg.yaml api.yaml
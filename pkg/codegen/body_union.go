@@ -0,0 +1,176 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// BodyUnion describes a request body made of a single oneOf or anyOf union.
+// The client gets a <OperationID>With<Variant> method per variant, sending the variant as the body.
+type BodyUnion struct {
+	// TypeName is the union type, FieldName its field in the request body type.
+	TypeName       string
+	FieldName      string
+	FieldIsPointer bool
+
+	// Either is true for unions of 2 types, which embed runtime.Either.
+	Either   bool
+	Variants []BodyUnionVariant
+}
+
+// BodyUnionVariant is a variant of a BodyUnion.
+type BodyUnionVariant struct {
+	UnionElement
+
+	// Name is the client method suffix, the variant type name without the suffix
+	// shared by all the variants, e.g. CreditCard for CreditCardPayment and BankPayment.
+	Name string
+
+	// EitherSide is A or B, the side of runtime.Either holding the variant.
+	EitherSide string
+}
+
+// createBodyUnion returns the BodyUnion of a request body type, or nil if the body
+// isn't made of a single union of named types.
+func createBodyUnion(td TypeDefinition, options ParseOptions) *BodyUnion {
+	schema := td.Schema
+	// Follow the aliases to a component schema, e.g. CreateRefundBody = RefundMethod.
+	for i := 0; i < 8 && schema.DefineViaAlias; i++ {
+		aliased, found := options.typeTracker.LookupByName(schema.TypeDecl())
+		if !found {
+			return nil
+		}
+		schema = aliased.Schema
+	}
+
+	if len(schema.Properties) != 1 || len(schema.UnionElements) > 0 || schema.HasAdditionalProperties {
+		return nil
+	}
+	field := schema.Properties[0]
+	if field.JsonFieldName != "" {
+		return nil
+	}
+
+	union, found := options.typeTracker.LookupByName(field.Schema.TypeDecl())
+	if !found || len(union.Schema.UnionElements) < 2 || union.Schema.HasAdditionalProperties {
+		return nil
+	}
+
+	elements := union.Schema.UnionElements
+	methods := make([]string, 0, len(elements))
+	for _, element := range elements {
+		method := element.Method()
+		if !isValidGoIdentity(method) || slices.Contains(methods, method) {
+			return nil
+		}
+		methods = append(methods, method)
+	}
+
+	res := &BodyUnion{
+		TypeName:       union.Name,
+		FieldName:      field.GoName,
+		FieldIsPointer: field.IsPointerType(),
+		Either:         len(elements) == 2,
+	}
+	names := unionVariantNames(methods)
+	for i, element := range elements {
+		variant := BodyUnionVariant{UnionElement: element, Name: names[i]}
+		if res.Either {
+			variant.EitherSide = []string{"A", "B"}[i]
+		}
+		res.Variants = append(res.Variants, variant)
+	}
+	return res
+}
+
+// unionVariantNames returns the names of the variants, without the trailing words shared by all of them,
+// as long as the names stay unique, e.g. CreditCard and Bank for CreditCardPayment and BankPayment.
+func unionVariantNames(methods []string) []string {
+	words := make([][]string, len(methods))
+	for i, method := range methods {
+		words[i] = splitCamelCaseWords(method)
+	}
+
+	common := 0
+	for {
+		var word string
+		shared := true
+		for _, w := range words {
+			if len(w) <= common+1 {
+				shared = false
+				break
+			}
+			last := w[len(w)-1-common]
+			if word == "" {
+				word = last
+			}
+			if last != word {
+				shared = false
+				break
+			}
+		}
+		if !shared {
+			break
+		}
+		common++
+	}
+
+	names := make([]string, len(methods))
+	for i, w := range words {
+		names[i] = strings.Join(w[:len(w)-common], "")
+		if !isValidGoIdentity(names[i]) || slices.Contains(names[:i], names[i]) {
+			return methods
+		}
+	}
+	return names
+}
+
+// splitCamelCaseWords splits a CamelCase name into words, keeping acronyms together,
+// e.g. SEPA and Transfer for SEPATransfer.
+func splitCamelCaseWords(name string) []string {
+	var (
+		words []string
+		start int
+	)
+	runes := []rune(name)
+	for i := 1; i < len(runes); i++ {
+		if !unicode.IsUpper(runes[i]) {
+			continue
+		}
+		acronymEnd := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+		if !unicode.IsUpper(runes[i-1]) || acronymEnd {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+	return append(words, string(runes[start:]))
+}
+
+// resolveBodyUnionCollisions drops the union variants whose client method would have
+// the name of another operation, e.g. createPaymentWithBank next to createPayment.
+func resolveBodyUnionCollisions(operations []OperationDefinition) {
+	ids := make(map[string]bool, len(operations))
+	for _, op := range operations {
+		ids[op.ID] = true
+	}
+
+	for _, op := range operations {
+		if op.Body == nil || op.Body.Union == nil {
+			continue
+		}
+		op.Body.Union.Variants = slices.DeleteFunc(op.Body.Union.Variants, func(v BodyUnionVariant) bool {
+			return ids[op.ID+"With"+v.Name]
+		})
+	}
+}
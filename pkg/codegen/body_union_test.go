@@ -0,0 +1,57 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnionVariantNames(t *testing.T) {
+	tests := []struct {
+		name    string
+		methods []string
+		want    []string
+	}{
+		{
+			name:    "shared suffix",
+			methods: []string{"CreditCardPayment", "BankPayment", "WalletPayment"},
+			want:    []string{"CreditCard", "Bank", "Wallet"},
+		},
+		{
+			name:    "shared suffix of several words",
+			methods: []string{"CardPaymentMethod", "BankPaymentMethod"},
+			want:    []string{"Card", "Bank"},
+		},
+		{
+			name:    "no shared suffix",
+			methods: []string{"Cat", "Dog"},
+			want:    []string{"Cat", "Dog"},
+		},
+		{
+			name:    "suffix is a whole name",
+			methods: []string{"Payment", "CardPayment"},
+			want:    []string{"Payment", "CardPayment"},
+		},
+		{
+			name:    "acronyms",
+			methods: []string{"SEPATransfer", "ACHTransfer"},
+			want:    []string{"SEPA", "ACH"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, unionVariantNames(tt.methods))
+		})
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+)
+
+// CallbackDefinition describes a single operation of a callback declared by an operation,
+// or of a webhook. It is used to generate the receiver handling the inbound deliveries.
+type CallbackDefinition struct {
+	// ID is the Go name of the callback operation, e.g. SubscribeOnEvent.
+	ID string
+
+	// Name is the callback or webhook name, as declared in the spec.
+	Name string
+
+	// OperationID is the ID of the operation declaring the callback, empty for webhooks.
+	OperationID string
+
+	// Expression is the runtime expression of the callback URL, e.g. {$request.body#/callbackUrl}.
+	// It is empty for webhooks.
+	Expression string
+
+	Method      string
+	Summary     string
+	Description string
+
+	// Body is the JSON request body of the callback, nil if the callback has no body.
+	Body *RequestBodyDefinition
+
+	// SuccessStatusCode is the status code the receiver responds with on success.
+	SuccessStatusCode int
+}
+
+// callbacksCollection holds callbacks or webhooks and the types they declare.
+type callbacksCollection struct {
+	callbacks     []CallbackDefinition
+	importSchemas []GoSchema
+	typeDefs      []TypeDefinition
+}
+
+// collect adds the callbacks declared by the operation.
+func (c *callbacksCollection) collect(operationID string, callbacks *orderedmap.Map[string, *v3high.Callback], options ParseOptions) error {
+	if callbacks == nil {
+		return nil
+	}
+
+	for name, callback := range callbacks.FromOldest() {
+		if callback == nil || callback.Expression == nil {
+			continue
+		}
+
+		baseID := operationID + typeNamePrefix(name) + nameNormalizer(name)
+		for expression, pathItem := range callback.Expression.FromOldest() {
+			if err := c.collectPathItem(baseID, name, operationID, expression, pathItem, callback.Expression.Len() > 1, options); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// collectWebhooks adds the webhooks of the top-level webhooks section.
+func (c *callbacksCollection) collectWebhooks(webhooks *orderedmap.Map[string, *v3high.PathItem], options ParseOptions) error {
+	if webhooks == nil {
+		return nil
+	}
+
+	for name, pathItem := range webhooks.FromOldest() {
+		if err := c.collectPathItem(typeNamePrefix(name)+nameNormalizer(name), name, "", "", pathItem, false, options); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// collectPathItem adds the operations of a callback or webhook path item.
+// The method is appended to baseID for operations without an operationId when it is
+// not unique, which is the case with several methods or when multiple is set.
+// Operations with a request body that is not JSON are skipped.
+func (c *callbacksCollection) collectPathItem(baseID, name, operationID, expression string, pathItem *v3high.PathItem, multiple bool, options ParseOptions) error {
+	if pathItem == nil {
+		return nil
+	}
+
+	ops := pathItem.GetOperations()
+	for method, op := range ops.FromOldest() {
+		id := baseID
+		if op.OperationId != "" {
+			id = typeNamePrefix(op.OperationId) + nameNormalizer(op.OperationId)
+		} else if ops.Len() > 1 || multiple {
+			id += UppercaseFirstCharacter(strings.ToLower(method))
+		}
+
+		if op.RequestBody != nil {
+			if pair := op.RequestBody.Content.First(); pair == nil || !isMediaTypeJson(pair.Key()) {
+				continue
+			}
+		}
+
+		body, bodyTypeDef, err := createBodyDefinition(id, op.RequestBody, options)
+		if err != nil {
+			return fmt.Errorf("error generating body definition of %s: %w", id, err)
+		}
+		if bodyTypeDef != nil {
+			c.typeDefs = append(c.typeDefs, *bodyTypeDef)
+			c.importSchemas = append(c.importSchemas, bodyTypeDef.Schema)
+		}
+		if body != nil {
+			c.typeDefs = append(c.typeDefs, body.Schema.AdditionalTypes...)
+		}
+
+		c.callbacks = append(c.callbacks, CallbackDefinition{
+			ID:                id,
+			Name:              name,
+			OperationID:       operationID,
+			Expression:        expression,
+			Method:            strings.ToUpper(method),
+			Summary:           op.Summary,
+			Description:       op.Description,
+			Body:              body,
+			SuccessStatusCode: callbackSuccessStatusCode(op.Responses),
+		})
+	}
+
+	return nil
+}
+
+// callbackSuccessStatusCode returns the first 2xx status code declared by the callback responses.
+// It defaults to 200 OK, or 204 No Content when no responses are declared.
+func callbackSuccessStatusCode(responses *v3high.Responses) int {
+	if responses == nil || responses.Codes == nil || responses.Codes.Len() == 0 {
+		return http.StatusNoContent
+	}
+	for code := range responses.Codes.KeysFromOldest() {
+		if statusCode, err := strconv.Atoi(code); err == nil && statusCode >= 200 && statusCode < 300 {
+			return statusCode
+		}
+	}
+	return http.StatusOK
+}
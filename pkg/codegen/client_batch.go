@@ -0,0 +1,167 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"fmt"
+	"slices"
+)
+
+// ClientBatchDefinition describes the batch operation of the client, sending the calls of the other operations
+// as sub-requests. The envelope fields are the JSON properties of the sub-requests and sub-responses,
+// see runtime.BatchEnvelope.
+type ClientBatchDefinition struct {
+	Operation OperationDefinition
+
+	// Method is the name of the client method sending a batch, Batch unless an operation has that name.
+	Method string
+
+	// RequestItems is the property of the batch request body holding the sub-requests,
+	// empty if the body is their array. Same for ResponseItems and the batch response.
+	RequestItems  string
+	ResponseItems string
+
+	ID      string
+	Path    string
+	Headers string
+	Body    string
+	Status  string
+}
+
+// CallMethod returns the name of the client method creating the batch call of op.
+func (b *ClientBatchDefinition) CallMethod(op OperationDefinition) string {
+	return op.ID + "BatchCall"
+}
+
+// Batches returns true if op can be sent in a batch, which excludes the batch operation itself.
+func (b *ClientBatchDefinition) Batches(op OperationDefinition) bool {
+	return b != nil && op.ID != b.Operation.ID
+}
+
+// newClientBatchDefinition returns the batch definition of the client configured by cfg,
+// checking that the envelope schemas are the items of the batch request body and response.
+func newClientBatchDefinition(cfg *ClientBatch, operations []OperationDefinition, typeDefs []TypeDefinition, tracker *TypeTracker) (*ClientBatchDefinition, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+
+	idx := slices.IndexFunc(operations, func(op OperationDefinition) bool { return op.specID == cfg.OperationID })
+	if idx < 0 {
+		return nil, fmt.Errorf("batch operation '%s' not found", cfg.OperationID)
+	}
+	op := operations[idx]
+	if op.Body == nil || op.Response.Success == nil || op.Response.ResultName != "" {
+		return nil, fmt.Errorf("batch operation '%s' must have a request body and a single success response", cfg.OperationID)
+	}
+
+	types := make(map[string]TypeDefinition, len(typeDefs))
+	for _, td := range typeDefs {
+		if _, found := types[td.Name]; !found {
+			types[td.Name] = td
+		}
+	}
+	lookup := func(schema string) (string, map[string]bool, error) {
+		name, found := tracker.LookupByRef("#/components/schemas/" + schema)
+		if !found {
+			return "", nil, fmt.Errorf("batch envelope schema '%s' not found", schema)
+		}
+		props := make(map[string]bool)
+		for _, prop := range findObjectProperties(GoSchema{RefType: name}, types, map[string]bool{}) {
+			props[prop.JsonFieldName] = true
+		}
+		return name, props, nil
+	}
+
+	reqType, reqProps, err := lookup(cfg.Request)
+	if err != nil {
+		return nil, err
+	}
+	respType, respProps, err := lookup(cfg.Response)
+	if err != nil {
+		return nil, err
+	}
+
+	hasOperation := func(id string) bool {
+		return slices.ContainsFunc(operations, func(op OperationDefinition) bool { return op.ID == id })
+	}
+	batch := &ClientBatchDefinition{Operation: op, Method: "Batch"}
+	if hasOperation(batch.Method) {
+		batch.Method = "SendBatch"
+	}
+	if hasOperation(batch.Method) {
+		return nil, fmt.Errorf("batch method %s collides with an operation", batch.Method)
+	}
+
+	var found bool
+	if batch.RequestItems, found = findBatchItems(op.Body.Schema, reqType, types, map[string]bool{}); !found {
+		return nil, fmt.Errorf("the request body of batch operation '%s' has no array of %s", cfg.OperationID, cfg.Request)
+	}
+	if batch.ResponseItems, found = findBatchItems(op.Response.Success.Schema, respType, types, map[string]bool{}); !found {
+		return nil, fmt.Errorf("the response of batch operation '%s' has no array of %s", cfg.OperationID, cfg.Response)
+	}
+
+	switch {
+	case reqProps["path"]:
+		batch.Path = "path"
+	case reqProps["url"]:
+		batch.Path = "url"
+	}
+	if !reqProps["method"] || batch.Path == "" {
+		return nil, fmt.Errorf("batch envelope schema '%s' must have the method and path (or url) properties", cfg.Request)
+	}
+	if !respProps["status"] {
+		return nil, fmt.Errorf("batch envelope schema '%s' must have the status property", cfg.Response)
+	}
+	if reqProps["id"] && respProps["id"] {
+		batch.ID = "id"
+	}
+	if reqProps["headers"] && respProps["headers"] {
+		batch.Headers = "headers"
+	}
+	if reqProps["body"] && respProps["body"] {
+		batch.Body = "body"
+	}
+
+	for _, op := range operations {
+		if batch.Batches(op) && hasOperation(batch.CallMethod(op)) {
+			return nil, fmt.Errorf("batch call method %s collides with an operation", batch.CallMethod(op))
+		}
+	}
+
+	return batch, nil
+}
+
+// findBatchItems returns the property of s holding an array of itemType, empty if s is the array,
+// following the named types it refers to.
+func findBatchItems(s GoSchema, itemType string, types map[string]TypeDefinition, visited map[string]bool) (string, bool) {
+	if s.ArrayType != nil {
+		return "", s.ArrayType.RefType == itemType || s.ArrayType.GoType == itemType
+	}
+	if len(s.Properties) > 0 {
+		for _, prop := range s.Properties {
+			if items := prop.Schema.ArrayType; items != nil && (items.RefType == itemType || items.GoType == itemType) {
+				return prop.JsonFieldName, true
+			}
+		}
+		return "", false
+	}
+
+	name := s.RefType
+	if name == "" {
+		name = s.GoType
+	}
+	td, found := types[name]
+	if !found || visited[name] {
+		return "", false
+	}
+	visited[name] = true
+	return findBatchItems(td.Schema, itemType, types, visited)
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+)
+
+// healthCheckPaths are the paths of the GET operations detected as the health check of the API.
+var healthCheckPaths = []string{"/health", "/healthz"}
+
+// findHealthCheckOperation returns the operation sent by the Ping method of the client: the one with the operationID,
+// when configured, or else the first operation with the x-healthcheck extension, or else the first GET /health or /healthz operation.
+// Detected operations with path parameters, which Ping can't fill in, are skipped. It returns nil if there is none,
+// or if an operation is already named Ping.
+func findHealthCheckOperation(operationID string, operations []OperationDefinition) (*OperationDefinition, error) {
+	hasPing := slices.ContainsFunc(operations, func(op OperationDefinition) bool { return op.ID == "Ping" })
+
+	if operationID != "" {
+		idx := slices.IndexFunc(operations, func(op OperationDefinition) bool { return op.specID == operationID })
+		if idx < 0 {
+			return nil, fmt.Errorf("health check operation '%s' not found", operationID)
+		}
+		if operations[idx].PathParams != nil {
+			return nil, fmt.Errorf("health check operation '%s' must not have path parameters", operationID)
+		}
+		if hasPing {
+			return nil, fmt.Errorf("the Ping method of health check operation '%s' collides with an operation", operationID)
+		}
+		return &operations[idx], nil
+	}
+
+	if hasPing {
+		return nil, nil
+	}
+	for _, matches := range []func(op OperationDefinition) bool{
+		func(op OperationDefinition) bool { return op.healthCheck },
+		func(op OperationDefinition) bool {
+			return op.Method == http.MethodGet && slices.Contains(healthCheckPaths, op.Path)
+		},
+	} {
+		for i, op := range operations {
+			if matches(op) && op.PathParams == nil {
+				return &operations[i], nil
+			}
+		}
+	}
+	return nil, nil
+}
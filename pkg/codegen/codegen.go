@@ -12,6 +12,9 @@ package codegen
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/pb33f/libopenapi"
@@ -21,16 +24,37 @@ import (
 // ParseContext holds the OpenAPI models.
 type ParseContext struct {
 	Operations      []OperationDefinition
+	Callbacks       []CallbackDefinition
+	Webhooks        []CallbackDefinition
 	TypeDefinitions map[SpecLocation][]TypeDefinition
 	Enums           []EnumDefinition
 	UnionTypes      []TypeDefinition
 	Imports         []string
 	ResponseErrors  []string
 	TypeTracker     *TypeTracker
+
+	// ClientBatch is the batch operation of the client, set when client.batch is configured.
+	ClientBatch *ClientBatchDefinition
+
+	// ClientHealthCheck is the health check operation sent by the Ping method of the client, if any.
+	ClientHealthCheck *OperationDefinition
+
+	// XConfig is the Config struct of the x-config extension, set when generate.emit-x-config is enabled.
+	XConfig *XConfigDefinition
+
+	// Info is the info object of the spec.
+	Info APIInfo
+}
+
+// APIInfo holds the title and version of the info object of the spec.
+type APIInfo struct {
+	Title   string
+	Version string
 }
 
 type operationsCollection struct {
 	operations     []OperationDefinition
+	callbacks      []CallbackDefinition
 	importSchemas  []GoSchema
 	typeDefs       []TypeDefinition
 	responseErrors []string
@@ -72,6 +96,23 @@ func CreateParseContext(docContents []byte, cfg Configuration) (*ParseContext, [
 	return res, nil
 }
 
+// CreateParseContextFromFile creates a ParseContext from the OpenAPI spec at path.
+// $ref to other files are resolved relative to the directory of the spec,
+// unless cfg.BaseDir is set.
+func CreateParseContextFromFile(path string, cfg Configuration) (*ParseContext, []error) {
+	// #nosec G304 -- the spec path is provided by the caller
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, []error{fmt.Errorf("error reading spec: %w", err)}
+	}
+
+	if cfg.BaseDir == "" {
+		cfg.BaseDir = filepath.Dir(path)
+	}
+
+	return CreateParseContext(contents, cfg)
+}
+
 func CreateParseContextFromDocument(doc libopenapi.Document, cfg Configuration) (*ParseContext, error) {
 	cfg = cfg.WithDefaults()
 
@@ -94,20 +135,71 @@ func CreateParseContextFromModel(model *v3high.Document, cfg Configuration) (*Pa
 		return nil, nil
 	}
 
+	if !cfg.Generate.FreeFormObjectType.IsValid() {
+		return nil, fmt.Errorf("%w: %q", ErrFreeFormObjectTypeUnsupported, cfg.Generate.FreeFormObjectType)
+	}
+
+	if !cfg.Generate.OptionalType.IsValid() {
+		return nil, fmt.Errorf("%w: %q", ErrOptionalTypeUnsupported, cfg.Generate.OptionalType)
+	}
+
+	if !cfg.Generate.DecimalType.IsValid() {
+		return nil, fmt.Errorf("%w: %q", ErrDecimalTypeUnsupported, cfg.Generate.DecimalType)
+	}
+
+	if !cfg.Generate.EnumStyle.IsValid() {
+		return nil, fmt.Errorf("%w: %q", ErrEnumStyleUnsupported, cfg.Generate.EnumStyle)
+	}
+
+	if !cfg.Client.JSONLibrary.IsValid() {
+		return nil, fmt.Errorf("%w: %q", ErrJSONLibraryUnsupported, cfg.Client.JSONLibrary)
+	}
+
+	if cfg.Client.Compression != nil {
+		for _, algorithm := range cfg.Client.Compression.ResponseDecode {
+			if !slices.Contains(supportedResponseDecoders, algorithm) {
+				return nil, fmt.Errorf("%w: %q", ErrCompressionUnsupported, algorithm)
+			}
+		}
+		if algorithm := cfg.Client.Compression.Request; algorithm != "" && !slices.Contains(supportedResponseDecoders, algorithm) {
+			return nil, fmt.Errorf("%w: %q", ErrCompressionUnsupported, algorithm)
+		}
+	}
+
 	parseOptions := ParseOptions{
 		OmitDescription:        cfg.Generate.OmitDescription,
+		ConstraintComments:     cfg.Generate.ConstraintComments,
 		DefaultIntType:         cfg.Generate.DefaultIntType,
+		IntTypeByFormat:        cfg.Generate.IntTypeByFormat,
+		FreeFormObjectType:     cfg.Generate.FreeFormObjectType,
+		OptionalType:           cfg.Generate.OptionalType,
+		DecimalType:            cfg.Generate.DecimalType,
+		TrimTypePrefix:         cfg.Generate.TrimTypePrefix,
 		AlwaysPrefixEnumValues: cfg.Generate.AlwaysPrefixEnumValues,
+		OpenEnums:              cfg.Generate.EnumStyle == EnumStyleOpen,
+		StrictEnums:            cfg.Generate.EnumStyle == EnumStyleStrict,
 		SkipValidation:         cfg.Generate.Validation.Skip,
 		ErrorMapping:           cfg.ErrorMapping,
 		AutoExtraTags:          cfg.Generate.AutoExtraTags,
+		PreserveJSONCase:       cfg.Generate.PreserveJSONCase,
+		EmbedJSONSchemas:       cfg.Generate.Handler != nil && cfg.Generate.Handler.SchemaValidation,
+		EmbedExamples:          cfg.Generate.MCPServer != nil,
+		FuzzSeeds:              cfg.Generate.FuzzSeeds,
+		GenerateCallbacks:      cfg.Generate.Callbacks,
+		GenerateWebhooks:       cfg.Generate.Webhooks,
+		GenerateHandler:        cfg.Generate.Handler != nil,
+		MergePatch:             cfg.Generate.MergePatch,
+		JSONPatch:              cfg.Generate.JSONPatch,
+		ProblemDetails:         cfg.Generate.ProblemDetails,
 		typeTracker:            newTypeTracker(),
 		visited:                map[string]bool{},
 		model:                  model,
+		inlineRefs:             inlinedComponentSchemas(model, cfg.Generate.InlineThreshold),
 	}
 
 	var (
 		operations     []OperationDefinition
+		callbacks      []CallbackDefinition
 		importSchemas  []GoSchema
 		responseErrors []string
 	)
@@ -115,7 +207,7 @@ func CreateParseContextFromModel(model *v3high.Document, cfg Configuration) (*Pa
 	// Process Components
 	typeDefs, err := collectComponentDefinitions(model, parseOptions)
 	if err != nil {
-		return nil, fmt.Errorf("error collecting component definitions: %s", err)
+		return nil, fmt.Errorf("error collecting component definitions: %w", err)
 	}
 
 	// collect operations
@@ -126,11 +218,22 @@ func CreateParseContextFromModel(model *v3high.Document, cfg Configuration) (*Pa
 
 	if opColl != nil {
 		operations = opColl.operations
+		callbacks = opColl.callbacks
 		importSchemas = opColl.importSchemas
 		typeDefs = append(typeDefs, opColl.typeDefs...)
 		responseErrors = opColl.responseErrors
 	}
 
+	// collect webhooks
+	var webhooks callbacksCollection
+	if parseOptions.GenerateWebhooks {
+		if err := webhooks.collectWebhooks(model.Webhooks, parseOptions); err != nil {
+			return nil, fmt.Errorf("error collecting webhooks: %w", err)
+		}
+		typeDefs = append(typeDefs, extractAllTypeDefinitions(webhooks.typeDefs)...)
+		importSchemas = append(importSchemas, webhooks.importSchemas...)
+	}
+
 	// Collect Schemas from components
 	for _, componentDef := range typeDefs {
 		importSchemas = append(importSchemas, componentDef.Schema)
@@ -175,19 +278,73 @@ func CreateParseContextFromModel(model *v3high.Document, cfg Configuration) (*Pa
 		}
 	}
 
+	setSuccessBodyUnions(operations, typeDefs)
+	setQueryFilters(operations, typeDefs)
+
 	respErrs, err := collectResponseErrors(responseErrors, parseOptions.typeTracker)
 	if err != nil {
 		return nil, fmt.Errorf("error collecting response errors: %w", err)
 	}
 
+	var (
+		clientBatch       *ClientBatchDefinition
+		clientHealthCheck *OperationDefinition
+	)
+	if cfg.Generate.Client && cfg.Client != nil {
+		clientBatch, err = newClientBatchDefinition(cfg.Client.Batch, operations, typeDefs, parseOptions.typeTracker)
+		if err != nil {
+			return nil, fmt.Errorf("error creating client batch: %w", err)
+		}
+		clientHealthCheck, err = findHealthCheckOperation(cfg.Client.HealthOperationID, operations)
+		if err != nil {
+			return nil, fmt.Errorf("error finding client health check: %w", err)
+		}
+	}
+
+	var xConfig *XConfigDefinition
+	if cfg.Generate.EmitXConfig {
+		xConfig, err = newXConfigDefinition(model.Extensions, parseOptions.typeTracker)
+		if err != nil {
+			return nil, fmt.Errorf("error creating x-config: %w", err)
+		}
+	}
+
+	var info APIInfo
+	if model.Info != nil {
+		info = APIInfo{Title: model.Info.Title, Version: model.Info.Version}
+	}
+	if cfg.Generate.InfoConstants {
+		for _, name := range []string{"APITitle", "APIVersion"} {
+			if parseOptions.typeTracker.Exists(name) {
+				return nil, fmt.Errorf("info constant %s collides with a generated type", name)
+			}
+		}
+	}
+	if cfg.Generate.PathTemplates {
+		for _, op := range operations {
+			for _, name := range []string{UppercaseFirstCharacter(op.ID) + "PathTemplate", UppercaseFirstCharacter(op.ID) + "Method"} {
+				if parseOptions.typeTracker.Exists(name) {
+					return nil, fmt.Errorf("path template constant %s collides with a generated type", name)
+				}
+			}
+		}
+	}
+
 	return &ParseContext{
 		Operations:      operations,
+		Callbacks:       callbacks,
+		Webhooks:        webhooks.callbacks,
 		TypeDefinitions: groupedTypeDefs,
 		Enums:           enums,
 		UnionTypes:      unionTypes,
 		Imports:         importMap(imprts).GoImports(),
 		ResponseErrors:  respErrs,
 		TypeTracker:     parseOptions.typeTracker,
+		ClientBatch:     clientBatch,
+
+		ClientHealthCheck: clientHealthCheck,
+		XConfig:           xConfig,
+		Info:              info,
 	}, nil
 }
 
@@ -201,6 +358,7 @@ func collectOperationDefinitions(model *v3high.Document, options ParseOptions) (
 		importSchemas  []GoSchema
 		typeDefs       []TypeDefinition
 		responseErrors []string
+		callbacks      callbacksCollection
 	)
 
 	// Track seen operation IDs to deduplicate inline before generating param types
@@ -224,6 +382,13 @@ func collectOperationDefinitions(model *v3high.Document, options ParseOptions) (
 			if err != nil {
 				return nil, fmt.Errorf("error creating operation ID: %w", err)
 			}
+			methodName, err := operationMethodName(extractExtensions(operation.Extensions))
+			if err != nil {
+				return nil, fmt.Errorf("error parsing %s extension for %s: %w", extGoMethodName, operationID, err)
+			}
+			if methodName != "" {
+				operationID = methodName
+			}
 
 			// Deduplicate operation ID inline before generating param types
 			// This ensures each operation gets unique type names for path/query params
@@ -310,13 +475,18 @@ func collectOperationDefinitions(model *v3high.Document, options ParseOptions) (
 			}
 			if responseDef != nil {
 				response = *responseDef
-				if responseDef.Error != nil {
+				// runtime.ProblemDetails already implements error.
+				if responseDef.Error != nil && !responseDef.Error.IsProblemDetails {
 					responseErrors = append(responseErrors, responseDef.Error.ResponseName)
 				}
 			}
 
 			// Parse x-mcp extension if present
 			var mcpExt *MCPExtension
+			var healthCheck bool
+			var compressRequest *bool
+			var idempotencyKey *bool
+			var longPoll bool
 			if operation.Extensions != nil {
 				extensions := extractExtensions(operation.Extensions)
 				if mcpValue, ok := extensions[extMCP]; ok {
@@ -325,32 +495,78 @@ func collectOperationDefinitions(model *v3high.Document, options ParseOptions) (
 						return nil, fmt.Errorf("error parsing x-mcp extension for %s: %w", operationID, err)
 					}
 				}
+				if value, ok := extensions[extHealthCheck]; ok {
+					healthCheck, err = parseBooleanValue(value)
+					if err != nil {
+						return nil, fmt.Errorf("error parsing x-healthcheck extension for %s: %w", operationID, err)
+					}
+				}
+				if value, ok := extensions[extCompressRequest]; ok {
+					compress, err := parseBooleanValue(value)
+					if err != nil {
+						return nil, fmt.Errorf("error parsing x-compress-request extension for %s: %w", operationID, err)
+					}
+					compressRequest = &compress
+				}
+				if value, ok := extensions[extIdempotencyKey]; ok {
+					setKey, err := parseBooleanValue(value)
+					if err != nil {
+						return nil, fmt.Errorf("error parsing x-idempotency-key extension for %s: %w", operationID, err)
+					}
+					idempotencyKey = &setKey
+				}
+				if value, ok := extensions[extLongPoll]; ok {
+					longPoll, err = parseBooleanValue(value)
+					if err != nil {
+						return nil, fmt.Errorf("error parsing x-long-poll extension for %s: %w", operationID, err)
+					}
+				}
 			}
 
 			operations = append(operations, OperationDefinition{
 				ID:          operationID,
 				Summary:     operation.Summary,
 				Description: operation.Description,
+				Tags:        operation.Tags,
 				// https://datatracker.ietf.org/doc/html/rfc7231
-				Method:     strings.ToUpper(method),
-				Path:       path,
-				PathParams: pathParamsDef,
-				Header:     headerDef,
-				Query:      queryParamsDef,
-				Response:   response,
-				Body:       bodyDefinition,
-				MCP:        mcpExt,
+				Method:          strings.ToUpper(method),
+				Path:            path,
+				PathParams:      pathParamsDef,
+				Header:          headerDef,
+				Query:           queryParamsDef,
+				Response:        response,
+				Body:            bodyDefinition,
+				MCP:             mcpExt,
+				CompressRequest: compressRequest,
+				IdempotencyKey:  idempotencyKey,
+				LongPoll:        longPoll,
+				specID:          operation.OperationId,
+				specLinks:       successResponseLinks(operation.Responses, response.SuccessStatusCode),
+
+				healthCheck: healthCheck,
 			})
+
+			if options.GenerateCallbacks {
+				if err := callbacks.collect(operationID, operation.Callbacks, options); err != nil {
+					return nil, fmt.Errorf("error collecting callbacks of %s: %w", operationID, err)
+				}
+			}
 		}
 	}
 
 	// Resolve RequestOptions name collisions (operation IDs already deduplicated inline)
-	operations = resolveRequestOptionsCollisions(operations, options.typeTracker)
+	operations = resolveRequestOptionsCollisions(operations, options.typeTracker, options.GenerateHandler)
+	resolveBodyUnionCollisions(operations)
+	resolveOperationLinks(operations)
+
+	typeDefs = append(typeDefs, callbacks.typeDefs...)
+	importSchemas = append(importSchemas, callbacks.importSchemas...)
 
 	allTypeDefs := extractAllTypeDefinitions(typeDefs)
 
 	return &operationsCollection{
 		operations:     operations,
+		callbacks:      callbacks.callbacks,
 		importSchemas:  importSchemas,
 		typeDefs:       allTypeDefs,
 		responseErrors: responseErrors,
@@ -359,8 +575,9 @@ func collectOperationDefinitions(model *v3high.Document, options ParseOptions) (
 
 // resolveRequestOptionsCollisions checks if any operation's RequestOptions type name
 // would collide with existing component schemas, and renames the operation ID if needed.
-// It also checks for ServiceRequestOptions collisions (used by handler generation).
-func resolveRequestOptionsCollisions(operations []OperationDefinition, tracker *TypeTracker) []OperationDefinition {
+// It also checks for ServiceRequestOptions collisions (used by handler generation),
+// and, when handler is set, for collisions of the {operationID}Context type of every operation.
+func resolveRequestOptionsCollisions(operations []OperationDefinition, tracker *TypeTracker, handler bool) []OperationDefinition {
 	result := make([]OperationDefinition, len(operations))
 
 	// First pass: register all client RequestOptions names so handler can detect collisions
@@ -373,25 +590,32 @@ func resolveRequestOptionsCollisions(operations []OperationDefinition, tracker *
 	}
 
 	for i, op := range operations {
-		if !op.HasRequestOptions() {
-			result[i] = op
-			continue
+		baseName := UppercaseFirstCharacter(op.ID)
+
+		if op.HasRequestOptions() {
+			// Check if the RequestOptions type name would collide and get a unique base name
+			baseName = tracker.generateUniqueBaseName(baseName, "RequestOptions")
+
+			// Also check if ServiceRequestOptions would collide with any client RequestOptions
+			// e.g., createPayment -> CreatePaymentServiceRequestOptions collides with
+			//       createPaymentService -> CreatePaymentServiceRequestOptions
+			serviceOptsName := baseName + "ServiceRequestOptions"
+			if clientRequestOptions[serviceOptsName] {
+				// Collision detected - append suffix to make it unique
+				baseName = baseName + "Handler"
+			}
 		}
 
-		// Check if the RequestOptions type name would collide and get a unique base name
-		baseName := UppercaseFirstCharacter(op.ID)
-		baseName = tracker.generateUniqueBaseName(baseName, "RequestOptions")
-
-		// Also check if ServiceRequestOptions would collide with any client RequestOptions
-		// e.g., createPayment -> CreatePaymentServiceRequestOptions collides with
-		//       createPaymentService -> CreatePaymentServiceRequestOptions
-		serviceOptsName := baseName + "ServiceRequestOptions"
-		if clientRequestOptions[serviceOptsName] {
-			// Collision detected - append suffix to make it unique
-			baseName = baseName + "Handler"
+		// The handler declares an {operationID}Context type for every operation,
+		// e.g., getUser -> GetUserContext collides with a GetUserContext schema
+		if handler {
+			baseName = tracker.generateUniqueBaseName(baseName, "Context")
+			tracker.registerName(baseName + "Context")
 		}
 
-		op.ID = baseName
+		if op.HasRequestOptions() || baseName != UppercaseFirstCharacter(op.ID) {
+			op.ID = baseName
+		}
 		result[i] = op
 	}
 
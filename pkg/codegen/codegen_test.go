@@ -11,10 +11,17 @@
 package codegen
 
 import (
+	"bytes"
 	"embed"
+	"go/ast"
 	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -210,6 +217,47 @@ func TestGoTypeImport(t *testing.T) {
 	}
 }
 
+func TestGoTypeOnItemsAndValues(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+		Client: &Client{
+			Name: "Client",
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "x-go-type-nested.yml")), cfg)
+	require.NoError(t, err)
+	code := codes.GetCombined()
+
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, strings.Count(code, `"github.com/acme/money"`))
+	assert.Contains(t, code, "type Prices []money.Money")
+	assert.Contains(t, code, "type PriceMap map[string]money.Money")
+	assert.Regexp(t, `Items\s+\[\]money\.Money\s+`+"`json:\"items,omitempty\"`", code)
+	assert.Regexp(t, `Totals\s+map\[string\]money\.Money\s+`+"`json:\"totals,omitempty\"`", code)
+	assert.Contains(t, code, "Amounts []money.Money `json:\"amounts,omitempty\"`")
+	assert.Contains(t, code, "type CreateCartResponse map[string]money.Money")
+
+	// Types of other packages are not validated, like external refs.
+	assert.NotContains(t, code, "func (p PriceMap) Validate() error")
+	assert.NotContains(t, code, "range c.Items")
+	assert.NotContains(t, code, "range c.Totals")
+	assert.Contains(t, code, "range c.Notes")
+
+	prices := code[strings.Index(code, "func (p Prices) Validate() error"):]
+	prices = prices[:strings.Index(prices, "\n}\n")]
+	assert.Contains(t, prices, "len(p) < 1")
+	assert.NotContains(t, prices, "range p")
+}
+
 func TestBackslashEscaping(t *testing.T) {
 	// Generate code
 	cfg := Configuration{
@@ -268,6 +316,69 @@ func TestBackslashEscapingJSON(t *testing.T) {
 	require.NoError(t, err, "Generated code should compile without syntax errors")
 }
 
+func TestDiscriminatorPropertyName(t *testing.T) {
+	// The discriminator property account_type becomes the AccountType Go field,
+	// but the dispatch must read the raw JSON key and switch on the raw mapping keys.
+	cfg := Configuration{
+		PackageName: "testpkg",
+		SkipPrune:   true,
+		Output: &Output{
+			UseSingleFile: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "discriminator-property-name.yml")), cfg)
+	require.NoError(t, err)
+
+	codeStr := codes.GetCombined()
+	assert.Contains(t, codeStr, "AccountType string `json:\"account_type\" validate:\"required\"`")
+	assert.Contains(t, codeStr, "Value string `json:\"account_type\"`")
+	assert.NotContains(t, codeStr, "`json:\"AccountType\"`")
+	assert.Contains(t, codeStr, `case "PERSONAL":`)
+	assert.Contains(t, codeStr, `case "Business":`)
+	assert.Contains(t, codeStr, `case "joint":`)
+	assert.Contains(t, codeStr, `runtime.MarshalEitherWithDiscriminator(obj, "account_type", disc)`)
+
+	_, err = format.Source([]byte(codeStr))
+	require.NoError(t, err)
+}
+
+func TestFastJSON(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testpkg",
+		SkipPrune:   true,
+		Output: &Output{
+			UseSingleFile: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "fast-json.yml")), cfg)
+	require.NoError(t, err)
+
+	codeStr := codes.GetCombined()
+	assert.Contains(t, codeStr, "func (o Order) MarshalJSON() ([]byte, error) {")
+	assert.Contains(t, codeStr, "func (o Order) writeJSON(enc *runtime.JSONEncoder, masked bool) {")
+	assert.Contains(t, codeStr, "func (o OrderItem) writeJSON(enc *runtime.JSONEncoder, masked bool) {")
+	assert.NotContains(t, codeStr, "func (a Address) MarshalJSON() ([]byte, error) {")
+
+	// field by field, honoring pointers and omitempty
+	assert.Contains(t, codeStr, "enc.Int(int64(o.ID))")
+	assert.Contains(t, codeStr, "if o.Discount != nil {")
+	assert.Contains(t, codeStr, "enc.String(string(o.Status))")
+	assert.Contains(t, codeStr, "if len(o.Tags) != 0 {")
+	assert.Contains(t, codeStr, "item.writeJSON(enc, masked)")
+	assert.Contains(t, codeStr, "enc.Time(*o.CreatedAt)")
+	assert.Contains(t, codeStr, "enc.Value(*o.Shipping)")
+	assert.Contains(t, codeStr, "enc.Float(float64(o.Price), 32)")
+
+	// sensitive fields are masked by MarshalJSONMasked
+	assert.Contains(t, codeStr, "o.writeJSON(enc, true)")
+	assert.Contains(t, codeStr, "enc.String(runtime.MaskSensitiveString(*o.CardNumber,")
+
+	_, err = format.Source([]byte(codeStr))
+	require.NoError(t, err)
+}
+
 func TestArrayItemPropertyNamedItem(t *testing.T) {
 	// Test that when an array item has a property named "item", the array item type
 	// gets a unique name (with numeric suffix) to avoid collision with the property's type.
@@ -365,6 +476,100 @@ func TestOperationResponseAliasReusesSameType(t *testing.T) {
 	require.NoError(t, err, "Generated code should compile without syntax errors")
 }
 
+// TestOperationContextConflictWithComponentSchema tests that when the handler's operation context
+// would conflict with a component schema name, the operation gets a unique name.
+func TestOperationContextConflictWithComponentSchema(t *testing.T) {
+	spec := `openapi: 3.0.0
+info:
+  title: Users
+  version: 1.0.0
+paths:
+  /users/{id}:
+    get:
+      operationId: getUser
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+      responses:
+        '200':
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/GetUserContext'
+  /health:
+    get:
+      operationId: healthCheck
+      responses:
+        '200':
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/HealthCheckContext'
+components:
+  schemas:
+    GetUserContext:
+      type: object
+      properties:
+        traceId:
+          type: string
+    HealthCheckContext:
+      type: object
+      properties:
+        region:
+          type: string
+`
+	cfg := Configuration{
+		PackageName: "api",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Handler: &HandlerOptions{
+				Kind: HandlerKindStdHTTP,
+			},
+		},
+	}
+
+	codes, err := Generate([]byte(spec), cfg)
+	require.NoError(t, err)
+
+	code := codes.GetCombined()
+
+	// The schemas keep their names
+	assert.Equal(t, 1, strings.Count(code, "type GetUserContext struct {"))
+	assert.Equal(t, 1, strings.Count(code, "type HealthCheckContext struct {"))
+
+	// The operations are renamed so their contexts don't redeclare the schemas
+	assert.Contains(t, code, "type GetUser1Context struct {\n\tcontext.Context\n\t*GetUser1ServiceRequestOptions\n}")
+	assert.Contains(t, code, "GetUser1(ctx *GetUser1Context) (*GetUser1ResponseData, error)")
+	assert.Contains(t, code, "type HealthCheck1Context struct {\n\tcontext.Context\n}")
+	assert.Contains(t, code, "HealthCheck1(ctx *HealthCheck1Context) (*HealthCheck1ResponseData, error)")
+
+	// Verify that the code compiles
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err, "Generated code should compile without syntax errors")
+
+	t.Run("without handler", func(t *testing.T) {
+		codes, err := Generate([]byte(spec), Configuration{
+			PackageName: "api",
+			Output: &Output{
+				UseSingleFile: true,
+			},
+		})
+		require.NoError(t, err)
+
+		// No operation context is generated, so the operations keep their names
+		code := codes.GetCombined()
+		assert.Contains(t, code, "type GetUserContext struct {")
+		assert.NotContains(t, code, "GetUser1")
+	})
+}
+
 func TestOverlayAppliesExtensions(t *testing.T) {
 	cfg := Configuration{
 		PackageName: "api",
@@ -489,3 +694,3671 @@ func TestRawContentTypesGenerateByteSlice(t *testing.T) {
 	_, err = format.Source([]byte(code))
 	require.NoError(t, err, "Generated code should compile without syntax errors")
 }
+
+func TestSensitiveDataMaskingFormats(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testsensitive",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "x-sensitive-data.yml")), cfg)
+	require.NoError(t, err)
+
+	code := codes.GetCombined()
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	assert.Contains(t, code, "func (p Payment) Masked() Payment {")
+	assert.Contains(t, code, `masked.CardNumber = runtime.MaskSensitiveString(p.CardNumber, runtime.SensitiveDataConfig{
+		Type:        runtime.MaskTypePartial,
+		Replacement: "",
+		Pattern:     "",
+		Algorithm:   "",
+		KeepPrefix:  0,
+		KeepSuffix:  4,
+	})`)
+	assert.Contains(t, code, `KeepPrefix:  2,
+			KeepSuffix:  2,`)
+	assert.Contains(t, code, `Type:        runtime.MaskTypeRegex,
+			Replacement: "***-**-$1",
+			Pattern:     "^\\d{3}-\\d{2}-(\\d{4})$",`)
+
+	// JSON marshaling stays raw, so no custom MarshalJSON is generated for masking
+	assert.NotContains(t, code, "func (p Payment) MarshalJSON()")
+}
+
+func TestSensitiveDataMarshalJSONVariants(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testsensitive",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "x-sensitive-data.yml")), cfg)
+	require.NoError(t, err)
+
+	code := codes.GetCombined()
+	assert.Contains(t, code, `func (p Payment) MarshalJSONMasked() ([]byte, error) {
+	return json.Marshal(p.Masked())
+}`)
+	assert.Contains(t, code, `func (p Payment) MarshalJSONUnmasked() ([]byte, error) {
+	return json.Marshal(p)
+}`)
+}
+
+func TestSensitiveDataRedacted(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testsensitive",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "x-sensitive-data.yml")), cfg)
+	require.NoError(t, err)
+
+	assert.Contains(t, codes.GetCombined(), `func (p Payment) Redacted() string {
+	return runtime.Redact(p)
+}`)
+}
+
+func TestArrayHeaderParams(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testheaders",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "array-header-params.yml")), cfg)
+	require.NoError(t, err)
+
+	combined := codes.GetCombined()
+	assert.Contains(t, combined, "XTags      []string `json:\"X-Tags,omitempty\"`")
+	assert.Contains(t, combined, `func (o *ListTagsRequestOptions) GetHeader() (map[string]string, error) {
+	return runtime.EncodeHeaderFields(o.Header)
+}`)
+	assert.Contains(t, combined, `func (o *ListItemsRequestOptions) GetHeader() (map[string]string, error) {
+	return runtime.AsMap[string](o.Header)
+}`)
+}
+
+func TestPathBuilders(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testpaths",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			PathBuilders: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "path-builders.yml")), cfg)
+	require.NoError(t, err)
+
+	combined := codes.GetCombined()
+	assert.Contains(t, combined, `func BuildGetItemPath(pathParams GetItemPath) string {
+	return fmt.Sprintf("/items/%s/%s",
+		runtime.EncodePathParam(pathParams.Category),
+		runtime.EncodePathParam(pathParams.Rating),
+	)
+}`)
+	assert.Contains(t, combined, `return fmt.Sprintf("/users/%s/files%%3Alatest/%s",
+		runtime.EncodePathParam(pathParams.ID),
+		runtime.EncodePathParam(pathParams.Name),
+	)`)
+	assert.Contains(t, combined, `func BuildGetHealthPath() string {
+	return "/health"
+}`)
+}
+
+func TestPathTemplates(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testpaths",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			PathTemplates: true,
+		},
+	}
+	spec := []byte(readTestdata(t, "path-builders.yml"))
+
+	ctx, errs := CreateParseContext(spec, cfg)
+	require.Nil(t, errs)
+	require.Len(t, ctx.Operations, 3)
+
+	codes, err := Generate(spec, cfg)
+	require.NoError(t, err)
+	combined := codes.GetCombined()
+	_, err = format.Source([]byte(combined))
+	require.NoError(t, err)
+
+	// constants are aligned by gofmt
+	flat := strings.Join(strings.Fields(combined), " ")
+	for _, op := range ctx.Operations {
+		assert.Contains(t, flat, op.ID+`PathTemplate = "`+op.Path+`"`)
+		assert.Contains(t, flat, op.ID+`Method = "`+op.Method+`"`)
+	}
+	// placeholders and static segments are kept verbatim
+	assert.Contains(t, flat, `GetUserFilePathTemplate = "/users/{id}/files:latest/{name}"`)
+	assert.Contains(t, flat, `GetItemMethod = "GET"`)
+
+	t.Run("collision", func(t *testing.T) {
+		spec := strings.Replace(string(spec), "paths:", `components:
+  schemas:
+    GetHealthMethod:
+      type: string
+paths:`, 1)
+		cfg := cfg
+		cfg.SkipPrune = true
+		_, err := Generate([]byte(spec), cfg)
+		require.ErrorContains(t, err, "path template constant GetHealthMethod collides with a generated type")
+	})
+}
+
+func TestNullableUnionCollapsesToPointer(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testnullable",
+		SkipPrune:   true,
+		Output: &Output{
+			UseSingleFile: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "nullable-union.yml")), cfg)
+	require.NoError(t, err)
+
+	combined := codes.GetCombined()
+	assert.Contains(t, combined, `type Owner struct {
+	Pet         *Pet             `+"`json:\"pet,omitempty\"`"+`
+	PreviousPet *Pet             `+"`json:\"previousPet,omitempty\"`"+`
+	LegacyPet   *Pet             `+"`json:\"legacyPet,omitempty\"`"+`
+	Nickname    *string          `+"`json:\"nickname,omitempty\"`"+`
+	PetOrName   *Owner_PetOrName `+"`json:\"petOrName,omitempty\"`"+`
+}`)
+	assert.NotContains(t, combined, "type Owner_Pet struct")
+	assert.NotContains(t, combined, "type Owner_LegacyPet struct")
+
+	// more than one non-null element is still a union
+	assert.Contains(t, combined, "runtime.Either[Pet, string]")
+}
+
+func TestHTTPHandlerKindsMiddleware(t *testing.T) {
+	for _, kind := range []HandlerKind{HandlerKindStdHTTP, HandlerKindChi, HandlerKindGorillaMux, HandlerKindGoZero, HandlerKindKratos} {
+		t.Run(string(kind), func(t *testing.T) {
+			cfg := Configuration{
+				PackageName: "testmiddleware",
+				Output: &Output{
+					UseSingleFile: true,
+				},
+				Generate: &GenerateOptions{
+					Handler: &HandlerOptions{
+						Kind: kind,
+					},
+				},
+			}
+
+			codes, err := Generate([]byte(readTestdata(t, "path-builders.yml")), cfg)
+			require.NoError(t, err)
+
+			combined := codes.GetCombined()
+			assert.Contains(t, combined, "func WithMiddleware(mw func(http.Handler) http.Handler) RouterOption {")
+			assert.Contains(t, combined, "middlewares          []func(http.Handler) http.Handler")
+			assert.Contains(t, combined, "func WithOperationMiddleware(operationID string, mw func(http.Handler) http.Handler) RouterOption {")
+			assert.Contains(t, combined, `cfg.operationHandler("GetItem", http.HandlerFunc(adapter.GetItem))`)
+		})
+	}
+}
+
+func TestHandlerKindsServiceContract(t *testing.T) {
+	kinds := []HandlerKind{
+		HandlerKindBeego, HandlerKindBuffalo, HandlerKindChi, HandlerKindEcho, HandlerKindFastHTTP,
+		HandlerKindFiber, HandlerKindGin, HandlerKindGoFrame, HandlerKindGoZero, HandlerKindGorillaMux,
+		HandlerKindHertz, HandlerKindIris, HandlerKindKratos, HandlerKindStdHTTP,
+	}
+
+	contracts := make(map[HandlerKind]string, len(kinds))
+	scaffolds := make(map[HandlerKind]string, len(kinds))
+	for _, kind := range kinds {
+		cfg := Configuration{
+			PackageName: "testcontract",
+			Output: &Output{
+				UseSingleFile: true,
+			},
+			Generate: &GenerateOptions{
+				Handler: &HandlerOptions{
+					Kind: kind,
+				},
+			},
+		}
+
+		codes, err := Generate([]byte(readTestdata(t, "path-builders.yml")), cfg)
+		require.NoError(t, err, kind)
+
+		contracts[kind] = serviceContract(t, codes.GetCombined())
+		scaffolds[kind] = codes[scaffoldPrefix+"service"]
+	}
+
+	require.Contains(t, contracts[HandlerKindChi], "type ServiceInterface interface {")
+	require.Contains(t, contracts[HandlerKindChi], "ServiceRequestOptions struct {")
+	require.Contains(t, contracts[HandlerKindChi], `Context struct {
+	context.Context`)
+	require.NotEmpty(t, scaffolds[HandlerKindChi])
+	for _, kind := range kinds {
+		// the service only sees the parsed request options, so one implementation serves every router
+		assert.Equal(t, contracts[HandlerKindChi], contracts[kind], kind)
+		assert.Equal(t, scaffolds[HandlerKindChi], scaffolds[kind], kind)
+	}
+}
+
+// serviceContract returns the declarations a service implementation depends on:
+// the service interface, the request options, the operation contexts and the response data types.
+func serviceContract(t *testing.T, code string) string {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", code, parser.ParseComments)
+	require.NoError(t, err)
+
+	isContract := func(name string) bool {
+		return name == "ServiceInterface" ||
+			strings.HasSuffix(name, "ServiceRequestOptions") ||
+			strings.HasSuffix(name, "Context") ||
+			strings.HasSuffix(name, "ResponseData")
+	}
+
+	var buf bytes.Buffer
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.GenDecl:
+			if d.Tok != token.TYPE || !isContract(d.Specs[0].(*ast.TypeSpec).Name.Name) {
+				continue
+			}
+		case *ast.FuncDecl:
+			name := d.Name.Name
+			if d.Recv != nil {
+				recv := d.Recv.List[0].Type
+				if star, ok := recv.(*ast.StarExpr); ok {
+					recv = star.X
+				}
+				name = recv.(*ast.Ident).Name
+			}
+			if !isContract(name) {
+				continue
+			}
+		}
+		require.NoError(t, printer.Fprint(&buf, fset, decl))
+		buf.WriteString("\n")
+	}
+	return buf.String()
+}
+
+func TestMCPToolExamples(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testmcp",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client:    true,
+			MCPServer: &MCPServerOptions{},
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "mcp-examples.yml")), cfg)
+	require.NoError(t, err)
+
+	combined := codes.GetCombined()
+	assert.Contains(t, combined, "func mcpExample(example string) mcp.PropertyOption {")
+	assert.Contains(t, combined, `mcp.WithObject("body", mcp.Description("Request body"), mcpExample("{\"email\":\"alice@example.com\",\"name\":\"Alice\"}")),`)
+	assert.Contains(t, combined, `mcp.WithObject("body", mcp.Description("Request body"), mcpExample("{\"name\":\"Bob\"}")),`)
+
+	// operations without an example are left as is
+	assert.Contains(t, combined, "tool := mcp.NewTool(\"CreatePet\",\n\t\tmcp.WithDescription(\"CreatePet\"),\n\t\tmcp.WithObject(\"body\", mcp.Description(\"Request body\")),")
+}
+
+func TestTypesOnly(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testtypes",
+		Output: &Output{
+			UseSingleFile: false,
+		},
+		Generate: &GenerateOptions{},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "types-only.yml")), cfg)
+	require.NoError(t, err)
+
+	var files []string
+	for name, code := range codes {
+		files = append(files, name)
+		_, err := format.Source([]byte(code))
+		require.NoError(t, err, name)
+		assert.NotContains(t, code, "ClientInterface", name)
+		assert.NotContains(t, code, "ServiceInterface", name)
+		assert.NotContains(t, code, "MCPTools", name)
+	}
+	assert.ElementsMatch(t, []string{"common", "types", "enums", "unions", "paths", "responses"}, files)
+
+	assert.Contains(t, codes["types"], "type Pet struct {")
+	assert.Contains(t, codes["enums"], "type PetKind string")
+	assert.Contains(t, codes["unions"], "runtime.Either[Person, Company]")
+}
+
+func TestClientHedging(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testhedging",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+		Client: &Client{
+			Name: "Client",
+			Hedging: &ClientHedging{
+				Delay:        50 * time.Millisecond,
+				OperationIDs: []string{"getCharge"},
+			},
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "hedging.yml")), cfg)
+	require.NoError(t, err)
+
+	combined := codes.GetCombined()
+	_, err = format.Source([]byte(combined))
+	require.NoError(t, err)
+
+	assert.Contains(t, combined, "var ClientHedgePolicy = runtime.HedgePolicy{")
+	assert.Contains(t, combined, "Delay:         50 * time.Millisecond,")
+	assert.Contains(t, combined, "MaxConcurrent: 2,")
+	assert.Equal(t, 1, strings.Count(combined, "runtime.Hedge(ctx, ClientHedgePolicy"))
+
+	getCharge := combined[strings.Index(combined, "func (c *Client) GetCharge("):]
+	assert.Contains(t, getCharge[:strings.Index(getCharge, "\n}\n")], "runtime.Hedge(ctx, ClientHedgePolicy")
+}
+
+func TestClientBatch(t *testing.T) {
+	generate := func(t *testing.T, batch *ClientBatch) (string, error) {
+		t.Helper()
+		cfg := Configuration{
+			PackageName: "testbatch",
+			Output: &Output{
+				UseSingleFile: true,
+			},
+			Generate: &GenerateOptions{
+				Client: true,
+			},
+			Client: &Client{
+				Name:  "Client",
+				Batch: batch,
+			},
+		}
+
+		codes, err := Generate([]byte(readTestdata(t, "client-batch.yml")), cfg)
+		if err != nil {
+			return "", err
+		}
+		combined := codes.GetCombined()
+		_, err = format.Source([]byte(combined))
+		require.NoError(t, err)
+		return combined, nil
+	}
+
+	t.Run("batch operation", func(t *testing.T) {
+		combined, err := generate(t, &ClientBatch{OperationID: "batch", Request: "SubRequest", Response: "SubResponse"})
+		require.NoError(t, err)
+
+		assert.Contains(t, combined, "func (c *Client) GetUserBatchCall(options *GetUserRequestOptions, reqEditors ...runtime.RequestEditorFn) *runtime.BatchCall[GetUserResponse] {")
+		assert.Contains(t, combined, "return NewClient(apiClient).CreateUser(ctx, options, reqEditors...)")
+		assert.NotContains(t, combined, "BatchBatchCall")
+
+		// The batch operation is named Batch.
+		assert.Contains(t, combined, "func (c *Client) SendBatch(ctx context.Context, options *BatchRequestOptions, calls ...runtime.BatchCaller) error {")
+		assert.Contains(t, combined, `runtime.ConvertBatchItems(subRequests, "requests", opts.Body)`)
+		assert.Contains(t, combined, `return runtime.BatchItems(resp, "responses")`)
+		assert.Regexp(t, `ID:\s+"id",\s+Method:\s+"method",\s+Path:\s+"url",\s+Headers:\s+"headers",\s+Body:\s+"body",\s+Status:\s+"status",`, combined)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		combined, err := generate(t, nil)
+		require.NoError(t, err)
+		assert.NotContains(t, combined, "BatchCall")
+	})
+
+	t.Run("envelope not found", func(t *testing.T) {
+		_, err := generate(t, &ClientBatch{OperationID: "batch", Request: "User", Response: "SubResponse"})
+		require.ErrorContains(t, err, "the request body of batch operation 'batch' has no array of User")
+	})
+
+	t.Run("unknown operation", func(t *testing.T) {
+		_, err := generate(t, &ClientBatch{OperationID: "bulk", Request: "SubRequest", Response: "SubResponse"})
+		require.ErrorContains(t, err, "batch operation 'bulk' not found")
+	})
+}
+
+func TestProblemDetails(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testproblems",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client:         true,
+			ProblemDetails: true,
+		},
+		Client: &Client{
+			Name: "Client",
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "problem-details.yml")), cfg)
+	require.NoError(t, err)
+
+	combined := codes.GetCombined()
+	_, err = format.Source([]byte(combined))
+	require.NoError(t, err)
+
+	assert.Contains(t, combined, "type GetPetErrorResponse = runtime.ProblemDetails")
+	assert.Contains(t, combined, "type GetPetErrorResponse500 = runtime.ProblemDetails")
+	assert.Contains(t, combined, "type CreatePetErrorResponse = runtime.ProblemDetails")
+	assert.Equal(t, 2, strings.Count(combined, "return nil, runtime.NewClientAPIError(target, runtime.WithStatusCode(resp.StatusCode))"))
+	assert.NotContains(t, combined, "func (s GetPetErrorResponse) Error() string")
+
+	t.Run("disabled", func(t *testing.T) {
+		cfg.Generate.ProblemDetails = false
+		codes, err := Generate([]byte(readTestdata(t, "problem-details.yml")), cfg)
+		require.NoError(t, err)
+		assert.NotContains(t, codes.GetCombined(), "runtime.ProblemDetails")
+	})
+}
+
+func TestConstraintComments(t *testing.T) {
+	generate := func(t *testing.T, enabled bool) string {
+		cfg := Configuration{
+			PackageName: "testconstraints",
+			SkipPrune:   true,
+			Output: &Output{
+				UseSingleFile: true,
+			},
+			Generate: &GenerateOptions{
+				ConstraintComments: enabled,
+			},
+		}
+		codes, err := Generate([]byte(readTestdata(t, "constraint-comments.yml")), cfg)
+		require.NoError(t, err)
+
+		combined := codes.GetCombined()
+		_, err = format.Source([]byte(combined))
+		require.NoError(t, err)
+		return combined
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+		combined := generate(t, true)
+		assert.Contains(t, combined, "// Name The name of the user.\n\t// Constraints: required, minLength=3, maxLength=20, pattern=^[a-z]+$\n")
+		assert.Contains(t, combined, "// Age constraints: required, minimum=3, exclusiveMaximum=150\n")
+		assert.Contains(t, combined, "// Tags constraints: minItems=1, uniqueItems\n")
+		assert.Contains(t, combined, "// Channel constraints: enum=[mobile app, web]\n")
+		assert.NotContains(t, combined, "// Nickname")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		combined := generate(t, false)
+		assert.Contains(t, combined, "// Name The name of the user.\n")
+		assert.NotContains(t, combined, "onstraints:")
+	})
+}
+
+func TestEnumStyle(t *testing.T) {
+	generate := func(t *testing.T, style EnumStyle) string {
+		cfg := Configuration{
+			PackageName: "testenums",
+			Output: &Output{
+				UseSingleFile: true,
+			},
+			Generate: &GenerateOptions{
+				EnumStyle: style,
+			},
+		}
+		codes, err := Generate([]byte(readTestdata(t, "enum-style.yml")), cfg)
+		require.NoError(t, err)
+
+		combined := codes.GetCombined()
+		_, err = format.Source([]byte(combined))
+		require.NoError(t, err)
+		return combined
+	}
+
+	t.Run("closed", func(t *testing.T) {
+		combined := generate(t, EnumStyleClosed)
+		assert.Contains(t, combined, `OrderStatus = "shipped"`)
+		assert.Contains(t, combined, `must be a valid OrderStatus value`)
+		assert.NotContains(t, combined, "IsValid() bool")
+	})
+
+	t.Run("open", func(t *testing.T) {
+		combined := generate(t, EnumStyleOpen)
+		assert.Contains(t, combined, "// OrderStatus is an open enum, any value is accepted. The known values are:")
+		assert.Contains(t, combined, `//   - "shipped"`)
+		assert.Contains(t, combined, "type OrderStatus string")
+		assert.Contains(t, combined, `case "delivered", "pending", "shipped":`)
+		assert.Contains(t, combined, "case 1, 2, 3:")
+		assert.Contains(t, combined, `case "mobile app", "web":`)
+		assert.NotContains(t, combined, `OrderStatus = "shipped"`)
+		assert.NotContains(t, combined, "must be a valid")
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		combined := generate(t, EnumStyleStrict)
+		assert.Contains(t, combined, `OrderStatus = "shipped"`)
+		assert.Contains(t, combined, "func (o OrderStatus) IsValid() bool {")
+		assert.Contains(t, combined, "func (o *OrderStatus) UnmarshalJSON(data []byte) error {")
+		assert.Contains(t, combined, "runtime.ErrUnknownEnumValue")
+		assert.Contains(t, combined, `"delivered, pending, shipped"`)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		cfg := Configuration{PackageName: "testenums", Generate: &GenerateOptions{EnumStyle: "ajar"}}
+		_, err := Generate([]byte(readTestdata(t, "enum-style.yml")), cfg)
+		require.ErrorIs(t, err, ErrEnumStyleUnsupported)
+	})
+}
+
+func TestClientFilterBuilders(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testfilters",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+		Client: &Client{
+			Name:           "Client",
+			FilterBuilders: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "filter-builders.yml")), cfg)
+	require.NoError(t, err)
+
+	combined := codes.GetCombined()
+	_, err = format.Source([]byte(combined))
+	require.NoError(t, err)
+
+	assert.Contains(t, combined, "func NewListInvoicesFilterBuilder() *ListInvoicesFilterBuilder {")
+	assert.Contains(t, combined, "func (b *ListInvoicesFilterBuilder) Status(values ...InvoiceFilterStatus) *ListInvoicesFilterBuilder {")
+	assert.Contains(t, combined, "func (b *ListInvoicesFilterBuilder) AmountGte(value int) *ListInvoicesFilterBuilder {")
+	assert.Contains(t, combined, "func (b *ListInvoicesFilterBuilder) Build() *InvoiceFilter {")
+	assert.Contains(t, combined, `"filter": {Style: "deepObject"},`)
+	assert.Contains(t, combined, "func (b *ListPaymentsFilterBuilder) Currency(value string) *ListPaymentsFilterBuilder {")
+	assert.NotContains(t, combined, "LimitBuilder")
+
+	t.Run("disabled", func(t *testing.T) {
+		cfg.Client.FilterBuilders = false
+		codes, err := Generate([]byte(readTestdata(t, "filter-builders.yml")), cfg)
+		require.NoError(t, err)
+		assert.NotContains(t, codes.GetCombined(), "FilterBuilder")
+	})
+}
+
+func TestTrimTypePrefix(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testtrim",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client:         true,
+			TrimTypePrefix: "Billing",
+		},
+	}
+
+	t.Run("trims component names", func(t *testing.T) {
+		codes, err := Generate([]byte(readTestdata(t, "trim-type-prefix.yml")), cfg)
+		require.NoError(t, err)
+
+		combined := codes.GetCombined()
+		_, err = format.Source([]byte(combined))
+		require.NoError(t, err)
+
+		assert.Contains(t, combined, "type Invoice struct {")
+		assert.Contains(t, combined, "type Customer struct {")
+		assert.Contains(t, combined, "type Tier string")
+		assert.Contains(t, combined, "type InvoiceID = string")
+		assert.Contains(t, combined, "type Error struct {")
+		assert.Contains(t, combined, "type GetInvoiceResponse = Invoice")
+		assert.Contains(t, combined, "ID InvoiceID `json:\"id\"")
+		assert.Contains(t, combined, "Customer Customer")
+		assert.Contains(t, combined, "Parent   *Invoice")
+		assert.NotContains(t, combined, "type BillingInvoice struct")
+		assert.NotContains(t, combined, "type BillingCustomer struct")
+
+		// A name equal to the prefix is kept.
+		assert.Contains(t, combined, "type Billing struct {")
+	})
+
+	t.Run("collision", func(t *testing.T) {
+		_, err := Generate([]byte(readTestdata(t, "trim-type-prefix-collision.yml")), cfg)
+		require.ErrorIs(t, err, ErrTrimmedTypeNameCollision)
+		assert.Contains(t, err.Error(), "components/schemas/Invoice and components/schemas/BillingInvoice")
+	})
+}
+
+func TestResponseHeaderValidation(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testheaders",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Handler: &HandlerOptions{
+				Kind: HandlerKindStdHTTP,
+				Validation: HandlerValidation{
+					Response: true,
+				},
+			},
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "response-headers.yml")), cfg)
+	require.NoError(t, err)
+
+	combined := codes.GetCombined()
+	assert.Contains(t, combined, "if resp == nil || resp.Status == 0 || resp.Status == 201 {")
+	assert.Contains(t, combined, `runtime.ValidateResponseHeader[string](respHeaders, "Location", true)`)
+	assert.Contains(t, combined, `runtime.ValidateResponseHeader[int](respHeaders, "X-Rate-Limit", true)`)
+	assert.Contains(t, combined, `runtime.ValidateResponseHeader[string](respHeaders, "X-Request-Id", false, "uuid")`)
+
+	// headers are not checked without response validation
+	cfg.Generate.Handler.Validation.Response = false
+	codes, err = Generate([]byte(readTestdata(t, "response-headers.yml")), cfg)
+	require.NoError(t, err)
+	assert.NotContains(t, codes.GetCombined(), "ValidateResponseHeader")
+}
+
+func TestResponseLinks(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testlinks",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "links.yml")), cfg)
+	require.NoError(t, err)
+
+	combined := codes.GetCombined()
+	assert.Contains(t, combined, `// The id value returned in the response can be used as the userId parameter in GET /users/{userId}.
+func CreateUserLinkGetUserByUserID(resp *CreateUserResponse) (*GetUserRequestOptions, error) {`)
+	assert.Contains(t, combined, `if pathParamsValues["userId"], err = runtime.ResolveJSONPointer(resp, "/id"); err != nil {`)
+
+	// operationRef, constant and header expressions
+	assert.Contains(t, combined, "func CreateUserLinkListUserItems(resp *CreateUserResponse, header http.Header) (*ListUserItemsRequestOptions, error) {")
+	assert.Contains(t, combined, `queryValues["limit"] = "10"`)
+	assert.Contains(t, combined, `if headerValues["X-Request-Id"], err = runtime.ResolveLinkHeader(header, "X-Request-Id"); err != nil {`)
+	assert.Contains(t, combined, "if opts.Header, err = runtime.ConvertLinkParams[ListUserItemsHeaders](headerValues); err != nil {")
+	assert.NotContains(t, combined, `"unknown"`)
+
+	// $request expressions are not available to the client
+	assert.NotContains(t, combined, "CreateUserLinkUnsupported")
+}
+
+func TestCallbacks(t *testing.T) {
+	t.Run("generates receiver", func(t *testing.T) {
+		cfg := Configuration{
+			PackageName: "testcallbacks",
+			Output: &Output{
+				UseSingleFile: true,
+			},
+			Generate: &GenerateOptions{
+				Callbacks: true,
+			},
+		}
+
+		codes, err := Generate([]byte(readTestdata(t, "callbacks.yml")), cfg)
+		require.NoError(t, err)
+
+		combined := codes.GetCombined()
+		assert.Contains(t, combined, `type CallbacksInterface interface {
+	// SubscribeOnEvent handles the onEvent callback of Subscribe.
+	//
+	// Delivers an event to the subscriber.
+	SubscribeOnEvent(ctx context.Context, body *SubscribeOnEventBody) error
+	// PingSubscriber handles the onPing callback of Subscribe.
+	PingSubscriber(ctx context.Context) error
+}`)
+		assert.Contains(t, combined, "type SubscribeOnEventBody = Event")
+		assert.Contains(t, combined, "type Event struct {")
+		assert.Contains(t, combined, "func (rcv *CallbackReceiver) SubscribeOnEvent(w http.ResponseWriter, r *http.Request) {")
+		assert.Contains(t, combined, "if v, ok := any(&body).(runtime.Validator); ok {")
+		assert.Contains(t, combined, "w.WriteHeader(202)")
+		assert.Contains(t, combined, "w.WriteHeader(204)")
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		cfg := Configuration{
+			PackageName: "testcallbacks",
+			Output: &Output{
+				UseSingleFile: true,
+			},
+		}
+
+		codes, err := Generate([]byte(readTestdata(t, "callbacks.yml")), cfg)
+		require.NoError(t, err)
+
+		combined := codes.GetCombined()
+		assert.NotContains(t, combined, "CallbacksInterface")
+		assert.NotContains(t, combined, "type Event struct")
+	})
+}
+
+func TestWebhooks(t *testing.T) {
+	t.Run("generates payloads and receiver", func(t *testing.T) {
+		cfg := Configuration{
+			PackageName: "testwebhooks",
+			Output: &Output{
+				UseSingleFile: true,
+			},
+			Generate: &GenerateOptions{
+				Webhooks: true,
+			},
+		}
+
+		codes, err := Generate([]byte(readTestdata(t, "webhooks.yml")), cfg)
+		require.NoError(t, err)
+
+		combined := codes.GetCombined()
+		assert.Contains(t, combined, "type NewPetBody = Pet")
+		assert.Contains(t, combined, "type Pet struct {")
+		assert.Contains(t, combined, `type OnPetRemovedBody struct {
+	ID int64 `+"`"+`json:"id" validate:"required"`+"`"+`
+}`)
+		assert.Contains(t, combined, `type WebhooksInterface interface {
+	// NewPet handles the newPet webhook.
+	//
+	// A new pet was added to the store.
+	NewPet(ctx context.Context, body *NewPetBody) error
+	// OnPetRemoved handles the petRemoved webhook.
+	OnPetRemoved(ctx context.Context, body *OnPetRemovedBody) error
+}`)
+		assert.Contains(t, combined, "func NewWebhookReceiver(svc WebhooksInterface, errHandler WebhookErrorHandler) *WebhookReceiver {")
+		assert.Contains(t, combined, "func (rcv *WebhookReceiver) NewPet(w http.ResponseWriter, r *http.Request) {")
+		assert.Contains(t, combined, "if err := rcv.svc.OnPetRemoved(r.Context(), bodyPtr); err != nil {")
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		cfg := Configuration{
+			PackageName: "testwebhooks",
+			Output: &Output{
+				UseSingleFile: true,
+			},
+		}
+
+		codes, err := Generate([]byte(readTestdata(t, "webhooks.yml")), cfg)
+		require.NoError(t, err)
+
+		combined := codes.GetCombined()
+		assert.NotContains(t, combined, "WebhooksInterface")
+		assert.NotContains(t, combined, "type Pet struct")
+	})
+}
+
+func TestIntTypeByFormat(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testints",
+		SkipPrune:   true,
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			DefaultIntType: "int32",
+			IntTypeByFormat: map[string]string{
+				"timestamp": "int64",
+			},
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "int-formats.yml")), cfg)
+	require.NoError(t, err)
+
+	assert.Contains(t, codes.GetCombined(), `type Event struct {
+	Count     *int32 `+"`"+`json:"count,omitempty"`+"`"+`
+	Small     *int32 `+"`"+`json:"small,omitempty"`+"`"+`
+	Big       *int64 `+"`"+`json:"big,omitempty"`+"`"+`
+	CreatedAt *int64 `+"`"+`json:"createdAt,omitempty"`+"`"+`
+	Version   *int32 `+"`"+`json:"version,omitempty"`+"`"+`
+}`)
+}
+
+func TestFreeFormObjects(t *testing.T) {
+	tests := []struct {
+		kind     FreeFormObjectType
+		expected string
+	}{
+		{
+			kind: "",
+			expected: `type Metadata = map[string]any
+
+type Empty struct{}
+
+type Event struct {
+	Name    string         ` + "`json:\"name\" validate:\"required\"`" + `
+	Payload map[string]any ` + "`json:\"payload,omitempty\"`" + `
+	Marker  *struct{}      ` + "`json:\"marker,omitempty\"`" + `
+}`,
+		},
+		{
+			kind: FreeFormObjectRawMessage,
+			expected: `type Metadata = json.RawMessage
+
+type Empty struct{}
+
+type Event struct {
+	Name    string          ` + "`json:\"name\" validate:\"required\"`" + `
+	Payload json.RawMessage ` + "`json:\"payload\"`" + `
+	Marker  *struct{}       ` + "`json:\"marker,omitempty\"`" + `
+}`,
+		},
+		{
+			kind: FreeFormObjectAny,
+			expected: `type Metadata = any
+
+type Empty struct{}
+
+type Event struct {
+	Name    string    ` + "`json:\"name\" validate:\"required\"`" + `
+	Payload any       ` + "`json:\"payload\"`" + `
+	Marker  *struct{} ` + "`json:\"marker,omitempty\"`" + `
+}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(string(tc.kind), func(t *testing.T) {
+			cfg := Configuration{
+				PackageName: "testfreeform",
+				SkipPrune:   true,
+				Output: &Output{
+					UseSingleFile: true,
+				},
+				Generate: &GenerateOptions{
+					FreeFormObjectType: tc.kind,
+				},
+			}
+
+			codes, err := Generate([]byte(readTestdata(t, "free-form-objects.yml")), cfg)
+			require.NoError(t, err)
+
+			combined := codes.GetCombined()
+			assert.Contains(t, combined, tc.expected)
+			// free-form values have nothing to validate beyond the struct tags
+			assert.Contains(t, combined, `func (e Event) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(e))
+}`)
+		})
+	}
+
+	t.Run("unsupported", func(t *testing.T) {
+		cfg := Configuration{
+			PackageName: "testfreeform",
+			Generate: &GenerateOptions{
+				FreeFormObjectType: "struct",
+			},
+		}
+
+		_, err := Generate([]byte(readTestdata(t, "free-form-objects.yml")), cfg)
+		require.ErrorIs(t, err, ErrFreeFormObjectTypeUnsupported)
+	})
+}
+
+func TestDecimalType(t *testing.T) {
+	tests := []struct {
+		kind     DecimalType
+		expected string
+	}{
+		{
+			kind: "",
+			expected: `type Payment struct {
+	Amount    string   ` + "`json:\"amount\" validate:\"required,max=20\"`" + `
+	Fee       *float64 ` + "`json:\"fee,omitempty\" validate:\"omitempty,lte=100\"`" + `
+	Reference *string  ` + "`json:\"reference,omitempty\"`" + `
+}`,
+		},
+		{
+			kind: DecimalTypeShopspring,
+			expected: `type Payment struct {
+	Amount    decimal.Decimal  ` + "`json:\"amount\" validate:\"required,gte=0\"`" + `
+	Fee       *decimal.Decimal ` + "`json:\"fee,omitempty\" validate:\"omitempty,lte=100\"`" + `
+	Reference *string          ` + "`json:\"reference,omitempty\"`" + `
+}`,
+		},
+		{
+			kind: DecimalTypeBigRat,
+			expected: `type Payment struct {
+	Amount    runtime.DecimalString ` + "`json:\"amount\" validate:\"required,gte=0\"`" + `
+	Fee       *runtime.Decimal      ` + "`json:\"fee,omitempty\" validate:\"omitempty,lte=100\"`" + `
+	Reference *string               ` + "`json:\"reference,omitempty\"`" + `
+}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(string(tc.kind), func(t *testing.T) {
+			cfg := Configuration{
+				PackageName: "testdecimal",
+				SkipPrune:   true,
+				Output: &Output{
+					UseSingleFile: true,
+				},
+				Generate: &GenerateOptions{
+					DecimalType: tc.kind,
+				},
+			}
+
+			codes, err := Generate([]byte(readTestdata(t, "decimal-formats.yml")), cfg)
+			require.NoError(t, err)
+
+			combined := codes.GetCombined()
+			assert.Contains(t, combined, tc.expected)
+			// decimals are validated with their validation tags
+			assert.Contains(t, combined, `func (p Payment) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(p))
+}`)
+
+			switch tc.kind {
+			case DecimalTypeShopspring:
+				assert.Contains(t, combined, `"github.com/shopspring/decimal"`)
+				assert.Contains(t, combined, `}, decimal.Decimal{})`)
+			case DecimalTypeBigRat:
+				assert.Contains(t, combined, `runtime.RegisterDecimalTypeFunc(typesValidator)`)
+			default:
+				assert.NotContains(t, combined, "decimal.Decimal")
+			}
+		})
+	}
+
+	t.Run("unsupported", func(t *testing.T) {
+		cfg := Configuration{
+			PackageName: "testdecimal",
+			Generate: &GenerateOptions{
+				DecimalType: "apd",
+			},
+		}
+
+		_, err := Generate([]byte(readTestdata(t, "decimal-formats.yml")), cfg)
+		require.ErrorIs(t, err, ErrDecimalTypeUnsupported)
+	})
+}
+
+func TestOptionalType(t *testing.T) {
+	generate := func(t *testing.T, kind OptionalType) string {
+		t.Helper()
+		cfg := Configuration{
+			PackageName: "testoptional",
+			SkipPrune:   true,
+			Output: &Output{
+				UseSingleFile: true,
+			},
+			Generate: &GenerateOptions{
+				OptionalType: kind,
+			},
+		}
+
+		codes, err := Generate([]byte(readTestdata(t, "optional-type.yml")), cfg)
+		require.NoError(t, err)
+		return codes.GetCombined()
+	}
+
+	t.Run("pointer by default", func(t *testing.T) {
+		combined := generate(t, "")
+
+		assert.Contains(t, combined, `	Name    *string  `+"`json:\"name,omitempty\" validate:\"omitempty,min=1\"`")
+		assert.NotContains(t, combined, "runtime.Optional")
+	})
+
+	t.Run("generic", func(t *testing.T) {
+		combined := generate(t, OptionalTypeGeneric)
+
+		assert.Contains(t, combined, `type Node struct {
+	ID      string                    `+"`json:\"id\" validate:\"required\"`"+`
+	Name    runtime.Optional[string]  `+"`json:\"name,omitzero\"`"+`
+	Tags    []string                  `+"`json:\"tags,omitempty\"`"+`
+	Address runtime.Optional[Address] `+"`json:\"address,omitzero\"`"+`
+	Next    *Node                     `+"`json:\"next,omitempty\"`"+`
+}`)
+		assert.Contains(t, combined, `	if v, ok := n.Name.Get(); ok {
+		if err := typesValidator.Var(v, "omitempty,min=1"); err != nil {
+			errors = errors.Append("Name", err)
+		}
+	}`)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		cfg := Configuration{
+			PackageName: "testoptional",
+			Generate: &GenerateOptions{
+				OptionalType: "nullable",
+			},
+		}
+
+		_, err := Generate([]byte(readTestdata(t, "optional-type.yml")), cfg)
+		require.ErrorIs(t, err, ErrOptionalTypeUnsupported)
+	})
+}
+
+func TestMergePatch(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testmergepatch",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client:     true,
+			MergePatch: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "merge-patch.yml")), cfg)
+	require.NoError(t, err)
+	combined := codes.GetCombined()
+
+	t.Run("body uses patch type", func(t *testing.T) {
+		assert.Contains(t, combined, "type UpdateUserBody = UserPatch")
+	})
+
+	t.Run("patch type", func(t *testing.T) {
+		assert.Contains(t, combined, `type UserPatch struct {
+	Name     runtime.Optional[string]       `+"`json:\"name,omitzero\"`"+`
+	Nickname runtime.Optional[string]       `+"`json:\"nickname,omitzero\"`"+`
+	Age      runtime.Optional[int]          `+"`json:\"age,omitzero\"`"+`
+	Tags     runtime.Optional[[]string]     `+"`json:\"tags,omitzero\"`"+`
+	Address  runtime.Optional[AddressPatch] `+"`json:\"address,omitzero\"`"+`
+}`)
+		assert.Contains(t, combined, "type AddressPatch struct {")
+		assert.Contains(t, combined, `	if v, ok := u.Name.Get(); ok {
+		if err := typesValidator.Var(v, "min=1"); err != nil {`)
+	})
+
+	t.Run("marshal and apply", func(t *testing.T) {
+		assert.Contains(t, combined, `	if u.Nickname.Present() {
+		object["nickname"], err = json.Marshal(u.Nickname)`)
+		assert.Contains(t, combined, `func (u UserPatch) Apply(base User) (User, error) {
+	return runtime.ApplyMergePatch(base, u)
+}`)
+		assert.Contains(t, combined, "func (a AddressPatch) Apply(base Address) (Address, error) {")
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		codes, err := Generate([]byte(readTestdata(t, "merge-patch.yml")), Configuration{PackageName: "testmergepatch"})
+		require.NoError(t, err)
+		assert.NotContains(t, codes.GetCombined(), "UserPatch")
+	})
+}
+
+func TestJSONPatch(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testjsonpatch",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client:    true,
+			JSONPatch: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "json-patch.yml")), cfg)
+	require.NoError(t, err)
+	combined := codes.GetCombined()
+
+	t.Run("body uses JSON Patch", func(t *testing.T) {
+		assert.Contains(t, combined, "type PatchUserBody = runtime.JSONPatch")
+		assert.Contains(t, combined, "type PatchAddressBody = runtime.JSONPatch")
+	})
+
+	t.Run("builder", func(t *testing.T) {
+		assert.Contains(t, combined, `type UserPatchBuilder struct {
+	builder *runtime.JSONPatchBuilder
+}`)
+		assert.Contains(t, combined, `func (u *UserPatchBuilder) Replace(path string, value any) *UserPatchBuilder {`)
+		assert.Contains(t, combined, "type AddressPatchBuilder struct {")
+	})
+
+	t.Run("paths", func(t *testing.T) {
+		assert.Contains(t, combined, `	userPath.Fields = map[string]*runtime.JSONPatchPath{
+		"name":      {},
+		"tags":      {Items: &runtime.JSONPatchPath{}},
+		"labels":    {Values: &runtime.JSONPatchPath{}},
+		"address":   addressPath,
+		"manager":   userPath,
+		"settings":  userSettingsPath,
+		"createdAt": {},
+		"metadata":  {Any: true},
+	}`)
+		assert.Contains(t, combined, `		"lines": {Items: linePath},`)
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		codes, err := Generate([]byte(readTestdata(t, "json-patch.yml")), Configuration{PackageName: "testjsonpatch"})
+		require.NoError(t, err)
+		assert.NotContains(t, codes.GetCombined(), "PatchBuilder")
+	})
+}
+
+func TestUnionBodyMethods(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testunionbody",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+		Client: &Client{
+			UnionBodyMethods: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "union-body-methods.yml")), cfg)
+	require.NoError(t, err)
+	combined := codes.GetCombined()
+
+	t.Run("oneOf body", func(t *testing.T) {
+		assert.Contains(t, combined, `func (c *Client) CreatePaymentWithCreditCard(ctx context.Context, body CreditCardPayment, options *CreatePaymentRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreatePaymentResponse, error) {
+	var union CreatePaymentBody_OneOf
+	if err := union.FromCreditCardPayment(body); err != nil {
+		return nil, fmt.Errorf("error creating request body: %w", err)
+	}
+
+	opts := CreatePaymentRequestOptions{}
+	if options != nil {
+		opts = *options
+	}
+	opts.Body = &CreatePaymentBody{CreatePaymentBody_OneOf: &union}
+	return c.CreatePayment(ctx, &opts, reqEditors...)
+}`)
+		assert.Contains(t, combined, "func (c *Client) CreatePaymentWithBank(ctx context.Context, body BankPayment,")
+		assert.Contains(t, combined, "func (c *Client) CreatePaymentWithWallet(ctx context.Context, body WalletPayment,")
+	})
+
+	t.Run("either body component", func(t *testing.T) {
+		assert.Contains(t, combined, `	var union RefundMethod_OneOf
+	union.B = body
+	union.N = 2`)
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		cfg.Client = nil
+		codes, err := Generate([]byte(readTestdata(t, "union-body-methods.yml")), cfg)
+		require.NoError(t, err)
+		assert.NotContains(t, codes.GetCombined(), "CreatePaymentWith")
+	})
+}
+
+func TestMultipleSuccessResponses(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "multisuccess",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+	}
+
+	t.Run("generates result type", func(t *testing.T) {
+		codes, err := Generate([]byte(readTestdata(t, "multiple-success.yml")), cfg)
+		require.NoError(t, err)
+
+		combined := codes.GetCombined()
+		assert.Contains(t, combined, "UpsertPet(ctx context.Context, options *UpsertPetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*UpsertPetResult, error)")
+		assert.Contains(t, combined, `type UpsertPetResult struct {
+	StatusCode int `+"`"+`json:"statusCode"`+"`"+`
+
+	// Body200 is set when StatusCode is 200.
+	Body200 *UpsertPetResponse `+"`"+`json:"body200,omitempty"`+"`"+`
+
+	// Body201 is set when StatusCode is 201.
+	Body201 *UpsertPetResponseJSON `+"`"+`json:"body201,omitempty"`+"`"+`
+}`)
+		assert.Contains(t, combined, `		case 201:
+			target := new(UpsertPetResponseJSON)
+			if err = json.Unmarshal(bodyBytes, target); err != nil {
+				return nil, fmt.Errorf("error decoding response: %w", err)
+			}
+			return &UpsertPetResult{StatusCode: resp.StatusCode, Body201: target}, nil
+		case 204:
+			return &UpsertPetResult{StatusCode: resp.StatusCode}, nil
+		}`)
+		assert.Contains(t, combined, "target := new(UpsertPetErrorResponse)")
+	})
+
+	t.Run("single success response", func(t *testing.T) {
+		codes, err := Generate([]byte(readTestdata(t, "links.yml")), cfg)
+		require.NoError(t, err)
+		assert.NotContains(t, codes.GetCombined(), "Result struct {")
+	})
+}
+
+func TestClientBodyEditors(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "bodyeditors",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+		Client: &Client{
+			BodyEditors: true,
+		},
+	}
+
+	t.Run("applies body editors", func(t *testing.T) {
+		codes, err := Generate([]byte(readTestdata(t, "user.yml")), cfg)
+		require.NoError(t, err)
+
+		combined := codes.GetCombined()
+		assert.Contains(t, combined, `	if options != nil && options.Body != nil {
+		if editor, ok := c.apiClient.(runtime.BodyEditor); ok {
+			if err = editor.EditBody(ctx, options.Body); err != nil {
+				return nil, fmt.Errorf("error editing request body: %w", err)
+			}
+		}
+	}`)
+		assert.Contains(t, combined, `func PatchUserBodyEditor(fn func(ctx context.Context, body *PatchUserBody) error) runtime.BodyEditorFn {
+	return func(ctx context.Context, body any) error {
+		if b, ok := body.(*PatchUserBody); ok {
+			return fn(ctx, b)
+		}
+		return nil
+	}
+}`)
+		assert.NotContains(t, combined, "func GetUserBodyEditor(")
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		cfg := cfg
+		cfg.Client = nil
+
+		codes, err := Generate([]byte(readTestdata(t, "user.yml")), cfg)
+		require.NoError(t, err)
+
+		combined := codes.GetCombined()
+		assert.NotContains(t, combined, "EditBody")
+		assert.NotContains(t, combined, "BodyEditor(")
+	})
+}
+
+func TestTestServer(t *testing.T) {
+	t.Run("implements the service interface", func(t *testing.T) {
+		cfg := Configuration{
+			PackageName: "testserver",
+			Output: &Output{
+				UseSingleFile: true,
+			},
+			Generate: &GenerateOptions{
+				Handler: &HandlerOptions{
+					Kind: HandlerKindStdHTTP,
+				},
+				TestServer: true,
+			},
+		}
+
+		codes, err := Generate([]byte(readTestdata(t, "user.yml")), cfg)
+		require.NoError(t, err)
+
+		combined := codes.GetCombined()
+		assert.Contains(t, combined, `type TestService struct {
+	// GetUserFunc handles GetUser when set.
+	GetUserFunc func(ctx *GetUserContext) (*GetUserResponseData, error)`)
+		assert.Contains(t, combined, "PatchUserFunc func(ctx *PatchUserContext) (*PatchUserResponseData, error)")
+		assert.Contains(t, combined, `func (s *TestService) PatchUser(ctx *PatchUserContext) (*PatchUserResponseData, error) {
+	s.record("PatchUser", ctx.PatchUserServiceRequestOptions)
+	if s.PatchUserFunc != nil {
+		return s.PatchUserFunc(ctx)
+	}
+	return NewPatchUserResponseData(new(PatchUserResponse)), nil
+}`)
+		assert.Contains(t, combined, `s.record("GetUser", nil)`)
+		assert.Contains(t, combined, "func (s *TestService) CallsTo(operation string) []TestServiceCall {")
+		assert.Contains(t, combined, "var _ ServiceInterface = (*TestService)(nil)")
+	})
+
+	t.Run("requires handler", func(t *testing.T) {
+		cfg := Configuration{
+			PackageName: "testserver",
+			Output: &Output{
+				UseSingleFile: true,
+			},
+			Generate: &GenerateOptions{
+				TestServer: true,
+			},
+		}
+
+		_, err := Generate([]byte(readTestdata(t, "user.yml")), cfg)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "test server generation requires handler generation")
+	})
+}
+
+func TestMockHTTPServer(t *testing.T) {
+	t.Run("serves the router", func(t *testing.T) {
+		cfg := Configuration{
+			PackageName: "mockserver",
+			Output: &Output{
+				UseSingleFile: true,
+			},
+			Generate: &GenerateOptions{
+				Handler: &HandlerOptions{
+					Kind: HandlerKindStdHTTP,
+				},
+				MockHTTPServer: true,
+			},
+		}
+
+		codes, err := Generate([]byte(readTestdata(t, "user.yml")), cfg)
+		require.NoError(t, err)
+
+		combined := codes.GetCombined()
+		_, err = format.Source([]byte(combined))
+		require.NoError(t, err)
+
+		assert.Contains(t, combined, `"net/http/httptest"`)
+		assert.Contains(t, combined, `type MockService struct {
+	*httptest.Server`)
+		assert.Contains(t, combined, "router := NewRouter(m, opts...)")
+		assert.Contains(t, combined, `func (m *MockService) PatchUser(ctx *PatchUserContext) (*PatchUserResponseData, error) {
+	m.record(ctx, "PatchUser", ctx.PatchUserServiceRequestOptions)
+	if m.PatchUserFunc != nil {
+		return m.PatchUserFunc(ctx)
+	}
+	return NewPatchUserResponseData(new(PatchUserResponse)), nil
+}`)
+		assert.Contains(t, combined, `m.record(ctx, "GetUser", nil)`)
+		assert.Contains(t, combined, "func (m *MockService) RequestsTo(operation string) []MockServiceRequest {")
+		assert.Contains(t, combined, "var _ ServiceInterface = (*MockService)(nil)")
+		assert.NotContains(t, combined, "type TestService struct")
+	})
+
+	t.Run("requires handler", func(t *testing.T) {
+		cfg := Configuration{
+			PackageName: "mockserver",
+			Output: &Output{
+				UseSingleFile: true,
+			},
+			Generate: &GenerateOptions{
+				MockHTTPServer: true,
+			},
+		}
+
+		_, err := Generate([]byte(readTestdata(t, "user.yml")), cfg)
+		require.ErrorContains(t, err, "mock HTTP server generation requires handler generation")
+	})
+
+	t.Run("serves the router of the engine kinds", func(t *testing.T) {
+		tests := []struct {
+			kind     HandlerKind
+			contains []string
+		}{
+			{
+				kind:     HandlerKindGin,
+				contains: []string{`"github.com/gin-gonic/gin"`, "router := gin.New()\n\tNewRouter(router, m, opts...)"},
+			},
+			{
+				kind:     HandlerKindEcho,
+				contains: []string{`"github.com/labstack/echo/v4"`, "router := echo.New()\n\tNewRouter(router, m, opts...)"},
+			},
+			{
+				kind:     HandlerKindIris,
+				contains: []string{"router := iris.New()\n\tNewRouter(router, m, opts...)", "if err := router.Build(); err != nil {"},
+			},
+			{
+				kind:     HandlerKindGoFrame,
+				contains: []string{"router := Handler(m, opts...)"},
+			},
+			{
+				kind:     HandlerKindBeego,
+				contains: []string{"router := NewRouter(m, opts...)"},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(string(tt.kind), func(t *testing.T) {
+				cfg := Configuration{
+					PackageName: "mockserver",
+					Output: &Output{
+						UseSingleFile: true,
+					},
+					Generate: &GenerateOptions{
+						Handler: &HandlerOptions{
+							Kind: tt.kind,
+						},
+						MockHTTPServer: true,
+					},
+				}
+
+				codes, err := Generate([]byte(readTestdata(t, "user.yml")), cfg)
+				require.NoError(t, err)
+
+				code := codes.GetCombined()
+				_, err = format.Source([]byte(code))
+				require.NoError(t, err)
+				for _, want := range tt.contains {
+					assert.Contains(t, code, want)
+				}
+				assert.Contains(t, code, "router.ServeHTTP(w, r.WithContext(")
+			})
+		}
+	})
+
+	t.Run("requires net/http handler", func(t *testing.T) {
+		for _, kind := range []HandlerKind{HandlerKindFastHTTP, HandlerKindFiber, HandlerKindHertz} {
+			cfg := Configuration{
+				PackageName: "mockserver",
+				Output: &Output{
+					UseSingleFile: true,
+				},
+				Generate: &GenerateOptions{
+					Handler: &HandlerOptions{
+						Kind: kind,
+					},
+					MockHTTPServer: true,
+				},
+			}
+
+			_, err := Generate([]byte(readTestdata(t, "user.yml")), cfg)
+			require.ErrorContains(t, err, "requires a net/http based handler kind, got "+string(kind))
+		}
+	})
+}
+
+func TestOutputFormatting(t *testing.T) {
+	t.Run("imports local prefix", func(t *testing.T) {
+		cfg := Configuration{
+			PackageName: "gen",
+			Output: &Output{
+				UseSingleFile:      true,
+				ImportsLocalPrefix: "github.com/yorunikakeru4",
+			},
+			Generate: &GenerateOptions{
+				Client: true,
+			},
+		}
+
+		codes, err := Generate([]byte(readTestdata(t, "user.yml")), cfg)
+		require.NoError(t, err)
+
+		combined := codes.GetCombined()
+		assert.Contains(t, combined, "\"github.com/go-playground/validator/v10\"\n\n\t\"github.com/yorunikakeru4/oapi-codegen-dd/v3/pkg/runtime\"\n)")
+	})
+
+	t.Run("skip format", func(t *testing.T) {
+		cfg := Configuration{
+			PackageName: "gen",
+			Output: &Output{
+				UseSingleFile: true,
+				SkipFormat:    true,
+			},
+			Generate: &GenerateOptions{
+				Client: true,
+			},
+		}
+
+		codes, err := Generate([]byte(readTestdata(t, "user.yml")), cfg)
+		require.NoError(t, err)
+
+		combined := codes.GetCombined()
+		assert.Contains(t, combined, "func NewClient(apiClient runtime.APIClient) *Client")
+		assert.NotContains(t, combined, "\"sync\"")
+		assert.NotContains(t, combined, "\"github.com/google/uuid\"")
+
+		_, err = format.Source([]byte(combined))
+		require.NoError(t, err)
+	})
+}
+
+func TestClientJSONLibrary(t *testing.T) {
+	generate := func(t *testing.T, library JSONLibrary) (string, error) {
+		t.Helper()
+		cfg := Configuration{
+			PackageName: "gen",
+			Output: &Output{
+				UseSingleFile: true,
+			},
+			Generate: &GenerateOptions{
+				Client: true,
+			},
+			Client: &Client{
+				JSONLibrary: library,
+			},
+		}
+
+		codes, err := Generate([]byte(readTestdata(t, "user.yml")), cfg)
+		if err != nil {
+			return "", err
+		}
+		return codes.GetCombined(), nil
+	}
+
+	t.Run("stdlib by default", func(t *testing.T) {
+		combined, err := generate(t, "")
+		require.NoError(t, err)
+
+		assert.Contains(t, combined, "if err = json.Unmarshal(bodyBytes, target); err != nil {")
+		assert.NotContains(t, combined, "clientJSON")
+	})
+
+	t.Run("gojson", func(t *testing.T) {
+		combined, err := generate(t, JSONLibraryGoJSON)
+		require.NoError(t, err)
+
+		assert.Contains(t, combined, `gojson "github.com/goccy/go-json"`)
+		assert.Contains(t, combined, "var clientJSON runtime.JSONCodec = clientJSONCodec{}")
+		assert.Contains(t, combined, "return gojson.Unmarshal(data, v)")
+		assert.Contains(t, combined, "opts = append([]runtime.APIClientOption{runtime.WithJSONCodec(clientJSON)}, opts...)")
+		assert.Contains(t, combined, "if err = clientJSON.Unmarshal(bodyBytes, target); err != nil {")
+		assert.Contains(t, combined, "err = clientJSON.Unmarshal(bodyBytes, target)")
+		assert.NotContains(t, combined, "json.Unmarshal(bodyBytes, target)")
+	})
+
+	t.Run("jsoniter", func(t *testing.T) {
+		combined, err := generate(t, JSONLibraryJSONIter)
+		require.NoError(t, err)
+
+		assert.Contains(t, combined, `jsoniter "github.com/json-iterator/go"`)
+		assert.Contains(t, combined, "return jsoniter.ConfigCompatibleWithStandardLibrary.Marshal(v)")
+		assert.Contains(t, combined, "if err = clientJSON.Unmarshal(bodyBytes, target); err != nil {")
+	})
+
+	t.Run("unsupported library", func(t *testing.T) {
+		_, err := generate(t, "easyjson")
+		require.ErrorIs(t, err, ErrJSONLibraryUnsupported)
+	})
+}
+
+func TestClientEmbedHTTPClient(t *testing.T) {
+	generate := func(t *testing.T, client *Client) string {
+		t.Helper()
+		cfg := Configuration{
+			PackageName: "gen",
+			Output: &Output{
+				UseSingleFile: true,
+			},
+			Generate: &GenerateOptions{
+				Client: true,
+			},
+			Client: client,
+		}
+
+		codes, err := Generate([]byte(readTestdata(t, "user.yml")), cfg)
+		require.NoError(t, err)
+		return codes.GetCombined()
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		combined := generate(t, nil)
+
+		assert.NotContains(t, combined, "runtime.HTTPClientDoer")
+		assert.NotContains(t, combined, "func NewClientWithHTTPClient(")
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		combined := generate(t, &Client{EmbedHTTPClient: true, Timeout: 10 * time.Second})
+
+		assert.Contains(t, combined, "// Requests are sent with an http.Client with a 10s timeout, unless opts set another one.")
+		assert.Contains(t, combined, "Client: &http.Client{Timeout: 10000 * time.Millisecond},")
+		assert.Contains(t, combined, "func NewClientWithHTTPClient(baseURL string, httpClient *http.Client, opts ...runtime.APIClientOption) (*Client, error) {")
+		assert.Contains(t, combined, "return NewDefaultClient(baseURL, append(opts, runtime.WithHTTPClient(runtime.HTTPClientDoer{Client: httpClient}))...)")
+	})
+}
+
+func TestClientDocComments(t *testing.T) {
+	generate := func(t *testing.T, docComments bool) string {
+		t.Helper()
+		cfg := Configuration{
+			PackageName: "gen",
+			Output: &Output{
+				UseSingleFile: true,
+			},
+			Generate: &GenerateOptions{
+				Client: true,
+			},
+			Client: &Client{
+				DocComments: docComments,
+			},
+		}
+
+		codes, err := Generate([]byte(readTestdata(t, "train-travel-api.yml")), cfg)
+		require.NoError(t, err)
+		return codes.GetCombined()
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		combined := generate(t, false)
+
+		assert.NotContains(t, combined, "// Operations by tag:")
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		combined := generate(t, true)
+
+		assert.Contains(t, combined, `// Client is the client for the API implementing the Client interface.
+//
+// Operations by tag:
+//
+// Stations:
+//   - [Client.GetStations]: Get a list of train stations
+//`)
+		assert.Contains(t, combined, `// Bookings:
+//   - [Client.GetBookings]: List existing bookings
+//   - [Client.CreateBooking]: Create a booking`)
+		assert.Contains(t, combined, `//   - [Client.CreateBookingPayment]: Pay for a Booking
+type Client struct {`)
+	})
+}
+
+func TestValidationMessageExtension(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "gen",
+		SkipPrune:   true,
+		Output: &Output{
+			UseSingleFile: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "validation-message.yml")), cfg)
+	require.NoError(t, err)
+
+	combined := codes.GetCombined()
+	_, err = format.Source([]byte(combined))
+	require.NoError(t, err)
+
+	assert.Contains(t, combined, `return runtime.NewValidationError("Array", "please add at least 1 tag")`)
+	assert.NotContains(t, combined, "must have at least 1 items")
+
+	assert.Contains(t, combined, `errors = errors.Add("Map", "between 2 and 4 labels are allowed (limit: 2)")`)
+	assert.Contains(t, combined, `errors = errors.Add("Map", "between 2 and 4 labels are allowed (limit: 4)")`)
+}
+
+func TestValidationContext(t *testing.T) {
+	generate := func(t *testing.T, validation ValidationOptions) string {
+		t.Helper()
+		cfg := Configuration{
+			PackageName: "gen",
+			SkipPrune:   true,
+			Output: &Output{
+				UseSingleFile: true,
+			},
+			Generate: &GenerateOptions{
+				Validation: validation,
+			},
+		}
+
+		codes, err := Generate([]byte(readTestdata(t, "validation-context.yml")), cfg)
+		require.NoError(t, err)
+		return codes.GetCombined()
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		combined := generate(t, ValidationOptions{})
+
+		assert.Contains(t, combined, "func (i Items) Validate() error {")
+		assert.NotContains(t, combined, "ValidateContext")
+	})
+
+	t.Run("checks the context in loops", func(t *testing.T) {
+		combined := generate(t, ValidationOptions{Context: true})
+
+		assert.Contains(t, combined, "func (i Items) ValidateContext(ctx context.Context) error {")
+		assert.Contains(t, combined, "if err := runtime.CheckValidationContext(ctx, i); err != nil {")
+		assert.Contains(t, combined, "if err := runtime.ValidateContext(ctx, item); err != nil {")
+		assert.Contains(t, combined, "if err := runtime.CheckValidationContext(ctx, nByName); err != nil {")
+		assert.Contains(t, combined, "if err := runtime.ValidateContext(ctx, c.Featured); err != nil {")
+
+		// Types without loops check the context once.
+		assert.Contains(t, combined, "func (i Item) ValidateContext(ctx context.Context) error {\n\tif err := ctx.Err(); err != nil {\n\t\treturn err\n\t}\n\treturn i.Validate()\n}")
+	})
+}
+
+func TestValidationConcurrent(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "gen",
+		SkipPrune:   true,
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Validation: ValidationOptions{Concurrent: true},
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "validation-context.yml")), cfg)
+	require.NoError(t, err)
+	combined := codes.GetCombined()
+
+	_, err = format.Source([]byte(combined))
+	require.NoError(t, err)
+
+	// The index of the closure doesn't shadow the receiver of Items.
+	assert.Contains(t, combined, "for i, err := range runtime.ValidateConcurrently(len(i), func(idx int) error {\n\t\tif v, ok := any(i[idx]).(runtime.Validator); ok {")
+	assert.Contains(t, combined, "return typesValidator.Var(c[idx], \"omitempty,min=2\")")
+	assert.Contains(t, combined, "runtime.ValidateConcurrently(len(c.Items), func(idx int) error {")
+	assert.Contains(t, combined, "errors = errors.Append(fmt.Sprintf(\"Items[%d]\", i), err)")
+
+	// Maps are validated sequentially.
+	assert.Contains(t, combined, "for k, v := range c.ByName {")
+}
+
+func TestValidationAssertions(t *testing.T) {
+	generate := func(t *testing.T, spec string, assertions bool) string {
+		t.Helper()
+		cfg := Configuration{
+			PackageName: "gen",
+			SkipPrune:   true,
+			Output: &Output{
+				UseSingleFile: true,
+			},
+			Generate: &GenerateOptions{
+				Validation: ValidationOptions{Assertions: assertions},
+			},
+		}
+
+		codes, err := Generate([]byte(readTestdata(t, spec)), cfg)
+		require.NoError(t, err)
+		return codes.GetCombined()
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		assert.NotContains(t, generate(t, "validation-context.yml", false), "var _ runtime.Validator")
+	})
+
+	t.Run("emitted for validating types", func(t *testing.T) {
+		combined := generate(t, "validation-context.yml", true)
+
+		assert.Contains(t, combined, "var _ runtime.Validator = (*Item)(nil)")
+		assert.Contains(t, combined, "var _ runtime.Validator = (*Items)(nil)")
+		assert.Contains(t, combined, "var _ runtime.Validator = (*Catalog)(nil)")
+	})
+
+	t.Run("omitted for types without validation", func(t *testing.T) {
+		combined := generate(t, "user.yml", true)
+
+		assert.NotContains(t, combined, "(*User)(nil)")
+		assert.NotContains(t, combined, "(*Error)(nil)")
+	})
+}
+
+func TestTemplateData(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "gen",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+		UserTemplates: map[string]string{
+			"client-options.tmpl": `{{ if .Extra.tracing }}
+// TracingService is the service name reported in traces.
+const TracingService = "{{ index .Extra "service-name" }}"
+{{ end }}`,
+		},
+		TemplateData: map[string]any{
+			"tracing":      true,
+			"service-name": "users",
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "user.yml")), cfg)
+	require.NoError(t, err)
+	assert.Contains(t, codes.GetCombined(), `const TracingService = "users"`)
+
+	cfg.TemplateData = map[string]any{"tracing": false}
+	codes, err = Generate([]byte(readTestdata(t, "user.yml")), cfg)
+	require.NoError(t, err)
+	assert.NotContains(t, codes.GetCombined(), "TracingService")
+}
+
+func TestMapConverters(t *testing.T) {
+	generate := func(t *testing.T, mapConverters bool) string {
+		t.Helper()
+		cfg := Configuration{
+			PackageName: "gen",
+			Output: &Output{
+				UseSingleFile: true,
+			},
+			Generate: &GenerateOptions{
+				MapConverters: mapConverters,
+			},
+		}
+
+		codes, err := Generate([]byte(readTestdata(t, "user.yml")), cfg)
+		require.NoError(t, err)
+		return codes.GetCombined()
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		assert.NotContains(t, generate(t, false), "ToMap()")
+	})
+
+	t.Run("struct types", func(t *testing.T) {
+		combined := generate(t, true)
+
+		assert.Contains(t, combined, "func (u User) ToMap() map[string]any {\n\tres, _ := runtime.AsRedactedMap(u)")
+		assert.Contains(t, combined, "func (u User) ToMapUnmasked() map[string]any {\n\tres, _ := runtime.AsMap[any](u)")
+		assert.Contains(t, combined, "func (u *User) FromMap(values map[string]any) error {\n\treturn runtime.FromMap(values, u)")
+	})
+}
+
+func TestHandlerPathParamFormats(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "gen",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Handler: &HandlerOptions{
+				Kind: HandlerKindStdHTTP,
+			},
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "path-param-formats.yml")), cfg)
+	require.NoError(t, err)
+	combined := codes.GetCombined()
+
+	_, err = format.Source([]byte(combined))
+	require.NoError(t, err)
+
+	assert.Regexp(t, `Date\s+runtime\.Date\s+`+"`json:\"date\"", combined)
+	assert.Regexp(t, `ID\s+uuid\.UUID\s+`+"`json:\"id\"", combined)
+	assert.Contains(t, combined, `pathParamDate, err := runtime.ParseString[runtime.Date](pathParamDateStr, "date")`)
+	assert.Contains(t, combined, `pathParamID, err := runtime.ParseString[uuid.UUID](pathParamIDStr, "uuid")`)
+	assert.Contains(t, combined, `ParamLocation: "path",`)
+}
+
+func TestTruncateHelpers(t *testing.T) {
+	generate := func(t *testing.T, truncateHelpers bool) string {
+		t.Helper()
+		cfg := Configuration{
+			PackageName: "gen",
+			Output: &Output{
+				UseSingleFile: true,
+			},
+			Generate: &GenerateOptions{
+				TruncateHelpers: truncateHelpers,
+			},
+		}
+
+		codes, err := Generate([]byte(readTestdata(t, "truncate-helpers.yml")), cfg)
+		require.NoError(t, err)
+		return codes.GetCombined()
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		assert.NotContains(t, generate(t, false), "Truncate()")
+	})
+
+	t.Run("string fields", func(t *testing.T) {
+		combined := generate(t, true)
+
+		assert.Contains(t, combined, "func (u *User) Truncate() {\n\tu.Name = runtime.TruncateString(u.Name, 5)")
+		assert.Contains(t, combined, "val := runtime.TruncateString(*u.Bio, 10)")
+		assert.Contains(t, combined, "val := Nick(runtime.TruncateString(string(*u.Nick), 4))")
+		assert.Contains(t, combined, "val := runtime.Email(runtime.TruncateString(string(*u.Email), 20))")
+		assert.Contains(t, combined, "for idx := range u.Tags {\n\t\tu.Tags[idx] = runtime.TruncateString(u.Tags[idx], 3)")
+	})
+
+	t.Run("nested types", func(t *testing.T) {
+		combined := generate(t, true)
+
+		assert.Contains(t, combined, "if u.Address != nil {\n\t\tu.Address.Truncate()")
+		assert.Contains(t, combined, "func (a *Address) Truncate() {")
+		assert.NotContains(t, combined, "func (n *Nick) Truncate()")
+	})
+}
+
+func TestHandlerResponseDataUnion(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "gen",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Handler: &HandlerOptions{
+				Kind: HandlerKindStdHTTP,
+			},
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "response-unions.yml")), cfg)
+	require.NoError(t, err)
+
+	combined := codes.GetCombined()
+	_, err = format.Source([]byte(combined))
+	require.NoError(t, err)
+
+	// oneOf of two types referenced from components
+	assert.Contains(t, combined, "func NewGetPetResponseDataFromCat(v Cat) *GetPetResponseData {")
+	assert.Contains(t, combined, "Pet_OneOf: &Pet_OneOf{runtime.NewEitherFromA[Cat, Dog](v)},")
+	assert.Contains(t, combined, "Pet_OneOf: &Pet_OneOf{runtime.NewEitherFromB[Cat, Dog](v)},")
+
+	// inline oneOf of more than two types
+	assert.Contains(t, combined, "func NewGetThingResponseDataFromBird(v Bird) (*GetThingResponseData, error) {")
+	assert.Contains(t, combined, "if err := union.FromBird(v); err != nil {")
+	assert.Contains(t, combined, "return NewGetThingResponseData(&GetThingResponse{GetThing_Response_OneOf: &union}), nil")
+}
+
+func TestHandlerSchemaValidation(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testschemas",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Handler: &HandlerOptions{
+				Kind:             HandlerKindStdHTTP,
+				SchemaValidation: true,
+			},
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "schema-validation.yml")), cfg)
+	require.NoError(t, err)
+
+	combined := codes.GetCombined()
+	_, err = format.Source([]byte(combined))
+	require.NoError(t, err)
+
+	assert.Contains(t, combined, "var createPetRequestSchema = runtime.MustCompileJSONSchema(`")
+	assert.Contains(t, combined, "if err := createPetRequestSchema.Validate(bodyBytes); err != nil {")
+	assert.Contains(t, combined, "var listPetsResponseSchema = runtime.MustCompileJSONSchema(`")
+	assert.Contains(t, combined, "if err := listPetsResponseSchema.ValidateValue(resp.Body); err != nil {")
+
+	// schemas are only emitted for operations with bodies
+	assert.NotContains(t, combined, "createPetResponseSchema")
+	assert.NotContains(t, combined, "listPetsRequestSchema")
+	assert.NotContains(t, combined, "deletePetRequestSchema")
+	assert.NotContains(t, combined, "deletePetResponseSchema")
+}
+
+func TestHandlerValidationErrorResponse(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testvalidation",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Handler: &HandlerOptions{
+				Kind: HandlerKindStdHTTP,
+				Validation: HandlerValidation{
+					Request:  true,
+					Response: true,
+				},
+			},
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "schema-validation.yml")), cfg)
+	require.NoError(t, err)
+
+	combined := codes.GetCombined()
+	_, err = format.Source([]byte(combined))
+	require.NoError(t, err)
+
+	assert.Contains(t, combined, "func (e OapiHandlerError) Unwrap() error {")
+	assert.Contains(t, combined, "func OapiWriteValidationError(w http.ResponseWriter, err error) {")
+	assert.Contains(t, combined, "errs = runtime.NewValidationErrorsFromError(err)")
+
+	// request and response validation errors keep the underlying runtime.ValidationErrors
+	assert.Contains(t, combined, `Kind:        OapiErrorKindValidation,
+			OperationID: "CreatePet",
+			Message:     err.Error(),
+			Err:         err,`)
+	assert.Contains(t, combined, `Message:     fmt.Sprintf("response validation failed: %v", err),
+					Err:         err,`)
+}
+
+func TestHandlerValidationMiddleware(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testvalidation",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Handler: &HandlerOptions{
+				Kind: HandlerKindChi,
+				Validation: HandlerValidation{
+					Middleware: true,
+				},
+			},
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "schema-validation.yml")), cfg)
+	require.NoError(t, err)
+
+	combined := codes.GetCombined()
+	_, err = format.Source([]byte(combined))
+	require.NoError(t, err)
+
+	assert.Contains(t, combined, "func ValidationMiddleware(errHandler OapiErrorHandler) func(http.Handler) http.Handler {")
+	assert.Contains(t, combined, `{"CreatePet", "POST", "/pets", (*HTTPAdapter).validateCreatePetRequest},`)
+	assert.Contains(t, combined, `{"DeletePet", "DELETE", "/pets/{id}", (*HTTPAdapter).validateDeletePetRequest},`)
+	// operations without request options have nothing to validate
+	assert.NotContains(t, combined, "validateListPetsRequest")
+
+	// handlers get the path parameters from the router
+	assert.Contains(t, combined, `"id": chi.URLParam(r, "id"),`)
+	assert.Contains(t, combined, "opts := a.parseDeletePetRequest(w, r, pathValues)")
+	assert.Contains(t, combined, `pathParamIDStr := pathValues["id"]`)
+
+	t.Run("requires validate methods", func(t *testing.T) {
+		cfg.Generate.Validation.Skip = true
+		_, err := Generate([]byte(readTestdata(t, "schema-validation.yml")), cfg)
+		require.ErrorContains(t, err, "validation middleware requires Validate methods")
+	})
+}
+
+func TestJSONFieldNamesAreVerbatim(t *testing.T) {
+	spec := []byte(readTestdata(t, "json-case.yml"))
+
+	t.Run("default", func(t *testing.T) {
+		cfg := Configuration{
+			PackageName: "testjsoncase",
+			Output: &Output{
+				UseSingleFile: true,
+			},
+		}
+
+		codes, err := Generate(spec, cfg)
+		require.NoError(t, err)
+
+		code := codes.GetCombined()
+		assert.Contains(t, code, "UserID     string  `json:\"User_ID\" validate:\"required\"`")
+		assert.Contains(t, code, "FirstName  *string `json:\"first_name,omitempty\"`")
+		assert.Contains(t, code, "HTTPStatus *int    `json:\"HTTPStatus,omitempty\"`")
+		assert.Contains(t, code, "AtType     *string `json:\"@type,omitempty\"`")
+		// extra tags may rename the JSON key
+		assert.Contains(t, code, "Nickname   *string `db:\"nickname\" json:\"nick_name\"`")
+	})
+
+	t.Run("preserve json case", func(t *testing.T) {
+		cfg := Configuration{
+			PackageName: "testjsoncase",
+			Output: &Output{
+				UseSingleFile: true,
+			},
+			Generate: &GenerateOptions{
+				PreserveJSONCase: true,
+			},
+		}
+
+		codes, err := Generate(spec, cfg)
+		require.NoError(t, err)
+
+		code := codes.GetCombined()
+		assert.Contains(t, code, "UserID     string  `json:\"User_ID\" validate:\"required\"`")
+		assert.Contains(t, code, "Nickname   *string `db:\"nickname\" json:\"nickname,omitempty\"`")
+		assert.NotContains(t, code, "nick_name")
+	})
+}
+
+func TestFuzzSeeds(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testfuzz",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client:    true,
+			FuzzSeeds: true,
+		},
+		Client: &Client{
+			Name: "Client",
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "fuzz-seeds.yml")), cfg)
+	require.NoError(t, err)
+
+	seeds := make(map[string]string)
+	for name, code := range codes {
+		if IsFuzzSeedFile(name) {
+			seeds[FuzzSeedFileName(name)] = code
+		}
+	}
+	assert.Equal(t, map[string]string{
+		"testdata/fuzz/FuzzCreatePetBody/seed-1":   "go test fuzz v1\n[]byte(\"{\\\"name\\\":\\\"Rex\\\",\\\"tag\\\":\\\"dog\\\"}\")\n",
+		"testdata/fuzz/FuzzCreatePetBody/seed-2":   "go test fuzz v1\n[]byte(\"{\\\"name\\\":\\\"Tom \\\\\\\"the cat\\\\\\\"\\\"}\")\n",
+		"testdata/fuzz/FuzzCreateOwnerBody/seed-1": "go test fuzz v1\n[]byte(\"{\\\"name\\\":\\\"Jane\\\"}\")\n",
+	}, seeds)
+
+	t.Run("disabled", func(t *testing.T) {
+		cfg.Generate.FuzzSeeds = false
+		codes, err := Generate([]byte(readTestdata(t, "fuzz-seeds.yml")), cfg)
+		require.NoError(t, err)
+		for name := range codes {
+			assert.False(t, IsFuzzSeedFile(name), name)
+		}
+	})
+}
+
+func TestFilePerTag(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testfilepertag",
+		Output: &Output{
+			FilePerTag: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+			Handler: &HandlerOptions{
+				Kind: HandlerKindStdHTTP,
+			},
+		},
+		Client: &Client{
+			Name: "Client",
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "file-per-tag.yml")), cfg)
+	require.NoError(t, err)
+
+	for _, name := range []string{"client", "client_options", "client_billing", "client_user_accounts", "adapter", "handler_billing", "handler_user_accounts"} {
+		require.Contains(t, codes, name)
+	}
+	assert.NotContains(t, codes, "client_invoices")
+
+	// The operations are generated in the file of their first tag, untagged ones in the default files.
+	assert.Contains(t, codes["client_billing"], "func (c *Client) ListInvoices(")
+	assert.Contains(t, codes["client_billing"], "func (c *Client) GetInvoice(")
+	assert.Contains(t, codes["client_billing"], "type GetInvoiceRequestOptions struct")
+	assert.NotContains(t, codes["client_billing"], "type Client struct")
+	assert.Contains(t, codes["client_user_accounts"], "func (c *Client) CreateUser(")
+	assert.Contains(t, codes["client"], "type Client struct")
+	assert.Contains(t, codes["client"], "func (c *Client) Health(")
+	assert.NotContains(t, codes["client"], "func (c *Client) ListInvoices(")
+
+	assert.Contains(t, codes["handler_billing"], "func (a *HTTPAdapter) GetInvoice(")
+	assert.Contains(t, codes["handler_billing"], "type GetInvoiceServiceRequestOptions struct")
+	assert.Contains(t, codes["handler_billing"], "type GetInvoiceResponseData struct")
+	assert.NotContains(t, codes["handler_billing"], "type HTTPAdapter struct")
+	assert.Contains(t, codes["handler_user_accounts"], "func (a *HTTPAdapter) CreateUser(")
+	assert.Contains(t, codes["adapter"], "type HTTPAdapter struct")
+	assert.Contains(t, codes["adapter"], "GetInvoice(ctx *GetInvoiceContext)")
+	assert.NotContains(t, codes["adapter"], "func (a *HTTPAdapter) GetInvoice(")
+
+	for name, code := range codes {
+		_, err := format.Source([]byte(code))
+		require.NoError(t, err, name)
+	}
+
+	t.Run("not with a single file", func(t *testing.T) {
+		cfg := cfg
+		cfg.Output = &Output{FilePerTag: true, UseSingleFile: true}
+		_, err := Generate([]byte(readTestdata(t, "file-per-tag.yml")), cfg)
+		require.ErrorContains(t, err, "file-per-tag")
+	})
+}
+
+func TestMultipleOf(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testmultipleof",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "multiple-of.yml")), cfg)
+	require.NoError(t, err)
+	combined := codes.GetCombined()
+	_, err = format.Source([]byte(combined))
+	require.NoError(t, err)
+
+	// integer multipleOf is checked with the remainder, float multipleOf within float tolerance
+	assert.Contains(t, combined, `if o.Step%10 != 0 {
+		errors = errors.Add("Step", "must be a multiple of 10")`)
+	assert.Contains(t, combined, `if !runtime.IsMultipleOf(*o.Weight, 0.5) {
+			errors = errors.Add("Weight", "must be a multiple of 0.5")`)
+
+	// primitive aliases are checked by the parent, as they have no Validate method
+	assert.Contains(t, combined, `if !runtime.IsMultipleOf(o.Quantity, 5) {`)
+	assert.Contains(t, combined, `if !runtime.IsMultipleOf(*o.Price, 0.01) {`)
+	assert.NotContains(t, combined, "func (o Order) Validate() error {\n\treturn runtime.ConvertValidatorError")
+}
+
+func TestClientHealthCheck(t *testing.T) {
+	generate := func(t *testing.T, spec string, client *Client) (string, error) {
+		t.Helper()
+		cfg := Configuration{
+			PackageName: "testhealth",
+			Output: &Output{
+				UseSingleFile: true,
+			},
+			Generate: &GenerateOptions{
+				Client: true,
+			},
+			Client: client,
+		}
+		codes, err := Generate([]byte(spec), cfg)
+		if err != nil {
+			return "", err
+		}
+		combined := codes.GetCombined()
+		_, err = format.Source([]byte(combined))
+		require.NoError(t, err)
+		return combined, nil
+	}
+	spec := readTestdata(t, "health-check.yml")
+
+	t.Run("x-healthcheck", func(t *testing.T) {
+		combined, err := generate(t, spec, &Client{Name: "Client"})
+		require.NoError(t, err)
+		assert.Contains(t, combined, `// Ping sends a HEAD /ready request, the health check of the API, without parameters nor body.
+// It returns an error if the response status isn't 2xx.
+func (c *Client) Ping(ctx context.Context, reqEditors ...runtime.RequestEditorFn) error {
+	return runtime.Ping(ctx, c.apiClient, "HEAD", "/ready", reqEditors...)
+}
+
+var _ runtime.Pinger = (*Client)(nil)`)
+	})
+
+	t.Run("path", func(t *testing.T) {
+		combined, err := generate(t, strings.Replace(spec, "x-healthcheck: true", "x-healthcheck: false", 1), &Client{Name: "Client"})
+		require.NoError(t, err)
+		assert.Contains(t, combined, `return runtime.Ping(ctx, c.apiClient, "GET", "/healthz", reqEditors...)`)
+	})
+
+	t.Run("operation ID", func(t *testing.T) {
+		combined, err := generate(t, spec, &Client{Name: "Client", HealthOperationID: "getHealth"})
+		require.NoError(t, err)
+		assert.Contains(t, combined, `return runtime.Ping(ctx, c.apiClient, "GET", "/healthz", reqEditors...)`)
+	})
+
+	t.Run("operation ID not found", func(t *testing.T) {
+		_, err := generate(t, spec, &Client{Name: "Client", HealthOperationID: "ping"})
+		require.ErrorContains(t, err, "health check operation 'ping' not found")
+	})
+
+	t.Run("operation ID with path parameters", func(t *testing.T) {
+		_, err := generate(t, spec, &Client{Name: "Client", HealthOperationID: "getComponentStatus"})
+		require.ErrorContains(t, err, "health check operation 'getComponentStatus' must not have path parameters")
+	})
+
+	t.Run("none", func(t *testing.T) {
+		combined, err := generate(t, readTestdata(t, "conditional-requests.yml"), &Client{Name: "Client"})
+		require.NoError(t, err)
+		assert.NotContains(t, combined, "Ping")
+	})
+
+	t.Run("Ping operation", func(t *testing.T) {
+		combined, err := generate(t, strings.Replace(spec, "operationId: getHealth", "operationId: ping", 1), &Client{Name: "Client"})
+		require.NoError(t, err)
+		assert.NotContains(t, combined, "runtime.Ping(")
+	})
+}
+
+func TestClientDecorator(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testdecorator",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+		Client: &Client{
+			Name:      "PetsClient",
+			Decorator: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "conditional-requests.yml")), cfg)
+	require.NoError(t, err)
+	combined := codes.GetCombined()
+	_, err = format.Source([]byte(combined))
+	require.NoError(t, err)
+
+	assert.Contains(t, combined, `type PetsClientDecorator struct {
+	PetsClientInterface
+`)
+	assert.Contains(t, combined, `func NewPetsClientDecorator(client PetsClientInterface) *PetsClientDecorator {
+	return &PetsClientDecorator{PetsClientInterface: client}
+}`)
+	assert.Contains(t, combined, `func (d *PetsClientDecorator) GetPet(ctx context.Context, options *GetPetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetPetResponse, error) {
+	if d.Before != nil {
+		var err error
+		if ctx, err = d.Before(ctx, "GetPet"); err != nil {
+			return nil, err
+		}
+	}
+	res, err := d.PetsClientInterface.GetPet(ctx, options, reqEditors...)
+	if d.After != nil {
+		d.After(ctx, "GetPet", err)
+	}
+	return res, err
+}`)
+}
+
+func TestClientRespectRateLimit(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testratelimit",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+		Client: &Client{
+			Name:             "Client",
+			RespectRateLimit: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "conditional-requests.yml")), cfg)
+	require.NoError(t, err)
+	combined := codes.GetCombined()
+	_, err = format.Source([]byte(combined))
+	require.NoError(t, err)
+
+	assert.Contains(t, combined, "opts = append([]runtime.APIClientOption{runtime.WithRespectRateLimit()}, opts...)")
+	assert.Contains(t, combined, `func (c *Client) RateLimit(operationPath string) (runtime.RateLimit, bool) {
+	if reporter, ok := c.apiClient.(runtime.RateLimitReporter); ok {
+		return reporter.RateLimit(operationPath)
+	}
+	return runtime.RateLimit{}, false
+}`)
+
+	t.Run("disabled", func(t *testing.T) {
+		cfg := cfg
+		cfg.Client = &Client{Name: "Client"}
+		codes, err := Generate([]byte(readTestdata(t, "conditional-requests.yml")), cfg)
+		require.NoError(t, err)
+		assert.NotContains(t, codes.GetCombined(), "RateLimit")
+	})
+}
+
+func TestClientCircuitBreaker(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testcircuitbreaker",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+		Client: &Client{
+			Name: "Client",
+			CircuitBreaker: &ClientCircuitBreaker{
+				FailureThreshold: 3,
+				OpenDuration:     10 * time.Second,
+			},
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "conditional-requests.yml")), cfg)
+	require.NoError(t, err)
+	combined := codes.GetCombined()
+	_, err = format.Source([]byte(combined))
+	require.NoError(t, err)
+
+	assert.Contains(t, combined, "opts = append([]runtime.APIClientOption{runtime.WithCircuitBreaker(ClientCircuitBreakerPolicy)}, opts...)")
+	assert.Contains(t, combined, `var ClientCircuitBreakerPolicy = runtime.CircuitBreakerPolicy{
+	FailureThreshold: 3,
+	OpenDuration:     10000 * time.Millisecond,
+}`)
+	assert.Contains(t, combined, `func (c *Client) CircuitBreaker(operationPath string) (runtime.CircuitBreakerState, bool) {
+	if reporter, ok := c.apiClient.(runtime.CircuitBreakerReporter); ok {
+		return reporter.CircuitBreaker(operationPath)
+	}
+	return runtime.CircuitBreakerState{}, false
+}`)
+
+	t.Run("defaults", func(t *testing.T) {
+		cfg := cfg
+		cfg.Client = &Client{Name: "Client", CircuitBreaker: &ClientCircuitBreaker{}}
+		codes, err := Generate([]byte(readTestdata(t, "conditional-requests.yml")), cfg)
+		require.NoError(t, err)
+		assert.Contains(t, codes.GetCombined(), `var ClientCircuitBreakerPolicy = runtime.CircuitBreakerPolicy{
+	FailureThreshold: 5,
+	OpenDuration:     30 * time.Second,
+}`)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		cfg := cfg
+		cfg.Client = &Client{Name: "Client"}
+		codes, err := Generate([]byte(readTestdata(t, "conditional-requests.yml")), cfg)
+		require.NoError(t, err)
+		assert.NotContains(t, codes.GetCombined(), "CircuitBreaker")
+	})
+}
+
+func TestClientInterceptors(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testinterceptors",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+		Client: &Client{
+			Name:         "Client",
+			Interceptors: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "conditional-requests.yml")), cfg)
+	require.NoError(t, err)
+	combined := codes.GetCombined()
+	_, err = format.Source([]byte(combined))
+	require.NoError(t, err)
+
+	assert.Contains(t, combined, `func (c *Client) GetPet(ctx context.Context, options *GetPetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetPetResponse, error) {
+	var err error
+	ctx = runtime.WithCallInfo(ctx, runtime.CallInfo{OperationID: "GetPet"})`)
+
+	t.Run("disabled", func(t *testing.T) {
+		cfg := cfg
+		cfg.Client = &Client{Name: "Client"}
+		codes, err := Generate([]byte(readTestdata(t, "conditional-requests.yml")), cfg)
+		require.NoError(t, err)
+		assert.NotContains(t, codes.GetCombined(), "runtime.WithCallInfo")
+	})
+}
+
+func TestClientQueryAllowReserved(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testqueryreserved",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "query-allow-reserved.yml")), cfg)
+	require.NoError(t, err)
+	combined := codes.GetCombined()
+	_, err = format.Source([]byte(combined))
+	require.NoError(t, err)
+
+	assert.Contains(t, combined, `queryEncoding := map[string]runtime.QueryEncoding{
+		"prefix":    {Style: "form", AllowReserved: true},
+		"recursive": {Style: "form", AllowEmptyValue: true},
+	}`)
+	assert.NotContains(t, combined, `"cursor": {`)
+}
+
+func TestClientLongPoll(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testlongpoll",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+		Client: &Client{
+			Name: "Client",
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "long-poll.yml")), cfg)
+	require.NoError(t, err)
+	combined := codes.GetCombined()
+	_, err = format.Source([]byte(combined))
+	require.NoError(t, err)
+
+	assert.Contains(t, combined, `func (c *Client) WaitEventsLongPoll(ctx context.Context, options *WaitEventsRequestOptions, reqEditors ...runtime.RequestEditorFn) (*WaitEventsResult, error) {
+	return runtime.LongPoll(ctx, c.apiClient, func(ctx context.Context, apiClient runtime.APIClient) (*WaitEventsResult, error) {
+		return NewClient(apiClient).WaitEvents(ctx, options, reqEditors...)
+	})
+}`)
+	// without the extension, or with a 204 No Content success only
+	assert.NotContains(t, combined, "LatestEventLongPoll")
+	assert.NotContains(t, combined, "AckEventsLongPoll")
+
+	t.Run("invalid extension", func(t *testing.T) {
+		spec := strings.Replace(readTestdata(t, "long-poll.yml"), "x-long-poll: true", "x-long-poll: sometimes", 1)
+		_, err := Generate([]byte(spec), cfg)
+		require.ErrorContains(t, err, "error parsing x-long-poll extension for WaitEvents")
+	})
+}
+
+func TestClientConditionalRequests(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testconditional",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+		Client: &Client{
+			Name:                "Client",
+			ConditionalRequests: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "conditional-requests.yml")), cfg)
+	require.NoError(t, err)
+	combined := codes.GetCombined()
+	_, err = format.Source([]byte(combined))
+	require.NoError(t, err)
+
+	assert.Contains(t, combined, "func (c *Client) GetPetConditional(ctx context.Context, options *GetPetRequestOptions, etag string, reqEditors ...runtime.RequestEditorFn) (*runtime.ConditionalResult[GetPetResponse], error) {")
+	assert.Contains(t, combined, "return NewClient(apiClient).GetPet(ctx, options, reqEditors...)")
+	assert.Contains(t, combined, "func (c *Client) ListPetsConditional(ctx context.Context, etag string, reqEditors ...runtime.RequestEditorFn) (*runtime.ConditionalResult[ListPetsResponse], error) {")
+
+	// Only GET operations with a response body have a conditional method.
+	assert.NotContains(t, combined, "DeletePetConditional")
+
+	t.Run("disabled", func(t *testing.T) {
+		cfg := cfg
+		cfg.Client = &Client{Name: "Client"}
+		codes, err := Generate([]byte(readTestdata(t, "conditional-requests.yml")), cfg)
+		require.NoError(t, err)
+		assert.NotContains(t, codes.GetCombined(), "Conditional")
+	})
+}
+
+func TestClientNoContent(t *testing.T) {
+	spec := `
+openapi: 3.0.0
+info:
+  title: No Content
+  version: 1.0.0
+paths:
+  /users/{id}:
+    delete:
+      operationId: deleteUser
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+      responses:
+        '204':
+          description: Deleted
+        '404':
+          description: Not found
+          content:
+            application/json:
+              schema:
+                type: object
+                properties:
+                  message:
+                    type: string
+  /jobs:
+    post:
+      operationId: startJob
+      responses:
+        '202':
+          description: Accepted
+`
+	cfg := Configuration{
+		PackageName: "testnocontent",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+		Client: &Client{Name: "Client"},
+	}
+	codes, err := Generate([]byte(spec), cfg)
+	require.NoError(t, err)
+	combined := codes.GetCombined()
+	_, err = format.Source([]byte(combined))
+	require.NoError(t, err)
+
+	assert.Contains(t, combined, "DeleteUser(ctx context.Context, options *DeleteUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*runtime.NoContent, error)")
+	assert.Contains(t, combined, "StartJob(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*runtime.NoContent, error)")
+	assert.Contains(t, combined, "if resp.StatusCode != 202 {")
+	assert.Contains(t, combined, "return &runtime.NoContent{StatusCode: resp.StatusCode, Headers: resp.Headers}, nil")
+	assert.NotContains(t, combined, "*struct{}")
+	assert.NotContains(t, combined, "new(struct{})")
+}
+
+func TestBinaryContent(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testbinary",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+			Handler: &HandlerOptions{
+				Kind: HandlerKindStdHTTP,
+			},
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "binary-content.yml")), cfg)
+	require.NoError(t, err)
+	combined := codes.GetCombined()
+	_, err = format.Source([]byte(combined))
+	require.NoError(t, err)
+
+	t.Run("request bodies are readers", func(t *testing.T) {
+		assert.Contains(t, combined, "type UploadFileBody = io.Reader")
+		assert.Contains(t, combined, "type UploadImageBody = io.Reader")
+		assert.Contains(t, combined, `type UploadFileRequestOptions struct {
+	PathParams *UploadFilePath
+	Body       UploadFileBody
+}`)
+		assert.Contains(t, combined, `// Binary body is streamed to the service
+	opts.Body = r.Body`)
+	})
+
+	t.Run("responses are files", func(t *testing.T) {
+		assert.Contains(t, combined, "type DownloadFileResponse = runtime.File")
+		assert.Contains(t, combined, "type UploadImageResponse = runtime.File")
+		assert.Contains(t, combined, `ctx = runtime.WithStreamedResponse(ctx, 200)`)
+		assert.Contains(t, combined, `target := new(DownloadFileResponse)
+		// The body is streamed, the caller closing the reader of the file, see runtime.WithStreamedResponse.
+		if resp.Body != nil {
+			target.InitFromReader(resp.Body, "")
+		}`)
+		assert.Contains(t, combined, `body, err := resp.Body.Reader()`)
+		assert.Contains(t, combined, `_, _ = io.Copy(w, body)`)
+	})
+
+	t.Run("streaming operations are not hedged", func(t *testing.T) {
+		cfg := Configuration{
+			PackageName: "testbinary",
+			Output: &Output{
+				UseSingleFile: true,
+			},
+			Generate: &GenerateOptions{
+				Client: true,
+			},
+			Client: &Client{
+				Hedging: &ClientHedging{
+					Delay:        50 * time.Millisecond,
+					OperationIDs: []string{"downloadFile", "uploadFile"},
+				},
+			},
+		}
+
+		codes, err := Generate([]byte(readTestdata(t, "binary-content.yml")), cfg)
+		require.NoError(t, err)
+		combined := codes.GetCombined()
+		_, err = format.Source([]byte(combined))
+		require.NoError(t, err)
+
+		assert.NotContains(t, combined, "runtime.Hedge(ctx")
+	})
+}
+
+func TestClientDownloadProgress(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testprogress",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+		Client: &Client{
+			DownloadProgress: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "binary-content.yml")), cfg)
+	require.NoError(t, err)
+	combined := codes.GetCombined()
+	_, err = format.Source([]byte(combined))
+	require.NoError(t, err)
+
+	assert.Contains(t, combined, `func (c *Client) DownloadFileWithProgress(ctx context.Context, options *DownloadFileRequestOptions, progress runtime.ProgressFunc, reqEditors ...runtime.RequestEditorFn) (*DownloadFileResponse, error) {
+	return c.DownloadFile(runtime.WithDownloadProgress(ctx, progress), options, reqEditors...)
+}`)
+	assert.Contains(t, combined, "func (c *Client) UploadImageWithProgress(")
+
+	// no binary response
+	assert.NotContains(t, combined, "UploadFileWithProgress")
+
+	t.Run("disabled by default", func(t *testing.T) {
+		cfg.Client = nil
+		codes, err := Generate([]byte(readTestdata(t, "binary-content.yml")), cfg)
+		require.NoError(t, err)
+		assert.NotContains(t, codes.GetCombined(), "WithProgress")
+	})
+}
+
+func TestGoTagsExtension(t *testing.T) {
+	spec := `
+openapi: 3.0.0
+info:
+  title: Test
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    User:
+      type: object
+      required: [id, name]
+      properties:
+        id:
+          type: string
+          x-go-tags:
+            db: user_id
+            mapstructure: id
+            json: ignored
+        name:
+          type: string
+          minLength: 1
+          x-go-tags:
+            db: name
+            validate: alphanum
+`
+	cfg := Configuration{
+		PackageName: "testgotags",
+		SkipPrune:   true,
+		Output: &Output{
+			UseSingleFile: true,
+		},
+	}
+	codes, err := Generate([]byte(spec), cfg)
+	require.NoError(t, err)
+	combined := codes.GetCombined()
+	_, err = format.Source([]byte(combined))
+	require.NoError(t, err)
+
+	assert.Contains(t, combined, "ID   string `db:\"user_id\" json:\"id\" mapstructure:\"id\" validate:\"required\"`")
+	assert.Contains(t, combined, "Name string `db:\"name\" json:\"name\" validate:\"required,min=1,alphanum\"`")
+}
+
+func TestEmitXConfig(t *testing.T) {
+	spec := `openapi: 3.0.0
+info:
+  title: Config
+  version: 1.0.0
+x-config:
+  port: 8080
+  ratio: 0.5
+  debug: false
+  service-name: orders
+  allowed_origins:
+    - https://example.com
+    - https://example.org
+  database:
+    host: localhost
+    max-conns: 10
+paths:
+  /health:
+    get:
+      operationId: getHealth
+      responses:
+        '200':
+          description: OK
+`
+	cfg := Configuration{
+		PackageName: "testconfig",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			EmitXConfig: true,
+		},
+	}
+
+	codes, err := Generate([]byte(spec), cfg)
+	require.NoError(t, err)
+	combined := codes.GetCombined()
+	_, err = format.Source([]byte(combined))
+	require.NoError(t, err)
+
+	assert.Contains(t, combined, "type Config struct {\n"+
+		"\tPort           int            `json:\"port\" yaml:\"port\"`\n"+
+		"\tRatio          float64        `json:\"ratio\" yaml:\"ratio\"`\n"+
+		"\tDebug          bool           `json:\"debug\" yaml:\"debug\"`\n"+
+		"\tServiceName    string         `json:\"service-name\" yaml:\"service-name\"`\n"+
+		"\tAllowedOrigins []string       `json:\"allowed_origins\" yaml:\"allowed_origins\"`\n"+
+		"\tDatabase       ConfigDatabase `json:\"database\" yaml:\"database\"`\n"+
+		"}")
+	assert.Contains(t, combined, "type ConfigDatabase struct {\n"+
+		"\tHost     string `json:\"host\" yaml:\"host\"`\n"+
+		"\tMaxConns int    `json:\"max-conns\" yaml:\"max-conns\"`\n"+
+		"}")
+	assert.Contains(t, combined, `func DefaultConfig() Config {
+	return Config{
+		Port:        8080,
+		Ratio:       0.5,
+		Debug:       false,
+		ServiceName: "orders",
+		AllowedOrigins: []string{
+			"https://example.com",
+			"https://example.org",
+		},
+		Database: ConfigDatabase{
+			Host:     "localhost",
+			MaxConns: 10,
+		},
+	}
+}`)
+
+	t.Run("disabled", func(t *testing.T) {
+		cfg.Generate.EmitXConfig = false
+		codes, err := Generate([]byte(spec), cfg)
+		require.NoError(t, err)
+		assert.NotContains(t, codes.GetCombined(), "DefaultConfig")
+	})
+}
+
+func TestInlineThreshold(t *testing.T) {
+	spec := `openapi: 3.0.0
+info:
+  title: Pets
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      operationId: getPet
+      responses:
+        '200':
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Pet'
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [name]
+      properties:
+        name:
+          type: string
+        tag:
+          $ref: '#/components/schemas/Tag'
+        owner:
+          $ref: '#/components/schemas/Person'
+        vet:
+          $ref: '#/components/schemas/Person'
+        address:
+          $ref: '#/components/schemas/Address'
+    Tag:
+      type: object
+      properties:
+        label:
+          type: string
+          maxLength: 5
+    Person:
+      type: object
+      properties:
+        name:
+          type: string
+    Address:
+      type: object
+      properties:
+        street:
+          type: string
+        city:
+          type: string
+        zip:
+          type: string
+`
+	cfg := Configuration{
+		PackageName: "testinline",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			InlineThreshold: 3,
+		},
+	}
+
+	codes, err := Generate([]byte(spec), cfg)
+	require.NoError(t, err)
+	combined := codes.GetCombined()
+	_, err = format.Source([]byte(combined))
+	require.NoError(t, err)
+
+	// single-use tiny schema
+	assert.NotContains(t, combined, "type Tag struct")
+	assert.Contains(t, combined, "Tag  *struct {\n\t\tLabel *string `json:\"label,omitempty\" validate:\"omitempty,max=5\"`\n\t} `json:\"tag,omitempty\"`")
+	assert.Contains(t, combined, `if p.Tag != nil {
+		if err := typesValidator.Struct(p.Tag); err != nil {
+			errors = errors.Append("Tag", runtime.ConvertValidatorError(err))
+		}
+	}`)
+
+	// shared schema
+	assert.Contains(t, combined, "type Person struct")
+	assert.Contains(t, combined, "Owner   *Person")
+	assert.Contains(t, combined, "Vet     *Person")
+
+	// schema at the threshold
+	assert.Contains(t, combined, "type Address struct")
+	assert.Contains(t, combined, "Address *Address")
+
+	t.Run("referenced by a request body", func(t *testing.T) {
+		spec := strings.Replace(spec, "components:", `    post:
+      operationId: tagPet
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Tag'
+      responses:
+        '204':
+          description: Tagged
+components:`, 1)
+		codes, err := Generate([]byte(spec), cfg)
+		require.NoError(t, err)
+		assert.Contains(t, codes.GetCombined(), "type Tag struct")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		cfg.Generate.InlineThreshold = 0
+		codes, err := Generate([]byte(spec), cfg)
+		require.NoError(t, err)
+		assert.Contains(t, codes.GetCombined(), "type Tag struct")
+	})
+}
+
+func TestDependentRequired(t *testing.T) {
+	spec := `openapi: 3.1.0
+info:
+  title: Payments
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Payment:
+      type: object
+      required: [amount]
+      properties:
+        amount:
+          type: integer
+        card_number:
+          type: string
+        expiry:
+          type: string
+        labels:
+          type: array
+          items:
+            type: string
+      dependentRequired:
+        card_number: [expiry]
+        labels: [amount, card_number]
+`
+	cfg := Configuration{
+		PackageName: "testdependentrequired",
+		SkipPrune:   true,
+	}
+
+	codes, err := Generate([]byte(spec), cfg)
+	require.NoError(t, err)
+	combined := codes.GetCombined()
+	_, err = format.Source([]byte(combined))
+	require.NoError(t, err)
+
+	assert.Contains(t, combined, `if p.CardNumber != nil && p.Expiry == nil {
+		errors = errors.Add("Expiry", "is required when CardNumber is present")
+	}`)
+	assert.Contains(t, combined, `if p.Labels != nil && p.CardNumber == nil {
+		errors = errors.Add("CardNumber", "is required when Labels is present")
+	}`)
+	// required properties are always present
+	assert.NotContains(t, combined, `errors.Add("Amount"`)
+
+	t.Run("generic optional type", func(t *testing.T) {
+		cfg := cfg
+		cfg.Generate = &GenerateOptions{OptionalType: OptionalTypeGeneric}
+		codes, err := Generate([]byte(spec), cfg)
+		require.NoError(t, err)
+		assert.Contains(t, codes.GetCombined(), `if p.CardNumber.Present() && !p.Expiry.Present() {`)
+	})
+
+	t.Run("non-pointer optional property", func(t *testing.T) {
+		spec := strings.Replace(spec, "        expiry:\n          type: string\n", "        expiry:\n          type: string\n          x-go-type-skip-optional-pointer: true\n", 1)
+		codes, err := Generate([]byte(spec), cfg)
+		require.NoError(t, err)
+		assert.Contains(t, codes.GetCombined(), `if p.CardNumber != nil && runtime.IsZero(p.Expiry) {`)
+	})
+}
+
+func TestInfoConstants(t *testing.T) {
+	spec := `openapi: 3.0.0
+info:
+  title: Pet "Store" API
+  version: 2.4.1
+paths:
+  /health:
+    get:
+      operationId: getHealth
+      responses:
+        '200':
+          description: OK
+`
+	cfg := Configuration{
+		PackageName: "testinfo",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			InfoConstants: true,
+		},
+	}
+
+	ctx, errs := CreateParseContext([]byte(spec), cfg)
+	require.Nil(t, errs)
+	assert.Equal(t, APIInfo{Title: `Pet "Store" API`, Version: "2.4.1"}, ctx.Info)
+
+	codes, err := Generate([]byte(spec), cfg)
+	require.NoError(t, err)
+	combined := codes.GetCombined()
+	_, err = format.Source([]byte(combined))
+	require.NoError(t, err)
+
+	assert.Contains(t, combined, `APITitle = "Pet \"Store\" API"`)
+	assert.Contains(t, combined, `APIVersion = "2.4.1"`)
+
+	t.Run("disabled", func(t *testing.T) {
+		cfg.Generate.InfoConstants = false
+		codes, err := Generate([]byte(spec), cfg)
+		require.NoError(t, err)
+		assert.NotContains(t, codes.GetCombined(), "APIVersion")
+	})
+}
+
+func TestClientCompressionResponseDecode(t *testing.T) {
+	generate := func(t *testing.T, algorithms ...string) (string, error) {
+		t.Helper()
+		cfg := Configuration{
+			PackageName: "testcompression",
+			Output: &Output{
+				UseSingleFile: true,
+			},
+			Generate: &GenerateOptions{
+				Client: true,
+			},
+			Client: &Client{
+				Compression: &ClientCompression{ResponseDecode: algorithms},
+			},
+		}
+
+		codes, err := Generate([]byte(readTestdata(t, "user.yml")), cfg)
+		if err != nil {
+			return "", err
+		}
+		return codes.GetCombined(), nil
+	}
+
+	t.Run("gzip only", func(t *testing.T) {
+		combined, err := generate(t, "gzip")
+		require.NoError(t, err)
+
+		assert.Contains(t, combined, "opts = append([]runtime.APIClientOption{runtime.WithContentDecoders(ClientContentDecoders)}, opts...)")
+		assert.Contains(t, combined, `"gzip": runtime.GzipDecoder,`)
+		assert.NotContains(t, combined, "brotli")
+		assert.NotContains(t, combined, "zstd")
+	})
+
+	t.Run("brotli and zstd", func(t *testing.T) {
+		combined, err := generate(t, "br", "zstd")
+		require.NoError(t, err)
+
+		assert.Contains(t, combined, `"github.com/andybalholm/brotli"`)
+		assert.Contains(t, combined, `"github.com/klauspost/compress/zstd"`)
+		assert.Contains(t, combined, "return io.NopCloser(brotli.NewReader(body)), nil")
+		assert.Contains(t, combined, "decoder, err := zstd.NewReader(body)")
+		assert.NotContains(t, combined, "runtime.GzipDecoder")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		combined, err := generate(t)
+		require.NoError(t, err)
+		assert.NotContains(t, combined, "ContentDecoders")
+	})
+
+	t.Run("unsupported algorithm", func(t *testing.T) {
+		_, err := generate(t, "deflate")
+		require.ErrorIs(t, err, ErrCompressionUnsupported)
+	})
+}
+
+func TestClientCompressionRequest(t *testing.T) {
+	generate := func(t *testing.T, spec string, compression *ClientCompression) (string, error) {
+		t.Helper()
+		cfg := Configuration{
+			PackageName: "testcompression",
+			Output: &Output{
+				UseSingleFile: true,
+			},
+			Generate: &GenerateOptions{
+				Client: true,
+			},
+			Client: &Client{
+				Compression: compression,
+			},
+		}
+
+		codes, err := Generate([]byte(spec), cfg)
+		if err != nil {
+			return "", err
+		}
+		combined := codes.GetCombined()
+		_, err = format.Source([]byte(combined))
+		return combined, err
+	}
+
+	// the request options of the client method of the operation
+	reqParams := func(combined, method string) string {
+		method = combined[strings.Index(combined, "func (c *Client) "+method+"("):]
+		method = method[strings.Index(method, "reqParams := "):]
+		return method[:strings.Index(method, "\n\t}")]
+	}
+
+	t.Run("operation opting out", func(t *testing.T) {
+		combined, err := generate(t, readTestdata(t, "compress-request.yml"), &ClientCompression{Request: "zstd"})
+		require.NoError(t, err)
+
+		assert.Contains(t, combined, `opts = append([]runtime.APIClientOption{runtime.WithContentEncoder("zstd", ClientContentEncoder)}, opts...)`)
+		assert.Contains(t, combined, `"github.com/klauspost/compress/zstd"`)
+		assert.Contains(t, combined, `var ClientContentEncoder runtime.ContentEncoder = func(w io.Writer) (io.WriteCloser, error) {
+	return zstd.NewWriter(w)
+}`)
+		assert.NotContains(t, combined, "brotli")
+		assert.Contains(t, reqParams(combined, "CreateEvents"), "CompressBody: true,")
+		assert.NotContains(t, reqParams(combined, "UpdateEvent"), "CompressBody")
+		assert.NotContains(t, reqParams(combined, "GetEvent"), "CompressBody")
+	})
+
+	t.Run("operation opting in", func(t *testing.T) {
+		spec := strings.Replace(readTestdata(t, "compress-request.yml"), "x-compress-request: false", "x-compress-request: true", 1)
+		combined, err := generate(t, spec, nil)
+		require.NoError(t, err)
+
+		assert.Contains(t, combined, `runtime.WithContentEncoder("gzip", ClientContentEncoder)`)
+		assert.Contains(t, combined, "var ClientContentEncoder runtime.ContentEncoder = runtime.GzipEncoder")
+		assert.NotContains(t, reqParams(combined, "CreateEvents"), "CompressBody")
+		assert.Contains(t, reqParams(combined, "UpdateEvent"), "CompressBody: true,")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		combined, err := generate(t, readTestdata(t, "compress-request.yml"), &ClientCompression{ResponseDecode: []string{"gzip"}})
+		require.NoError(t, err)
+		assert.NotContains(t, combined, "ContentEncoder")
+		assert.NotContains(t, combined, "CompressBody")
+	})
+
+	t.Run("invalid extension", func(t *testing.T) {
+		spec := strings.Replace(readTestdata(t, "compress-request.yml"), "x-compress-request: false", "x-compress-request: sometimes", 1)
+		_, err := generate(t, spec, nil)
+		require.ErrorContains(t, err, "error parsing x-compress-request extension for UpdateEvent")
+	})
+
+	t.Run("unsupported algorithm", func(t *testing.T) {
+		_, err := generate(t, readTestdata(t, "compress-request.yml"), &ClientCompression{Request: "deflate"})
+		require.ErrorIs(t, err, ErrCompressionUnsupported)
+	})
+}
+
+func TestClientIdempotencyKeys(t *testing.T) {
+	generate := func(t *testing.T, spec string, enabled bool) (string, error) {
+		t.Helper()
+		cfg := Configuration{
+			PackageName: "testidempotency",
+			Output: &Output{
+				UseSingleFile: true,
+			},
+			Generate: &GenerateOptions{
+				Client: true,
+			},
+			Client: &Client{
+				IdempotencyKeys: enabled,
+			},
+		}
+
+		codes, err := Generate([]byte(spec), cfg)
+		if err != nil {
+			return "", err
+		}
+		combined := codes.GetCombined()
+		_, err = format.Source([]byte(combined))
+		return combined, err
+	}
+
+	// the request options of the client method of the operation
+	reqParams := func(combined, method string) string {
+		method = combined[strings.Index(combined, "func (c *Client) "+method+"("):]
+		method = method[strings.Index(method, "reqParams := "):]
+		return method[:strings.Index(method, "\n\t}")]
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+		combined, err := generate(t, readTestdata(t, "idempotency-keys.yml"), true)
+		require.NoError(t, err)
+
+		assert.Contains(t, reqParams(combined, "CreateOrder"), "IdempotencyKey: true,")
+		assert.NotContains(t, reqParams(combined, "UpdateOrder"), "IdempotencyKey")
+		assert.Contains(t, reqParams(combined, "CancelOrder"), "IdempotencyKey: true,")
+		assert.NotContains(t, reqParams(combined, "GetOrder"), "IdempotencyKey")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		combined, err := generate(t, readTestdata(t, "idempotency-keys.yml"), false)
+		require.NoError(t, err)
+
+		assert.NotContains(t, reqParams(combined, "CreateOrder"), "IdempotencyKey")
+		assert.Contains(t, reqParams(combined, "CancelOrder"), "IdempotencyKey: true,")
+	})
+
+	t.Run("invalid extension", func(t *testing.T) {
+		spec := strings.Replace(readTestdata(t, "idempotency-keys.yml"), "x-idempotency-key: false", "x-idempotency-key: sometimes", 1)
+		_, err := generate(t, spec, true)
+		require.ErrorContains(t, err, "error parsing x-idempotency-key extension for UpdateOrder")
+	})
+}
+
+func TestClientResponseValidation(t *testing.T) {
+	generate := func(t *testing.T, spec string, response bool) string {
+		t.Helper()
+		cfg := Configuration{
+			PackageName: "testresponses",
+			Output: &Output{
+				UseSingleFile: true,
+			},
+			Generate: &GenerateOptions{
+				Client:     true,
+				Validation: ValidationOptions{Response: response},
+			},
+		}
+
+		codes, err := Generate([]byte(readTestdata(t, spec)), cfg)
+		require.NoError(t, err)
+		combined := codes.GetCombined()
+		_, err = format.Source([]byte(combined))
+		require.NoError(t, err)
+		return combined
+	}
+
+	t.Run("single success response", func(t *testing.T) {
+		combined := generate(t, "user.yml", true)
+
+		assert.Contains(t, combined, `		if v, ok := any(target).(runtime.Validator); ok {
+			if err = v.Validate(); err != nil {
+				return target, runtime.NewResponseValidationError(err, resp.StatusCode)
+			}
+		}
+		return target, nil`)
+	})
+
+	t.Run("multiple success responses", func(t *testing.T) {
+		combined := generate(t, "multiple-success.yml", true)
+
+		assert.Contains(t, combined, "result := &UpsertPetResult{StatusCode: resp.StatusCode, Body201: target}")
+		assert.Contains(t, combined, "return result, runtime.NewResponseValidationError(err, resp.StatusCode)")
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		assert.NotContains(t, generate(t, "user.yml", false), "NewResponseValidationError")
+	})
+}
+
+func TestFieldPaths(t *testing.T) {
+	generate := func(t *testing.T, fieldPaths map[string][]string) (string, error) {
+		t.Helper()
+		cfg := Configuration{
+			PackageName: "testfieldpaths",
+			Output: &Output{
+				UseSingleFile: true,
+			},
+			FieldPaths: fieldPaths,
+		}
+
+		codes, err := Generate([]byte(readTestdata(t, "field-paths.yml")), cfg)
+		if err != nil {
+			return "", err
+		}
+		combined := codes.GetCombined()
+		_, err = format.Source([]byte(combined))
+		return combined, err
+	}
+
+	combined, err := generate(t, map[string][]string{
+		"Order": {"customer.email", "items[].sku", "items"},
+		"Error": {"errors[].code"},
+	})
+	require.NoError(t, err)
+
+	t.Run("nested pointers", func(t *testing.T) {
+		assert.Contains(t, combined, `// ResolveCustomerEmail returns the customer.email field of Order, false if a value on the path is missing.
+func (o Order) ResolveCustomerEmail() (string, bool) {
+	res0 := o.Customer
+	if res0 == nil {
+		var zero string
+		return zero, false
+	}
+	res1 := *res0
+	res2 := res1.Email
+	if res2 == nil {
+		var zero string
+		return zero, false
+	}
+	res3 := *res2
+	return res3, true
+}`)
+	})
+
+	t.Run("array", func(t *testing.T) {
+		assert.Contains(t, combined, `func (o Order) ResolveItemsSku() (string, bool) {
+	res0 := o.Items
+	if len(res0) == 0 {
+		var zero string
+		return zero, false
+	}
+	res1 := res0[0]
+	res2 := res1.Sku
+	return res2, true
+}`)
+		assert.Contains(t, combined, `func (o Order) ResolveItems() ([]Item, bool) {
+	res0 := o.Items
+	return res0, true
+}`)
+		assert.Contains(t, combined, `func (e Error) ResolveErrorsCode() (string, bool) {`)
+	})
+
+	t.Run("unknown path", func(t *testing.T) {
+		_, err := generate(t, map[string][]string{"Order": {"customer.phone"}})
+		require.ErrorIs(t, err, ErrFieldPathNotFound)
+		require.ErrorContains(t, err, "customer.phone in Order")
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+		_, err := generate(t, map[string][]string{"Invoice": {"id"}})
+		require.ErrorIs(t, err, ErrFieldPathNotFound)
+	})
+}
+
+func TestOrderExtension(t *testing.T) {
+	spec := `openapi: 3.0.0
+info:
+  title: Pets
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [name]
+      properties:
+        name:
+          type: string
+        tag:
+          type: string
+        updatedAt:
+          type: string
+          format: date-time
+          x-order: 11
+        id:
+          type: integer
+          x-order: 1
+        createdAt:
+          type: string
+          format: date-time
+          x-order: 10
+        owner:
+          type: string
+          x-order: 10
+        age:
+          type: integer
+`
+	cfg := Configuration{
+		PackageName: "testorder",
+		SkipPrune:   true,
+		Output: &Output{
+			UseSingleFile: true,
+		},
+	}
+
+	codes, err := Generate([]byte(spec), cfg)
+	require.NoError(t, err)
+	combined := codes.GetCombined()
+	_, err = format.Source([]byte(combined))
+	require.NoError(t, err)
+
+	assert.Contains(t, combined, `type Pet struct {
+	ID        *int       `+"`json:\"id,omitempty\"`"+`
+	CreatedAt *time.Time `+"`json:\"createdAt,omitempty\"`"+`
+	Owner     *string    `+"`json:\"owner,omitempty\"`"+`
+	UpdatedAt *time.Time `+"`json:\"updatedAt,omitempty\"`"+`
+	Name      string     `+"`json:\"name\" validate:\"required\"`"+`
+	Tag       *string    `+"`json:\"tag,omitempty\"`"+`
+	Age       *int       `+"`json:\"age,omitempty\"`"+`
+}`)
+
+	t.Run("invalid value", func(t *testing.T) {
+		_, err := Generate([]byte(strings.Replace(spec, "x-order: 1\n", "x-order: first\n", 1)), cfg)
+		require.ErrorContains(t, err, `invalid value for "x-order" of property 'id'`)
+	})
+}
+
+func TestGoMethodNameExtension(t *testing.T) {
+	spec := `openapi: 3.0.0
+info:
+  title: Pets
+  version: 1.0.0
+paths:
+  /api/v2/pets:
+    get:
+      operationId: api_v2_pets_list_GET
+      x-go-method-name: listPets
+      responses:
+        '200':
+          description: OK
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  type: string
+`
+	cfg := Configuration{
+		PackageName: "testmethodname",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client:    true,
+			MCPServer: &MCPServerOptions{},
+			Handler: &HandlerOptions{
+				Kind: HandlerKindStdHTTP,
+			},
+		},
+	}
+
+	codes, err := Generate([]byte(spec), cfg)
+	require.NoError(t, err)
+	combined := codes.GetCombined()
+	_, err = format.Source([]byte(combined))
+	require.NoError(t, err)
+
+	assert.Contains(t, combined, "func (c *Client) ListPets(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*ListPetsResponse, error) {")
+	assert.Contains(t, combined, "ListPets(ctx *ListPetsContext) (*ListPetsResponseData, error)")
+	assert.Contains(t, combined, `mcp.NewTool("ListPets",`)
+	assert.NotContains(t, combined, "APIV2")
+
+	t.Run("sanitized", func(t *testing.T) {
+		codes, err := Generate([]byte(strings.Replace(spec, "x-go-method-name: listPets", "x-go-method-name: list-pets", 1)), cfg)
+		require.NoError(t, err)
+		assert.Contains(t, codes.GetCombined(), "func (c *Client) List_pets(")
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		_, err := Generate([]byte(strings.Replace(spec, "x-go-method-name: listPets", "x-go-method-name: ''", 1)), cfg)
+		require.ErrorContains(t, err, "error parsing x-go-method-name extension")
+	})
+}
+
+func TestPackagePerSpecLocation(t *testing.T) {
+	spec := `openapi: 3.0.0
+info:
+  title: Pets
+  version: 1.0.0
+paths:
+  /pets/{id}:
+    get:
+      operationId: getPet
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+        - name: X-Request-Id
+          in: header
+          schema:
+            type: string
+            maxLength: 36
+      responses:
+        '200':
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Pet'
+    put:
+      operationId: updatePet
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Pet'
+      responses:
+        '204':
+          description: Updated
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [name]
+      properties:
+        name:
+          type: string
+        kind:
+          type: string
+          enum: [cat, dog]
+`
+	cfg := Configuration{
+		PackageName: "pets",
+		Output: &Output{
+			UseSingleFile:          false,
+			PackagePerSpecLocation: true,
+			ImportPath:             "example.com/pets",
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+	}
+
+	codes, err := Generate([]byte(spec), cfg)
+	require.NoError(t, err)
+
+	for name, code := range codes {
+		_, err := format.Source([]byte(code))
+		require.NoError(t, err, name)
+	}
+
+	types := codes["package:types/types"]
+	assert.Contains(t, types, "package types")
+	assert.Contains(t, types, "type Pet struct")
+	assert.Contains(t, codes["package:types/enums"], "package types")
+	assert.Contains(t, codes["package:types/common"], "var typesValidator")
+
+	assert.Contains(t, codes["package:payloads/payloads"], `"example.com/pets/types"`)
+	assert.Contains(t, codes["package:payloads/payloads"], "= types.Pet")
+	assert.Contains(t, codes["package:responses/responses"], "= types.Pet")
+	assert.Contains(t, codes["package:headers/common"], "var typesValidator")
+
+	client := codes["client"]
+	assert.Contains(t, client, "package pets")
+	assert.Contains(t, client, `"example.com/pets/responses"`)
+	assert.Contains(t, client, "*responses.GetPetResponse")
+	assert.NotContains(t, codes, "types")
+	assert.NotContains(t, codes, "payloads")
+
+	t.Run("requires multiple files", func(t *testing.T) {
+		cfg := cfg
+		cfg.Output = &Output{UseSingleFile: true, PackagePerSpecLocation: true, ImportPath: "example.com/pets"}
+		_, err := Generate([]byte(spec), cfg)
+		require.ErrorContains(t, err, "cannot be used with output.use-single-file")
+	})
+
+	t.Run("requires import path", func(t *testing.T) {
+		cfg := cfg
+		cfg.Output = &Output{PackagePerSpecLocation: true}
+		_, err := Generate([]byte(spec), cfg)
+		require.ErrorContains(t, err, "requires output.import-path")
+	})
+}
+
+func TestDurationType(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "testduration",
+		SkipPrune:   true,
+		Output: &Output{
+			UseSingleFile: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "duration-types.yml")), cfg)
+	require.NoError(t, err)
+
+	combined := codes.GetCombined()
+	assert.Contains(t, combined, `type Job struct {
+	Timeout      runtime.DurationSeconds  `+"`json:\"timeout\" validate:\"required,gte=1,lte=3600\"`"+`
+	PollInterval *runtime.DurationMillis  `+"`json:\"pollInterval,omitempty\"`"+`
+	Retention    *runtime.DurationISO8601 `+"`json:\"retention,omitempty\"`"+`
+	Name         *string                  `+"`json:\"name,omitempty\"`"+`
+}`)
+	// durations are validated with their validation tags
+	assert.Contains(t, combined, `func (j Job) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(j))
+}`)
+
+	t.Run("unit not matching the type", func(t *testing.T) {
+		spec := strings.Replace(readTestdata(t, "duration-types.yml"), "x-go-type-duration: iso8601", "x-go-type-duration: seconds", 1)
+		_, err := Generate([]byte(spec), cfg)
+		require.ErrorContains(t, err, `unit "seconds" doesn't apply to type [string]`)
+	})
+
+	t.Run("unsupported unit", func(t *testing.T) {
+		spec := strings.Replace(readTestdata(t, "duration-types.yml"), "x-go-type-duration: ms", "x-go-type-duration: hours", 1)
+		_, err := Generate([]byte(spec), cfg)
+		require.ErrorContains(t, err, `unsupported unit "hours"`)
+	})
+}
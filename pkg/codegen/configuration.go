@@ -12,6 +12,9 @@ package codegen
 
 import (
 	"fmt"
+	"maps"
+	"net/http"
+	"slices"
 	"time"
 )
 
@@ -19,6 +22,8 @@ import (
 // PackageName to generate the code under.
 // CopyrightHeader is the header to add to the generated code. Use without //.
 // SkipPrune indicates whether to skip pruning unused components on the generated code.
+// BaseDir is the directory $ref to other files, e.g. ./schemas/user.yaml#/User, are resolved from.
+// External references are not resolved when empty. The CLI defaults it to the directory of the spec.
 // Output specifies the output options for the generated code.
 //
 // Filter is the configuration for filtering the paths and operations to be parsed.
@@ -29,24 +34,35 @@ import (
 //	The key is the spec error type name
 //	and the value is the dotted json path to the string result.
 //
+// FieldPaths generates a Resolve<Fields> method on the types for each of their spec field paths, e.g. data[].message,
+// returning the value at the path and whether it's present, see runtime.ResolveField.
+//
+//	The key is the generated type name
+//	and the value lists the dotted json paths, resolved at generation time.
+//
 // UserTemplates is the map of user-provided templates overriding the default ones.
 // UserContext is the map of user-provided context values to be used in templates user overrides.
+// TemplateData is the map of user-provided values exposed to all templates as .Extra,
+// e.g. build-time flags driving conditional content in user templates.
 type Configuration struct {
 	PackageName     string  `yaml:"package"`
 	CopyrightHeader string  `yaml:"copyright-header"`
 	SkipPrune       bool    `yaml:"skip-prune"`
+	BaseDir         string  `yaml:"base-dir,omitempty"`
 	Output          *Output `yaml:"output"`
 
 	Generate *GenerateOptions `yaml:"generate"`
 	Filter   FilterConfig     `yaml:"filter,omitempty"`
 	Overlay  *OverlayOptions  `yaml:"overlay,omitempty"`
 
-	AdditionalImports []AdditionalImport `yaml:"additional-imports,omitempty"`
-	ErrorMapping      map[string]string  `yaml:"error-mapping,omitempty"`
-	Client            *Client            `yaml:"client,omitempty"`
+	AdditionalImports []AdditionalImport  `yaml:"additional-imports,omitempty"`
+	ErrorMapping      map[string]string   `yaml:"error-mapping,omitempty"`
+	FieldPaths        map[string][]string `yaml:"field-paths,omitempty"`
+	Client            *Client             `yaml:"client,omitempty"`
 
 	UserTemplates map[string]string `yaml:"user-templates,omitempty"`
 	UserContext   map[string]any    `yaml:"user-context,omitempty"`
+	TemplateData  map[string]any    `yaml:"template-data,omitempty"`
 }
 
 // Merge combines two configurations, with the receiver (o) taking priority.
@@ -126,6 +142,10 @@ func (o Configuration) OverwriteWith(other Configuration) Configuration {
 		o.CopyrightHeader = other.CopyrightHeader
 	}
 
+	if other.BaseDir != "" {
+		o.BaseDir = other.BaseDir
+	}
+
 	// Overwrite SkipPrune
 	if other.SkipPrune {
 		o.SkipPrune = other.SkipPrune
@@ -145,6 +165,24 @@ func (o Configuration) OverwriteWith(other Configuration) Configuration {
 			if other.Output.UseSingleFile {
 				o.Output.UseSingleFile = other.Output.UseSingleFile
 			}
+			if other.Output.ImportsLocalPrefix != "" {
+				o.Output.ImportsLocalPrefix = other.Output.ImportsLocalPrefix
+			}
+			if other.Output.SkipFormat {
+				o.Output.SkipFormat = other.Output.SkipFormat
+			}
+			if other.Output.FilePerTag {
+				o.Output.FilePerTag = other.Output.FilePerTag
+			}
+			if other.Output.EmitGoGenerate {
+				o.Output.EmitGoGenerate = other.Output.EmitGoGenerate
+			}
+			if other.Output.PackagePerSpecLocation {
+				o.Output.PackagePerSpecLocation = other.Output.PackagePerSpecLocation
+			}
+			if other.Output.ImportPath != "" {
+				o.Output.ImportPath = other.Output.ImportPath
+			}
 		}
 	}
 
@@ -159,12 +197,84 @@ func (o Configuration) OverwriteWith(other Configuration) Configuration {
 			if other.Generate.OmitDescription {
 				o.Generate.OmitDescription = other.Generate.OmitDescription
 			}
+			if other.Generate.ConstraintComments {
+				o.Generate.ConstraintComments = other.Generate.ConstraintComments
+			}
 			if other.Generate.DefaultIntType != "" {
 				o.Generate.DefaultIntType = other.Generate.DefaultIntType
 			}
+			if len(other.Generate.IntTypeByFormat) > 0 {
+				o.Generate.IntTypeByFormat = other.Generate.IntTypeByFormat
+			}
+			if other.Generate.FreeFormObjectType != "" {
+				o.Generate.FreeFormObjectType = other.Generate.FreeFormObjectType
+			}
+			if other.Generate.OptionalType != "" {
+				o.Generate.OptionalType = other.Generate.OptionalType
+			}
+			if other.Generate.DecimalType != "" {
+				o.Generate.DecimalType = other.Generate.DecimalType
+			}
+			if other.Generate.TrimTypePrefix != "" {
+				o.Generate.TrimTypePrefix = other.Generate.TrimTypePrefix
+			}
 			if other.Generate.AlwaysPrefixEnumValues {
 				o.Generate.AlwaysPrefixEnumValues = other.Generate.AlwaysPrefixEnumValues
 			}
+			if other.Generate.EnumStyle != "" {
+				o.Generate.EnumStyle = other.Generate.EnumStyle
+			}
+			if other.Generate.RespectXInternal {
+				o.Generate.RespectXInternal = other.Generate.RespectXInternal
+			}
+			if other.Generate.PreserveJSONCase {
+				o.Generate.PreserveJSONCase = other.Generate.PreserveJSONCase
+			}
+			if other.Generate.ProblemDetails {
+				o.Generate.ProblemDetails = true
+			}
+			if other.Generate.FuzzSeeds {
+				o.Generate.FuzzSeeds = true
+			}
+			if other.Generate.PathBuilders {
+				o.Generate.PathBuilders = other.Generate.PathBuilders
+			}
+			if other.Generate.PathTemplates {
+				o.Generate.PathTemplates = other.Generate.PathTemplates
+			}
+			if other.Generate.Callbacks {
+				o.Generate.Callbacks = other.Generate.Callbacks
+			}
+			if other.Generate.Webhooks {
+				o.Generate.Webhooks = other.Generate.Webhooks
+			}
+			if other.Generate.TestServer {
+				o.Generate.TestServer = other.Generate.TestServer
+			}
+			if other.Generate.MockHTTPServer {
+				o.Generate.MockHTTPServer = other.Generate.MockHTTPServer
+			}
+			if other.Generate.MapConverters {
+				o.Generate.MapConverters = other.Generate.MapConverters
+			}
+			if other.Generate.TruncateHelpers {
+				o.Generate.TruncateHelpers = other.Generate.TruncateHelpers
+			}
+			if other.Generate.MergePatch {
+				o.Generate.MergePatch = other.Generate.MergePatch
+			}
+			if other.Generate.JSONPatch {
+				o.Generate.JSONPatch = other.Generate.JSONPatch
+			}
+			if other.Generate.EmitXConfig {
+				o.Generate.EmitXConfig = other.Generate.EmitXConfig
+			}
+			if other.Generate.InfoConstants {
+				o.Generate.InfoConstants = other.Generate.InfoConstants
+			}
+			if other.Generate.InlineThreshold != 0 {
+				o.Generate.InlineThreshold = other.Generate.InlineThreshold
+			}
 			// Overwrite Validation options
 			if other.Generate.Validation.Skip {
 				o.Generate.Validation.Skip = other.Generate.Validation.Skip
@@ -175,6 +285,15 @@ func (o Configuration) OverwriteWith(other Configuration) Configuration {
 			if other.Generate.Validation.Response {
 				o.Generate.Validation.Response = other.Generate.Validation.Response
 			}
+			if other.Generate.Validation.Context {
+				o.Generate.Validation.Context = other.Generate.Validation.Context
+			}
+			if other.Generate.Validation.Assertions {
+				o.Generate.Validation.Assertions = other.Generate.Validation.Assertions
+			}
+			if other.Generate.Validation.Concurrent {
+				o.Generate.Validation.Concurrent = other.Generate.Validation.Concurrent
+			}
 
 			// Overwrite Handler options
 			if other.Generate.Handler != nil {
@@ -193,6 +312,12 @@ func (o Configuration) OverwriteWith(other Configuration) Configuration {
 					if other.Generate.Handler.Validation.Response {
 						o.Generate.Handler.Validation.Response = other.Generate.Handler.Validation.Response
 					}
+					if other.Generate.Handler.Validation.Middleware {
+						o.Generate.Handler.Validation.Middleware = other.Generate.Handler.Validation.Middleware
+					}
+					if other.Generate.Handler.SchemaValidation {
+						o.Generate.Handler.SchemaValidation = other.Generate.Handler.SchemaValidation
+					}
 				}
 			}
 		}
@@ -209,6 +334,57 @@ func (o Configuration) OverwriteWith(other Configuration) Configuration {
 			if other.Client.Timeout != 0 {
 				o.Client.Timeout = other.Client.Timeout
 			}
+			if other.Client.BodyEditors {
+				o.Client.BodyEditors = true
+			}
+			if other.Client.JSONLibrary != "" {
+				o.Client.JSONLibrary = other.Client.JSONLibrary
+			}
+			if other.Client.EmbedHTTPClient {
+				o.Client.EmbedHTTPClient = true
+			}
+			if other.Client.DocComments {
+				o.Client.DocComments = true
+			}
+			if other.Client.UnionBodyMethods {
+				o.Client.UnionBodyMethods = true
+			}
+			if other.Client.Hedging != nil {
+				o.Client.Hedging = other.Client.Hedging
+			}
+			if other.Client.FilterBuilders {
+				o.Client.FilterBuilders = true
+			}
+			if other.Client.Batch != nil {
+				o.Client.Batch = other.Client.Batch
+			}
+			if other.Client.ConditionalRequests {
+				o.Client.ConditionalRequests = true
+			}
+			if other.Client.DownloadProgress {
+				o.Client.DownloadProgress = true
+			}
+			if other.Client.HealthOperationID != "" {
+				o.Client.HealthOperationID = other.Client.HealthOperationID
+			}
+			if other.Client.RespectRateLimit {
+				o.Client.RespectRateLimit = true
+			}
+			if other.Client.Decorator {
+				o.Client.Decorator = true
+			}
+			if other.Client.Compression != nil {
+				o.Client.Compression = other.Client.Compression
+			}
+			if other.Client.CircuitBreaker != nil {
+				o.Client.CircuitBreaker = other.Client.CircuitBreaker
+			}
+			if other.Client.Interceptors {
+				o.Client.Interceptors = true
+			}
+			if other.Client.IdempotencyKeys {
+				o.Client.IdempotencyKeys = true
+			}
 		}
 	}
 
@@ -227,6 +403,11 @@ func (o Configuration) OverwriteWith(other Configuration) Configuration {
 		o.ErrorMapping = other.ErrorMapping
 	}
 
+	// Overwrite FieldPaths
+	if len(other.FieldPaths) > 0 {
+		o.FieldPaths = other.FieldPaths
+	}
+
 	// Overwrite UserTemplates
 	if len(other.UserTemplates) > 0 {
 		o.UserTemplates = other.UserTemplates
@@ -237,6 +418,14 @@ func (o Configuration) OverwriteWith(other Configuration) Configuration {
 		o.UserContext = other.UserContext
 	}
 
+	// Merge TemplateData: other's keys overwrite the receiver's, the other keys are kept
+	if len(other.TemplateData) > 0 {
+		data := make(map[string]any, len(o.TemplateData)+len(other.TemplateData))
+		maps.Copy(data, o.TemplateData)
+		maps.Copy(data, other.TemplateData)
+		o.TemplateData = data
+	}
+
 	return o
 }
 
@@ -294,12 +483,50 @@ type GenerateOptions struct {
 	// OmitDescription specifies whether to omit schema description from the spec in the generated code. Defaults to false.
 	OmitDescription bool `yaml:"omit-description"`
 
+	// ConstraintComments appends a summary of the constraints of struct fields, e.g. required, minLength or pattern,
+	// to their doc comments, so that they show up in IDE hovers.
+	ConstraintComments bool `yaml:"constraint-comments,omitempty"`
+
 	// DefaultIntType specifies the default integer type to use. Defaults to "int".
 	DefaultIntType string `yaml:"default-int-type"`
 
+	// IntTypeByFormat maps integer formats to Go types, e.g. {"timestamp": "int64"}.
+	// It takes precedence over the built-in int8 - int64 and uint8 - uint64 formats,
+	// integers with an unmapped format fall back to DefaultIntType.
+	IntTypeByFormat map[string]string `yaml:"int-type-by-format,omitempty"`
+
+	// FreeFormObjectType specifies the Go type of free-form objects: `type: object` without
+	// properties and additionalProperties. Objects with `additionalProperties: false` and
+	// no properties are always struct{}. Supported values: "map" (map[string]any),
+	// "rawmessage" (json.RawMessage) and "any". Defaults to "map".
+	FreeFormObjectType FreeFormObjectType `yaml:"free-form-object-type,omitempty"`
+
+	// OptionalType specifies the Go type of optional nullable fields of objects.
+	// Supported values: "pointer" (*T) and "generic" (runtime.Optional[T]), which tells
+	// an absent field apart from a field explicitly set to null. Defaults to "pointer".
+	OptionalType OptionalType `yaml:"optional-type,omitempty"`
+
+	// DecimalType specifies the Go type of `format: decimal` strings and numbers.
+	// Supported values: "string" (string and float64), "shopspring" (decimal.Decimal from
+	// github.com/shopspring/decimal) and "bigrat" (runtime.Decimal and runtime.DecimalString,
+	// backed by big.Rat). Defaults to "string".
+	DecimalType DecimalType `yaml:"decimal-type,omitempty"`
+
+	// TrimTypePrefix strips a prefix from the Go type names of components, e.g. "Billing" turns
+	// BillingInvoice into Invoice. The prefix is matched against the Go type name and only trimmed
+	// at a word boundary; JSON names are kept as is. Generation fails if two components end up
+	// with the same name after trimming. Defaults to "".
+	TrimTypePrefix string `yaml:"trim-type-prefix,omitempty"`
+
 	// AlwaysPrefixEnumValues specifies whether to always prefix enum values with the schema name. Defaults to true.
 	AlwaysPrefixEnumValues bool `yaml:"always-prefix-enum-values"`
 
+	// EnumStyle specifies how enums are generated: "closed" (default) generates a constant per value
+	// and rejects unknown values in Validate, "open" generates the type only, documenting the known values,
+	// with an IsValid method, so that new values sent by the server are accepted. "strict" is "closed"
+	// with an IsValid method and an UnmarshalJSON rejecting unknown values, failing the unmarshaling of their parent.
+	EnumStyle EnumStyle `yaml:"enum-style,omitempty"`
+
 	// Validation specifies options for Validate() method generation.
 	Validation ValidationOptions `yaml:"validation"`
 
@@ -307,6 +534,85 @@ type GenerateOptions struct {
 	// Key is the Go struct tag name, value is the OpenAPI schema field to extract.
 	// Example: {"jsonschema": "description", "validate": "x-validation"}
 	AutoExtraTags map[string]string `yaml:"auto-extra-tags,omitempty"`
+
+	// RespectXInternal specifies whether paths, operations and component schemas
+	// marked with `x-internal: true` are excluded from generation. Defaults to false.
+	RespectXInternal bool `yaml:"respect-x-internal,omitempty"`
+
+	// PreserveJSONCase guarantees that the `json` struct tag of every field is the verbatim
+	// property name from the spec, e.g. `User_ID` stays `User_ID` while the Go field becomes `UserID`.
+	// Extensions that would rename the JSON key (x-oapi-codegen-extra-tags with a json key) are ignored.
+	// Defaults to false.
+	PreserveJSONCase bool `yaml:"preserve-json-case,omitempty"`
+
+	// ProblemDetails decodes the application/problem+json error responses of operations into runtime.ProblemDetails,
+	// an RFC 9457 problem details object implementing error, instead of generating a type from their schema. Defaults to false.
+	ProblemDetails bool `yaml:"problem-details,omitempty"`
+
+	// FuzzSeeds generates a seed corpus file for `go test -fuzz` per example of JSON request bodies,
+	// under testdata/fuzz/Fuzz<BodyType> of the output directory. Bodies without examples are skipped.
+	// Defaults to false.
+	FuzzSeeds bool `yaml:"fuzz-seeds,omitempty"`
+
+	// PathBuilders generates a Build<OperationID>Path function per operation that returns
+	// the operation path with its path parameters formatted and escaped. Defaults to false.
+	PathBuilders bool `yaml:"path-builders,omitempty"`
+
+	// PathTemplates generates the <OperationID>PathTemplate and <OperationID>Method constants per operation,
+	// with the path template of the spec, placeholders included, and the HTTP method. Defaults to false.
+	PathTemplates bool `yaml:"path-templates,omitempty"`
+
+	// Callbacks generates a CallbackReceiver with one net/http handler per operation callback.
+	// Each handler decodes and validates the callback request body and passes it to
+	// the matching CallbacksInterface method. Defaults to false.
+	Callbacks bool `yaml:"callbacks,omitempty"`
+
+	// Webhooks generates a WebhookReceiver for the top-level webhooks section of OpenAPI 3.1,
+	// with the typed payloads of the webhooks. Defaults to false.
+	Webhooks bool `yaml:"webhooks,omitempty"`
+
+	// TestServer generates a Test<HandlerName> in-memory implementation of the handler service interface
+	// for contract tests, with programmable per-operation responses and a call recorder.
+	// Requires handler generation to be enabled. Defaults to false.
+	TestServer bool `yaml:"test-server,omitempty"`
+
+	// MockHTTPServer generates a Mock<HandlerName> httptest server serving the router of the handler service interface
+	// for integration tests, with programmable per-operation responses and a recorder of the received requests.
+	// Requires a net/http based handler kind: every kind but fasthttp, fiber and hertz. Defaults to false.
+	MockHTTPServer bool `yaml:"mock-http-server,omitempty"`
+
+	// MapConverters generates ToMap, ToMapUnmasked and FromMap methods on struct types, converting them
+	// to and from map[string]any through their JSON encoding. Defaults to false.
+	MapConverters bool `yaml:"map-converters,omitempty"`
+
+	// TruncateHelpers generates a Truncate method on struct types that clamps their string fields
+	// to the maxLength of their schema, including those of nested types, instead of failing validation.
+	// Defaults to false.
+	TruncateHelpers bool `yaml:"truncate-helpers,omitempty"`
+
+	// MergePatch generates a <Schema>Patch type for application/merge-patch+json request bodies referencing
+	// a component schema. Its runtime.Optional fields tell a field left unchanged apart from a field set to null,
+	// and its Apply method merges the patch onto a value of the schema type. Defaults to false.
+	MergePatch bool `yaml:"merge-patch,omitempty"`
+
+	// JSONPatch generates a <Schema>PatchBuilder for application/json-patch+json request bodies,
+	// whose request body type becomes runtime.JSONPatch. The patched schema is the component schema
+	// of the x-json-patch-target media type extension, or the one the body references.
+	// The builder validates the operation paths against the fields of the schema. Defaults to false.
+	JSONPatch bool `yaml:"json-patch,omitempty"`
+
+	// EmitXConfig generates a Config struct and a DefaultConfig function from the top-level x-config extension
+	// of the spec, with a field per key and the values of the extension as defaults. Defaults to false.
+	EmitXConfig bool `yaml:"emit-x-config,omitempty"`
+
+	// InfoConstants generates the APITitle and APIVersion constants from the title and version
+	// of the info object of the spec. Defaults to false.
+	InfoConstants bool `yaml:"info-constants,omitempty"`
+
+	// InlineThreshold generates the component schemas with fewer than InlineThreshold properties, all of primitive types,
+	// as an anonymous struct in the struct referencing them instead of as a named type, when they're referenced once,
+	// by a property. Schemas referenced several times keep their type. Defaults to 0, no inlining.
+	InlineThreshold int `yaml:"inline-threshold"`
 }
 
 type ValidationOptions struct {
@@ -318,14 +624,55 @@ type ValidationOptions struct {
 	Simple bool `yaml:"simple"`
 
 	// Response specifies whether to generate Validate() methods for response types.
-	// Useful for contract testing to ensure responses match the OpenAPI spec. Defaults to false.
+	// Useful for contract testing to ensure responses match the OpenAPI spec.
+	// The generated client methods validate the decoded success responses,
+	// returning them with a runtime.ResponseValidationError when invalid. Defaults to false.
 	Response bool `yaml:"response"`
+
+	// Context generates a ValidateContext(ctx) method next to Validate(), which checks ctx.Err()
+	// periodically while iterating over slices and maps, returning early on cancellation. Defaults to false.
+	Context bool `yaml:"context,omitempty"`
+
+	// Assertions emits a compile-time assertion `var _ runtime.Validator = (*T)(nil)` for every type that needs validation,
+	// so that the generated code fails to compile if its Validate() method is missing. Defaults to false.
+	Assertions bool `yaml:"assertions,omitempty"`
+
+	// Concurrent validates the items of the slices in parallel in the Validate() methods, with runtime.ValidateConcurrently,
+	// for large aggregates. The errors are the same as with the sequential validation. Defaults to false.
+	Concurrent bool `yaml:"concurrent,omitempty"`
 }
 
 type Output struct {
 	UseSingleFile bool   `yaml:"use-single-file"`
 	Directory     string `yaml:"directory"`
 	Filename      string `yaml:"filename"`
+
+	// ImportsLocalPrefix groups the imports starting with this prefix, e.g. github.com/mycompany,
+	// after the third-party imports. Comma-separated prefixes are supported.
+	ImportsLocalPrefix string `yaml:"imports-local-prefix,omitempty"`
+
+	// SkipFormat skips goimports and gofmt, for faster generation when the output is not committed.
+	// Unused imports are still removed, with a syntactic pass guessing package names from import paths.
+	SkipFormat bool `yaml:"skip-format,omitempty"`
+
+	// FilePerTag splits the client and handler code into a file per operation tag, e.g. client_billing.go,
+	// using the first tag of each operation. Operations without tags stay in the default client.go and adapter.go files.
+	// It cannot be used with UseSingleFile.
+	FilePerTag bool `yaml:"file-per-tag,omitempty"`
+
+	// EmitGoGenerate writes a //go:generate directive running oapi-codegen with the same config and spec,
+	// so the code can be regenerated with go generate. It goes at the top of the single file,
+	// or into a generate.go file when the code is split. Nothing is emitted for stdout output.
+	EmitGoGenerate bool `yaml:"emit-go-generate,omitempty"`
+
+	// PackagePerSpecLocation moves the types of each spec location into a sub-package of the generated package:
+	// types (component schemas and enums), unions, paths, queries, headers, payloads and responses.
+	// Spec locations referencing each other share a package. Requires ImportPath and cannot be used with UseSingleFile.
+	PackagePerSpecLocation bool `yaml:"package-per-spec-location,omitempty"`
+
+	// ImportPath is the Go import path of the generated package, e.g. github.com/mycompany/api/gen,
+	// used to import its sub-packages.
+	ImportPath string `yaml:"import-path,omitempty"`
 }
 
 // OverlayOptions specifies OpenAPI Overlay files to apply to the spec before generation.
@@ -339,6 +686,226 @@ type OverlayOptions struct {
 type Client struct {
 	Name    string        `yaml:"name"`
 	Timeout time.Duration `yaml:"timeout"`
+
+	// BodyEditors makes the client methods run the runtime.BodyEditorFn editors on the typed request body,
+	// before it is serialized, and generates a typed <OperationID>BodyEditor adapter per operation with a body.
+	BodyEditors bool `yaml:"body-editors,omitempty"`
+
+	// JSONLibrary is the library used to marshal request bodies and unmarshal responses:
+	// "stdlib" (default), "jsoniter" (github.com/json-iterator/go) or "gojson" (github.com/goccy/go-json).
+	// Custom MarshalJSON/UnmarshalJSON methods of the generated types are honored by all of them.
+	JSONLibrary JSONLibrary `yaml:"json-library,omitempty"`
+
+	// EmbedHTTPClient makes NewDefault<Client> send the requests with an http.Client using Timeout,
+	// and generates New<Client>WithHTTPClient to send them with your own *http.Client, e.g. one with a custom transport.
+	EmbedHTTPClient bool `yaml:"embed-http-client,omitempty"`
+
+	// DocComments adds the operations grouped by tag, with their summaries, to the doc comment of the client.
+	DocComments bool `yaml:"doc-comments,omitempty"`
+
+	// UnionBodyMethods generates a <OperationID>With<Variant> client method per variant of a oneOf or anyOf
+	// request body, taking the variant value as the body instead of the union type.
+	UnionBodyMethods bool `yaml:"union-body-methods,omitempty"`
+
+	// Hedging sends hedged requests for the listed operations: another attempt is started when a response
+	// takes longer than Delay, and the first successful response wins. Only list idempotent operations.
+	Hedging *ClientHedging `yaml:"hedging,omitempty"`
+
+	// FilterBuilders generates a fluent <OperationID><Param>Builder per deepObject query parameter with an object schema,
+	// building the filter value and its encoded query string. Defaults to false.
+	FilterBuilders bool `yaml:"filter-builders,omitempty"`
+
+	// Batch generates a Batch method sending the calls of the other operations as the sub-requests
+	// of a single request of a batch operation, and an <OperationID>BatchCall method per operation creating such a call.
+	Batch *ClientBatch `yaml:"batch,omitempty"`
+
+	// ConditionalRequests generates an <OperationID>Conditional method per GET operation, sending If-None-Match
+	// with the ETag of a previous response. It returns a runtime.ConditionalResult with the ETag of the response,
+	// and NotModified set instead of the body on a 304 Not Modified response.
+	ConditionalRequests bool `yaml:"conditional-requests,omitempty"`
+
+	// DownloadProgress generates an <OperationID>WithProgress method per operation with a binary success response,
+	// taking a runtime.ProgressFunc called with the bytes read so far and the Content-Length while the body is downloaded.
+	DownloadProgress bool `yaml:"download-progress,omitempty"`
+
+	// HealthOperationID is the operation ID, as declared in the spec, of the health check sent by the Ping method of the client.
+	// If empty, the operation with the x-healthcheck extension is used, or else the GET /health or /healthz operation.
+	HealthOperationID string `yaml:"health-operation-id,omitempty"`
+
+	// RespectRateLimit makes NewDefault<Client> delay the requests of an operation when the rate limit of its last response,
+	// parsed from the X-RateLimit-* headers, is near exhaustion, and generates a RateLimit method returning that rate limit.
+	RespectRateLimit bool `yaml:"respect-rate-limit,omitempty"`
+
+	// Decorator generates a <Client>Decorator implementing the client interface by delegating the calls to an embedded client,
+	// with Before and After hooks around each call. Embed it to override only some methods, e.g. to refresh credentials.
+	Decorator bool `yaml:"decorator,omitempty"`
+
+	// Compression configures the decoding of compressed responses and the compression of the request bodies by the client.
+	Compression *ClientCompression `yaml:"compression,omitempty"`
+
+	// CircuitBreaker makes NewDefault<Client> keep a circuit breaker per operation, failing its calls fast
+	// after repeated transport errors or non-2xx responses, and generates a CircuitBreaker method returning its state.
+	CircuitBreaker *ClientCircuitBreaker `yaml:"circuit-breaker,omitempty"`
+
+	// Interceptors makes the client methods set the runtime.CallInfo of their call in the context,
+	// with their name as OperationID, for the interceptors of the api client, see runtime.WithInterceptors.
+	Interceptors bool `yaml:"interceptors,omitempty"`
+
+	// IdempotencyKeys makes the client methods of the POST and PATCH operations send an Idempotency-Key header
+	// with a random key per call, kept by the retries of the call. Operations override it with the x-idempotency-key extension.
+	IdempotencyKeys bool `yaml:"idempotency-keys,omitempty"`
+}
+
+// ClientCircuitBreaker configures the circuit breakers of the operations of the client, see runtime.WithCircuitBreaker.
+type ClientCircuitBreaker struct {
+	// FailureThreshold is the number of consecutive failures opening the breaker of an operation. Defaults to 5.
+	FailureThreshold int `yaml:"failure-threshold"`
+
+	// OpenDuration is how long an open breaker fails the calls before letting a trial call through. Defaults to 30s.
+	OpenDuration time.Duration `yaml:"open-duration"`
+}
+
+// ClientCompression configures the compression of the client, see runtime.WithContentDecoders and runtime.WithContentEncoder.
+type ClientCompression struct {
+	// ResponseDecode lists the Content-Encoding algorithms of the responses decoded by NewDefault<Client>:
+	// "gzip", "br" (github.com/andybalholm/brotli) or "zstd" (github.com/klauspost/compress/zstd).
+	// The libraries are only imported when their algorithm is listed.
+	ResponseDecode []string `yaml:"response-decode,omitempty"`
+
+	// Request is the Content-Encoding algorithm compressing the request bodies of the operations, one of ResponseDecode's.
+	// Operations override it with the x-compress-request extension, gzip being used when only the extension enables it.
+	Request string `yaml:"request,omitempty"`
+}
+
+// Decodes returns true if the client decodes responses compressed with the algorithm.
+func (c *ClientCompression) Decodes(algorithm string) bool {
+	return c != nil && slices.Contains(c.ResponseDecode, algorithm)
+}
+
+// Encodes returns true if the client compresses request bodies with the algorithm.
+func (c *ClientCompression) Encodes(algorithm string) bool {
+	return c != nil && c.Request == algorithm
+}
+
+// CompressesRequest returns true if the client compresses the request body of the operation:
+// its x-compress-request extension if set, or else the Compression.Request setting.
+func (c *Client) CompressesRequest(op OperationDefinition) bool {
+	if c == nil || op.Body == nil {
+		return false
+	}
+	if op.CompressRequest != nil {
+		return *op.CompressRequest
+	}
+	return c.Compression != nil && c.Compression.Request != ""
+}
+
+// SetsIdempotencyKey returns true if the client sends an Idempotency-Key header with the requests of the operation:
+// its x-idempotency-key extension if set, or else the IdempotencyKeys setting for POST and PATCH operations.
+func (c *Client) SetsIdempotencyKey(op OperationDefinition) bool {
+	if op.IdempotencyKey != nil {
+		return *op.IdempotencyKey
+	}
+	return c != nil && c.IdempotencyKeys && (op.Method == http.MethodPost || op.Method == http.MethodPatch)
+}
+
+// RequestEncoding returns the algorithm compressing the request bodies of the operations,
+// empty if the client compresses none of them.
+func (c *Client) RequestEncoding(ops []OperationDefinition) string {
+	if !slices.ContainsFunc(ops, c.CompressesRequest) {
+		return ""
+	}
+	if c.Compression != nil && c.Compression.Request != "" {
+		return c.Compression.Request
+	}
+	return "gzip"
+}
+
+// supportedResponseDecoders are the algorithms of ClientCompression.ResponseDecode.
+var supportedResponseDecoders = []string{"gzip", "br", "zstd"}
+
+// Conditional returns true if the client has a conditional method for the operation, see ConditionalRequests.
+func (c *Client) Conditional(op OperationDefinition) bool {
+	return c != nil && c.ConditionalRequests && op.Method == http.MethodGet && op.ClientResponseName() != "" &&
+		(op.Response.ResultName != "" || op.Response.SuccessStatusCode != http.StatusNoContent)
+}
+
+// Progress returns true if the client has a download progress method for the operation, see DownloadProgress:
+// it has a single success response, with a binary body.
+func (c *Client) Progress(op OperationDefinition) bool {
+	return c != nil && c.DownloadProgress && op.Response.ResultName == "" &&
+		op.Response.Success != nil && op.Response.Success.IsBinary()
+}
+
+// LongPoll returns true if the client has a long-poll method for the operation, see runtime.LongPoll:
+// it has the x-long-poll extension and a success response other than 204 No Content, which means no change.
+func (c *Client) LongPoll(op OperationDefinition) bool {
+	return c != nil && op.LongPoll && op.ClientResponseName() != "" &&
+		(op.Response.ResultName != "" || op.Response.SuccessStatusCode != http.StatusNoContent)
+}
+
+// ClientBatch specifies the batch operation of the client, see runtime.ExecuteBatch.
+// The sub-request schema must have the method and path (or url) properties, the sub-response schema the status property.
+// Both can have the id, headers and body properties.
+type ClientBatch struct {
+	// OperationID is the operation ID, as declared in the spec, of the batch operation.
+	OperationID string `yaml:"operation-id"`
+
+	// Request is the name of the component schema of a sub-request, the items of the batch request body.
+	Request string `yaml:"request"`
+
+	// Response is the name of the component schema of a sub-response, the items of the batch response.
+	Response string `yaml:"response"`
+}
+
+// ClientHedging specifies the operations of the client sending hedged requests, see runtime.Hedge.
+type ClientHedging struct {
+	// Delay is how long to wait for a response before starting another attempt.
+	Delay time.Duration `yaml:"delay"`
+
+	// MaxConcurrent is the maximum number of attempts of a request. Defaults to 2.
+	MaxConcurrent int `yaml:"max-concurrent"`
+
+	// OperationIDs are the operation IDs, as declared in the spec, of the hedged operations.
+	OperationIDs []string `yaml:"operation-ids"`
+}
+
+// Hedges returns true if the client sends hedged requests for the operation.
+// Operations streaming a binary request or response body are never hedged:
+// the request body can only be sent once, and the response body is canceled along with the hedged attempts.
+func (h *ClientHedging) Hedges(op OperationDefinition) bool {
+	return h != nil && slices.Contains(h.OperationIDs, op.specID) &&
+		(op.Body == nil || !op.Body.IsBinary()) && len(op.Response.BinaryStatusCodes()) == 0
+}
+
+// JSONLibrary specifies the JSON library used by the generated client.
+type JSONLibrary string
+
+const (
+	JSONLibraryStdlib   JSONLibrary = "stdlib"
+	JSONLibraryJSONIter JSONLibrary = "jsoniter"
+	JSONLibraryGoJSON   JSONLibrary = "gojson"
+)
+
+// IsValid returns true if the JSON library is empty or a supported value.
+func (l JSONLibrary) IsValid() bool {
+	switch l {
+	case "", JSONLibraryStdlib, JSONLibraryJSONIter, JSONLibraryGoJSON:
+		return true
+	default:
+		return false
+	}
+}
+
+// ImportSpec returns the import of the library in the generated code, empty for the standard library.
+func (l JSONLibrary) ImportSpec() string {
+	switch l {
+	case JSONLibraryJSONIter:
+		return `jsoniter "github.com/json-iterator/go"`
+	case JSONLibraryGoJSON:
+		return `gojson "github.com/goccy/go-json"`
+	default:
+		return ""
+	}
 }
 
 // HandlerKind specifies the router/framework to generate handler code for.
@@ -346,6 +913,7 @@ type HandlerKind string
 
 const (
 	HandlerKindBeego      HandlerKind = "beego"
+	HandlerKindBuffalo    HandlerKind = "buffalo"
 	HandlerKindChi        HandlerKind = "chi"
 	HandlerKindEcho       HandlerKind = "echo"
 	HandlerKindFastHTTP   HandlerKind = "fasthttp"
@@ -363,7 +931,93 @@ const (
 // IsValid returns true if the handler kind is a supported value.
 func (k HandlerKind) IsValid() bool {
 	switch k {
-	case HandlerKindBeego, HandlerKindChi, HandlerKindEcho, HandlerKindFastHTTP, HandlerKindFiber, HandlerKindGin, HandlerKindGoFrame, HandlerKindGoZero, HandlerKindGorillaMux, HandlerKindHertz, HandlerKindIris, HandlerKindKratos, HandlerKindStdHTTP:
+	case HandlerKindBeego, HandlerKindBuffalo, HandlerKindChi, HandlerKindEcho, HandlerKindFastHTTP, HandlerKindFiber, HandlerKindGin, HandlerKindGoFrame, HandlerKindGoZero, HandlerKindGorillaMux, HandlerKindHertz, HandlerKindIris, HandlerKindKratos, HandlerKindStdHTTP:
+		return true
+	default:
+		return false
+	}
+}
+
+// ServesNetHTTP returns true if the router of the handler kind serves net/http requests:
+// every kind but fasthttp, fiber and hertz, which have their own request and response types.
+func (k HandlerKind) ServesNetHTTP() bool {
+	switch k {
+	case HandlerKindFastHTTP, HandlerKindFiber, HandlerKindHertz:
+		return false
+	default:
+		return k.IsValid()
+	}
+}
+
+// FreeFormObjectType specifies the Go type generated for free-form objects.
+type FreeFormObjectType string
+
+const (
+	FreeFormObjectMap        FreeFormObjectType = "map"
+	FreeFormObjectRawMessage FreeFormObjectType = "rawmessage"
+	FreeFormObjectAny        FreeFormObjectType = "any"
+)
+
+// IsValid returns true if the free-form object type is empty or a supported value.
+func (t FreeFormObjectType) IsValid() bool {
+	switch t {
+	case "", FreeFormObjectMap, FreeFormObjectRawMessage, FreeFormObjectAny:
+		return true
+	default:
+		return false
+	}
+}
+
+// OptionalType specifies the Go type generated for optional nullable fields.
+type OptionalType string
+
+const (
+	OptionalTypePointer OptionalType = "pointer"
+	OptionalTypeGeneric OptionalType = "generic"
+)
+
+// IsValid returns true if the optional type is empty or a supported value.
+func (t OptionalType) IsValid() bool {
+	switch t {
+	case "", OptionalTypePointer, OptionalTypeGeneric:
+		return true
+	default:
+		return false
+	}
+}
+
+// DecimalType specifies the Go type generated for `format: decimal`.
+type DecimalType string
+
+const (
+	DecimalTypeString     DecimalType = "string"
+	DecimalTypeShopspring DecimalType = "shopspring"
+	DecimalTypeBigRat     DecimalType = "bigrat"
+)
+
+// IsValid returns true if the decimal type is empty or a supported value.
+func (t DecimalType) IsValid() bool {
+	switch t {
+	case "", DecimalTypeString, DecimalTypeShopspring, DecimalTypeBigRat:
+		return true
+	default:
+		return false
+	}
+}
+
+// EnumStyle specifies how enums are generated.
+type EnumStyle string
+
+const (
+	EnumStyleClosed EnumStyle = "closed"
+	EnumStyleOpen   EnumStyle = "open"
+	EnumStyleStrict EnumStyle = "strict"
+)
+
+// IsValid returns true if the enum style is empty or a supported value.
+func (s EnumStyle) IsValid() bool {
+	switch s {
+	case "", EnumStyleClosed, EnumStyleOpen, EnumStyleStrict:
 		return true
 	default:
 		return false
@@ -382,6 +1036,11 @@ type HandlerOptions struct {
 	// Validation specifies options for request/response validation in handlers.
 	Validation HandlerValidation `yaml:"validation"`
 
+	// SchemaValidation embeds the JSON Schemas of JSON request and response bodies
+	// and validates bodies against them before and after calling the service.
+	// This covers constraints that tags can't express, e.g. anyOf on a body. Defaults to false.
+	SchemaValidation bool `yaml:"schema-validation,omitempty"`
+
 	// ModelsPackageAlias is the package alias to prefix model types with.
 	// Used when models are generated separately (generate.models: false).
 	// Example: "types" will generate "types.User" instead of "User".
@@ -449,6 +1108,10 @@ type HandlerValidation struct {
 	// Response enables validation of outgoing responses. Defaults to false.
 	// Useful for contract testing.
 	Response bool `yaml:"response"`
+
+	// Middleware generates a ValidationMiddleware that validates the requests of all the operations,
+	// for servers that validate requests in a middleware rather than in each handler. Defaults to false.
+	Middleware bool `yaml:"middleware,omitempty"`
 }
 
 // MiddlewareOptions specifies options for generating middleware.go.
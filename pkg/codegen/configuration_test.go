@@ -161,6 +161,17 @@ func TestConfiguration_WithDefaults(t *testing.T) {
 		result := userConfig.WithDefaults()
 		assert.Equal(t, "value", result.UserContext["key"])
 	})
+
+	t.Run("user TemplateData is preserved", func(t *testing.T) {
+		userConfig := Configuration{
+			TemplateData: map[string]any{
+				"tracing": true,
+			},
+		}
+
+		result := userConfig.WithDefaults()
+		assert.Equal(t, true, result.TemplateData["tracing"])
+	})
 }
 
 func TestConfiguration_OverwriteWith(t *testing.T) {
@@ -299,6 +310,26 @@ func TestConfiguration_OverwriteWith(t *testing.T) {
 		assert.Equal(t, "override_message", result.ErrorMapping["Error"])
 	})
 
+	t.Run("other TemplateData is merged into user TemplateData", func(t *testing.T) {
+		userConfig := Configuration{
+			TemplateData: map[string]any{
+				"tracing": true,
+				"team":    "payments",
+			},
+		}
+		overrides := Configuration{
+			TemplateData: map[string]any{
+				"tracing": false,
+				"region":  "eu",
+			},
+		}
+
+		result := userConfig.OverwriteWith(overrides)
+
+		assert.Equal(t, map[string]any{"tracing": false, "team": "payments", "region": "eu"}, result.TemplateData)
+		assert.Equal(t, true, userConfig.TemplateData["tracing"]) // receiver map not modified
+	})
+
 	t.Run("SkipPrune can be overwritten", func(t *testing.T) {
 		userConfig := Configuration{
 			SkipPrune: false,
@@ -0,0 +1,353 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"fmt"
+	"sort"
+)
+
+// APIChangeKind is the kind of an APIChange.
+type APIChangeKind string
+
+const (
+	APIChangeAdded   APIChangeKind = "added"
+	APIChangeRemoved APIChangeKind = "removed"
+	APIChangeChanged APIChangeKind = "changed"
+)
+
+// APIChange is a difference between the Go APIs generated from two versions of a spec.
+type APIChange struct {
+	// Operation is the ID of the changed operation, empty for type changes.
+	Operation string
+
+	// TypeName is the name of the changed type, empty for operation changes.
+	TypeName string
+
+	// Field is the changed field of the type, or the changed part of the operation signature,
+	// e.g. body or response. Empty when the whole operation or type is added or removed.
+	Field string
+
+	Kind APIChangeKind
+
+	// Breaking is true if code written against the old API may no longer compile or pass validation.
+	Breaking bool
+
+	// Message describes the change.
+	Message string
+}
+
+func (c APIChange) String() string {
+	if c.Breaking {
+		return "breaking: " + c.Message
+	}
+	return "non-breaking: " + c.Message
+}
+
+// DiffContexts compares the operations and types of two parse contexts, without generating code.
+// Operation changes come first, sorted by operation ID, followed by type changes sorted by type name.
+//
+// Removing an operation, type or field, changing a type, or adding something required is breaking.
+// Adding an optional parameter, body or field, or a new operation or type is not.
+func DiffContexts(oldCtx, newCtx *ParseContext) []APIChange {
+	if oldCtx == nil {
+		oldCtx = &ParseContext{}
+	}
+	if newCtx == nil {
+		newCtx = &ParseContext{}
+	}
+
+	res := diffOperations(oldCtx.Operations, newCtx.Operations)
+	return append(res, diffTypes(parseContextTypeDefinitions(oldCtx), parseContextTypeDefinitions(newCtx))...)
+}
+
+// operationSignaturePart is a part of the generated client method signature,
+// the type of a request options field or the returned type.
+type operationSignaturePart struct {
+	name     string
+	typeName string
+	required bool
+}
+
+// operationSignature returns the parts of the operation signature, in request options field order.
+func operationSignature(op OperationDefinition) []operationSignaturePart {
+	var res []operationSignaturePart
+	if op.PathParams != nil {
+		res = append(res, operationSignaturePart{name: "path params", typeName: op.PathParams.Name, required: true})
+	}
+	if op.Query != nil {
+		res = append(res, operationSignaturePart{name: "query", typeName: op.Query.Name, required: paramsRequired(op.Query)})
+	}
+	if op.Header != nil {
+		res = append(res, operationSignaturePart{name: "header", typeName: op.Header.Name, required: paramsRequired(op.Header)})
+	}
+	if op.Body != nil {
+		res = append(res, operationSignaturePart{name: "body", typeName: op.Body.Schema.TypeDecl(), required: op.Body.Required})
+	}
+	return append(res, operationSignaturePart{name: "response", typeName: op.ClientResponseName(), required: true})
+}
+
+// paramsRequired reports whether any of the parameters is required.
+func paramsRequired(params *RequestParametersDefinition) bool {
+	for _, p := range params.Params {
+		if p.Required {
+			return true
+		}
+	}
+	return false
+}
+
+func diffOperations(oldList, newList []OperationDefinition) []APIChange {
+	oldOps := make(map[string]OperationDefinition, len(oldList))
+	for _, op := range oldList {
+		oldOps[op.ID] = op
+	}
+	newOps := make(map[string]OperationDefinition, len(newList))
+	for _, op := range newList {
+		newOps[op.ID] = op
+	}
+
+	var res []APIChange
+	for _, id := range sortedUnionKeys(oldOps, newOps) {
+		oldOp, inOld := oldOps[id]
+		newOp, inNew := newOps[id]
+
+		switch {
+		case !inNew:
+			res = append(res, APIChange{
+				Operation: id,
+				Kind:      APIChangeRemoved,
+				Breaking:  true,
+				Message:   fmt.Sprintf("operation %s (%s %s) removed", id, oldOp.Method, oldOp.Path),
+			})
+		case !inOld:
+			res = append(res, APIChange{
+				Operation: id,
+				Kind:      APIChangeAdded,
+				Message:   fmt.Sprintf("operation %s (%s %s) added", id, newOp.Method, newOp.Path),
+			})
+		default:
+			res = append(res, diffOperation(oldOp, newOp)...)
+		}
+	}
+
+	return res
+}
+
+func diffOperation(from, to OperationDefinition) []APIChange {
+	var res []APIChange
+	if from.Method != to.Method || from.Path != to.Path {
+		res = append(res, APIChange{
+			Operation: to.ID,
+			Field:     "path",
+			Kind:      APIChangeChanged,
+			Message: fmt.Sprintf("operation %s moved from %s %s to %s %s",
+				to.ID, from.Method, from.Path, to.Method, to.Path),
+		})
+	}
+
+	oldParts := map[string]operationSignaturePart{}
+	for _, p := range operationSignature(from) {
+		oldParts[p.name] = p
+	}
+	newParts := map[string]operationSignaturePart{}
+	for _, p := range operationSignature(to) {
+		newParts[p.name] = p
+	}
+
+	for _, name := range []string{"path params", "query", "header", "body", "response"} {
+		oldPart, inOld := oldParts[name]
+		newPart, inNew := newParts[name]
+
+		switch {
+		case !inOld && !inNew:
+			continue
+		case !inNew:
+			res = append(res, APIChange{
+				Operation: to.ID,
+				Field:     name,
+				Kind:      APIChangeRemoved,
+				Breaking:  true,
+				Message:   fmt.Sprintf("operation %s no longer takes %s %s", to.ID, name, oldPart.typeName),
+			})
+		case !inOld:
+			res = append(res, APIChange{
+				Operation: to.ID,
+				Field:     name,
+				Kind:      APIChangeAdded,
+				Breaking:  newPart.required,
+				Message:   fmt.Sprintf("operation %s takes %s %s %s", to.ID, requiredString(newPart.required), name, newPart.typeName),
+			})
+		case oldPart.typeName != newPart.typeName:
+			res = append(res, APIChange{
+				Operation: to.ID,
+				Field:     name,
+				Kind:      APIChangeChanged,
+				Breaking:  true,
+				Message: fmt.Sprintf("operation %s %s changed from %s to %s",
+					to.ID, name, oldPart.typeName, newPart.typeName),
+			})
+		case !oldPart.required && newPart.required:
+			res = append(res, APIChange{
+				Operation: to.ID,
+				Field:     name,
+				Kind:      APIChangeChanged,
+				Breaking:  true,
+				Message:   fmt.Sprintf("operation %s %s is now required", to.ID, name),
+			})
+		}
+	}
+
+	return res
+}
+
+func diffTypes(oldList, newList []TypeDefinition) []APIChange {
+	oldTypes := make(map[string]TypeDefinition, len(oldList))
+	for _, td := range oldList {
+		if _, found := oldTypes[td.Name]; !found {
+			oldTypes[td.Name] = td
+		}
+	}
+	newTypes := make(map[string]TypeDefinition, len(newList))
+	for _, td := range newList {
+		if _, found := newTypes[td.Name]; !found {
+			newTypes[td.Name] = td
+		}
+	}
+
+	var res []APIChange
+	for _, name := range sortedUnionKeys(oldTypes, newTypes) {
+		oldType, inOld := oldTypes[name]
+		newType, inNew := newTypes[name]
+
+		switch {
+		case !inNew:
+			res = append(res, APIChange{
+				TypeName: name,
+				Kind:     APIChangeRemoved,
+				Breaking: true,
+				Message:  fmt.Sprintf("type %s removed", name),
+			})
+		case !inOld:
+			res = append(res, APIChange{
+				TypeName: name,
+				Kind:     APIChangeAdded,
+				Message:  fmt.Sprintf("type %s added", name),
+			})
+		default:
+			res = append(res, diffType(oldType, newType)...)
+		}
+	}
+
+	return res
+}
+
+func diffType(from, to TypeDefinition) []APIChange {
+	// Types without fields are compared by their declaration, e.g. type Name = string.
+	if len(from.Schema.Properties) == 0 || len(to.Schema.Properties) == 0 {
+		oldDecl, newDecl := from.Schema.TypeDecl(), to.Schema.TypeDecl()
+		if oldDecl == newDecl {
+			return nil
+		}
+		return []APIChange{{
+			TypeName: to.Name,
+			Kind:     APIChangeChanged,
+			Breaking: true,
+			Message:  fmt.Sprintf("type %s changed from %s to %s", to.Name, typeDeclSummary(from), typeDeclSummary(to)),
+		}}
+	}
+
+	oldFields := make(map[string]Property, len(from.Schema.Properties))
+	for _, p := range from.Schema.Properties {
+		oldFields[p.GoName] = p
+	}
+	newFields := make(map[string]Property, len(to.Schema.Properties))
+	for _, p := range to.Schema.Properties {
+		newFields[p.GoName] = p
+	}
+
+	var res []APIChange
+	for _, p := range from.Schema.Properties {
+		if _, found := newFields[p.GoName]; !found {
+			res = append(res, APIChange{
+				TypeName: to.Name,
+				Field:    p.GoName,
+				Kind:     APIChangeRemoved,
+				Breaking: true,
+				Message:  fmt.Sprintf("field %s.%s removed", to.Name, p.GoName),
+			})
+		}
+	}
+
+	for _, p := range to.Schema.Properties {
+		oldField, found := oldFields[p.GoName]
+		required := p.Constraints.Required != nil && *p.Constraints.Required
+		switch {
+		case !found:
+			res = append(res, APIChange{
+				TypeName: to.Name,
+				Field:    p.GoName,
+				Kind:     APIChangeAdded,
+				Breaking: required,
+				Message:  fmt.Sprintf("%s field %s.%s %s added", requiredString(required), to.Name, p.GoName, p.GoTypeDef()),
+			})
+		case oldField.GoTypeDef() != p.GoTypeDef():
+			res = append(res, APIChange{
+				TypeName: to.Name,
+				Field:    p.GoName,
+				Kind:     APIChangeChanged,
+				Breaking: true,
+				Message: fmt.Sprintf("field %s.%s changed from %s to %s",
+					to.Name, p.GoName, oldField.GoTypeDef(), p.GoTypeDef()),
+			})
+		case required && (oldField.Constraints.Required == nil || !*oldField.Constraints.Required):
+			res = append(res, APIChange{
+				TypeName: to.Name,
+				Field:    p.GoName,
+				Kind:     APIChangeChanged,
+				Breaking: true,
+				Message:  fmt.Sprintf("field %s.%s is now required", to.Name, p.GoName),
+			})
+		}
+	}
+
+	return res
+}
+
+// typeDeclSummary returns the declaration of a type without fields, or "struct" for types with fields.
+func typeDeclSummary(td TypeDefinition) string {
+	if len(td.Schema.Properties) > 0 {
+		return "struct"
+	}
+	return td.Schema.TypeDecl()
+}
+
+func requiredString(required bool) string {
+	if required {
+		return "required"
+	}
+	return "optional"
+}
+
+// sortedUnionKeys returns the keys present in any of the maps, sorted.
+func sortedUnionKeys[T any](a, b map[string]T) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, found := a[k]; !found {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
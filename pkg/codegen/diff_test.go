@@ -0,0 +1,69 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffContexts(t *testing.T) {
+	cfg := Configuration{PackageName: "gen", SkipPrune: true}.WithDefaults()
+
+	oldCtx, errs := CreateParseContext([]byte(readTestdata(t, "diff/old.yml")), cfg)
+	require.Empty(t, errs)
+	newCtx, errs := CreateParseContext([]byte(readTestdata(t, "diff/new.yml")), cfg)
+	require.Empty(t, errs)
+
+	t.Run("changes", func(t *testing.T) {
+		expected := []APIChange{
+			{Operation: "DeletePet", Kind: APIChangeRemoved, Breaking: true, Message: "operation DeletePet (DELETE /pets/{id}) removed"},
+			{Operation: "GetPet", Kind: APIChangeAdded, Message: "operation GetPet (GET /pets/{id}) added"},
+			{Operation: "ListPets", Field: "query", Kind: APIChangeAdded, Message: "operation ListPets takes optional query ListPetsQuery"},
+			{TypeName: "DeletePetPath", Kind: APIChangeRemoved, Breaking: true, Message: "type DeletePetPath removed"},
+			{TypeName: "GetPetPath", Kind: APIChangeAdded, Message: "type GetPetPath added"},
+			{TypeName: "GetPetResponse", Kind: APIChangeAdded, Message: "type GetPetResponse added"},
+			{TypeName: "ListPetsQuery", Kind: APIChangeAdded, Message: "type ListPetsQuery added"},
+			{TypeName: "Pet", Field: "Nickname", Kind: APIChangeRemoved, Breaking: true, Message: "field Pet.Nickname removed"},
+			{TypeName: "Pet", Field: "Age", Kind: APIChangeChanged, Breaking: true, Message: "field Pet.Age changed from *int to *string"},
+			{TypeName: "Pet", Field: "Species", Kind: APIChangeAdded, Breaking: true, Message: "required field Pet.Species string added"},
+			{TypeName: "Pet", Field: "Color", Kind: APIChangeAdded, Message: "optional field Pet.Color *string added"},
+			{TypeName: "Tag", Kind: APIChangeChanged, Breaking: true, Message: "type Tag changed from string to int"},
+		}
+		assert.Equal(t, expected, DiffContexts(oldCtx, newCtx))
+	})
+
+	t.Run("same spec has no changes", func(t *testing.T) {
+		assert.Empty(t, DiffContexts(oldCtx, oldCtx))
+	})
+
+	t.Run("required parameter added", func(t *testing.T) {
+		response := ResponseDefinition{Success: &ResponseContentDefinition{ResponseName: "ListPetsResponse"}}
+		ops := []OperationDefinition{{ID: "ListPets", Method: "GET", Path: "/pets", Response: response}}
+		withQuery := []OperationDefinition{{
+			ID:       "ListPets",
+			Method:   "GET",
+			Path:     "/pets",
+			Response: response,
+			Query: &RequestParametersDefinition{
+				Name:   "ListPetsQuery",
+				Params: []ParameterDefinition{{ParamName: "limit", In: "query", Required: true}},
+			},
+		}}
+
+		res := DiffContexts(&ParseContext{Operations: ops}, &ParseContext{Operations: withQuery})
+		require.Len(t, res, 1)
+		assert.True(t, res[0].Breaking)
+		assert.Equal(t, "breaking: operation ListPets takes required query ListPetsQuery", res[0].String())
+	})
+}
@@ -34,4 +34,13 @@ var (
 	ErrHandlerKindRequired                       = errors.New("handler kind is required")
 	ErrHandlerKindUnsupported                    = errors.New("unsupported handler kind")
 	ErrServerHandlerPackageRequired              = errors.New("server handler-package is required when server generation is enabled")
+	ErrFreeFormObjectTypeUnsupported             = errors.New("unsupported free-form object type")
+	ErrOptionalTypeUnsupported                   = errors.New("unsupported optional type")
+	ErrDecimalTypeUnsupported                    = errors.New("unsupported decimal type")
+	ErrEnumStyleUnsupported                      = errors.New("unsupported enum style")
+	ErrJSONLibraryUnsupported                    = errors.New("unsupported JSON library")
+	ErrCompressionUnsupported                    = errors.New("unsupported compression algorithm")
+	ErrExternalRefFileNotFound                   = errors.New("external $ref file not found")
+	ErrTrimmedTypeNameCollision                  = errors.New("type names collide after trimming the type prefix")
+	ErrFieldPathNotFound                         = errors.New("field path not found")
 )
@@ -30,6 +30,10 @@ const (
 	// extPropGoImport specifies the module to import which provides above type
 	extPropGoImport = "x-go-type-import"
 
+	// extPropGoTypeDuration maps an integer, number or string to a time.Duration based type,
+	// marshaled in the unit of the extension, see durationGoType.
+	extPropGoTypeDuration = "x-go-type-duration"
+
 	// extGoName is used to override a field name
 	extGoName = "x-go-name"
 
@@ -39,6 +43,7 @@ const (
 	extPropGoJsonIgnore = "x-go-json-ignore"
 	extPropOmitEmpty    = "x-omitempty"
 	extPropExtraTags    = "x-oapi-codegen-extra-tags"
+	extPropGoTags       = "x-go-tags"
 	extPropJsonSchema   = "x-jsonschema"
 
 	// Override generated variable names for enum constants.
@@ -54,6 +59,40 @@ const (
 
 	// extMCP configures MCP tool generation for an operation
 	extMCP = "x-mcp"
+
+	// extHealthCheck marks an operation as the health check of the API, sent by the Ping client method.
+	extHealthCheck = "x-healthcheck"
+
+	// extCompressRequest overrides, per operation, the compression of the request body by the client.
+	extCompressRequest = "x-compress-request"
+
+	// extIdempotencyKey overrides, per operation, the Idempotency-Key header sent by the client.
+	extIdempotencyKey = "x-idempotency-key"
+
+	// extLongPoll marks an operation as a long-poll one, getting an <OperationID>LongPoll client method.
+	extLongPoll = "x-long-poll"
+
+	// extInternal marks a path, operation or schema as internal-only.
+	extInternal = "x-internal"
+
+	// extValidationMessage overrides the message of the minItems, maxItems, minProperties and
+	// maxProperties validation errors, %d or %s being replaced by the bound.
+	extValidationMessage = "x-validation-message"
+
+	// extGoFastJSON generates a MarshalJSON encoding the fields of an object schema without reflection.
+	extGoFastJSON = "x-go-fast-json"
+
+	// extJSONPatchTarget references the component schema patched by an application/json-patch+json body.
+	extJSONPatchTarget = "x-json-patch-target"
+
+	// extGoMethodName overrides the name of the client and server methods of an operation.
+	extGoMethodName = "x-go-method-name"
+
+	// extOrder sets the position of a property among the fields of its struct, see sortPropertiesByOrder.
+	extOrder = "x-order"
+
+	// extXConfig declares the configuration of the service at the top level of the spec, generating a Config struct.
+	extXConfig = "x-config"
 )
 
 // MCPExtension configures MCP tool generation for an operation.
@@ -239,6 +278,31 @@ func parseBooleanValue(value any) (bool, error) {
 	return false, fmt.Errorf("failed to convert type: %T", value)
 }
 
+// parseOrder parses the x-order extension value.
+func parseOrder(value any) (int, error) {
+	switch v := value.(type) {
+	case int:
+		return v, nil
+	case string:
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, fmt.Errorf("failed to convert value %q to int", v)
+		}
+		return n, nil
+	}
+	return 0, fmt.Errorf("failed to convert type: %T", value)
+}
+
+// extIsInternal reports whether the given extensions carry a truthy x-internal value.
+func extIsInternal(extensions *orderedmap.Map[string, *yaml.Node]) bool {
+	val, ok := extractExtensions(extensions)[extInternal]
+	if !ok {
+		return false
+	}
+	b, err := parseBooleanValue(val)
+	return err == nil && b
+}
+
 // extParseSensitiveData parses the x-sensitive-data extension value into runtime.SensitiveDataConfig
 func extParseSensitiveData(extPropValue any) (*runtime.SensitiveDataConfig, error) {
 	config := runtime.NewDefaultSensitiveDataConfig()
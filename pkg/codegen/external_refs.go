@@ -0,0 +1,110 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pb33f/libopenapi/bundler"
+	"github.com/pb33f/libopenapi/datamodel"
+	"go.yaml.in/yaml/v4"
+)
+
+// bundleExternalRefs resolves $ref to other files, relative to baseDir, and lifts the referenced
+// schemas into components, so the rest of the pipeline only deals with local references.
+// Documents without external references are returned unchanged.
+func bundleExternalRefs(contents []byte, baseDir string) ([]byte, error) {
+	found, err := checkExternalRefFiles(contents, baseDir, "spec", map[string]bool{})
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return contents, nil
+	}
+
+	docConfig := &datamodel.DocumentConfiguration{
+		BasePath:                   baseDir,
+		AllowFileReferences:        true,
+		SkipCircularReferenceCheck: true,
+		Logger:                     slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+	bundled, err := bundler.BundleBytesComposed(contents, docConfig, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error bundling external references from %s: %w", baseDir, err)
+	}
+	return bundled, nil
+}
+
+// checkExternalRefFiles reports whether the document references other files,
+// and returns an error naming the first referenced file that does not exist.
+// Referenced files are checked recursively, relative to their own directory.
+func checkExternalRefFiles(contents []byte, dir, source string, visited map[string]bool) (bool, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(contents, &root); err != nil {
+		return false, fmt.Errorf("error parsing %s: %w", source, err)
+	}
+
+	found := false
+	for _, ref := range collectRefValues(&root, nil) {
+		file, _, _ := strings.Cut(ref, "#")
+		if file == "" || strings.HasPrefix(file, "http://") || strings.HasPrefix(file, "https://") {
+			continue
+		}
+		found = true
+
+		path := file
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, file)
+		}
+		if visited[path] {
+			continue
+		}
+		visited[path] = true
+
+		// #nosec G304 -- the referenced files are part of the user-provided spec
+		refContents, err := os.ReadFile(path)
+		if err != nil {
+			return false, fmt.Errorf("%w: %s, referenced as %q from %s", ErrExternalRefFileNotFound, path, ref, source)
+		}
+		if _, err := checkExternalRefFiles(refContents, filepath.Dir(path), path, visited); err != nil {
+			return false, err
+		}
+	}
+
+	return found, nil
+}
+
+// collectRefValues returns the values of all $ref keys in the YAML tree.
+func collectRefValues(node *yaml.Node, res []string) []string {
+	if node == nil {
+		return res
+	}
+	if node.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if key.Value == "$ref" && value.Kind == yaml.ScalarNode {
+				res = append(res, value.Value)
+				continue
+			}
+			res = collectRefValues(value, res)
+		}
+		return res
+	}
+	for _, child := range node.Content {
+		res = collectRefValues(child, res)
+	}
+	return res
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateParseContextFromFile(t *testing.T) {
+	t.Run("resolves external refs", func(t *testing.T) {
+		ctx, errs := CreateParseContextFromFile("testdata/external-refs/api.yaml", Configuration{PackageName: "extrefs"})
+		require.Nil(t, errs)
+
+		var names []string
+		for _, td := range ctx.TypeDefinitions[SpecLocationSchema] {
+			names = append(names, td.Name)
+		}
+		assert.ElementsMatch(t, []string{"User", "Address"}, names)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, errs := CreateParseContextFromFile("testdata/external-refs/missing.yaml", Configuration{PackageName: "extrefs"})
+		require.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], ErrExternalRefFileNotFound)
+		assert.Contains(t, errs[0].Error(), filepath.Join("testdata", "external-refs", "schemas", "missing.yaml"))
+	})
+
+	t.Run("missing spec", func(t *testing.T) {
+		_, errs := CreateParseContextFromFile("testdata/external-refs/nope.yaml", Configuration{})
+		require.Len(t, errs, 1)
+		assert.Contains(t, errs[0].Error(), "error reading spec")
+	})
+}
+
+func TestGenerateWithBaseDir(t *testing.T) {
+	contents, err := os.ReadFile("testdata/external-refs/api.yaml")
+	require.NoError(t, err)
+
+	cfg := Configuration{
+		PackageName: "extrefs",
+		BaseDir:     "testdata/external-refs",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+	}
+
+	codes, err := Generate(contents, cfg)
+	require.NoError(t, err)
+
+	combined := codes.GetCombined()
+	assert.Contains(t, combined, "type GetUserResponse = User")
+	assert.Contains(t, combined, "type User struct {")
+	assert.Contains(t, combined, "Address *Address `json:\"address,omitempty\"`")
+}
+
+func TestCheckExternalRefFiles(t *testing.T) {
+	t.Run("local refs only", func(t *testing.T) {
+		found, err := checkExternalRefFiles([]byte(`
+components:
+  schemas:
+    A:
+      $ref: '#/components/schemas/B'
+    B:
+      $ref: 'https://example.com/b.yaml#/B'
+`), ".", "spec", map[string]bool{})
+		require.NoError(t, err)
+		assert.False(t, found)
+	})
+
+	t.Run("nested missing file", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "a.yaml"), []byte("A:\n  $ref: './b.yaml#/B'\n"), 0o600))
+
+		_, err := checkExternalRefFiles([]byte("x:\n  $ref: './a.yaml#/A'\n"), dir, "spec", map[string]bool{})
+		require.ErrorIs(t, err, ErrExternalRefFileNotFound)
+		assert.Contains(t, err.Error(), `referenced as "./b.yaml#/B" from `+filepath.Join(dir, "a.yaml"))
+	})
+}
@@ -0,0 +1,227 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// FastJSONField describes a field written by the reflect-free MarshalJSON of an x-go-fast-json type.
+type FastJSONField struct {
+	Property Property
+
+	// Key is the key of the field in the JSON object.
+	Key string
+
+	// OmitEmpty and OmitZero are the options of the json struct tag.
+	OmitEmpty bool
+	OmitZero  bool
+
+	value fastJSONValue
+}
+
+// fastJSONValue describes how a value is written by the runtime.JSONEncoder.
+type fastJSONValue struct {
+	// method is the encoder method writing the value, conversion the type it takes.
+	method     string
+	conversion string
+	bits       int
+
+	// nested is true for the x-go-fast-json types, written by their writeJSON method.
+	nested bool
+
+	// item is the value of the items of a slice, written one by one.
+	item *fastJSONValue
+}
+
+// HasFastJSON returns true if the type is an object with x-go-fast-json, encoded by a generated
+// MarshalJSON writing its fields one by one instead of encoding/json reflection.
+func (t TypeDefinition) HasFastJSON() bool {
+	return !t.IsAlias() && !t.NeedsMarshaler && t.MergePatchOf == "" && t.JSONPatchOf == "" && t.Schema.hasFastJSON()
+}
+
+func (s GoSchema) hasFastJSON() bool {
+	if s.HasAdditionalProperties || len(s.UnionElements) > 0 || len(s.Properties) == 0 ||
+		!strings.HasPrefix(s.GoType, "struct") || s.OpenAPISchema == nil || s.OpenAPISchema.Extensions == nil {
+		return false
+	}
+	ext := s.OpenAPISchema.Extensions.Value(extGoFastJSON)
+	if ext == nil {
+		return false
+	}
+	if fastJSON, err := parseBooleanValue(ext.Value); err != nil || !fastJSON {
+		return false
+	}
+	for _, p := range s.Properties {
+		// embedded fields are merged by the generic marshaler
+		if p.JsonFieldName == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// fastJSONTypes returns the names of the types with a reflect-free MarshalJSON.
+func fastJSONTypes(typeDefs map[SpecLocation][]TypeDefinition) map[string]bool {
+	res := make(map[string]bool)
+	for _, tds := range typeDefs {
+		for _, td := range tds {
+			if td.HasFastJSON() {
+				res[td.Name] = true
+			}
+		}
+	}
+	return res
+}
+
+// FastJSONFields returns the fields written by the generated MarshalJSON, in the order of the struct.
+// The fields of the fastJSON types are written by their own writeJSON method.
+func (t TypeDefinition) FastJSONFields(preserveJSONCase bool, fastJSON map[string]bool) []FastJSONField {
+	var fields []FastJSONField
+	for _, p := range deduplicateProperties(t.Schema.Properties) {
+		name, opts, _ := strings.Cut(p.jsonTag(preserveJSONCase), ",")
+		if name == "-" && opts == "" {
+			continue
+		}
+		if name == "" {
+			name = p.GoName
+		}
+
+		field := FastJSONField{Property: p, Key: name, value: fastJSONValue{method: "Value"}}
+		for _, opt := range strings.Split(opts, ",") {
+			switch opt {
+			case "omitempty":
+				field.OmitEmpty = true
+			case "omitzero":
+				field.OmitZero = true
+			}
+		}
+
+		// the string option quotes the encoded value, leave it to encoding/json
+		if !strings.Contains(","+opts+",", ",string,") {
+			field.value = fastJSONValueOf(p.Schema, fastJSON)
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// fastJSONValueOf returns how the values of the schema are written.
+func fastJSONValueOf(s GoSchema, fastJSON map[string]bool) fastJSONValue {
+	typeDecl := strings.TrimPrefix(s.TypeDecl(), "*")
+	switch typeDecl {
+	case "string":
+		return fastJSONValue{method: "String"}
+	case "bool":
+		return fastJSONValue{method: "Bool"}
+	case "int", "int8", "int16", "int32", "int64":
+		return fastJSONValue{method: "Int", conversion: "int64"}
+	case "uint", "uint8", "uint16", "uint32", "uint64":
+		return fastJSONValue{method: "Uint", conversion: "uint64"}
+	case "float32":
+		return fastJSONValue{method: "Float", conversion: "float64", bits: 32}
+	case "float64":
+		return fastJSONValue{method: "Float", bits: 64}
+	case "time.Time":
+		return fastJSONValue{method: "Time"}
+	}
+
+	if fastJSON[typeDecl] {
+		return fastJSONValue{nested: true}
+	}
+
+	// named strings, e.g. enums
+	if typeDecl != "" && !strings.ContainsAny(typeDecl, ".[]*") && s.OpenAPISchema != nil && slices.Equal(s.OpenAPISchema.Type, []string{"string"}) &&
+		s.OpenAPISchema.Format == "" && (s.OpenAPISchema.Extensions == nil || s.OpenAPISchema.Extensions.Value(extPropGoType) == nil) {
+		return fastJSONValue{method: "String", conversion: "string"}
+	}
+
+	// slices of values written one by one, but []byte, base64-encoded by encoding/json
+	if s.ArrayType != nil && strings.HasPrefix(s.TypeDecl(), "[]") && !strings.HasPrefix(s.ArrayType.TypeDecl(), "*") {
+		item := fastJSONValueOf(*s.ArrayType, fastJSON)
+		if itemType := s.ArrayType.TypeDecl(); item.method != "Value" && itemType != "uint8" && itemType != "byte" {
+			return fastJSONValue{item: &item}
+		}
+	}
+
+	return fastJSONValue{method: "Value"}
+}
+
+// FastJSONSize returns an estimate of the size of the encoded type, used to preallocate the buffer.
+func (t TypeDefinition) FastJSONSize(preserveJSONCase bool, fastJSON map[string]bool) int {
+	size := 2
+	for _, f := range t.FastJSONFields(preserveJSONCase, fastJSON) {
+		size += len(f.Key) + 16
+		if f.value.nested || f.value.item != nil {
+			size += 64
+		}
+	}
+	return size
+}
+
+// Encode returns the statements writing the value of expr with the enc encoder.
+func (f FastJSONField) Encode(expr string) string {
+	return f.value.encode(expr, 0)
+}
+
+func (v fastJSONValue) encode(expr string, depth int) string {
+	switch {
+	case v.nested:
+		if strings.HasPrefix(expr, "*") {
+			expr = "(" + expr + ")"
+		}
+		return expr + ".writeJSON(enc, masked)"
+
+	case v.item != nil:
+		item := "item"
+		if depth > 0 {
+			item = fmt.Sprintf("item%d", depth+1)
+		}
+		return strings.Join([]string{
+			fmt.Sprintf("if %s == nil {", expr),
+			"enc.Null()",
+			"} else {",
+			"enc.BeginArray()",
+			fmt.Sprintf("for _, %s := range %s {", item, expr),
+			v.item.encode(item, depth+1),
+			"}",
+			"enc.EndArray()",
+			"}",
+		}, "\n")
+	}
+
+	if v.conversion != "" {
+		expr = fmt.Sprintf("%s(%s)", v.conversion, expr)
+	}
+	if v.bits != 0 {
+		return fmt.Sprintf("enc.%s(%s, %d)", v.method, expr, v.bits)
+	}
+	return fmt.Sprintf("enc.%s(%s)", v.method, expr)
+}
+
+// NotEmpty returns the condition under which the value of expr isn't omitted by omitempty.
+func (f FastJSONField) NotEmpty(expr string) string {
+	typeDecl := f.Property.Schema.TypeDecl()
+	switch {
+	case strings.HasPrefix(typeDecl, "[]") || strings.HasPrefix(typeDecl, "map["):
+		return "len(" + expr + ") != 0"
+	case f.value.method == "String":
+		return expr + ` != ""`
+	case f.value.method == "Bool":
+		return expr
+	case f.value.method == "Int" || f.value.method == "Uint" || f.value.method == "Float":
+		return expr + " != 0"
+	default:
+		return "!runtime.IsEmptyJSONValue(" + expr + ")"
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+)
+
+// operationsByFileTag groups the operations by their first tag, for Output.FilePerTag.
+// The keys are the snake-case file suffixes of the tags, sorted. Operations without tags are returned separately.
+func operationsByFileTag(ops []OperationDefinition) ([]string, map[string][]OperationDefinition, []OperationDefinition) {
+	byTag := map[string][]OperationDefinition{}
+	var untagged []OperationDefinition
+	for _, op := range ops {
+		suffix := ""
+		if len(op.Tags) > 0 {
+			suffix = tagFileSuffix(op.Tags[0])
+		}
+		if suffix == "" {
+			untagged = append(untagged, op)
+			continue
+		}
+		byTag[suffix] = append(byTag[suffix], op)
+	}
+
+	suffixes := make([]string, 0, len(byTag))
+	for suffix := range byTag {
+		suffixes = append(suffixes, suffix)
+	}
+	sort.Strings(suffixes)
+	return suffixes, byTag, untagged
+}
+
+// tagFileSuffix returns the snake-case file name suffix of a tag, e.g. "Billing Accounts" -> billing_accounts.
+func tagFileSuffix(tag string) string {
+	var b strings.Builder
+	for _, r := range tag {
+		if r < 128 && (r == '_' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z') {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune(' ')
+		}
+	}
+	return strings.Trim(strcase.ToSnake(b.String()), "_")
+}
+
+// tagFileKey returns the GeneratedCode key of a per-tag file, e.g. client_billing,
+// suffixed with _tag if a generated file already has that name or if the name would make it a test file.
+func tagFileKey(prefix, suffix string, out GeneratedCode) string {
+	key := prefix + "_" + suffix
+	if _, found := out[key]; found || strings.HasSuffix(key, "_test") {
+		key += "_tag"
+	}
+	return key
+}
@@ -16,12 +16,13 @@ import (
 	"strings"
 
 	"github.com/pb33f/libopenapi"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
 	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
 	"github.com/pb33f/libopenapi/orderedmap"
 	"go.yaml.in/yaml/v4"
 )
 
-func filterOutDocument(doc libopenapi.Document, cfg FilterConfig) (*v3high.Document, bool, error) {
+func filterOutDocument(doc libopenapi.Document, cfg FilterConfig, respectInternal bool) (*v3high.Document, bool, error) {
 	model, err := doc.BuildV3Model()
 	if err != nil {
 		return nil, false, fmt.Errorf("error building model: %w", err)
@@ -29,7 +30,13 @@ func filterOutDocument(doc libopenapi.Document, cfg FilterConfig) (*v3high.Docum
 
 	removedOperations := filterOperations(&model.Model, cfg)
 	removedProperties := filterComponentSchemaProperties(&model.Model, cfg)
-	filtered := removedOperations || removedProperties
+	removedInternal := false
+	if respectInternal {
+		if removedInternal, err = filterInternal(&model.Model); err != nil {
+			return nil, false, err
+		}
+	}
+	filtered := removedOperations || removedProperties || removedInternal
 
 	// Don't reload yet - let the caller decide when to reload (after pruning if needed)
 	return &model.Model, filtered, nil
@@ -98,24 +105,7 @@ func filterOperations(model *v3high.Document, cfg FilterConfig) bool {
 
 			if remove {
 				removed = true
-				switch strings.ToLower(method) {
-				case "get":
-					pathItem.Get = nil
-				case "post":
-					pathItem.Post = nil
-				case "put":
-					pathItem.Put = nil
-				case "delete":
-					pathItem.Delete = nil
-				case "patch":
-					pathItem.Patch = nil
-				case "head":
-					pathItem.Head = nil
-				case "options":
-					pathItem.Options = nil
-				case "trace":
-					pathItem.Trace = nil
-				}
+				removePathItemOperation(pathItem, method)
 			}
 		}
 	}
@@ -123,6 +113,241 @@ func filterOperations(model *v3high.Document, cfg FilterConfig) bool {
 	return removed
 }
 
+// filterInternal removes paths, operations and component schemas marked with x-internal: true.
+// The component schemas that can't exist without a removed one, e.g. arrays of its items or allOf including it,
+// are removed too, along with the properties of the other schemas referencing any of them.
+// It returns an error naming the operation and the schema if a kept operation still references a removed one,
+// e.g. in its request body.
+// It returns true if anything was removed.
+func filterInternal(model *v3high.Document) (bool, error) {
+	removed := false
+
+	if model.Paths != nil && model.Paths.PathItems != nil {
+		var paths []string
+		for path := range model.Paths.PathItems.KeysFromOldest() {
+			paths = append(paths, path)
+		}
+
+		for _, path := range paths {
+			pathItem := model.Paths.PathItems.GetOrZero(path)
+			if pathItem == nil {
+				continue
+			}
+			if extIsInternal(pathItem.Extensions) {
+				model.Paths.PathItems.Delete(path)
+				removed = true
+				continue
+			}
+
+			for method, op := range pathItem.GetOperations().FromOldest() {
+				if extIsInternal(op.Extensions) {
+					removePathItemOperation(pathItem, method)
+					removed = true
+				}
+			}
+		}
+	}
+
+	if model.Components == nil || model.Components.Schemas == nil {
+		return removed, nil
+	}
+
+	internalRefs := map[string]bool{}
+	var schemaNames []string
+	for name := range model.Components.Schemas.KeysFromOldest() {
+		schemaNames = append(schemaNames, name)
+	}
+
+	for _, name := range schemaNames {
+		schemaProxy := model.Components.Schemas.GetOrZero(name)
+		if schemaProxy == nil {
+			continue
+		}
+		if schema := schemaProxy.Schema(); schema != nil && extIsInternal(schema.Extensions) {
+			model.Components.Schemas.Delete(name)
+			internalRefs[componentSchemaRefPrefix+name] = true
+			removed = true
+		}
+	}
+
+	if len(internalRefs) == 0 {
+		return removed, nil
+	}
+
+	// Removing a schema can make others depend on a removed one, until none does.
+	for changed := true; changed; {
+		changed = false
+		for _, name := range schemaNames {
+			schemaProxy := model.Components.Schemas.GetOrZero(name)
+			if schemaProxy == nil {
+				continue
+			}
+			removeInternalProperties(schemaProxy, internalRefs)
+			if findInternalRef(schemaProxy, internalRefs) != "" {
+				model.Components.Schemas.Delete(name)
+				internalRefs[componentSchemaRefPrefix+name] = true
+				changed = true
+			}
+		}
+	}
+
+	if err := checkOperationsInternalRefs(model, internalRefs); err != nil {
+		return removed, err
+	}
+	return removed, nil
+}
+
+// componentSchemaRefPrefix is the prefix of the references to component schemas.
+const componentSchemaRefPrefix = "#/components/schemas/"
+
+// removeInternalProperties removes the properties of the schema, and of its inline property schemas,
+// referencing an internal schema, see findInternalRef.
+func removeInternalProperties(schemaProxy *base.SchemaProxy, internalRefs map[string]bool) {
+	if schemaProxy == nil || schemaProxy.IsReference() {
+		return
+	}
+	schema := schemaProxy.Schema()
+	if schema == nil || schema.Properties == nil {
+		return
+	}
+
+	var propKeys []string
+	for prop := range schema.Properties.KeysFromOldest() {
+		propKeys = append(propKeys, prop)
+	}
+
+	for _, propName := range propKeys {
+		prop := schema.Properties.GetOrZero(propName)
+		removeInternalProperties(prop, internalRefs)
+		if findInternalRef(prop, internalRefs) == "" {
+			continue
+		}
+		schema.Properties.Delete(propName)
+		schema.Required = slices.DeleteFunc(schema.Required, func(s string) bool {
+			return s == propName
+		})
+	}
+}
+
+// findInternalRef returns the reference to an internal schema of the schema, itself or in its inline sub-schemas,
+// e.g. its array items, additional properties or allOf, oneOf and anyOf members. It returns "" if there's none.
+// The referenced schemas are not looked into, they're checked on their own.
+func findInternalRef(schemaProxy *base.SchemaProxy, internalRefs map[string]bool) string {
+	if schemaProxy == nil {
+		return ""
+	}
+	if schemaProxy.IsReference() {
+		if ref := schemaProxy.GetReference(); internalRefs[ref] {
+			return ref
+		}
+		return ""
+	}
+
+	schema := schemaProxy.Schema()
+	if schema == nil {
+		return ""
+	}
+
+	subSchemas := slices.Concat(schema.AllOf, schema.OneOf, schema.AnyOf, schema.PrefixItems,
+		[]*base.SchemaProxy{schema.Not, schema.Contains, schema.If, schema.Then, schema.Else})
+	if schema.Items != nil && schema.Items.IsA() {
+		subSchemas = append(subSchemas, schema.Items.A)
+	}
+	if schema.AdditionalProperties != nil && schema.AdditionalProperties.IsA() {
+		subSchemas = append(subSchemas, schema.AdditionalProperties.A)
+	}
+	for _, props := range []*orderedmap.Map[string, *base.SchemaProxy]{schema.Properties, schema.PatternProperties} {
+		for _, prop := range props.FromOldest() {
+			subSchemas = append(subSchemas, prop)
+		}
+	}
+
+	for _, sub := range subSchemas {
+		if ref := findInternalRef(sub, internalRefs); ref != "" {
+			return ref
+		}
+	}
+	return ""
+}
+
+// checkOperationsInternalRefs returns an error if a parameter, request body or response of an operation
+// references an internal schema, which can't be removed from it like a property.
+func checkOperationsInternalRefs(model *v3high.Document, internalRefs map[string]bool) error {
+	if model.Paths == nil || model.Paths.PathItems == nil {
+		return nil
+	}
+
+	for path, pathItem := range model.Paths.PathItems.FromOldest() {
+		for method, op := range pathItem.GetOperations().FromOldest() {
+			var schemas []*base.SchemaProxy
+			for _, param := range slices.Concat(pathItem.Parameters, op.Parameters) {
+				schemas = append(schemas, param.Schema)
+				schemas = append(schemas, contentSchemas(param.Content)...)
+			}
+			if op.RequestBody != nil {
+				schemas = append(schemas, contentSchemas(op.RequestBody.Content)...)
+			}
+			if op.Responses != nil {
+				responses := []*v3high.Response{op.Responses.Default}
+				for _, resp := range op.Responses.Codes.FromOldest() {
+					responses = append(responses, resp)
+				}
+				for _, resp := range responses {
+					if resp == nil {
+						continue
+					}
+					schemas = append(schemas, contentSchemas(resp.Content)...)
+					for _, header := range resp.Headers.FromOldest() {
+						schemas = append(schemas, header.Schema)
+					}
+				}
+			}
+
+			for _, schema := range schemas {
+				if ref := findInternalRef(schema, internalRefs); ref != "" {
+					opName := op.OperationId
+					if opName == "" {
+						opName = strings.ToUpper(method) + " " + path
+					}
+					return fmt.Errorf("operation %s references the x-internal schema %s", opName,
+						strings.TrimPrefix(ref, componentSchemaRefPrefix))
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// contentSchemas returns the schemas of the media types of the content.
+func contentSchemas(content *orderedmap.Map[string, *v3high.MediaType]) []*base.SchemaProxy {
+	var schemas []*base.SchemaProxy
+	for _, mediaType := range content.FromOldest() {
+		schemas = append(schemas, mediaType.Schema)
+	}
+	return schemas
+}
+
+func removePathItemOperation(pathItem *v3high.PathItem, method string) {
+	switch strings.ToLower(method) {
+	case "get":
+		pathItem.Get = nil
+	case "post":
+		pathItem.Post = nil
+	case "put":
+		pathItem.Put = nil
+	case "delete":
+		pathItem.Delete = nil
+	case "patch":
+		pathItem.Patch = nil
+	case "head":
+		pathItem.Head = nil
+	case "options":
+		pathItem.Options = nil
+	case "trace":
+		pathItem.Trace = nil
+	}
+}
+
 func filterComponentSchemaProperties(model *v3high.Document, cfg FilterConfig) bool {
 	if cfg.IsEmpty() {
 		return false
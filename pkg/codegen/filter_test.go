@@ -344,3 +344,224 @@ func TestFilterOperationsByPath(t *testing.T) {
 		assert.Contains(t, combined, `"/enum"`)
 	})
 }
+
+func TestFilterInternal(t *testing.T) {
+	spec := `
+openapi: 3.0.0
+info:
+  title: Internal API
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      responses:
+        '200':
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Pet'
+    post:
+      operationId: createPetInternal
+      x-internal: true
+      responses:
+        '201':
+          description: Created
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/AuditRecord'
+  /admin:
+    x-internal: true
+    get:
+      operationId: getAdmin
+      responses:
+        '200':
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/AdminSettings'
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [name, secret]
+      properties:
+        name:
+          type: string
+        secret:
+          $ref: '#/components/schemas/PetSecret'
+    PetSecret:
+      type: object
+      x-internal: true
+      properties:
+        token:
+          type: string
+    AuditRecord:
+      type: object
+      properties:
+        actor:
+          type: string
+    AdminSettings:
+      type: object
+      properties:
+        debug:
+          type: boolean
+`
+
+	t.Run("internal parts are excluded", func(t *testing.T) {
+		cfg := Configuration{
+			PackageName: "testinternal",
+			Generate: &GenerateOptions{
+				Client:           true,
+				RespectXInternal: true,
+			},
+			Output: &Output{
+				UseSingleFile: true,
+			},
+		}
+
+		code, err := Generate([]byte(spec), cfg)
+		require.NoError(t, err)
+
+		combined := code.GetCombined()
+		assert.Contains(t, combined, "type Pet struct")
+		assert.Contains(t, combined, "ListPets(")
+		assert.NotContains(t, combined, "CreatePetInternal")
+		assert.NotContains(t, combined, "GetAdmin")
+		assert.NotContains(t, combined, "PetSecret")
+		assert.NotContains(t, combined, "AuditRecord")
+		assert.NotContains(t, combined, "AdminSettings")
+	})
+
+	t.Run("internal schemas referenced by array items are removed", func(t *testing.T) {
+		spec := `
+openapi: 3.0.0
+info:
+  title: Internal API
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      responses:
+        '200':
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Pet'
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        name:
+          type: string
+        secrets:
+          type: array
+          items:
+            $ref: '#/components/schemas/PetSecret'
+        history:
+          $ref: '#/components/schemas/PetSecrets'
+        extra:
+          allOf:
+            - $ref: '#/components/schemas/PetSecret'
+    PetSecrets:
+      type: array
+      items:
+        $ref: '#/components/schemas/PetSecret'
+    PetSecret:
+      type: object
+      x-internal: true
+      properties:
+        token:
+          type: string
+`
+		cfg := Configuration{
+			PackageName: "testinternal",
+			Generate: &GenerateOptions{
+				Client:           true,
+				RespectXInternal: true,
+			},
+			Output: &Output{
+				UseSingleFile: true,
+			},
+		}
+
+		code, err := Generate([]byte(spec), cfg)
+		require.NoError(t, err)
+
+		combined := code.GetCombined()
+		assert.Contains(t, combined, "type Pet struct")
+		assert.NotContains(t, combined, "PetSecret")
+		assert.NotContains(t, combined, "Secrets")
+		assert.NotContains(t, combined, "History")
+		assert.NotContains(t, combined, "Extra")
+	})
+
+	t.Run("internal schemas referenced by operations are an error", func(t *testing.T) {
+		spec := `
+openapi: 3.0.0
+info:
+  title: Internal API
+  version: 1.0.0
+paths:
+  /pets:
+    post:
+      operationId: createPet
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: array
+              items:
+                $ref: '#/components/schemas/PetSecret'
+      responses:
+        '204':
+          description: No Content
+components:
+  schemas:
+    PetSecret:
+      type: object
+      x-internal: true
+      properties:
+        token:
+          type: string
+`
+		cfg := Configuration{
+			PackageName: "testinternal",
+			Generate: &GenerateOptions{
+				Client:           true,
+				RespectXInternal: true,
+			},
+		}
+
+		_, err := Generate([]byte(spec), cfg)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "operation createPet references the x-internal schema PetSecret")
+	})
+
+	t.Run("internal parts are kept by default", func(t *testing.T) {
+		cfg := Configuration{
+			PackageName: "testinternal",
+			Generate: &GenerateOptions{
+				Client: true,
+			},
+			Output: &Output{
+				UseSingleFile: true,
+			},
+		}
+
+		code, err := Generate([]byte(spec), cfg)
+		require.NoError(t, err)
+
+		combined := code.GetCombined()
+		assert.Contains(t, combined, "CreatePetInternal(")
+		assert.Contains(t, combined, "GetAdmin(")
+		assert.Contains(t, combined, "type PetSecret struct")
+		assert.Contains(t, combined, "type AdminSettings struct")
+	})
+}
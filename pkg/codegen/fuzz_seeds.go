@@ -0,0 +1,49 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// fuzzSeedPrefix is the prefix used to identify fuzz seed corpus files in GeneratedCode.
+const fuzzSeedPrefix = "fuzz:"
+
+// IsFuzzSeedFile returns true if the file name indicates a fuzz seed corpus file.
+func IsFuzzSeedFile(name string) bool {
+	return strings.HasPrefix(name, fuzzSeedPrefix)
+}
+
+// FuzzSeedFileName returns the path of the fuzz seed corpus file, relative to the package directory,
+// without the fuzz prefix, e.g. testdata/fuzz/FuzzCreatePetBody/seed-1.
+func FuzzSeedFileName(name string) string {
+	return strings.TrimPrefix(name, fuzzSeedPrefix)
+}
+
+// fuzzSeedFiles returns a seed corpus file per example of the request bodies,
+// keyed by their path relative to the package directory.
+// They seed the fuzz target named after the body type, e.g. FuzzCreatePetBody(f *testing.F),
+// whose fuzz function takes the body as []byte.
+func fuzzSeedFiles(operations []OperationDefinition) map[string]string {
+	res := make(map[string]string)
+	for _, op := range operations {
+		if op.Body == nil {
+			continue
+		}
+		for i, example := range op.Body.Examples {
+			name := path.Join("testdata", "fuzz", "Fuzz"+op.Body.Name, fmt.Sprintf("seed-%d", i+1))
+			res[name] = fmt.Sprintf("go test fuzz v1\n[]byte(%q)\n", example)
+		}
+	}
+	return res
+}
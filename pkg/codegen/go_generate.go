@@ -0,0 +1,91 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// GoGenerateFileName is the name of the file with the //go:generate directive, when the code is split into several files.
+const GoGenerateFileName = "generate"
+
+// GoGenerateDirective returns the //go:generate directive regenerating the code written to outputDir,
+// with the config file at configPath, if any, and the spec at specPath, a file path or a URL.
+// Local paths are relative to the current directory, and made relative to outputDir, where go generate runs the command.
+func GoGenerateDirective(outputDir, configPath, specPath string) (string, error) {
+	rel := func(path string) (string, error) {
+		if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+			return path, nil
+		}
+		absDir, err := filepath.Abs(outputDir)
+		if err != nil {
+			return "", err
+		}
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			return "", err
+		}
+		res, err := filepath.Rel(absDir, absPath)
+		if err != nil {
+			return "", err
+		}
+		return filepath.ToSlash(res), nil
+	}
+
+	args := []string{"//go:generate", "oapi-codegen"}
+	if configPath != "" {
+		path, err := rel(configPath)
+		if err != nil {
+			return "", fmt.Errorf("error resolving config path: %w", err)
+		}
+		args = append(args, "-config", quoteGoGenerateArg(path))
+	}
+	path, err := rel(specPath)
+	if err != nil {
+		return "", fmt.Errorf("error resolving spec path: %w", err)
+	}
+	args = append(args, quoteGoGenerateArg(path))
+
+	return strings.Join(args, " "), nil
+}
+
+// AddGoGenerateDirective inserts the directive before the package clause of the generated code.
+func AddGoGenerateDirective(src, directive string) string {
+	idx := 0
+	if !strings.HasPrefix(src, "package ") {
+		idx = strings.Index(src, "\npackage ")
+		if idx < 0 {
+			return src
+		}
+		idx++
+	}
+	return src[:idx] + directive + "\n\n" + src[idx:]
+}
+
+// GoGenerateFile returns the file of the package with the directive, for code split into several files.
+func GoGenerateFile(cfg Configuration, directive string) string {
+	header := "Code generated by oapi-codegen. DO NOT EDIT."
+	if cfg.CopyrightHeader != "" {
+		header = cfg.CopyrightHeader
+	}
+	return fmt.Sprintf("// %s\n\n%s\n\npackage %s\n", header, directive, cfg.PackageName)
+}
+
+// quoteGoGenerateArg quotes an argument with spaces, which go generate would split otherwise.
+func quoteGoGenerateArg(arg string) string {
+	if strings.ContainsAny(arg, " \t\"") {
+		return strconv.Quote(arg)
+	}
+	return arg
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGoGenerateDirective(t *testing.T) {
+	t.Run("paths relative to the output directory", func(t *testing.T) {
+		res, err := GoGenerateDirective("api/gen", "cfg.yaml", "specs/api.yaml")
+		require.NoError(t, err)
+		assert.Equal(t, "//go:generate oapi-codegen -config ../../cfg.yaml ../../specs/api.yaml", res)
+	})
+
+	t.Run("same directory", func(t *testing.T) {
+		res, err := GoGenerateDirective(".", "cfg.yaml", "api.yaml")
+		require.NoError(t, err)
+		assert.Equal(t, "//go:generate oapi-codegen -config cfg.yaml api.yaml", res)
+	})
+
+	t.Run("without config file", func(t *testing.T) {
+		res, err := GoGenerateDirective("gen", "", "api.yaml")
+		require.NoError(t, err)
+		assert.Equal(t, "//go:generate oapi-codegen ../api.yaml", res)
+	})
+
+	t.Run("spec url", func(t *testing.T) {
+		res, err := GoGenerateDirective("gen", "gen/cfg.yaml", "https://example.com/api.yaml")
+		require.NoError(t, err)
+		assert.Equal(t, "//go:generate oapi-codegen -config cfg.yaml https://example.com/api.yaml", res)
+	})
+
+	t.Run("paths with spaces are quoted", func(t *testing.T) {
+		res, err := GoGenerateDirective(".", "my cfg.yaml", "api.yaml")
+		require.NoError(t, err)
+		assert.Equal(t, `//go:generate oapi-codegen -config "my cfg.yaml" api.yaml`, res)
+	})
+}
+
+func TestAddGoGenerateDirective(t *testing.T) {
+	directive := "//go:generate oapi-codegen -config cfg.yaml api.yaml"
+
+	t.Run("after header", func(t *testing.T) {
+		src := "// Code generated by oapi-codegen. DO NOT EDIT.\n\npackage api\n\ntype A string\n"
+		res := AddGoGenerateDirective(src, directive)
+		assert.Equal(t, "// Code generated by oapi-codegen. DO NOT EDIT.\n\n"+directive+"\n\npackage api\n\ntype A string\n", res)
+
+		_, err := parser.ParseFile(token.NewFileSet(), "gen.go", res, parser.ParseComments)
+		require.NoError(t, err)
+	})
+
+	t.Run("without header", func(t *testing.T) {
+		res := AddGoGenerateDirective("package api\n", directive)
+		assert.Equal(t, directive+"\n\npackage api\n", res)
+	})
+}
+
+func TestGoGenerateFile(t *testing.T) {
+	directive := "//go:generate oapi-codegen api.yaml"
+
+	res := GoGenerateFile(Configuration{PackageName: "api"}, directive)
+	assert.Equal(t, "// Code generated by oapi-codegen. DO NOT EDIT.\n\n"+directive+"\n\npackage api\n", res)
+
+	res = GoGenerateFile(Configuration{PackageName: "api", CopyrightHeader: "Copyright Acme"}, directive)
+	assert.Equal(t, "// Copyright Acme\n\n"+directive+"\n\npackage api\n", res)
+}
@@ -0,0 +1,259 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"fmt"
+	"mime"
+	"strings"
+
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+)
+
+// isMediaTypeJSONPatch returns true for JSON Patch (RFC 6902) bodies.
+func isMediaTypeJSONPatch(mediaType string) bool {
+	parsed, _, err := mime.ParseMediaType(mediaType)
+	return err == nil && parsed == "application/json-patch+json"
+}
+
+// jsonPatchTarget returns the name of the type patched by a JSON Patch body:
+// the component schema of the x-json-patch-target extension of the media type,
+// or the component schema the body references, as long as it's an object.
+func jsonPatchTarget(content *v3high.MediaType, ref string, options ParseOptions) (string, bool) {
+	if val, ok := extractExtensions(content.Extensions)[extJSONPatchTarget]; ok {
+		if target, err := parseString(val); err == nil {
+			ref = target
+		}
+	}
+	if ref == "" {
+		return "", false
+	}
+
+	typeName, found := options.typeTracker.LookupByRef(ref)
+	if !found {
+		return "", false
+	}
+	td, found := options.typeTracker.LookupByName(typeName)
+	if !found || !strings.HasPrefix(td.Schema.TypeDecl(), "struct") {
+		return "", false
+	}
+	return typeName, true
+}
+
+// createJSONPatchBuilder returns the name of the JSON Patch builder of the named type,
+// with its type definition. Builders are created once, later calls return the existing name.
+func createJSONPatchBuilder(typeName string, options ParseOptions) (string, []TypeDefinition) {
+	if name, found := options.typeTracker.jsonPatchBuilders[typeName]; found {
+		return name, nil
+	}
+
+	builderName := options.typeTracker.generateUniqueName(typeName + "PatchBuilder")
+	options.typeTracker.jsonPatchBuilders[typeName] = builderName
+
+	w := &jsonPatchPathsWriter{
+		typeTracker: options.typeTracker,
+		vars:        make(map[string]string),
+		taken:       make(map[string]bool),
+		decls:       make(map[string]string),
+		visiting:    make(map[string]bool),
+	}
+	root := w.named(typeName)
+
+	var sb strings.Builder
+	for _, name := range w.order {
+		fmt.Fprintf(&sb, "%s := &runtime.JSONPatchPath{}\n", w.vars[name])
+	}
+	for _, name := range w.order {
+		sb.WriteString(w.decls[name])
+	}
+	fmt.Fprintf(&sb, "return %s", root)
+
+	td := TypeDefinition{
+		Name: builderName,
+		Schema: GoSchema{
+			GoType: "struct {\nbuilder *runtime.JSONPatchBuilder\n}",
+			Description: fmt.Sprintf("builds a JSON Patch (RFC 6902) of %s.\n"+
+				"Operation paths are validated against the fields of %s, invalid ones are reported by Build.", typeName, typeName),
+		},
+		SpecLocation:   SpecLocationBody,
+		JSONPatchOf:    typeName,
+		JSONPatchPaths: sb.String(),
+	}
+	options.typeTracker.register(td, "")
+
+	return builderName, []TypeDefinition{td}
+}
+
+// jsonPatchPathsWriter writes the Go code of the runtime.JSONPatchPath tree of a type.
+// Named struct types get a variable each, so recursive types refer to themselves.
+type jsonPatchPathsWriter struct {
+	typeTracker *TypeTracker
+	vars        map[string]string
+	taken       map[string]bool
+	decls       map[string]string
+	order       []string
+	visiting    map[string]bool
+}
+
+// named returns the variable of the named struct type, writing its fields the first time.
+func (w *jsonPatchPathsWriter) named(typeName string) string {
+	if v, found := w.vars[typeName]; found {
+		return v
+	}
+
+	base := lowercaseFirstCharacter(strings.ReplaceAll(typeName, "_", ""))
+	v := base + "Path"
+	for i := 1; w.taken[v]; i++ {
+		v = fmt.Sprintf("%s%dPath", base, i)
+	}
+	w.vars[typeName] = v
+	w.taken[v] = true
+	w.order = append(w.order, typeName)
+
+	td, _ := w.typeTracker.LookupByName(typeName)
+	fields, values, anyPath := w.fields(td.Schema)
+
+	var sb strings.Builder
+	if anyPath {
+		fmt.Fprintf(&sb, "%s.Any = true\n", v)
+	} else {
+		if len(fields) > 0 {
+			fmt.Fprintf(&sb, "%s.Fields = %s\n", v, fields)
+		}
+		if values != "" {
+			fmt.Fprintf(&sb, "%s.Values = %s\n", v, values)
+		}
+	}
+	w.decls[typeName] = sb.String()
+	return v
+}
+
+// fields returns the Fields map literal and the Values expression of a struct schema,
+// or true if the struct accepts any path, e.g. when it embeds a union.
+func (w *jsonPatchPathsWriter) fields(schema GoSchema) (string, string, bool) {
+	props, ok := w.flattenProperties(schema)
+	if !ok {
+		return "", "", true
+	}
+
+	var values string
+	if schema.HasAdditionalProperties {
+		values = "&runtime.JSONPatchPath{Any: true}"
+		if schema.AdditionalPropertiesType != nil {
+			values = w.pointer(*schema.AdditionalPropertiesType)
+		}
+	}
+
+	// Objects with no known properties accept any property.
+	if len(props) == 0 && values == "" {
+		return "", "", true
+	}
+	if len(props) == 0 {
+		return "", values, false
+	}
+
+	var sb strings.Builder
+	sb.WriteString("map[string]*runtime.JSONPatchPath{\n")
+	for _, p := range props {
+		fmt.Fprintf(&sb, "%q: %s,\n", p.JsonFieldName, w.node(p.Schema))
+	}
+	sb.WriteString("}")
+	return sb.String(), values, false
+}
+
+// flattenProperties returns the named properties of a struct schema, including the ones
+// of embedded types, leaving out readOnly properties the client can't change.
+// It returns false if an embedded type has no fixed fields.
+func (w *jsonPatchPathsWriter) flattenProperties(schema GoSchema) ([]Property, bool) {
+	if len(schema.UnionElements) > 0 {
+		return nil, false
+	}
+
+	var props []Property
+	for _, p := range schema.Properties {
+		if p.Constraints.ReadOnly != nil && *p.Constraints.ReadOnly {
+			continue
+		}
+		if p.JsonFieldName != "" {
+			props = append(props, p)
+			continue
+		}
+
+		td, found := w.typeTracker.LookupByName(p.Schema.TypeDecl())
+		if !found || !strings.HasPrefix(td.Schema.TypeDecl(), "struct") {
+			return nil, false
+		}
+		embedded, ok := w.flattenProperties(td.Schema)
+		if !ok {
+			return nil, false
+		}
+		props = append(props, embedded...)
+	}
+	return props, true
+}
+
+// node returns the expression of the path of a value, as a map literal element.
+func (w *jsonPatchPathsWriter) node(schema GoSchema) string {
+	switch {
+	case schema.IsAnyType() || schema.IsExternalRef() || len(schema.UnionElements) > 0:
+		return "{Any: true}"
+	case schema.ArrayType != nil:
+		return fmt.Sprintf("{Items: %s}", w.pointer(*schema.ArrayType))
+	case schema.AdditionalPropertiesType != nil && len(schema.Properties) == 0:
+		return fmt.Sprintf("{Values: %s}", w.pointer(*schema.AdditionalPropertiesType))
+	}
+
+	typeDecl := strings.TrimPrefix(schema.TypeDecl(), "*")
+	if strings.HasPrefix(typeDecl, "struct") {
+		fields, values, anyPath := w.fields(schema)
+		switch {
+		case anyPath:
+			return "{Any: true}"
+		case fields != "" && values != "":
+			return fmt.Sprintf("{Fields: %s, Values: %s}", fields, values)
+		case fields != "":
+			return fmt.Sprintf("{Fields: %s}", fields)
+		case values != "":
+			return fmt.Sprintf("{Values: %s}", values)
+		}
+		return "{}"
+	}
+
+	// Primitive types and library types such as time.Time are values.
+	if isPrimitiveType(typeDecl) || strings.Contains(typeDecl, ".") {
+		return "{}"
+	}
+
+	td, found := w.typeTracker.LookupByName(typeDecl)
+	if !found || td.Schema.TypeDecl() == "" {
+		return "{Any: true}"
+	}
+	if strings.HasPrefix(td.Schema.TypeDecl(), "struct") {
+		return w.named(typeDecl)
+	}
+
+	// Aliases and named arrays or maps: use the path of the underlying type.
+	if w.visiting[typeDecl] {
+		return "{Any: true}"
+	}
+	w.visiting[typeDecl] = true
+	defer delete(w.visiting, typeDecl)
+	return w.node(td.Schema)
+}
+
+// pointer returns the expression of the path of a value, as a *runtime.JSONPatchPath.
+func (w *jsonPatchPathsWriter) pointer(schema GoSchema) string {
+	expr := w.node(schema)
+	if strings.HasPrefix(expr, "{") {
+		return "&runtime.JSONPatchPath" + expr
+	}
+	return expr
+}
@@ -0,0 +1,317 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"go.yaml.in/yaml/v4"
+)
+
+// jsonSchemaBuilder converts OpenAPI schemas into self-contained JSON Schema documents
+// understood by runtime.JSONSchema. Referenced schemas are collected under $defs,
+// so recursive schemas stay finite.
+type jsonSchemaBuilder struct {
+	model *v3high.Document
+	// specLocation decides which of readOnly / writeOnly properties are dropped from required.
+	specLocation SpecLocation
+	defs         map[string]any
+	defNames     map[string]string
+}
+
+// buildJSONSchema renders the schema behind the proxy as a compact JSON Schema document.
+func buildJSONSchema(proxy *base.SchemaProxy, model *v3high.Document, specLocation SpecLocation) (string, error) {
+	b := &jsonSchemaBuilder{
+		model:        model,
+		specLocation: specLocation,
+		defs:         map[string]any{},
+		defNames:     map[string]string{},
+	}
+
+	root, err := b.convertProxy(proxy)
+	if err != nil {
+		return "", err
+	}
+
+	if len(b.defs) > 0 {
+		root["$defs"] = b.defs
+	}
+
+	res, err := json.Marshal(root)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling JSON schema: %w", err)
+	}
+	return string(res), nil
+}
+
+func (b *jsonSchemaBuilder) convertProxy(proxy *base.SchemaProxy) (map[string]any, error) {
+	if proxy == nil {
+		return map[string]any{}, nil
+	}
+
+	ref := ""
+	if low := proxy.GoLow(); low != nil {
+		ref = low.GetReference()
+	}
+	if ref == "" {
+		return b.convert(resolveSchema(proxy, b.model))
+	}
+
+	name, seen := b.defNames[ref]
+	if !seen {
+		name = b.defName(ref)
+		b.defNames[ref] = name
+		// register before converting, so recursive references terminate
+		b.defs[name] = map[string]any{}
+		def, err := b.convert(resolveSchema(proxy, b.model))
+		if err != nil {
+			return nil, fmt.Errorf("error converting %s: %w", ref, err)
+		}
+		b.defs[name] = def
+	}
+	return map[string]any{"$ref": "#/$defs/" + name}, nil
+}
+
+// defName returns a unique $defs key for the reference, based on its last path segment.
+func (b *jsonSchemaBuilder) defName(ref string) string {
+	baseName := path.Base(ref)
+	name := baseName
+	for i := 2; ; i++ {
+		if _, exists := b.defs[name]; !exists {
+			return name
+		}
+		name = fmt.Sprintf("%s%d", baseName, i)
+	}
+}
+
+func (b *jsonSchemaBuilder) convert(schema *base.Schema) (map[string]any, error) {
+	res := map[string]any{}
+	if schema == nil {
+		return res, nil
+	}
+
+	nullable := schema.Nullable != nil && *schema.Nullable
+	if len(schema.Type) > 0 {
+		types := schema.Type
+		if nullable && !slices.Contains(types, "null") {
+			types = append(append([]string{}, types...), "null")
+		}
+		if len(types) == 1 {
+			res["type"] = types[0]
+		} else {
+			res["type"] = types
+		}
+	}
+
+	if len(schema.Enum) > 0 {
+		values := make([]any, 0, len(schema.Enum)+1)
+		hasNull := false
+		for _, node := range schema.Enum {
+			v, err := decodeYAMLNode(node)
+			if err != nil {
+				return nil, fmt.Errorf("error decoding enum value: %w", err)
+			}
+			hasNull = hasNull || v == nil
+			values = append(values, v)
+		}
+		if nullable && !hasNull {
+			values = append(values, nil)
+		}
+		res["enum"] = values
+	}
+
+	if schema.Const != nil {
+		v, err := decodeYAMLNode(schema.Const)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding const value: %w", err)
+		}
+		res["const"] = v
+	}
+
+	if schema.Properties != nil && schema.Properties.Len() > 0 {
+		props := map[string]any{}
+		for name, prop := range schema.Properties.FromOldest() {
+			converted, err := b.convertProxy(prop)
+			if err != nil {
+				return nil, fmt.Errorf("error converting property %s: %w", name, err)
+			}
+			props[name] = converted
+		}
+		res["properties"] = props
+	}
+
+	if required := b.required(schema); len(required) > 0 {
+		res["required"] = required
+	}
+
+	if ap := schema.AdditionalProperties; ap != nil {
+		if ap.IsA() {
+			converted, err := b.convertProxy(ap.A)
+			if err != nil {
+				return nil, fmt.Errorf("error converting additionalProperties: %w", err)
+			}
+			res["additionalProperties"] = converted
+		} else if !ap.B {
+			res["additionalProperties"] = false
+		}
+	}
+
+	if items := schema.Items; items != nil && items.IsA() {
+		converted, err := b.convertProxy(items.A)
+		if err != nil {
+			return nil, fmt.Errorf("error converting items: %w", err)
+		}
+		res["items"] = converted
+	}
+
+	for keyword, proxies := range map[string][]*base.SchemaProxy{
+		"allOf": schema.AllOf,
+		"anyOf": schema.AnyOf,
+		"oneOf": schema.OneOf,
+	} {
+		if len(proxies) == 0 {
+			continue
+		}
+		converted := make([]any, len(proxies))
+		for i, p := range proxies {
+			c, err := b.convertProxy(p)
+			if err != nil {
+				return nil, fmt.Errorf("error converting %s[%d]: %w", keyword, i, err)
+			}
+			converted[i] = c
+		}
+		if keyword != "allOf" && nullable {
+			// nullable: true on a union admits null as one more alternative
+			converted = append(converted, map[string]any{"type": "null"})
+		}
+		res[keyword] = converted
+	}
+
+	if schema.Not != nil {
+		converted, err := b.convertProxy(schema.Not)
+		if err != nil {
+			return nil, fmt.Errorf("error converting not: %w", err)
+		}
+		res["not"] = converted
+	}
+
+	setIfNotNil(res, "minLength", schema.MinLength)
+	setIfNotNil(res, "maxLength", schema.MaxLength)
+	if schema.Pattern != "" {
+		res["pattern"] = schema.Pattern
+	}
+	setIfNotNil(res, "minimum", schema.Minimum)
+	setIfNotNil(res, "maximum", schema.Maximum)
+	setIfNotNil(res, "multipleOf", schema.MultipleOf)
+	setIfNotNil(res, "minItems", schema.MinItems)
+	setIfNotNil(res, "maxItems", schema.MaxItems)
+	setIfNotNil(res, "minProperties", schema.MinProperties)
+	setIfNotNil(res, "maxProperties", schema.MaxProperties)
+	if schema.UniqueItems != nil && *schema.UniqueItems {
+		res["uniqueItems"] = true
+	}
+
+	// OpenAPI 3.0 uses booleans that modify minimum/maximum, 3.1 uses numbers.
+	if v := schema.ExclusiveMinimum; v != nil {
+		if v.IsA() {
+			if v.A && schema.Minimum != nil {
+				delete(res, "minimum")
+				res["exclusiveMinimum"] = *schema.Minimum
+			}
+		} else {
+			res["exclusiveMinimum"] = v.B
+		}
+	}
+	if v := schema.ExclusiveMaximum; v != nil {
+		if v.IsA() {
+			if v.A && schema.Maximum != nil {
+				delete(res, "maximum")
+				res["exclusiveMaximum"] = *schema.Maximum
+			}
+		} else {
+			res["exclusiveMaximum"] = v.B
+		}
+	}
+
+	return res, nil
+}
+
+// required returns the required properties, leaving out readOnly properties for request
+// bodies and writeOnly properties for responses, as they are absent in that direction.
+func (b *jsonSchemaBuilder) required(schema *base.Schema) []string {
+	var res []string
+	for _, name := range schema.Required {
+		if schema.Properties != nil {
+			if prop, ok := schema.Properties.Get(name); ok && prop != nil {
+				if s := resolveSchema(prop, b.model); s != nil {
+					if b.specLocation == SpecLocationBody && s.ReadOnly != nil && *s.ReadOnly {
+						continue
+					}
+					if b.specLocation == SpecLocationResponse && s.WriteOnly != nil && *s.WriteOnly {
+						continue
+					}
+				}
+			}
+		}
+		res = append(res, name)
+	}
+	return res
+}
+
+func setIfNotNil[T any](m map[string]any, key string, v *T) {
+	if v != nil {
+		m[key] = *v
+	}
+}
+
+func decodeYAMLNode(node *yaml.Node) (any, error) {
+	if node == nil {
+		return nil, nil
+	}
+	var v any
+	if err := node.Decode(&v); err != nil {
+		return nil, err
+	}
+	return normalizeYAMLValue(v), nil
+}
+
+// normalizeYAMLValue converts map[any]any produced by the YAML decoder into
+// map[string]any, so the value can be marshaled to JSON.
+func normalizeYAMLValue(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		for k, item := range val {
+			val[k] = normalizeYAMLValue(item)
+		}
+		return val
+	case map[any]any:
+		res := make(map[string]any, len(val))
+		for k, item := range val {
+			res[fmt.Sprint(k)] = normalizeYAMLValue(item)
+		}
+		return res
+	case []any:
+		for i, item := range val {
+			val[i] = normalizeYAMLValue(item)
+		}
+		return val
+	}
+	return v
+}
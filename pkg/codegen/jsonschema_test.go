@@ -0,0 +1,68 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yorunikakeru4/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+func TestBuildJSONSchema(t *testing.T) {
+	srcDoc, err := LoadDocumentFromContents([]byte(readTestdata(t, "schema-validation.yml")))
+	require.NoError(t, err)
+	v3Model, err := srcDoc.BuildV3Model()
+	require.NoError(t, err)
+	model := &v3Model.Model
+
+	petProxy := model.Paths.PathItems.Value("/pets").Post.RequestBody.Content.Value("application/json").Schema
+
+	t.Run("request body", func(t *testing.T) {
+		res, err := buildJSONSchema(petProxy, model, SpecLocationBody)
+		require.NoError(t, err)
+
+		assert.JSONEq(t, `{
+			"$ref": "#/$defs/Pet",
+			"$defs": {
+				"Pet": {
+					"type": "object",
+					"required": ["name", "contact"],
+					"properties": {
+						"id": {"type": "integer"},
+						"name": {"type": ["string", "null"]},
+						"age": {"type": "integer", "exclusiveMinimum": 0},
+						"parent": {"$ref": "#/$defs/Pet"},
+						"contact": {
+							"anyOf": [
+								{"type": "object", "required": ["email"], "properties": {"email": {"type": "string"}}},
+								{"type": "object", "required": ["phone"], "properties": {"phone": {"type": "string"}}}
+							]
+						}
+					}
+				}
+			}
+		}`, res)
+
+		schema, err := runtime.CompileJSONSchema([]byte(res))
+		require.NoError(t, err)
+		assert.NoError(t, schema.Validate([]byte(`{"name": null, "contact": {"email": "a@b.c"}, "parent": {"name": "p", "contact": {"phone": "1"}}}`)))
+		assert.Error(t, schema.Validate([]byte(`{"name": "rex", "contact": {}}`)))
+		assert.Error(t, schema.Validate([]byte(`{"name": "rex", "age": 0, "contact": {"phone": "1"}}`)))
+	})
+
+	t.Run("response keeps readOnly required", func(t *testing.T) {
+		res, err := buildJSONSchema(petProxy, model, SpecLocationResponse)
+		require.NoError(t, err)
+		assert.Contains(t, res, `"required":["id","name","contact"]`)
+	})
+}
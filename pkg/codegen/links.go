@@ -0,0 +1,243 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+)
+
+// Sources of a link parameter value.
+const (
+	linkSourceBody   = "body"
+	linkSourceHeader = "header"
+	linkSourceConst  = "const"
+)
+
+// LinkDefinition describes an OpenAPI link of the success response of an operation.
+// It is used to generate a helper building the request options of the linked operation.
+type LinkDefinition struct {
+	// Name is the Go name of the link, e.g. GetUserByUserId.
+	Name        string
+	Description string
+
+	// TargetID is the ID of the linked operation.
+	TargetID string
+
+	Params []LinkParameterDefinition
+}
+
+// LinkParameterDefinition describes a single parameter of a link.
+type LinkParameterDefinition struct {
+	// Name is the parameter name, as declared by the linked operation.
+	Name string
+
+	// Field is the request options field holding the parameter: PathParams, Query or Header.
+	Field string
+
+	// TypeName is the Go type of Field.
+	TypeName string
+
+	// Source is where the value comes from: body, header or const.
+	Source string
+
+	// Value is the JSON pointer for body, the header name for header, or the constant value.
+	Value string
+}
+
+// LinkParameterGroup holds the link parameters stored in the same request options field.
+type LinkParameterGroup struct {
+	Field    string
+	TypeName string
+	Params   []LinkParameterDefinition
+}
+
+// UsesHeader reports whether any of the parameters is read from the response headers.
+func (l LinkDefinition) UsesHeader() bool {
+	for _, p := range l.Params {
+		if p.Source == linkSourceHeader {
+			return true
+		}
+	}
+	return false
+}
+
+// Groups returns the parameters grouped by request options field, in the order of first appearance.
+func (l LinkDefinition) Groups() []LinkParameterGroup {
+	var res []LinkParameterGroup
+	for _, p := range l.Params {
+		i := 0
+		for ; i < len(res); i++ {
+			if res[i].Field == p.Field {
+				break
+			}
+		}
+		if i == len(res) {
+			res = append(res, LinkParameterGroup{Field: p.Field, TypeName: p.TypeName})
+		}
+		res[i].Params = append(res[i].Params, p)
+	}
+	return res
+}
+
+// specLink is a link as declared in the spec, before the linked operation is resolved.
+type specLink struct {
+	name string
+	link *v3high.Link
+}
+
+// successResponseLinks returns the links declared on the success response of the operation.
+func successResponseLinks(responses *v3high.Responses, successCode int) []specLink {
+	if responses == nil || responses.Codes == nil {
+		return nil
+	}
+
+	response := responses.Codes.GetOrZero(strconv.Itoa(successCode))
+	if response == nil {
+		response = responses.Codes.GetOrZero("2XX")
+	}
+	if response == nil {
+		response = responses.Codes.GetOrZero("2xx")
+	}
+	if response == nil || response.Links == nil {
+		return nil
+	}
+
+	var res []specLink
+	for name, link := range response.Links.FromOldest() {
+		if link != nil {
+			res = append(res, specLink{name: name, link: link})
+		}
+	}
+	return res
+}
+
+// resolveOperationLinks resolves the links of every operation against the operations they point to.
+// Links to unknown operations, or to operations without parameters, are skipped,
+// as are parameters using runtime expressions other than $response.body and $response.header.
+func resolveOperationLinks(operations []OperationDefinition) {
+	bySpecID := make(map[string]int, len(operations))
+	byPathMethod := make(map[string]int, len(operations))
+	for i, op := range operations {
+		if op.specID != "" {
+			bySpecID[op.specID] = i
+		}
+		byPathMethod[op.Method+" "+op.Path] = i
+	}
+
+	for i := range operations {
+		op := &operations[i]
+		for _, sl := range op.specLinks {
+			targetIdx, ok := bySpecID[sl.link.OperationId]
+			if !ok && sl.link.OperationRef != "" {
+				targetIdx, ok = byPathMethod[operationRefKey(sl.link.OperationRef)]
+			}
+			if !ok {
+				continue
+			}
+
+			target := operations[targetIdx]
+			if !target.HasRequestOptions() {
+				continue
+			}
+
+			link := LinkDefinition{
+				Name:        typeNamePrefix(sl.name) + nameNormalizer(sl.name),
+				Description: sl.link.Description,
+				TargetID:    target.ID,
+			}
+			if sl.link.Parameters != nil {
+				for name, expr := range sl.link.Parameters.FromOldest() {
+					if param, ok := newLinkParameter(target, name, expr); ok {
+						link.Params = append(link.Params, param)
+					}
+				}
+			}
+			if len(link.Params) > 0 {
+				op.Links = append(op.Links, link)
+			}
+		}
+	}
+}
+
+// operationRefKey turns a local operationRef like #/paths/~1users~1{id}/get into "GET /users/{id}".
+func operationRefKey(ref string) string {
+	ref, ok := strings.CutPrefix(ref, "#/paths/")
+	if !ok {
+		return ""
+	}
+	idx := strings.LastIndex(ref, "/")
+	if idx < 0 {
+		return ""
+	}
+	path := strings.NewReplacer("~1", "/", "~0", "~").Replace(ref[:idx])
+	return strings.ToUpper(ref[idx+1:]) + " " + path
+}
+
+// newLinkParameter maps a link parameter to the parameter of the target operation.
+// The name may be qualified with the location, e.g. path.id, as allowed by the spec.
+func newLinkParameter(target OperationDefinition, name, expr string) (LinkParameterDefinition, bool) {
+	in := ""
+	if loc, paramName, found := strings.Cut(name, "."); found && slices.Contains([]string{"path", "query", "header"}, loc) {
+		in, name = loc, paramName
+	}
+
+	param := LinkParameterDefinition{Name: name}
+	found := false
+
+	if (in == "" || in == "path") && target.PathParams != nil {
+		for _, prop := range target.PathParams.Schema.Properties {
+			if prop.JsonFieldName == name {
+				param.Field, param.TypeName, found = "PathParams", target.PathParams.Name, true
+				break
+			}
+		}
+	}
+	if !found && (in == "" || in == "query") && target.Query != nil {
+		if ParameterDefinitions(target.Query.Params).FindByName(name) != nil {
+			param.Field, param.TypeName, found = "Query", target.Query.Name, true
+		}
+	}
+	if !found && (in == "" || in == "header") && target.Header != nil {
+		for _, p := range target.Header.Params {
+			if http.CanonicalHeaderKey(p.ParamName) == http.CanonicalHeaderKey(name) {
+				param.Name = p.ParamName
+				param.Field, param.TypeName, found = "Header", target.Header.Name, true
+				break
+			}
+		}
+	}
+	if !found {
+		return LinkParameterDefinition{}, false
+	}
+
+	switch {
+	case strings.HasPrefix(expr, "$response.body"):
+		pointer := strings.TrimPrefix(expr, "$response.body")
+		if pointer != "" && !strings.HasPrefix(pointer, "#") {
+			return LinkParameterDefinition{}, false
+		}
+		param.Source, param.Value = linkSourceBody, strings.TrimPrefix(pointer, "#")
+	case strings.HasPrefix(expr, "$response.header."):
+		param.Source, param.Value = linkSourceHeader, strings.TrimPrefix(expr, "$response.header.")
+	case strings.HasPrefix(expr, "$"):
+		// $request.*, $url, $method and $statusCode are not available to the client helper
+		return LinkParameterDefinition{}, false
+	default:
+		param.Source, param.Value = linkSourceConst, expr
+	}
+
+	return param, true
+}
@@ -0,0 +1,38 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperationRefKey(t *testing.T) {
+	assert.Equal(t, "GET /users/{userId}", operationRefKey("#/paths/~1users~1{userId}/get"))
+	assert.Equal(t, "POST /a~b", operationRefKey("#/paths/~1a~0b/post"))
+	assert.Equal(t, "", operationRefKey("https://example.com/spec.yml#/paths/~1users/get"))
+}
+
+func TestLinkDefinitionGroups(t *testing.T) {
+	link := LinkDefinition{Params: []LinkParameterDefinition{
+		{Name: "id", Field: "PathParams", Source: linkSourceBody},
+		{Name: "limit", Field: "Query", Source: linkSourceConst},
+		{Name: "version", Field: "PathParams", Source: linkSourceHeader},
+	}}
+
+	groups := link.Groups()
+	assert.Len(t, groups, 2)
+	assert.Equal(t, "PathParams", groups[0].Field)
+	assert.Len(t, groups[0].Params, 2)
+	assert.Equal(t, "Query", groups[1].Field)
+	assert.True(t, link.UsesHeader())
+}
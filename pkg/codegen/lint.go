@@ -0,0 +1,68 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"fmt"
+	"sort"
+)
+
+// LintWarning is a spec hygiene issue found by Lint.
+type LintWarning struct {
+	// TypeName is the Go type the warning refers to.
+	TypeName string
+
+	// Message describes the issue and how to fix it.
+	Message string
+}
+
+func (w LintWarning) String() string {
+	return w.Message
+}
+
+// Lint returns warnings for inline schemas whose Go type names are derived from their
+// location in the spec, e.g. Object_field1_nestedField.
+// These names are ugly and change whenever the surrounding schema is restructured,
+// extracting the schemas to named components gives them a stable name.
+// Lint does not change the generated code.
+func Lint(ctx *ParseContext) []LintWarning {
+	if ctx == nil {
+		return nil
+	}
+
+	seen := map[string]bool{}
+	var res []LintWarning
+	for _, td := range parseContextTypeDefinitions(ctx) {
+		if !isInlineTypeDefinition(td) || seen[td.Name] {
+			continue
+		}
+		seen[td.Name] = true
+
+		res = append(res, LintWarning{
+			TypeName: td.Name,
+			Message: fmt.Sprintf(
+				"type %s is generated from an inline schema, consider extracting it to a named schema in #/components/schemas",
+				td.Name),
+		})
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].TypeName < res[j].TypeName
+	})
+
+	return res
+}
+
+// isInlineTypeDefinition reports whether the type was created for an inline schema,
+// named after its path in the spec.
+func isInlineTypeDefinition(td TypeDefinition) bool {
+	return td.Name != "" && td.JsonName == "-"
+}
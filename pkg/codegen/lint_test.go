@@ -0,0 +1,56 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLint(t *testing.T) {
+	t.Run("inline schemas", func(t *testing.T) {
+		ctx, errs := CreateParseContext([]byte(readTestdata(t, "lint.yml")), Configuration{PackageName: "lint"})
+		require.Nil(t, errs)
+
+		warnings := Lint(ctx)
+		require.Len(t, warnings, 2)
+
+		assert.Equal(t, "CreateOrderBody_Shipping", warnings[0].TypeName)
+		assert.Equal(t, "Order_Details", warnings[1].TypeName)
+		assert.Contains(t, warnings[1].String(), "#/components/schemas")
+	})
+
+	t.Run("named schemas only", func(t *testing.T) {
+		spec := `
+openapi: 3.0.0
+info:
+  title: Lint
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    User:
+      type: object
+      properties:
+        name:
+          type: string
+`
+		ctx, errs := CreateParseContext([]byte(spec), Configuration{PackageName: "lint", SkipPrune: true})
+		require.Nil(t, errs)
+		assert.Empty(t, Lint(ctx))
+	})
+
+	t.Run("nil context", func(t *testing.T) {
+		assert.Nil(t, Lint(nil))
+	})
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"fmt"
+	"mime"
+	"strings"
+)
+
+// isMediaTypeMergePatch returns true for JSON Merge Patch (RFC 7396) bodies.
+func isMediaTypeMergePatch(mediaType string) bool {
+	parsed, _, err := mime.ParseMediaType(mediaType)
+	return err == nil && parsed == "application/merge-patch+json"
+}
+
+// createMergePatchType returns the name of the merge patch type of the named struct type,
+// with the type definitions created for it: the patch type and the patch types of its nested structs.
+// Types are created once, later calls return the existing name with no type definitions.
+// It returns false if the type is not a struct with named fields only.
+func createMergePatchType(typeName string, options ParseOptions) (string, []TypeDefinition, bool) {
+	if name, found := options.typeTracker.mergePatches[typeName]; found {
+		return name, nil, true
+	}
+
+	td, found := options.typeTracker.LookupByName(typeName)
+	if !found || !isMergePatchable(td.Schema) {
+		return "", nil, false
+	}
+
+	patchName := options.typeTracker.generateUniqueName(typeName + "Patch")
+	// Register the name before the properties, so recursive types refer to it.
+	options.typeTracker.mergePatches[typeName] = patchName
+	options.typeTracker.registerName(patchName)
+
+	var (
+		props    []Property
+		patchTds []TypeDefinition
+	)
+	for _, p := range td.Schema.Properties {
+		// readOnly fields can't be changed by the client
+		if p.Constraints.ReadOnly != nil && *p.Constraints.ReadOnly {
+			continue
+		}
+
+		// Nested objects are merged too, so they are patched with their own patch type.
+		if p.Schema.ArrayType == nil && p.Schema.AdditionalPropertiesType == nil {
+			if nestedName, nestedTds, ok := createMergePatchType(p.Schema.TypeDecl(), options); ok {
+				p.Schema = GoSchema{
+					GoType:         nestedName,
+					DefineViaAlias: true,
+					Description:    p.Schema.Description,
+					OpenAPISchema:  p.Schema.OpenAPISchema,
+				}
+				patchTds = append(patchTds, nestedTds...)
+			}
+		}
+
+		p.Constraints.Required = ptr(false)
+		p.Constraints.Nullable = ptr(true)
+		p.Constraints.ValidationTags = mergePatchValidationTags(p.Constraints.ValidationTags)
+		p.GenericOptional = true
+		p.ForceOptional = true
+		p.ParentType = patchName
+		props = append(props, p)
+	}
+
+	schema := GoSchema{
+		Properties: props,
+		Description: fmt.Sprintf("is a JSON Merge Patch (RFC 7396) of %s.\n"+
+			"Fields that are not set are left unchanged, fields set to null are removed.", typeName),
+		OpenAPISchema: td.Schema.OpenAPISchema,
+	}
+	schema.GoType = schema.createGoStruct(genFieldsFromProperties(props, options))
+
+	patchTd := TypeDefinition{
+		Name:             patchName,
+		Schema:           schema,
+		SpecLocation:     td.SpecLocation,
+		HasSensitiveData: hasSensitiveData(schema),
+		MergePatchOf:     typeName,
+	}
+	options.typeTracker.register(patchTd, "")
+
+	return patchName, append([]TypeDefinition{patchTd}, patchTds...), true
+}
+
+// isMergePatchable returns true if the schema is a struct with named fields only,
+// so it can be patched field by field.
+func isMergePatchable(schema GoSchema) bool {
+	if !strings.HasPrefix(schema.TypeDecl(), "struct") || len(schema.UnionElements) > 0 ||
+		schema.HasAdditionalProperties {
+		return false
+	}
+
+	hasFields := false
+	for _, p := range schema.Properties {
+		if p.JsonFieldName == "" {
+			return false
+		}
+		if p.Constraints.ReadOnly == nil || !*p.Constraints.ReadOnly {
+			hasFields = true
+		}
+	}
+	return hasFields
+}
+
+// mergePatchValidationTags returns the validation tags of a merge patch field:
+// every field is optional, the other constraints apply to the values that are set.
+func mergePatchValidationTags(tags []string) []string {
+	res := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if tag != "required" {
+			res = append(res, tag)
+		}
+	}
+	return res
+}
@@ -542,6 +542,23 @@ func schemaNameToTypeName(name string) string {
 	return typeNamePrefix(name) + nameNormalizer(name) + arraySuffix
 }
 
+// trimTypeNamePrefix strips prefix from a Go type name, e.g. BillingInvoice -> Invoice.
+// The name is left untouched unless the rest starts a new word with an upper case letter,
+// so "Bill" does not turn Billing into "ing".
+func trimTypeNamePrefix(typeName, prefix string) string {
+	if prefix == "" {
+		return typeName
+	}
+	rest, found := strings.CutPrefix(typeName, prefix)
+	if !found || rest == "" {
+		return typeName
+	}
+	if r, _ := utf8.DecodeRuneInString(rest); !unicode.IsUpper(r) {
+		return typeName
+	}
+	return rest
+}
+
 // pathToTypeName converts a path, like Object/field1/nestedField into a go
 // type name.
 func pathToTypeName(path []string) string {
@@ -678,3 +695,15 @@ func isMediaTypeJson(mediaType string) bool {
 	}
 	return parsed == "application/json" || strings.HasSuffix(parsed, "+json")
 }
+
+// isMediaTypeBinary returns true for application/octet-stream, the media type of arbitrary binary data.
+func isMediaTypeBinary(mediaType string) bool {
+	parsed, _, err := mime.ParseMediaType(mediaType)
+	return err == nil && parsed == "application/octet-stream"
+}
+
+// isMediaTypeProblemJSON returns true for the RFC 9457 problem details media type, application/problem+json.
+func isMediaTypeProblemJSON(mediaType string) bool {
+	parsed, _, err := mime.ParseMediaType(mediaType)
+	return err == nil && parsed == "application/problem+json"
+}
@@ -349,6 +349,22 @@ func TestSchemaNameToTypeName(t *testing.T) {
 	}
 }
 
+func TestTrimTypeNamePrefix(t *testing.T) {
+	t.Parallel()
+
+	for in, want := range map[string]string{
+		"BillingInvoice":  "Invoice",
+		"BillingCustomer": "Customer",
+		"Billing":         "Billing",
+		"Billingual":      "Billingual",
+		"Invoice":         "Invoice",
+		"InvoiceBilling":  "InvoiceBilling",
+	} {
+		assert.Equal(t, want, trimTypeNamePrefix(in, "Billing"), in)
+	}
+	assert.Equal(t, "BillingInvoice", trimTypeNamePrefix("BillingInvoice", ""))
+}
+
 func TestRefPathToObjName(t *testing.T) {
 	t.Parallel()
 
@@ -21,6 +21,14 @@ import (
 )
 
 func CreateDocument(docContents []byte, cfg Configuration) (libopenapi.Document, error) {
+	if cfg.BaseDir != "" {
+		var err error
+		docContents, err = bundleExternalRefs(docContents, cfg.BaseDir)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	doc, err := LoadDocumentFromContents(docContents)
 	if err != nil {
 		return nil, err
@@ -39,7 +47,8 @@ func CreateDocument(docContents []byte, cfg Configuration) (libopenapi.Document,
 	}
 
 	var filtered bool
-	model, filtered, err := filterOutDocument(doc, cfg.Filter)
+	respectInternal := cfg.Generate != nil && cfg.Generate.RespectXInternal
+	model, filtered, err := filterOutDocument(doc, cfg.Filter, respectInternal)
 	if err != nil {
 		return nil, fmt.Errorf("error filtering document: %w", err)
 	}
@@ -47,7 +56,11 @@ func CreateDocument(docContents []byte, cfg Configuration) (libopenapi.Document,
 	// If we filtered anything, we must prune to remove dangling references
 	// Otherwise, only prune if SkipPrune is false
 	if filtered || !cfg.SkipPrune {
-		if err = pruneSchema(model); err != nil {
+		opts := pruneOptions{
+			callbacks: cfg.Generate != nil && cfg.Generate.Callbacks,
+			webhooks:  cfg.Generate != nil && cfg.Generate.Webhooks,
+		}
+		if err = pruneSchema(model, opts); err != nil {
 			return nil, fmt.Errorf("error pruning schema: %w", err)
 		}
 		return doc, nil
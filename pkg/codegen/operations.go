@@ -11,6 +11,7 @@
 package codegen
 
 import (
+	"fmt"
 	"net/http"
 	"strings"
 )
@@ -19,6 +20,7 @@ import (
 // ID The operation_id description from Swagger, used to generate function names.
 // Summary string from OpenAPI spec, used to generate a comment.
 // Description string from OpenAPI spec.
+// Tags The tags of the operation, used to group operations in the client doc comment.
 // Method The HTTP method for this operation.
 // Path The path for this operation.
 // PathParams Parameters in the path
@@ -30,6 +32,7 @@ type OperationDefinition struct {
 	ID          string
 	Summary     string
 	Description string
+	Tags        []string
 	Method      string
 	Path        string
 	PathParams  *TypeDefinition
@@ -45,6 +48,28 @@ type OperationDefinition struct {
 
 	// MCP contains x-mcp extension configuration for MCP tool generation
 	MCP *MCPExtension
+
+	// Links are the OpenAPI links of the success response.
+	Links []LinkDefinition
+
+	// QueryFilters are the deepObject query parameters with an object schema.
+	QueryFilters []QueryFilterDefinition
+
+	// CompressRequest is the x-compress-request extension, overriding Client.Compression.Request when set.
+	CompressRequest *bool
+
+	// IdempotencyKey is the x-idempotency-key extension, overriding Client.IdempotencyKeys when set.
+	IdempotencyKey *bool
+
+	// LongPoll is true if the operation has the x-long-poll extension, see Client.LongPoll.
+	LongPoll bool
+
+	// specID is the operationId as declared in the spec, used to resolve links.
+	specID    string
+	specLinks []specLink
+
+	// healthCheck is true if the operation has the x-healthcheck extension.
+	healthCheck bool
 }
 
 // RequiresParamObject indicates If we have parameters other than path parameters, they're bundled into an
@@ -67,6 +92,50 @@ func (o OperationDefinition) SummaryAsComment() string {
 	return strings.Join(parts, "\n")
 }
 
+// operationsByTagComment returns a doc comment block listing the client methods grouped by the operation tags,
+// in order of first appearance, with their summaries. Operations without tags are listed last, under "Other".
+func operationsByTagComment(clientName string, ops []OperationDefinition) string {
+	if len(ops) == 0 {
+		return ""
+	}
+
+	var tags []string
+	byTag := map[string][]OperationDefinition{}
+	var untagged []OperationDefinition
+	for _, op := range ops {
+		if len(op.Tags) == 0 {
+			untagged = append(untagged, op)
+			continue
+		}
+		for _, tag := range op.Tags {
+			if _, found := byTag[tag]; !found {
+				tags = append(tags, tag)
+			}
+			byTag[tag] = append(byTag[tag], op)
+		}
+	}
+
+	lines := []string{"//", "// Operations by tag:"}
+	writeGroup := func(name string, group []OperationDefinition) {
+		lines = append(lines, "//", fmt.Sprintf("// %s:", name))
+		for _, op := range group {
+			item := fmt.Sprintf("//   - [%s.%s]", clientName, op.ID)
+			if summary, _, _ := strings.Cut(strings.TrimSpace(op.Summary), "\n"); summary != "" {
+				item += ": " + strings.TrimSpace(summary)
+			}
+			lines = append(lines, item)
+		}
+	}
+	for _, tag := range tags {
+		writeGroup(tag, byTag[tag])
+	}
+	if len(untagged) > 0 {
+		writeGroup("Other", untagged)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
 func (o OperationDefinition) GetSuccessResponse() string {
 	if o.Response.SuccessStatusCode == http.StatusNoContent {
 		return ""
@@ -74,10 +143,55 @@ func (o OperationDefinition) GetSuccessResponse() string {
 	return o.Response.Success.ResponseName
 }
 
+// ClientResponseName returns the type returned by the client method, the result type
+// when the operation declares more than one success response,
+// and runtime.NoContent when the success response has no body.
+func (o OperationDefinition) ClientResponseName() string {
+	if o.Response.ResultName != "" {
+		return o.Response.ResultName
+	}
+	if o.Response.Success != nil && !o.Response.Success.HasBody() {
+		return "runtime.NoContent"
+	}
+	return o.Response.Success.ResponseName
+}
+
 func (o OperationDefinition) HasRequestOptions() bool {
 	return o.PathParams != nil || o.Header != nil || o.Query != nil || o.Body != nil
 }
 
+// EscapedPath returns the operation path with the static path segments escaped.
+func (o OperationDefinition) EscapedPath() string {
+	return escapePathElements(o.Path)
+}
+
+// PathFormat returns the escaped operation path as a fmt format string,
+// with each path parameter replaced by a %s verb.
+func (o OperationDefinition) PathFormat() string {
+	return replacePathParamsWithStr(strings.ReplaceAll(o.EscapedPath(), "%", "%%"))
+}
+
+// PathParamGoNames returns the Go field names of the path parameters,
+// in the order they appear in the path.
+func (o OperationDefinition) PathParamGoNames() []string {
+	if o.PathParams == nil {
+		return nil
+	}
+
+	goNames := make(map[string]string, len(o.PathParams.Schema.Properties))
+	for _, prop := range o.PathParams.Schema.Properties {
+		goNames[prop.JsonFieldName] = prop.GoName
+	}
+
+	var res []string
+	for _, name := range orderedParamsFromUri(o.Path) {
+		if goName, ok := goNames[name]; ok {
+			res = append(res, goName)
+		}
+	}
+	return res
+}
+
 // filterParameterDefinitionByType returns the subset of the specified parameters which are of the
 // specified type.
 func filterParameterDefinitionByType(params []ParameterDefinition, in string) []ParameterDefinition {
@@ -90,6 +204,24 @@ func filterParameterDefinitionByType(params []ParameterDefinition, in string) []
 	return out
 }
 
+// operationMethodName returns the method name set by the x-go-method-name extension of an operation,
+// sanitized into a valid exported Go identifier, or empty if it has none.
+func operationMethodName(extensions map[string]any) (string, error) {
+	value, ok := extensions[extGoMethodName]
+	if !ok {
+		return "", nil
+	}
+	name, err := parseString(value)
+	if err != nil {
+		return "", err
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", fmt.Errorf("empty method name")
+	}
+	return UppercaseFirstCharacter(sanitizeGoIdentity(name)), nil
+}
+
 // createOperationID generates a unique operation ID based on the HTTP method and path.
 // If the initial value is provided, it will be used.
 // The resulting operation ID is a camel-cased string.
@@ -90,3 +90,34 @@ func TestCreateOperationID(t *testing.T) {
 		}
 	}
 }
+
+func TestOperationsByTagComment(t *testing.T) {
+	ops := []OperationDefinition{
+		{ID: "ListPets", Summary: "List all pets", Tags: []string{"pets"}},
+		{ID: "Health", Summary: "Health check\nReturns 200 when ready."},
+		{ID: "AdoptPet", Tags: []string{"pets", "adoptions"}},
+		{ID: "ListAdoptions", Summary: "  List adoptions  ", Tags: []string{"adoptions"}},
+	}
+
+	expected := `//
+// Operations by tag:
+//
+// pets:
+//   - [Client.ListPets]: List all pets
+//   - [Client.AdoptPet]
+//
+// adoptions:
+//   - [Client.AdoptPet]
+//   - [Client.ListAdoptions]: List adoptions
+//
+// Other:
+//   - [Client.Health]: Health check`
+
+	if got := operationsByTagComment("Client", ops); got != expected {
+		t.Errorf("unexpected comment:\n%s\nexpected:\n%s", got, expected)
+	}
+
+	if got := operationsByTagComment("Client", nil); got != "" {
+		t.Errorf("expected no comment without operations, got %q", got)
+	}
+}
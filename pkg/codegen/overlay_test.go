@@ -127,3 +127,51 @@ func TestApplyOverlays_SingleSource(t *testing.T) {
 	require.True(t, ok)
 	assert.Equal(t, "UserModel", goName.Value)
 }
+
+func TestApplyOverlays_AddComponents(t *testing.T) {
+	doc, err := LoadDocumentFromContents([]byte(readTestdata(t, "overlay-base.yml")))
+	require.NoError(t, err)
+
+	result, err := applyOverlays(doc, []string{"testdata/overlay-add-components.yml"})
+	require.NoError(t, err)
+
+	model, err := result.BuildV3Model()
+	require.NoError(t, err)
+	components := model.Model.Components
+
+	t.Run("security scheme", func(t *testing.T) {
+		scheme, ok := components.SecuritySchemes.Get("ApiKeyAuth")
+		require.True(t, ok)
+		assert.Equal(t, "apiKey", scheme.Type)
+		assert.Equal(t, "X-API-Key", scheme.Name)
+	})
+
+	t.Run("reusable parameter", func(t *testing.T) {
+		param, ok := components.Parameters.Get("PageSize")
+		require.True(t, ok)
+		assert.Equal(t, "page_size", param.Name)
+		assert.Equal(t, "query", param.In)
+	})
+
+	t.Run("header", func(t *testing.T) {
+		_, ok := components.Headers.Get("X-Request-ID")
+		require.True(t, ok)
+	})
+
+	t.Run("existing schemas are kept", func(t *testing.T) {
+		_, ok := components.Schemas.Get("User")
+		assert.True(t, ok)
+		_, ok = components.Schemas.Get("Team")
+		assert.True(t, ok)
+	})
+
+	t.Run("operation uses the new components", func(t *testing.T) {
+		pathItem, ok := model.Model.Paths.PathItems.Get("/users")
+		require.True(t, ok)
+		require.Len(t, pathItem.Get.Parameters, 1)
+		assert.Equal(t, "page_size", pathItem.Get.Parameters[0].Name)
+		require.Len(t, pathItem.Get.Security, 1)
+		_, ok = pathItem.Get.Security[0].Requirements.Get("ApiKeyAuth")
+		assert.True(t, ok)
+	})
+}
@@ -15,16 +15,24 @@ import (
 	"bytes"
 	"embed"
 	"fmt"
+	"go/ast"
 	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
 	"os"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"text/template"
+	"unicode"
 
 	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
 
 	"github.com/iancoleman/strcase"
+	"golang.org/x/tools/go/ast/astutil"
 	"golang.org/x/tools/imports"
 )
 
@@ -35,6 +43,7 @@ var templates embed.FS
 
 // GeneratedCode is a map of file names to generated code content.
 // Scaffold files (service, middleware, server/main) are prefixed with "scaffold:" in the key.
+// Fuzz seed corpus files are prefixed with "fuzz:", see FuzzSeedFileName.
 type GeneratedCode map[string]string
 
 // GetCombined returns the combined single-file output (the "all" key).
@@ -64,9 +73,28 @@ type Parser struct {
 
 type ParseOptions struct {
 	OmitDescription        bool
+	ConstraintComments     bool
 	DefaultIntType         string
 	AlwaysPrefixEnumValues bool
-	SkipValidation         bool
+	OpenEnums              bool
+	StrictEnums            bool
+
+	// IntTypeByFormat maps integer formats to Go types, overriding the built-in mapping.
+	IntTypeByFormat map[string]string
+
+	// FreeFormObjectType is the Go type of objects without properties and additionalProperties.
+	FreeFormObjectType FreeFormObjectType
+
+	// OptionalType is the Go type of optional nullable fields of objects.
+	OptionalType OptionalType
+
+	// DecimalType is the Go type of `format: decimal` strings and numbers.
+	DecimalType DecimalType
+
+	// TrimTypePrefix is stripped from the Go type names of components.
+	TrimTypePrefix string
+
+	SkipValidation bool
 
 	// ErrorMapping maps response type names to the field that should be used
 	// for the Error() method. When a response type has error mapping configured,
@@ -77,6 +105,37 @@ type ParseOptions struct {
 	// Key is the Go struct tag name, value is the OpenAPI schema field to extract.
 	AutoExtraTags map[string]string
 
+	// PreserveJSONCase keeps the json struct tag equal to the verbatim spec property name.
+	PreserveJSONCase bool
+
+	// EmbedJSONSchemas renders the JSON Schema of JSON request bodies and success responses,
+	// used by handlers to validate bodies against the spec at runtime.
+	EmbedJSONSchemas bool
+
+	// EmbedExamples keeps the JSON example of request bodies, used to seed MCP tool arguments.
+	EmbedExamples bool
+
+	// FuzzSeeds keeps all the JSON examples of request bodies, written as fuzz seed corpus files.
+	FuzzSeeds bool
+
+	// GenerateCallbacks collects the callbacks declared by operations, with their request body types.
+	GenerateCallbacks bool
+
+	// GenerateWebhooks collects the top-level webhooks, with their request body types.
+	GenerateWebhooks bool
+
+	// GenerateHandler reserves the {operationID}Context type names declared by the handler.
+	GenerateHandler bool
+
+	// MergePatch generates merge patch types for application/merge-patch+json request bodies.
+	MergePatch bool
+
+	// ProblemDetails decodes application/problem+json error responses into runtime.ProblemDetails.
+	ProblemDetails bool
+
+	// JSONPatch generates JSON Patch builders for application/json-patch+json request bodies.
+	JSONPatch bool
+
 	// runtime options
 	typeTracker  *TypeTracker
 	reference    string
@@ -89,6 +148,9 @@ type ParseOptions struct {
 	// model is the high-level OpenAPI model, used to resolve $ref to mutated schemas
 	// instead of following stale low-level references
 	model *v3high.Document
+
+	// inlineRefs are the refs of the component schemas generated as anonymous structs, see GenerateOptions.InlineThreshold.
+	inlineRefs map[string]bool
 }
 
 func (o ParseOptions) WithReference(reference string) ParseOptions {
@@ -112,6 +174,9 @@ type EnumContext struct {
 	Config      Configuration
 	WithHeader  bool
 	TypeTracker *TypeTracker
+
+	// Extra is the user-provided Configuration.TemplateData.
+	Extra map[string]any
 }
 
 // TplTypeContext is the context passed to templates to generate code for type definitions.
@@ -126,6 +191,15 @@ type TplTypeContext struct {
 	WithHeader     bool
 	ResponseErrors map[string]bool
 	TypeTracker    *TypeTracker
+
+	// TruncatableTypes are the names of the types with a Truncate method, set when truncate helpers are enabled.
+	TruncatableTypes map[string]bool
+
+	// FastJSONTypes are the names of the types with a reflect-free MarshalJSON, set with x-go-fast-json.
+	FastJSONTypes map[string]bool
+
+	// Extra is the user-provided Configuration.TemplateData.
+	Extra map[string]any
 }
 
 // TplOperationsContext is the context passed to templates to generate client code.
@@ -136,6 +210,63 @@ type TplOperationsContext struct {
 	WithHeader    bool
 	ServerOptions *ServerOptions
 	PackageName   string
+
+	// Batch is the batch operation of the client, see ClientBatchDefinition.
+	Batch *ClientBatchDefinition
+
+	// HealthCheck is the health check operation sent by the Ping method of the client, if any.
+	HealthCheck *OperationDefinition
+
+	// FileOperations are the operations generated in the file, when Output.FilePerTag splits them by tag.
+	FileOperations []OperationDefinition
+
+	// OperationsOnly skips the code shared by all operations, e.g. the client struct,
+	// for the per-tag files of Output.FilePerTag.
+	OperationsOnly bool
+
+	// Extra is the user-provided Configuration.TemplateData.
+	Extra map[string]any
+}
+
+// OperationsInFile returns the operations generated in the file: FileOperations if set, all operations otherwise.
+func (c TplOperationsContext) OperationsInFile() []OperationDefinition {
+	if c.FileOperations != nil {
+		return c.FileOperations
+	}
+	return c.Operations
+}
+
+// TplCallbacksContext is the context passed to the callbacks and webhooks templates.
+type TplCallbacksContext struct {
+	Callbacks  []CallbackDefinition
+	Imports    []string
+	Config     Configuration
+	WithHeader bool
+
+	// Extra is the user-provided Configuration.TemplateData.
+	Extra map[string]any
+}
+
+// TplXConfigContext is the context passed to the x-config template.
+type TplXConfigContext struct {
+	XConfig    *XConfigDefinition
+	Imports    []string
+	Config     Configuration
+	WithHeader bool
+
+	// Extra is the user-provided Configuration.TemplateData.
+	Extra map[string]any
+}
+
+// TplInfoContext is the context passed to the info template.
+type TplInfoContext struct {
+	Info       APIInfo
+	Imports    []string
+	Config     Configuration
+	WithHeader bool
+
+	// Extra is the user-provided Configuration.TemplateData.
+	Extra map[string]any
 }
 
 // NewParser creates a new Parser with the provided ParseConfig and ParseContext.
@@ -178,12 +309,35 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 	useSingleFile := p.cfg.Output != nil && p.cfg.Output.UseSingleFile
 	withHeader := !useSingleFile
 
+	// Split the client and handler operations by tag. The untagged operations stay in the default files.
+	filePerTag := p.cfg.Output != nil && p.cfg.Output.FilePerTag
+	if filePerTag && useSingleFile {
+		return nil, fmt.Errorf("output.file-per-tag cannot be used with output.use-single-file")
+	}
+	packagePerSpecLocation := p.cfg.Output != nil && p.cfg.Output.PackagePerSpecLocation
+	if packagePerSpecLocation && useSingleFile {
+		return nil, fmt.Errorf("output.package-per-spec-location cannot be used with output.use-single-file")
+	}
+	if packagePerSpecLocation && p.cfg.Output.ImportPath == "" {
+		return nil, fmt.Errorf("output.package-per-spec-location requires output.import-path")
+	}
+	var tagSuffixes []string
+	var tagOps map[string][]OperationDefinition
+	var untaggedOps []OperationDefinition
+	if filePerTag {
+		tagSuffixes, tagOps, untaggedOps = operationsByFileTag(p.ctx.Operations)
+		if untaggedOps == nil {
+			untaggedOps = []OperationDefinition{}
+		}
+	}
+
 	// Only generate models if Models is not explicitly false
 	shouldGenerateModels := p.cfg.Generate == nil || p.cfg.Generate.Models == nil || *p.cfg.Generate.Models
 	if useSingleFile {
 		out, err := p.ParseTemplates([]string{"header-inc.tmpl"}, EnumContext{
 			Imports:    p.ctx.Imports,
 			Config:     p.cfg,
+			Extra:      p.cfg.TemplateData,
 			WithHeader: true,
 		})
 		if err != nil {
@@ -196,6 +350,7 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 			out, err := p.ParseTemplates([]string{"common.tmpl"}, EnumContext{
 				Imports:    p.ctx.Imports,
 				Config:     p.cfg,
+				Extra:      p.cfg.TemplateData,
 				WithHeader: false,
 			})
 			if err != nil {
@@ -207,25 +362,204 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 
 	if len(p.ctx.Operations) > 0 && p.cfg.Generate.Client {
 		opsCtx := &TplOperationsContext{
-			Operations: p.ctx.Operations,
-			Imports:    p.ctx.Imports,
-			Config:     p.cfg,
-			WithHeader: withHeader,
+			Operations:     p.ctx.Operations,
+			Imports:        p.ctx.Imports,
+			Config:         p.cfg,
+			Extra:          p.cfg.TemplateData,
+			WithHeader:     withHeader,
+			Batch:          p.ctx.ClientBatch,
+			HealthCheck:    p.ctx.ClientHealthCheck,
+			FileOperations: untaggedOps,
 		}
 		for _, tmpl := range []string{"client", "client-options"} {
+			if tmpl == "client-options" && filePerTag && len(untaggedOps) == 0 {
+				continue
+			}
 			out, err := p.ParseTemplates([]string{tmpl + ".tmpl"}, opsCtx)
 			if err != nil {
 				return nil, fmt.Errorf("error generating code for client: %w", err)
 			}
 			formatted := out
 			if !useSingleFile {
-				formatted, err = FormatCode(out)
+				formatted, err = p.formatCode(out)
 				if err != nil {
 					return nil, err
 				}
 			}
 			typesOut[strcase.ToSnake(tmpl)] = formatted
 		}
+
+		for _, suffix := range tagSuffixes {
+			out, err := p.parseTagFile(opsCtx, tagOps[suffix], "client.tmpl", "client-options.tmpl")
+			if err != nil {
+				return nil, fmt.Errorf("error generating code for client: %w", err)
+			}
+			typesOut[tagFileKey("client", suffix, typesOut)] = out
+		}
+	}
+
+	if p.cfg.Generate.Client && p.cfg.Client != nil && p.cfg.Client.FilterBuilders && hasQueryFilters(p.ctx.Operations) {
+		out, err := p.ParseTemplates([]string{"filter-builders.tmpl"}, &TplOperationsContext{
+			Operations: p.ctx.Operations,
+			Imports:    p.ctx.Imports,
+			Config:     p.cfg,
+			Extra:      p.cfg.TemplateData,
+			WithHeader: withHeader,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error generating code for filter builders: %w", err)
+		}
+		formatted := out
+		if !useSingleFile {
+			formatted, err = p.formatCode(out)
+			if err != nil {
+				return nil, err
+			}
+		}
+		typesOut["filter_builders"] = formatted
+	}
+
+	if len(p.ctx.Operations) > 0 && p.cfg.Generate.PathBuilders {
+		out, err := p.ParseTemplates([]string{"path-builders.tmpl"}, &TplOperationsContext{
+			Operations: p.ctx.Operations,
+			Imports:    p.ctx.Imports,
+			Config:     p.cfg,
+			Extra:      p.cfg.TemplateData,
+			WithHeader: withHeader,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error generating code for path builders: %w", err)
+		}
+		formatted := out
+		if !useSingleFile {
+			formatted, err = p.formatCode(out)
+			if err != nil {
+				return nil, err
+			}
+		}
+		typesOut["path_builders"] = formatted
+	}
+
+	if len(p.ctx.Operations) > 0 && p.cfg.Generate.PathTemplates {
+		out, err := p.ParseTemplates([]string{"path-templates.tmpl"}, &TplOperationsContext{
+			Operations: p.ctx.Operations,
+			Imports:    p.ctx.Imports,
+			Config:     p.cfg,
+			Extra:      p.cfg.TemplateData,
+			WithHeader: withHeader,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error generating code for path templates: %w", err)
+		}
+		formatted := out
+		if !useSingleFile {
+			formatted, err = p.formatCode(out)
+			if err != nil {
+				return nil, err
+			}
+		}
+		typesOut["path_templates"] = formatted
+	}
+
+	if p.cfg.Generate.InfoConstants {
+		out, err := p.ParseTemplates([]string{"info.tmpl"}, &TplInfoContext{
+			Info:       p.ctx.Info,
+			Imports:    p.ctx.Imports,
+			Config:     p.cfg,
+			Extra:      p.cfg.TemplateData,
+			WithHeader: withHeader,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error generating code for info constants: %w", err)
+		}
+		formatted := out
+		if !useSingleFile {
+			formatted, err = p.formatCode(out)
+			if err != nil {
+				return nil, err
+			}
+		}
+		typesOut["info"] = formatted
+	}
+
+	if p.ctx.XConfig != nil {
+		out, err := p.ParseTemplates([]string{"x-config.tmpl"}, &TplXConfigContext{
+			XConfig:    p.ctx.XConfig,
+			Imports:    p.ctx.Imports,
+			Config:     p.cfg,
+			Extra:      p.cfg.TemplateData,
+			WithHeader: withHeader,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error generating code for x-config: %w", err)
+		}
+		formatted := out
+		if !useSingleFile {
+			formatted, err = p.formatCode(out)
+			if err != nil {
+				return nil, err
+			}
+		}
+		typesOut["config"] = formatted
+	}
+
+	if len(p.ctx.Callbacks) > 0 && p.cfg.Generate.Callbacks {
+		out, err := p.ParseTemplates([]string{"callbacks.tmpl"}, &TplCallbacksContext{
+			Callbacks:  p.ctx.Callbacks,
+			Imports:    p.ctx.Imports,
+			Config:     p.cfg,
+			Extra:      p.cfg.TemplateData,
+			WithHeader: withHeader,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error generating code for callbacks: %w", err)
+		}
+		formatted := out
+		if !useSingleFile {
+			formatted, err = p.formatCode(out)
+			if err != nil {
+				return nil, err
+			}
+		}
+		typesOut["callbacks"] = formatted
+	}
+
+	if len(p.ctx.Webhooks) > 0 && p.cfg.Generate.Webhooks {
+		out, err := p.ParseTemplates([]string{"webhooks.tmpl"}, &TplCallbacksContext{
+			Callbacks:  p.ctx.Webhooks,
+			Imports:    p.ctx.Imports,
+			Config:     p.cfg,
+			Extra:      p.cfg.TemplateData,
+			WithHeader: withHeader,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error generating code for webhooks: %w", err)
+		}
+		formatted := out
+		if !useSingleFile {
+			formatted, err = p.formatCode(out)
+			if err != nil {
+				return nil, err
+			}
+		}
+		typesOut["webhooks"] = formatted
+	}
+
+	if p.cfg.Generate.TestServer && p.cfg.Generate.Handler == nil {
+		return nil, fmt.Errorf("test server generation requires handler generation to be enabled (set generate.handler)")
+	}
+
+	if p.cfg.Generate.MockHTTPServer {
+		if p.cfg.Generate.Handler == nil {
+			return nil, fmt.Errorf("mock HTTP server generation requires handler generation to be enabled (set generate.handler)")
+		}
+		if !p.cfg.Generate.Handler.Kind.ServesNetHTTP() {
+			return nil, fmt.Errorf("mock HTTP server generation requires a net/http based handler kind, got %s", p.cfg.Generate.Handler.Kind)
+		}
+	}
+
+	if p.cfg.Generate.Handler != nil && p.cfg.Generate.Handler.Validation.Middleware && p.cfg.Generate.Validation.Skip {
+		return nil, fmt.Errorf("validation middleware requires Validate methods (unset generate.validation.skip)")
 	}
 
 	// Generate handler code if handler generation is enabled
@@ -234,8 +568,12 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 			Operations: p.ctx.Operations,
 			Imports:    p.ctx.Imports,
 			Config:     p.cfg,
+			Extra:      p.cfg.TemplateData,
 			WithHeader: withHeader,
 		}
+		// The adapter, response data and service options of the tagged operations go to the per-tag files.
+		fileOpsCtx := *opsCtx
+		fileOpsCtx.FileOperations = untaggedOps
 		// Determine which templates to use based on handler kind
 		handlerKind := p.cfg.Generate.Handler.Kind
 		templatePrefix := "handler/" + string(handlerKind) + "/"
@@ -252,11 +590,15 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 		} else {
 			// In multi-file mode, generate separate files from shared templates
 			for _, tmpl := range []string{"errors", "adapter", "router"} {
-				out, err := p.ParseTemplates([]string{sharedPrefix + tmpl + ".tmpl"}, opsCtx)
+				tmplCtx := opsCtx
+				if tmpl == "adapter" {
+					tmplCtx = &fileOpsCtx
+				}
+				out, err := p.ParseTemplates([]string{sharedPrefix + tmpl + ".tmpl"}, tmplCtx)
 				if err != nil {
 					return nil, fmt.Errorf("error generating code for %s: %w", tmpl, err)
 				}
-				formatted, err := FormatCode(out)
+				formatted, err := p.formatCode(out)
 				if err != nil {
 					return nil, err
 				}
@@ -266,13 +608,13 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 
 		// Generate shared templates (router-agnostic) - these are regenerated files
 		for _, tmpl := range []string{"response-data", "service-options"} {
-			out, err := p.ParseTemplates([]string{sharedPrefix + tmpl + ".tmpl"}, opsCtx)
+			out, err := p.ParseTemplates([]string{sharedPrefix + tmpl + ".tmpl"}, &fileOpsCtx)
 			if err != nil {
 				return nil, fmt.Errorf("error generating code for %s: %w", tmpl, err)
 			}
 			formatted := out
 			if !useSingleFile {
-				formatted, err = FormatCode(out)
+				formatted, err = p.formatCode(out)
 				if err != nil {
 					return nil, fmt.Errorf("error formatting %s: %w", tmpl, err)
 				}
@@ -280,6 +622,45 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 			typesOut[strcase.ToSnake(tmpl)] = formatted
 		}
 
+		for _, suffix := range tagSuffixes {
+			out, err := p.parseTagFile(opsCtx, tagOps[suffix],
+				sharedPrefix+"adapter.tmpl", sharedPrefix+"service-options.tmpl", sharedPrefix+"response-data.tmpl")
+			if err != nil {
+				return nil, fmt.Errorf("error generating code for handler: %w", err)
+			}
+			typesOut[tagFileKey("handler", suffix, typesOut)] = out
+		}
+
+		if p.cfg.Generate.TestServer {
+			out, err := p.ParseTemplates([]string{sharedPrefix + "test-server.tmpl"}, opsCtx)
+			if err != nil {
+				return nil, fmt.Errorf("error generating code for test server: %w", err)
+			}
+			formatted := out
+			if !useSingleFile {
+				formatted, err = p.formatCode(out)
+				if err != nil {
+					return nil, fmt.Errorf("error formatting test server: %w", err)
+				}
+			}
+			typesOut["test_server"] = formatted
+		}
+
+		if p.cfg.Generate.MockHTTPServer {
+			out, err := p.ParseTemplates([]string{sharedPrefix + "mock-http-server.tmpl"}, opsCtx)
+			if err != nil {
+				return nil, fmt.Errorf("error generating code for mock HTTP server: %w", err)
+			}
+			formatted := out
+			if !useSingleFile {
+				formatted, err = p.formatCode(out)
+				if err != nil {
+					return nil, fmt.Errorf("error formatting mock HTTP server: %w", err)
+				}
+			}
+			typesOut["mock_http_server"] = formatted
+		}
+
 		// Resolve scaffold output once for service and middleware
 		scaffoldOutput := p.cfg.Generate.Handler.ResolveScaffoldOutput(p.cfg.Output)
 		scaffoldPackage := scaffoldOutput.Package
@@ -293,6 +674,7 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 				Operations:  p.ctx.Operations,
 				Imports:     p.ctx.Imports,
 				Config:      p.cfg,
+				Extra:       p.cfg.TemplateData,
 				WithHeader:  withHeader,
 				PackageName: scaffoldPackage,
 			}
@@ -306,7 +688,7 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 				}
 			}
 			// Scaffold files are always separate files, so always format them
-			formattedMiddleware, err := FormatCode(middlewareOut)
+			formattedMiddleware, err := p.formatCode(middlewareOut)
 			if err != nil {
 				return nil, fmt.Errorf("error formatting middleware: %w", err)
 			}
@@ -324,6 +706,7 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 			Operations:  p.ctx.Operations,
 			Imports:     p.ctx.Imports,
 			Config:      p.cfg,
+			Extra:       p.cfg.TemplateData,
 			WithHeader:  withHeader,
 			PackageName: scaffoldPackage,
 		}
@@ -333,7 +716,7 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 		}
 
 		// Scaffold files are always separate files, so always format them
-		formatted, err := FormatCode(out)
+		formatted, err := p.formatCode(out)
 		if err != nil {
 			return nil, fmt.Errorf("error formatting service: %w", err)
 		}
@@ -356,6 +739,7 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 				Operations:    p.ctx.Operations,
 				Imports:       p.ctx.Imports,
 				Config:        p.cfg,
+				Extra:         p.cfg.TemplateData,
 				WithHeader:    withHeader,
 				ServerOptions: &serverOpts,
 				PackageName:   serverOutput.Package,
@@ -372,7 +756,7 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 				}
 			}
 
-			formatted, err := FormatCode(out)
+			formatted, err := p.formatCode(out)
 			if err != nil {
 				return nil, fmt.Errorf("error formatting server: %w", err)
 			}
@@ -389,6 +773,7 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 			Operations: p.ctx.Operations,
 			Imports:    p.ctx.Imports,
 			Config:     p.cfg,
+			Extra:      p.cfg.TemplateData,
 			WithHeader: withHeader,
 		}
 		out, err := p.ParseTemplates([]string{"mcp/tools.tmpl"}, opsCtx)
@@ -397,7 +782,7 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 		}
 		formatted := out
 		if !useSingleFile {
-			formatted, err = FormatCode(out)
+			formatted, err = p.formatCode(out)
 			if err != nil {
 				return nil, fmt.Errorf("error formatting MCP tools: %w", err)
 			}
@@ -410,12 +795,13 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 		out, err := p.ParseTemplates([]string{"common.tmpl"}, EnumContext{
 			Imports:    p.ctx.Imports,
 			Config:     p.cfg,
+			Extra:      p.cfg.TemplateData,
 			WithHeader: withHeader,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("error generating code for validator: %w", err)
 		}
-		formatted, err := FormatCode(out)
+		formatted, err := p.formatCode(out)
 		if err != nil {
 			return nil, err
 		}
@@ -426,6 +812,7 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 			Enums:       p.ctx.Enums,
 			Imports:     p.ctx.Imports,
 			Config:      p.cfg,
+			Extra:       p.cfg.TemplateData,
 			WithHeader:  withHeader,
 			TypeTracker: p.ctx.TypeTracker,
 		})
@@ -434,7 +821,7 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 		}
 		formatted := out
 		if !useSingleFile {
-			formatted, err = FormatCode(out)
+			formatted, err = p.formatCode(out)
 			if err != nil {
 				return nil, err
 			}
@@ -458,6 +845,17 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 		typeSchemaMap[td.Name] = td.Schema
 	}
 
+	if err := checkFieldPaths(p.cfg.FieldPaths, p.ctx.TypeDefinitions, typeSchemaMap); err != nil {
+		return nil, err
+	}
+
+	var truncatable map[string]bool
+	if p.cfg.Generate.TruncateHelpers {
+		truncatable = truncatableTypes(typeSchemaMap)
+	}
+
+	fastJSON := fastJSONTypes(p.ctx.TypeDefinitions)
+
 	// Only generate model types if Models is not explicitly false
 	if shouldGenerateModels {
 		for sl, tds := range p.ctx.TypeDefinitions {
@@ -470,9 +868,13 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 				SpecLocation:   string(sl),
 				Imports:        p.ctx.Imports,
 				Config:         p.cfg,
+				Extra:          p.cfg.TemplateData,
 				WithHeader:     withHeader,
 				ResponseErrors: responseErrs,
 				TypeTracker:    p.ctx.TypeTracker,
+
+				TruncatableTypes: truncatable,
+				FastJSONTypes:    fastJSON,
 			}
 			out, err := p.ParseTemplates([]string{"types.tmpl"}, typesCtx)
 			if err != nil {
@@ -480,7 +882,7 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 			}
 			formatted := out
 			if !useSingleFile {
-				formatted, err = FormatCode(out)
+				formatted, err = p.formatCode(out)
 				if err != nil {
 					return nil, err
 				}
@@ -495,6 +897,7 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 				SpecLocation:   "union",
 				Imports:        p.ctx.Imports,
 				Config:         p.cfg,
+				Extra:          p.cfg.TemplateData,
 				WithHeader:     withHeader,
 				ResponseErrors: responseErrs,
 				TypeTracker:    p.ctx.TypeTracker,
@@ -504,7 +907,7 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 			}
 			formatted := out
 			if !useSingleFile {
-				formatted, err = FormatCode(out)
+				formatted, err = p.formatCode(out)
 				if err != nil {
 					return nil, err
 				}
@@ -536,7 +939,7 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 			res += code + "\n"
 		}
 
-		formatted, err := FormatCode(res)
+		formatted, err := p.formatCode(res)
 		if err != nil {
 			println(res)
 			return nil, err
@@ -544,11 +947,25 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 		typesOut = map[string]string{"all": formatted}
 	}
 
+	if packagePerSpecLocation {
+		var err error
+		typesOut, err = splitSpecLocationPackages(typesOut, p.cfg.Output.ImportPath, p.formatCode)
+		if err != nil {
+			return nil, fmt.Errorf("error splitting the spec locations into packages: %w", err)
+		}
+	}
+
 	// Merge scaffold files into the main map with prefix
 	for name, content := range scaffoldOut {
 		typesOut[scaffoldPrefix+name] = content
 	}
 
+	if p.cfg.Generate.FuzzSeeds {
+		for name, content := range fuzzSeedFiles(p.ctx.Operations) {
+			typesOut[fuzzSeedPrefix+name] = content
+		}
+	}
+
 	return typesOut, nil
 }
 
@@ -571,6 +988,24 @@ func (p *Parser) ParseTemplates(templates []string, data any) (string, error) {
 	return strings.Join(generatedTemplates, "\n"), nil
 }
 
+// parseTagFile generates the per-tag file of Output.FilePerTag from the templates, for the operations of the tag.
+// Only the first template writes the file header.
+func (p *Parser) parseTagFile(opsCtx *TplOperationsContext, ops []OperationDefinition, templates ...string) (string, error) {
+	var parts []string
+	for i, tmpl := range templates {
+		tagCtx := *opsCtx
+		tagCtx.FileOperations = ops
+		tagCtx.OperationsOnly = true
+		tagCtx.WithHeader = i == 0
+		out, err := p.ParseTemplates([]string{tmpl}, &tagCtx)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, out)
+	}
+	return p.formatCode(strings.Join(parts, "\n"))
+}
+
 func loadTemplates(cfg Configuration) (*template.Template, error) {
 	tpl := template.New("templates").Funcs(TemplateFunctions)
 
@@ -626,12 +1061,32 @@ func loadTemplates(cfg Configuration) (*template.Template, error) {
 // FormatCode formats the provided Go code.
 // It optimizes imports and formats the code using gofmt.
 func FormatCode(src string) (string, error) {
+	return formatCode(src, "")
+}
+
+// formatCode formats the generated code according to the output options.
+func (p *Parser) formatCode(src string) (string, error) {
+	if p.cfg.Output == nil {
+		return FormatCode(src)
+	}
+	if p.cfg.Output.SkipFormat {
+		res, err := pruneUnusedImports([]byte(strings.Trim(src, "\n") + "\n"))
+		if err != nil {
+			return "", fmt.Errorf("error pruning imports: %w", err)
+		}
+		return sanitizeCode(string(res)), nil
+	}
+	return formatCode(src, p.cfg.Output.ImportsLocalPrefix)
+}
+
+// formatCode optimizes imports, grouping the ones starting with localPrefix, and formats the code using gofmt.
+func formatCode(src, localPrefix string) (string, error) {
 	src = strings.Trim(src, "\n") + "\n"
 	if src == "\n" || src == "" {
 		return src, nil
 	}
 
-	res, err := optimizeImports([]byte(src))
+	res, err := optimizeImports([]byte(src), localPrefix)
 	if err != nil {
 		return "", fmt.Errorf("error optimizing imports: %w", err)
 	}
@@ -652,7 +1107,14 @@ func sanitizeCode(src string) string {
 	return strings.ReplaceAll(src, "\uFEFF", "")
 }
 
-func optimizeImports(src []byte) ([]byte, error) {
+// importsMu guards imports.LocalPrefix, which is a package variable.
+var importsMu sync.Mutex
+
+func optimizeImports(src []byte, localPrefix string) ([]byte, error) {
+	importsMu.Lock()
+	defer importsMu.Unlock()
+
+	imports.LocalPrefix = localPrefix
 	outBytes, err := imports.Process("gen.go", src, nil)
 	if err != nil {
 		return nil, err
@@ -660,6 +1122,86 @@ func optimizeImports(src []byte) ([]byte, error) {
 	return outBytes, nil
 }
 
+// pruneUnusedImports removes the imports whose package is not referenced.
+// Unlike goimports, it does not load the imported packages: the package name is guessed from the import path,
+// skipping a trailing major version element, e.g. validator for github.com/go-playground/validator/v10.
+func pruneUnusedImports(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "gen.go", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	used := map[string]bool{}
+	ast.Inspect(file, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok && id.Obj == nil {
+				used[id.Name] = true
+			}
+		}
+		return true
+	})
+
+	var unused []*ast.ImportSpec
+	for _, imp := range file.Imports {
+		path, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			return nil, err
+		}
+		name := assumedPackageName(path)
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		if name != "_" && name != "." && !used[name] {
+			unused = append(unused, imp)
+		}
+	}
+	if len(unused) == 0 {
+		return src, nil
+	}
+
+	for _, imp := range unused {
+		path, _ := strconv.Unquote(imp.Path.Value)
+		name := ""
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		astutil.DeleteNamedImport(fset, file, name, path)
+	}
+
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, file); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// assumedPackageName returns the package name goimports assumes for an import path it cannot load:
+// the last path element without a major version suffix and a go- prefix, up to the first non-identifier character.
+func assumedPackageName(path string) string {
+	elems := strings.Split(path, "/")
+	name := elems[len(elems)-1]
+	if len(elems) > 1 && isMajorVersionElement(name) {
+		name = elems[len(elems)-2]
+	}
+	name = strings.TrimPrefix(name, "go-")
+	if i := strings.IndexFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	}); i >= 0 {
+		name = name[:i]
+	}
+	return name
+}
+
+// isMajorVersionElement reports whether the path element is a module major version, e.g. v2.
+func isMajorVersionElement(elem string) bool {
+	if len(elem) < 2 || elem[0] != 'v' {
+		return false
+	}
+	_, err := strconv.Atoi(elem[1:])
+	return err == nil
+}
+
 func getSpecLocationOutName(specLocation SpecLocation) string {
 	switch specLocation {
 	case SpecLocationPath:
@@ -41,12 +41,14 @@ var TemplateFunctions = template.FuncMap{
 	"dict":           dict,
 	"slice":          func() []any { return []any{} },
 	"escapeGoString": escapeGoString,
+	"goStringLit":    goStringLiteral,
 	"append": func(slice []any, val any) []any {
 		return append(slice, val)
 	},
-	"filterOmitEmpty": filterOmitEmpty,
-	"deref":           derefBool,
-	"replace":         strings.ReplaceAll,
+	"filterOmitEmpty":        filterOmitEmpty,
+	"deref":                  derefBool,
+	"replace":                strings.ReplaceAll,
+	"operationsByTagComment": operationsByTagComment,
 }
 
 // uppercaseFirstCharacter Uppercases the first character in a string.
@@ -135,6 +137,15 @@ func escapeGoString(s string) string {
 	return quoted
 }
 
+// goStringLiteral returns s as a Go string literal, preferring a raw (backquoted) literal
+// for readability and falling back to an interpreted one when s can't be written raw.
+func goStringLiteral(s string) string {
+	if strings.ContainsAny(s, "`\r") || !utf8.ValidString(s) {
+		return strconv.Quote(s)
+	}
+	return "`" + s + "`"
+}
+
 // filterOmitEmpty removes "omitempty" from a slice of validation tags
 func filterOmitEmpty(tags []string) []string {
 	result := make([]string, 0, len(tags))
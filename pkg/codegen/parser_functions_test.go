@@ -76,3 +76,33 @@ func TestEscapeGoString(t *testing.T) {
 		})
 	}
 }
+
+func TestGoStringLiteral(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "plain string uses raw literal",
+			input:    `{"pattern":"^\\d+$"}`,
+			expected: "`{\"pattern\":\"^\\\\d+$\"}`",
+		},
+		{
+			name:     "backquote falls back to interpreted literal",
+			input:    "a`b",
+			expected: `"a` + "`" + `b"`,
+		},
+		{
+			name:     "carriage return falls back to interpreted literal",
+			input:    "a\rb",
+			expected: `"a\rb"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, goStringLiteral(tt.input))
+		})
+	}
+}
@@ -71,7 +71,84 @@ func main() {
 	fmt.Println("Hello, World!")
 }
 `
-	res, err := optimizeImports([]byte(src))
+	res, err := optimizeImports([]byte(src), "")
+	require.NoError(t, err)
+	require.Equal(t, expected, string(res))
+}
+
+func TestOptimizeImports_LocalPrefix(t *testing.T) {
+	src := `
+package main
+import (
+	"fmt"
+	"github.com/mycompany/shared/errs"
+	"github.com/google/uuid"
+)
+func main() {
+	fmt.Println(uuid.New(), errs.ErrNotFound)
+}
+`
+
+	expected := `package main
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/mycompany/shared/errs"
+)
+
+func main() {
+	fmt.Println(uuid.New(), errs.ErrNotFound)
+}
+`
+	res, err := optimizeImports([]byte(src), "github.com/mycompany")
+	require.NoError(t, err)
+	require.Equal(t, expected, string(res))
+
+	t.Run("prefix is not kept for the next call", func(t *testing.T) {
+		res, err := optimizeImports([]byte(src), "")
+		require.NoError(t, err)
+		require.NotContains(t, string(res), "\"github.com/google/uuid\"\n\n")
+	})
+}
+
+func TestPruneUnusedImports(t *testing.T) {
+	src := `package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/yorunikakeru4/oapi-codegen-dd/v3/pkg/runtime"
+	json "github.com/goccy/go-json"
+	"gopkg.in/yaml.v3"
+	_ "embed"
+)
+
+func main() {
+	fmt.Println(validator.New(), runtime.ErrValidationEmail, yaml.Marshal)
+}
+`
+
+	expected := `package main
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/yorunikakeru4/oapi-codegen-dd/v3/pkg/runtime"
+	"gopkg.in/yaml.v3"
+	_ "embed"
+)
+
+func main() {
+	fmt.Println(validator.New(), runtime.ErrValidationEmail, yaml.Marshal)
+}
+`
+	res, err := pruneUnusedImports([]byte(src))
 	require.NoError(t, err)
 	require.Equal(t, expected, string(res))
 }
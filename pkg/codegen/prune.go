@@ -20,10 +20,21 @@ import (
 	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
 )
 
-func pruneSchema(model *v3high.Document) error {
+// pruneOptions selects the optional parts of the document that count as references when pruning.
+type pruneOptions struct {
+	// callbacks keeps the components referenced by operation callbacks.
+	callbacks bool
+
+	// webhooks keeps the top-level webhooks and the components they reference.
+	webhooks bool
+}
+
+func pruneSchema(model *v3high.Document, opts pruneOptions) error {
 	// Aggressively remove everything we don't generate code for
 	slog.Debug("Pruning: removing webhooks, security schemes, callbacks, component examples, links")
-	model.Webhooks = nil
+	if !opts.webhooks {
+		model.Webhooks = nil
+	}
 	if model.Components != nil {
 		// Set to nil - we don't generate code for these
 		model.Components.SecuritySchemes = nil
@@ -43,7 +54,7 @@ func pruneSchema(model *v3high.Document) error {
 			return fmt.Errorf("pruning exceeded maximum iterations (%d), possible infinite loop", maxIterations)
 		}
 
-		refs := findOperationRefs(model)
+		refs := findOperationRefs(model, opts)
 		slog.Debug("Found operation refs", "count", len(refs), "iteration", iteration)
 
 		countRemoved := removeOrphanedComponents(model, refs)
@@ -118,9 +129,20 @@ func removeOrphanedComponents(model *v3high.Document, refs map[string]bool) int
 	return countRemoved
 }
 
-func findOperationRefs(model *v3high.Document) map[string]bool {
+func findOperationRefs(model *v3high.Document, opts pruneOptions) map[string]bool {
 	refSet := make(map[string]bool)
 
+	if opts.webhooks && model.Webhooks != nil {
+		for _, pathItem := range model.Webhooks.FromOldest() {
+			for _, param := range pathItem.Parameters {
+				collectRefFromProxy(param, refSet, model)
+			}
+			for _, op := range pathItem.GetOperations().FromOldest() {
+				collectOperationRefs(op, refSet, model)
+			}
+		}
+	}
+
 	if model.Paths == nil || model.Paths.PathItems == nil {
 		return refSet
 	}
@@ -134,23 +156,22 @@ func findOperationRefs(model *v3high.Document) map[string]bool {
 
 		// Collect operation-level refs
 		for _, op := range pathItem.GetOperations().FromOldest() {
-			// Request body
-			if op.RequestBody != nil {
-				collectRefFromProxy(op.RequestBody, refSet, model)
-			}
+			collectOperationRefs(op, refSet, model)
 
-			// Parameters
-			for _, param := range op.Parameters {
-				collectRefFromProxy(param, refSet, model)
+			if !opts.callbacks || op.Callbacks == nil {
+				continue
 			}
-
-			// Responses
-			if op.Responses != nil {
-				if op.Responses.Default != nil {
-					collectRefFromProxy(op.Responses.Default, refSet, model)
+			for _, callback := range op.Callbacks.FromOldest() {
+				if callback == nil || callback.Expression == nil {
+					continue
 				}
-				for _, resp := range op.Responses.Codes.FromOldest() {
-					collectRefFromProxy(resp, refSet, model)
+				for _, cbPathItem := range callback.Expression.FromOldest() {
+					for _, param := range cbPathItem.Parameters {
+						collectRefFromProxy(param, refSet, model)
+					}
+					for _, cbOp := range cbPathItem.GetOperations().FromOldest() {
+						collectOperationRefs(cbOp, refSet, model)
+					}
 				}
 			}
 		}
@@ -330,6 +351,29 @@ func getComponentKeys(component iter.Seq[string]) []string {
 // collectRefFromProxy collects the $ref from a proxy object and all schema refs from its content
 // This works for Parameters, RequestBodies, Responses, Headers - anything with GoLow().GetReference()
 // When model is provided, component schema references are resolved from the model (which may have been mutated)
+// collectOperationRefs collects the refs of the request body, parameters and responses of the operation.
+func collectOperationRefs(op *v3high.Operation, refSet map[string]bool, model *v3high.Document) {
+	// Request body
+	if op.RequestBody != nil {
+		collectRefFromProxy(op.RequestBody, refSet, model)
+	}
+
+	// Parameters
+	for _, param := range op.Parameters {
+		collectRefFromProxy(param, refSet, model)
+	}
+
+	// Responses
+	if op.Responses != nil {
+		if op.Responses.Default != nil {
+			collectRefFromProxy(op.Responses.Default, refSet, model)
+		}
+		for _, resp := range op.Responses.Codes.FromOldest() {
+			collectRefFromProxy(resp, refSet, model)
+		}
+	}
+}
+
 func collectRefFromProxy(proxy any, refSet map[string]bool, model *v3high.Document) {
 	if proxy == nil {
 		return
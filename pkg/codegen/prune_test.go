@@ -27,7 +27,7 @@ func TestFindReferences(t *testing.T) {
 
 		model, _ := doc.BuildV3Model()
 
-		refs := findOperationRefs(&model.Model)
+		refs := findOperationRefs(&model.Model, pruneOptions{})
 		assert.Len(t, refs, 5)
 	})
 
@@ -53,7 +53,7 @@ func TestFindReferences(t *testing.T) {
 		assert.Nil(t, err)
 		m2, _ := doc2.BuildV3Model()
 
-		refs := findOperationRefs(&m2.Model)
+		refs := findOperationRefs(&m2.Model, pruneOptions{})
 		assert.Len(t, refs, 3)
 	})
 
@@ -78,7 +78,7 @@ func TestFindReferences(t *testing.T) {
 		assert.Nil(t, err)
 		m2, _ := doc2.BuildV3Model()
 
-		refs := findOperationRefs(&m2.Model)
+		refs := findOperationRefs(&m2.Model, pruneOptions{})
 		assert.Len(t, refs, 3)
 	})
 }
@@ -98,7 +98,7 @@ func TestFilterOnlyCat(t *testing.T) {
 		},
 	}
 
-	refs := findOperationRefs(&model.Model)
+	refs := findOperationRefs(&model.Model, pruneOptions{})
 	assert.Len(t, refs, 5)
 	assert.Equal(t, 5, model.Model.Components.Schemas.Len())
 
@@ -108,14 +108,14 @@ func TestFilterOnlyCat(t *testing.T) {
 	assert.Nil(t, err)
 	m2, _ := doc2.BuildV3Model()
 
-	refs = findOperationRefs(&m2.Model)
+	refs = findOperationRefs(&m2.Model, pruneOptions{})
 	assert.Len(t, refs, 3)
 
 	assert.NotEmpty(t, m2.Model.Paths.PathItems.GetOrZero("/cat"), "/cat path should still be in spec")
 	assert.NotEmpty(t, m2.Model.Paths.PathItems.GetOrZero("/cat").Get, "GET /cat operation should still be in spec")
 	assert.Empty(t, m2.Model.Paths.PathItems.GetOrZero("/dog").Get, "GET /dog should have been removed from spec")
 
-	err = pruneSchema(&m2.Model)
+	err = pruneSchema(&m2.Model, pruneOptions{})
 	assert.Nil(t, err)
 	if err != nil {
 		t.Fatal(err)
@@ -140,7 +140,7 @@ func TestFilterOnlyDog(t *testing.T) {
 		},
 	}
 
-	refs := findOperationRefs(m)
+	refs := findOperationRefs(m, pruneOptions{})
 	assert.Len(t, refs, 5)
 
 	filterOperations(m, cfg)
@@ -149,7 +149,7 @@ func TestFilterOnlyDog(t *testing.T) {
 	assert.Nil(t, err)
 	m2, _ := doc2.BuildV3Model()
 
-	refs = findOperationRefs(&m2.Model)
+	refs = findOperationRefs(&m2.Model, pruneOptions{})
 	assert.Len(t, refs, 3)
 
 	assert.Equal(t, 5, m2.Model.Components.Schemas.Len())
@@ -158,7 +158,7 @@ func TestFilterOnlyDog(t *testing.T) {
 	assert.NotEmpty(t, m2.Model.Paths.PathItems.GetOrZero("/dog").Get)
 	assert.Empty(t, m2.Model.Paths.PathItems.GetOrZero("/cat").Get)
 
-	err = pruneSchema(&m2.Model)
+	err = pruneSchema(&m2.Model, pruneOptions{})
 	assert.Nil(t, err)
 	if err != nil {
 		t.Fatal(err)
@@ -187,7 +187,7 @@ func TestPruningUnusedComponents(t *testing.T) {
 	assert.Equal(t, 1, m.Components.Links.Len())
 	assert.Equal(t, 1, m.Components.Callbacks.Len())
 
-	_ = pruneSchema(&model.Model)
+	_ = pruneSchema(&model.Model, pruneOptions{})
 
 	assert.Equal(t, 0, m.Components.Schemas.Len())
 	assert.Equal(t, 0, m.Components.Parameters.Len())
@@ -199,6 +199,57 @@ func TestPruningUnusedComponents(t *testing.T) {
 	assert.Nil(t, m.Components.Callbacks)
 }
 
+func TestPruneKeepsCallbackRefs(t *testing.T) {
+	contents, err := os.ReadFile("testdata/callbacks.yml")
+	assert.NoError(t, err)
+
+	for _, tc := range []struct {
+		name     string
+		opts     pruneOptions
+		expected int
+	}{
+		{name: "callbacks ignored", opts: pruneOptions{}, expected: 0},
+		{name: "callbacks kept", opts: pruneOptions{callbacks: true}, expected: 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			doc, err := LoadDocumentFromContents(contents)
+			assert.NoError(t, err)
+			model, err := doc.BuildV3Model()
+			assert.NoError(t, err)
+
+			assert.NoError(t, pruneSchema(&model.Model, tc.opts))
+			assert.Equal(t, tc.expected, model.Model.Components.Schemas.Len())
+		})
+	}
+}
+
+func TestPruneKeepsWebhooks(t *testing.T) {
+	contents, err := os.ReadFile("testdata/webhooks.yml")
+	assert.NoError(t, err)
+
+	t.Run("webhooks removed", func(t *testing.T) {
+		doc, err := LoadDocumentFromContents(contents)
+		assert.NoError(t, err)
+		model, err := doc.BuildV3Model()
+		assert.NoError(t, err)
+
+		assert.NoError(t, pruneSchema(&model.Model, pruneOptions{}))
+		assert.Nil(t, model.Model.Webhooks)
+		assert.Equal(t, 0, model.Model.Components.Schemas.Len())
+	})
+
+	t.Run("webhooks kept", func(t *testing.T) {
+		doc, err := LoadDocumentFromContents(contents)
+		assert.NoError(t, err)
+		model, err := doc.BuildV3Model()
+		assert.NoError(t, err)
+
+		assert.NoError(t, pruneSchema(&model.Model, pruneOptions{webhooks: true}))
+		assert.Equal(t, 2, model.Model.Webhooks.Len())
+		assert.NotNil(t, model.Model.Components.Schemas.GetOrZero("Pet"))
+	})
+}
+
 func TestPruneParameterSchemaRefs(t *testing.T) {
 	// Test that schemas referenced by component parameters are not pruned
 	contents, err := os.ReadFile("testdata/prune-component-params.yml")
@@ -215,7 +266,7 @@ func TestPruneParameterSchemaRefs(t *testing.T) {
 	assert.Equal(t, 2, m.Components.Parameters.Len(), "Should have 2 parameters before pruning")
 
 	// Prune the schema
-	err = pruneSchema(&model.Model)
+	err = pruneSchema(&model.Model, pruneOptions{})
 	assert.NoError(t, err)
 
 	// After pruning: schemas referenced by parameters should be preserved
@@ -258,7 +309,7 @@ func TestPruneInlineParameterSchemaRefs(t *testing.T) {
 		assert.NoError(t, err)
 
 		// Prune unused schemas
-		err = pruneSchema(&model2.Model)
+		err = pruneSchema(&model2.Model, pruneOptions{})
 		assert.NoError(t, err)
 
 		// After pruning: should have 2 schemas (UserId, User)
@@ -298,7 +349,7 @@ func TestPruneComponentSchemaRefs(t *testing.T) {
 		assert.Equal(t, 6, model.Model.Components.Schemas.Len())
 
 		// Prune unused schemas
-		err = pruneSchema(&model.Model)
+		err = pruneSchema(&model.Model, pruneOptions{})
 		assert.NoError(t, err)
 
 		// After pruning: should have 5 schemas (all except UnusedSchema)
@@ -344,7 +395,7 @@ func TestPruneComponentRequestBodyRefs(t *testing.T) {
 		assert.Equal(t, 3, model.Model.Components.Schemas.Len())
 
 		// Prune unused components
-		err = pruneSchema(&model.Model)
+		err = pruneSchema(&model.Model, pruneOptions{})
 		assert.NoError(t, err)
 
 		// After pruning: should have 3 request bodies (all except UnusedRequest)
@@ -397,7 +448,7 @@ func TestPruneDefaultResponseHeaders(t *testing.T) {
 		assert.Equal(t, 3, model.Model.Components.Schemas.Len())
 
 		// Prune unused components
-		err = pruneSchema(&model.Model)
+		err = pruneSchema(&model.Model, pruneOptions{})
 		assert.NoError(t, err)
 
 		// After pruning: should have 2 headers (ErrorCode, ErrorMessage - UnusedHeader should be pruned)
@@ -441,7 +492,7 @@ func TestPruneExamples(t *testing.T) {
 		assert.NoError(t, err)
 
 		// Prune the document
-		err = pruneSchema(&model.Model)
+		err = pruneSchema(&model.Model, pruneOptions{})
 		assert.NoError(t, err)
 
 		// components/examples should be removed by pruning (set to nil)
@@ -483,7 +534,7 @@ func TestPruneExamples(t *testing.T) {
 		assert.NoError(t, err)
 
 		// Prune the document
-		err = pruneSchema(&model.Model)
+		err = pruneSchema(&model.Model, pruneOptions{})
 		assert.NoError(t, err)
 
 		// components/examples should be removed (set to nil)
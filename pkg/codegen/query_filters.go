@@ -0,0 +1,128 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"strings"
+)
+
+// QueryFilterDefinition describes a deepObject query parameter with an object schema,
+// for which a fluent filter builder is generated.
+type QueryFilterDefinition struct {
+	// Name is the Go name of the builder, e.g. ListInvoicesFilterBuilder.
+	Name string
+
+	// Param is the query parameter holding the filter.
+	Param ParameterDefinition
+
+	// TypeDecl is the Go type of the filter value, without the pointer.
+	TypeDecl string
+
+	Fields []QueryFilterField
+}
+
+// QueryFilterField describes a property of a filter, set by a builder method.
+type QueryFilterField struct {
+	// Method is the name of the builder method setting the field.
+	Method string
+
+	Property Property
+
+	// ArgType is the type of the method argument, the item type for arrays.
+	ArgType string
+}
+
+// IsArray reports whether the field is an array, set from variadic values.
+func (f QueryFilterField) IsArray() bool {
+	return f.Property.Schema.ArrayType != nil && strings.HasPrefix(f.Property.Schema.TypeDecl(), "[]")
+}
+
+// reservedFilterBuilderMethods are the methods every filter builder has.
+var reservedFilterBuilderMethods = map[string]bool{
+	"Build":  true,
+	"Encode": true,
+}
+
+// setQueryFilters sets the QueryFilters of the operations with deepObject query parameters
+// whose schema is an object, following the named types it refers to.
+func setQueryFilters(operations []OperationDefinition, typeDefs []TypeDefinition) {
+	types := make(map[string]TypeDefinition, len(typeDefs))
+	for _, td := range typeDefs {
+		if _, found := types[td.Name]; !found {
+			types[td.Name] = td
+		}
+	}
+
+	for i, op := range operations {
+		if op.Query == nil {
+			continue
+		}
+		for _, param := range op.Query.Params {
+			if !strings.EqualFold(op.Query.Encoding[param.ParamName].Style, "deepObject") {
+				continue
+			}
+			props := findObjectProperties(param.Schema, types, map[string]bool{})
+			if len(props) == 0 {
+				continue
+			}
+
+			filter := QueryFilterDefinition{
+				Name:     op.ID + param.GoName() + "Builder",
+				Param:    param,
+				TypeDecl: strings.TrimPrefix(param.Schema.TypeDecl(), "*"),
+			}
+			for _, prop := range props {
+				method := prop.GoName
+				if reservedFilterBuilderMethods[method] {
+					method = "With" + method
+				}
+				argType := strings.TrimPrefix(prop.Schema.TypeDecl(), "*")
+				field := QueryFilterField{Method: method, Property: prop, ArgType: argType}
+				if field.IsArray() {
+					field.ArgType = prop.Schema.ArrayType.TypeDecl()
+				}
+				filter.Fields = append(filter.Fields, field)
+			}
+			operations[i].QueryFilters = append(operations[i].QueryFilters, filter)
+		}
+	}
+}
+
+// hasQueryFilters returns true if any of the operations has a filter query parameter.
+func hasQueryFilters(operations []OperationDefinition) bool {
+	for _, op := range operations {
+		if len(op.QueryFilters) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// findObjectProperties returns the properties of an object schema, following the named types it refers to.
+func findObjectProperties(s GoSchema, types map[string]TypeDefinition, visited map[string]bool) []Property {
+	if len(s.Properties) > 0 {
+		return s.Properties
+	}
+	if s.ArrayType != nil || len(s.UnionElements) > 0 {
+		return nil
+	}
+
+	name := s.RefType
+	if name == "" {
+		name = s.GoType
+	}
+	td, found := types[name]
+	if !found || visited[name] {
+		return nil
+	}
+	visited[name] = true
+	return findObjectProperties(td.Schema, types, visited)
+}
@@ -55,6 +55,18 @@ type GoSchema struct {
 	DefineViaAlias   bool
 	IsPrimitiveAlias bool
 	OpenAPISchema    *base.Schema
+
+	// Inlined is true for a component schema generated as an anonymous struct, see GenerateOptions.InlineThreshold.
+	Inlined bool
+
+	// DependentRequired are the properties required when another property is present, from dependentRequired.
+	DependentRequired []DependentRequired
+}
+
+// DependentRequired is the JSON name of a property of an object with the names of the properties required when it's present.
+type DependentRequired struct {
+	Property string
+	Required []string
 }
 
 func (s GoSchema) IsRef() bool {
@@ -68,6 +80,15 @@ func (s GoSchema) IsExternalRef() bool {
 	return strings.Contains(s.RefType, ".")
 }
 
+// IsExternalGoType returns true if the type is from another package and set with x-go-type, e.g. money.Money.
+// Like external refs, it's not validated.
+func (s GoSchema) IsExternalGoType() bool {
+	if s.IsRef() || !strings.Contains(s.GoType, ".") || s.OpenAPISchema == nil || s.OpenAPISchema.Extensions == nil {
+		return false
+	}
+	return s.OpenAPISchema.Extensions.Value(extPropGoType) != nil
+}
+
 func (s GoSchema) TypeDecl() string {
 	if s.IsRef() {
 		return s.RefType
@@ -121,8 +142,8 @@ func (s GoSchema) GetAdditionalTypeDefs() []TypeDefinition {
 // - Union types (has union elements)
 // - Types with validation constraints
 func (s GoSchema) NeedsValidation() bool {
-	// External refs don't need validation (they're from other packages)
-	if s.IsExternalRef() {
+	// External refs and types don't need validation (they're from other packages)
+	if s.IsExternalRef() || s.IsExternalGoType() {
 		return false
 	}
 
@@ -141,6 +162,11 @@ func (s GoSchema) NeedsValidation() bool {
 		return true
 	}
 
+	// multipleOf is checked by the Validate method
+	if s.Constraints.MultipleOf != nil && isNumericType(s.TypeDecl()) {
+		return true
+	}
+
 	// If it has union elements, it needs validation
 	if len(s.UnionElements) > 0 {
 		return true
@@ -148,9 +174,13 @@ func (s GoSchema) NeedsValidation() bool {
 
 	// If it has properties, check if any of them need validation
 	if len(s.Properties) > 0 {
+		// dependentRequired is checked by the Validate method
+		if len(s.DependentRequired) > 0 {
+			return true
+		}
 		for _, prop := range s.Properties {
 			// Property has validation tags
-			if len(prop.Constraints.ValidationTags) > 0 {
+			if len(prop.Constraints.ValidationTags) > 0 || prop.hasMultipleOf() {
 				return true
 			}
 			// Property needs custom validation (RefType, struct, union, etc.)
@@ -178,8 +208,8 @@ func (s GoSchema) NeedsValidation() bool {
 
 	// Check if it's an array with items that need validation
 	if s.ArrayType != nil {
-		// Check if the array has minItems/maxItems constraints
-		if s.Constraints.MinItems != nil || s.Constraints.MaxItems != nil {
+		// Check if the array has minItems/maxItems/uniqueItems constraints
+		if s.Constraints.MinItems != nil || s.Constraints.MaxItems != nil || s.Constraints.UniqueItems != nil {
 			return true
 		}
 		// Check if the array item type needs validation
@@ -410,9 +440,9 @@ func GenerateGoSchema(schemaProxy *base.SchemaProxy, options ParseOptions) (GoSc
 			// Check if we're in response context and the schema has writeOnly required fields.
 			// If so, we need to generate an inline type instead of using the component reference,
 			// because writeOnly fields should not be required in responses.
-			needsInlineType := false
+			needsInlineType := options.inlineRefs[ref]
 			if options.specLocation == SpecLocationResponse && schema != nil {
-				needsInlineType = hasWriteOnlyRequiredFields(schema)
+				needsInlineType = needsInlineType || hasWriteOnlyRequiredFields(schema)
 			}
 
 			if !needsInlineType {
@@ -426,6 +456,7 @@ func GenerateGoSchema(schemaProxy *base.SchemaProxy, options ParseOptions) (GoSc
 				constraints := newConstraints(schema, ConstraintsContext{
 					hasNilType:   slices.Contains(schema.Type, "null"),
 					specLocation: options.specLocation,
+					decimalType:  options.DecimalType,
 				})
 				return GoSchema{
 					GoType:           refType,
@@ -840,6 +871,7 @@ func enhanceSchema(src, other GoSchema, options ParseOptions) GoSchema {
 	src.Properties = append(src.Properties, other.Properties...)
 	src.Discriminator = other.Discriminator
 	src.UnionElements = other.UnionElements
+	src.DependentRequired = append(src.DependentRequired, other.DependentRequired...)
 	src.AdditionalTypes = append(src.AdditionalTypes, other.AdditionalTypes...)
 
 	srcFields := genFieldsFromProperties(src.Properties, options)
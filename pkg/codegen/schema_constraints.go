@@ -22,6 +22,7 @@ type ConstraintsContext struct {
 	hasNilType   bool
 	required     bool
 	specLocation SpecLocation
+	decimalType  DecimalType
 }
 
 type Constraints struct {
@@ -34,11 +35,17 @@ type Constraints struct {
 	Pattern        *string
 	Min            *float64
 	Max            *float64
+	MultipleOf     *float64
 	MinItems       *int64
 	MaxItems       *int64
+	UniqueItems    *bool
 	MinProperties  *int64
 	MaxProperties  *int64
 	ValidationTags []string
+
+	// ValidationMessage is the x-validation-message of the schema, replacing the default message
+	// of its minItems, maxItems, minProperties and maxProperties errors.
+	ValidationMessage string
 }
 
 func (c Constraints) IsEqual(other Constraints) bool {
@@ -51,11 +58,14 @@ func (c Constraints) IsEqual(other Constraints) bool {
 		ptrEqual(c.Pattern, other.Pattern) &&
 		ptrEqual(c.Min, other.Min) &&
 		ptrEqual(c.Max, other.Max) &&
+		ptrEqual(c.MultipleOf, other.MultipleOf) &&
 		ptrEqual(c.MinItems, other.MinItems) &&
 		ptrEqual(c.MaxItems, other.MaxItems) &&
+		ptrEqual(c.UniqueItems, other.UniqueItems) &&
 		ptrEqual(c.MinProperties, other.MinProperties) &&
 		ptrEqual(c.MaxProperties, other.MaxProperties) &&
-		slices.Equal(c.ValidationTags, other.ValidationTags)
+		slices.Equal(c.ValidationTags, other.ValidationTags) &&
+		c.ValidationMessage == other.ValidationMessage
 }
 
 // Count returns the number of validation constraints.
@@ -81,6 +91,9 @@ func (c Constraints) Count() int {
 	if c.Max != nil {
 		count++
 	}
+	if c.MultipleOf != nil {
+		count++
+	}
 
 	// Array constraints
 	if c.MinItems != nil {
@@ -89,6 +102,9 @@ func (c Constraints) Count() int {
 	if c.MaxItems != nil {
 		count++
 	}
+	if c.UniqueItems != nil {
+		count++
+	}
 
 	// Object constraints
 	if c.MinProperties != nil {
@@ -115,10 +131,14 @@ func newConstraints(schema *base.Schema, opts ConstraintsContext) Constraints {
 	isBoolean := slices.Contains(schema.Type, "boolean")
 	isString := slices.Contains(schema.Type, "string")
 
+	// Decimals generated as decimal types are validated as numbers, whether they are strings or numbers in JSON.
+	isDecimal := schema.Format == "decimal" && (isString || isFloat) &&
+		(opts.decimalType == DecimalTypeShopspring || opts.decimalType == DecimalTypeBigRat)
+
 	// Check if the string format converts to a non-string Go type.
 	// These formats do not support minLength/maxLength validation tags because
 	// the Go type is not a string (e.g., time.Time, uuid.UUID).
-	hasNonStringFormat := isString && (schema.Format == "date-time" || schema.Format == "date" || schema.Format == "uuid")
+	hasNonStringFormat := isString && (schema.Format == "date-time" || schema.Format == "date" || schema.Format == "uuid" || isDecimal)
 	isArray := slices.Contains(schema.Type, "array")
 	isObject := schema.Type == nil || slices.Contains(schema.Type, "object")
 	var validationTags []string
@@ -197,7 +217,7 @@ func newConstraints(schema *base.Schema, opts ConstraintsContext) Constraints {
 	var minValue *float64
 	// Only store minimum for numeric types (integer/number)
 	// For strings, minimum is invalid per OpenAPI spec - ignore it completely
-	if schema.Minimum != nil && (isInt || isFloat) {
+	if schema.Minimum != nil && (isInt || isFloat || isDecimal) {
 		minTag := "gte"
 		val := *schema.Minimum
 		if schema.ExclusiveMinimum != nil && ((schema.ExclusiveMinimum.IsA() && schema.ExclusiveMinimum.A) || schema.ExclusiveMinimum.IsB()) {
@@ -218,7 +238,7 @@ func newConstraints(schema *base.Schema, opts ConstraintsContext) Constraints {
 	var maxValue *float64
 	// Only store maximum for numeric types (integer/number)
 	// For strings, maximum is invalid per OpenAPI spec - ignore it completely
-	if schema.Maximum != nil && (isInt || isFloat) {
+	if schema.Maximum != nil && (isInt || isFloat || isDecimal) {
 		maxTag := "lte"
 		val := *schema.Maximum
 		if schema.ExclusiveMaximum != nil && ((schema.ExclusiveMaximum.IsA() && schema.ExclusiveMaximum.A) || schema.ExclusiveMaximum.IsB()) {
@@ -236,6 +256,13 @@ func newConstraints(schema *base.Schema, opts ConstraintsContext) Constraints {
 		validationTags = append(validationTags, tag)
 	}
 
+	var multipleOf *float64
+	// Only store multipleOf for numeric types, it's checked by the generated Validate methods
+	// as go-playground/validator has no tag for it.
+	if schema.MultipleOf != nil && *schema.MultipleOf > 0 && (isInt || isFloat) {
+		multipleOf = schema.MultipleOf
+	}
+
 	var minLength *int64
 	// Only store minLength for strings and arrays
 	// For integers/numbers/booleans, minLength is invalid per OpenAPI spec - ignore it completely
@@ -267,6 +294,11 @@ func newConstraints(schema *base.Schema, opts ConstraintsContext) Constraints {
 		maxItems = schema.MaxItems
 	}
 
+	var uniqueItems *bool
+	if isArray && deref(schema.UniqueItems) {
+		uniqueItems = ptr(true)
+	}
+
 	var minProperties *int64
 	if schema.MinProperties != nil {
 		minProperties = schema.MinProperties
@@ -277,6 +309,11 @@ func newConstraints(schema *base.Schema, opts ConstraintsContext) Constraints {
 		maxProperties = schema.MaxProperties
 	}
 
+	var validationMessage string
+	if ext, ok := extractExtensions(schema.Extensions)[extValidationMessage]; ok {
+		validationMessage, _ = parseString(ext)
+	}
+
 	if len(validationTags) == 1 && validationTags[0] == "omitempty" {
 		validationTags = nil
 	}
@@ -321,13 +358,17 @@ func newConstraints(schema *base.Schema, opts ConstraintsContext) Constraints {
 		WriteOnly:      writeOnly,
 		Min:            minValue,
 		Max:            maxValue,
+		MultipleOf:     multipleOf,
 		MinLength:      minLength,
 		MaxLength:      maxLength,
 		Pattern:        pattern,
 		MinItems:       minItems,
 		MaxItems:       maxItems,
+		UniqueItems:    uniqueItems,
 		MinProperties:  minProperties,
 		MaxProperties:  maxProperties,
 		ValidationTags: validationTags,
+
+		ValidationMessage: validationMessage,
 	}
 }
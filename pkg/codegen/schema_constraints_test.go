@@ -51,6 +51,23 @@ func TestNewConstraints(t *testing.T) {
 		}, res)
 	})
 
+	t.Run("multipleOf", func(t *testing.T) {
+		multipleOf := 0.5
+		res := newConstraints(&base.Schema{Type: []string{"number"}, MultipleOf: &multipleOf}, ConstraintsContext{required: true})
+		assert.Equal(t, Constraints{
+			Required:       ptr(true),
+			MultipleOf:     &multipleOf,
+			ValidationTags: []string{"required"},
+		}, res)
+
+		// multipleOf is ignored for non-numeric types and when not positive
+		res = newConstraints(&base.Schema{Type: []string{"string"}, MultipleOf: &multipleOf}, ConstraintsContext{required: true})
+		assert.Nil(t, res.MultipleOf)
+		zero := 0.0
+		res = newConstraints(&base.Schema{Type: []string{"integer"}, MultipleOf: &zero}, ConstraintsContext{required: true})
+		assert.Nil(t, res.MultipleOf)
+	})
+
 	t.Run("number constraints", func(t *testing.T) {
 		minValue := float64(10)
 		maxValue := float64(100)
@@ -28,6 +28,8 @@ import (
 // PrefixTypeName determines if the enum value is prefixed with its TypeName.
 // Values contains the final constant names mapped to their values (computed in filterOutEnums).
 // SpecLocation indicates where in the OpenAPI spec this enum was defined.
+// Open indicates that no constants are generated and any value is valid, see EnumStyleOpen.
+// Strict indicates that unknown values are rejected when unmarshaling, see EnumStyleStrict.
 type EnumDefinition struct {
 	Name           string
 	ValueWrapper   string
@@ -35,6 +37,8 @@ type EnumDefinition struct {
 	Schema         GoSchema
 	Values         []EnumValue
 	SpecLocation   SpecLocation
+	Open           bool
+	Strict         bool
 }
 
 // EnumValue represents a single enum constant.
@@ -251,6 +255,8 @@ func filterOutEnums(types []TypeDefinition, options ParseOptions) ([]EnumDefinit
 				ValueWrapper:   wrapper,
 				PrefixTypeName: options.AlwaysPrefixEnumValues,
 				SpecLocation:   td.SpecLocation,
+				Open:           options.OpenEnums,
+				Strict:         options.StrictEnums,
 			})
 			m[name] = 1
 		}
@@ -275,6 +281,8 @@ func filterOutEnums(types []TypeDefinition, options ParseOptions) ([]EnumDefinit
 					ValueWrapper:   wrapper,
 					PrefixTypeName: options.AlwaysPrefixEnumValues,
 					SpecLocation:   td.SpecLocation,
+					Open:           options.OpenEnums,
+					Strict:         options.StrictEnums,
 				})
 			} else {
 				rest = append(rest, td)
@@ -293,6 +301,19 @@ func filterOutEnums(types []TypeDefinition, options ParseOptions) ([]EnumDefinit
 	for i := range enums {
 		e := enums[i]
 
+		// Open enums have no constants, only the values are needed.
+		if e.Open {
+			values := make([]EnumValue, 0, len(e.Schema.EnumValues))
+			for _, v := range e.Schema.EnumValues {
+				values = append(values, EnumValue{Value: v})
+			}
+			slices.SortFunc(values, func(a, b EnumValue) int {
+				return strings.Compare(a.Value, b.Value)
+			})
+			enums[i].Values = values
+			continue
+		}
+
 		// Compute final Values.
 		values := make([]EnumValue, 0, len(e.Schema.EnumValues))
 		for k, v := range e.Schema.EnumValues {
@@ -365,6 +386,14 @@ var nonConstantTypes = map[string]bool{
 	"time.Time":    true, // requires runtime initialization
 	"runtime.File": true, // struct type for binary file uploads
 	"uuid.UUID":    true, // [16]byte array type
+
+	"decimal.Decimal":       true, // struct types for format: decimal
+	"runtime.Decimal":       true,
+	"runtime.DecimalString": true,
+
+	"runtime.DurationSeconds": true, // struct types for x-go-type-duration
+	"runtime.DurationMillis":  true,
+	"runtime.DurationISO8601": true,
 }
 
 // isComparableType checks if a Go type can be used as a constant or map key
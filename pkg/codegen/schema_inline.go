@@ -0,0 +1,246 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"slices"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+)
+
+// schemaRefCounter counts the references to the component schemas, and those of them made by object properties.
+type schemaRefCounter struct {
+	refs         map[string]int
+	propertyRefs map[string]int
+}
+
+// inlinedComponentSchemas returns the refs of the component schemas generated as an anonymous struct
+// in the struct referencing them instead of as a named type: objects with fewer than threshold properties,
+// all of primitive types, referenced once, by a property of another object.
+// It returns nil if threshold isn't positive.
+func inlinedComponentSchemas(model *v3high.Document, threshold int) map[string]bool {
+	if threshold <= 0 || model == nil || model.Components == nil || model.Components.Schemas == nil {
+		return nil
+	}
+
+	c := &schemaRefCounter{refs: map[string]int{}, propertyRefs: map[string]int{}}
+	c.document(model)
+
+	res := map[string]bool{}
+	for name, proxy := range model.Components.Schemas.FromOldest() {
+		ref := "#/components/schemas/" + name
+		if c.refs[ref] != 1 || c.propertyRefs[ref] != 1 || proxy == nil || proxy.IsReference() {
+			continue
+		}
+		if isInlinableSchema(proxy.Schema(), threshold) {
+			res[ref] = true
+		}
+	}
+	return res
+}
+
+// isInlinableSchema returns true if the schema is a plain object of fewer than threshold primitive properties,
+// whose fields are validated with their validation tags only.
+func isInlinableSchema(schema *base.Schema, threshold int) bool {
+	if schema == nil || !slices.Equal(schema.Type, []string{"object"}) ||
+		schema.Properties == nil || schema.Properties.Len() == 0 || schema.Properties.Len() >= threshold ||
+		schema.AllOf != nil || schema.AnyOf != nil || schema.OneOf != nil || schema.Not != nil ||
+		schema.AdditionalProperties != nil || schema.Discriminator != nil || len(schema.Enum) > 0 ||
+		(schema.Extensions != nil && schema.Extensions.Len() > 0) {
+		return false
+	}
+
+	for _, prop := range schema.Properties.FromOldest() {
+		if prop == nil || prop.IsReference() {
+			return false
+		}
+		p := prop.Schema()
+		// multipleOf has no validation tag, it's checked by the Validate methods of named types
+		if p == nil || len(p.Enum) > 0 || p.MultipleOf != nil || (p.Extensions != nil && p.Extensions.Len() > 0) {
+			return false
+		}
+		types := slices.DeleteFunc(slices.Clone(p.Type), func(t string) bool { return t == "null" })
+		if len(types) != 1 || !slices.Contains([]string{"string", "integer", "number", "boolean"}, types[0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// document counts the references of the paths, webhooks and components of the document.
+func (c *schemaRefCounter) document(model *v3high.Document) {
+	pathItems := func(items []*v3high.PathItem) {
+		for _, item := range items {
+			if item == nil {
+				continue
+			}
+			for _, param := range item.Parameters {
+				c.parameter(param)
+			}
+			for _, op := range item.GetOperations().FromOldest() {
+				c.operation(op)
+			}
+		}
+	}
+
+	if model.Paths != nil && model.Paths.PathItems != nil {
+		var items []*v3high.PathItem
+		for _, item := range model.Paths.PathItems.FromOldest() {
+			items = append(items, item)
+			for _, op := range item.GetOperations().FromOldest() {
+				if op.Callbacks == nil {
+					continue
+				}
+				for _, callback := range op.Callbacks.FromOldest() {
+					if callback == nil || callback.Expression == nil {
+						continue
+					}
+					for _, cbItem := range callback.Expression.FromOldest() {
+						items = append(items, cbItem)
+					}
+				}
+			}
+		}
+		pathItems(items)
+	}
+	if model.Webhooks != nil {
+		var items []*v3high.PathItem
+		for _, item := range model.Webhooks.FromOldest() {
+			items = append(items, item)
+		}
+		pathItems(items)
+	}
+
+	components := model.Components
+	for _, proxy := range components.Schemas.FromOldest() {
+		c.proxy(proxy, false)
+	}
+	if components.Parameters != nil {
+		for _, param := range components.Parameters.FromOldest() {
+			c.parameter(param)
+		}
+	}
+	if components.RequestBodies != nil {
+		for _, body := range components.RequestBodies.FromOldest() {
+			c.requestBody(body)
+		}
+	}
+	if components.Responses != nil {
+		for _, resp := range components.Responses.FromOldest() {
+			c.response(resp)
+		}
+	}
+	if components.Headers != nil {
+		for _, header := range components.Headers.FromOldest() {
+			c.header(header)
+		}
+	}
+}
+
+func (c *schemaRefCounter) operation(op *v3high.Operation) {
+	if op == nil {
+		return
+	}
+	for _, param := range op.Parameters {
+		c.parameter(param)
+	}
+	c.requestBody(op.RequestBody)
+	if op.Responses != nil {
+		c.response(op.Responses.Default)
+		for _, resp := range op.Responses.Codes.FromOldest() {
+			c.response(resp)
+		}
+	}
+}
+
+func (c *schemaRefCounter) parameter(param *v3high.Parameter) {
+	if param == nil || param.GoLow().IsReference() {
+		return
+	}
+	c.proxy(param.Schema, false)
+	c.content(param.Content)
+}
+
+func (c *schemaRefCounter) requestBody(body *v3high.RequestBody) {
+	if body == nil || body.GoLow().IsReference() {
+		return
+	}
+	c.content(body.Content)
+}
+
+func (c *schemaRefCounter) response(resp *v3high.Response) {
+	if resp == nil || resp.GoLow().IsReference() {
+		return
+	}
+	c.content(resp.Content)
+	if resp.Headers != nil {
+		for _, header := range resp.Headers.FromOldest() {
+			c.header(header)
+		}
+	}
+}
+
+func (c *schemaRefCounter) header(header *v3high.Header) {
+	if header == nil || header.GoLow().IsReference() {
+		return
+	}
+	c.proxy(header.Schema, false)
+	c.content(header.Content)
+}
+
+func (c *schemaRefCounter) content(content *orderedmap.Map[string, *v3high.MediaType]) {
+	if content == nil {
+		return
+	}
+	for _, mediaType := range content.FromOldest() {
+		if mediaType != nil {
+			c.proxy(mediaType.Schema, false)
+		}
+	}
+}
+
+// proxy counts the reference of the schema proxy, or the references of its schema if it's inline.
+func (c *schemaRefCounter) proxy(proxy *base.SchemaProxy, isProperty bool) {
+	if proxy == nil {
+		return
+	}
+	if ref := proxy.GoLow().GetReference(); ref != "" {
+		c.refs[ref]++
+		if isProperty {
+			c.propertyRefs[ref]++
+		}
+		return
+	}
+
+	schema := proxy.Schema()
+	if schema == nil {
+		return
+	}
+	if schema.Properties != nil {
+		for _, prop := range schema.Properties.FromOldest() {
+			c.proxy(prop, true)
+		}
+	}
+	if schema.Items != nil && schema.Items.IsA() {
+		c.proxy(schema.Items.A, false)
+	}
+	if schema.AdditionalProperties != nil && schema.AdditionalProperties.IsA() {
+		c.proxy(schema.AdditionalProperties.A, false)
+	}
+	for _, group := range [][]*base.SchemaProxy{schema.AllOf, schema.AnyOf, schema.OneOf, schema.PrefixItems} {
+		for _, sp := range group {
+			c.proxy(sp, false)
+		}
+	}
+	c.proxy(schema.Not, false)
+}
@@ -80,6 +80,7 @@ func createFromCombinator(schema *base.Schema, options ParseOptions) (GoSchema,
 		}
 
 		out.Properties = append(out.Properties, allOfSchema.Properties...)
+		out.DependentRequired = append(out.DependentRequired, allOfSchema.DependentRequired...)
 		additionalTypes = append(additionalTypes, allOfSchema.AdditionalTypes...)
 	}
 
@@ -570,6 +571,9 @@ func mergeOpenapiSchemas(s1, s2 *base.Schema) (*base.Schema, error) {
 	// Required. We merge these.
 	result.Required = append(s1.Required, s2.Required...)
 
+	// DependentRequired. We merge the required properties of each property.
+	result.DependentRequired = mergeDependentRequired(s1.DependentRequired, s2.DependentRequired)
+
 	// We merge all properties
 	for k, v := range s1.Properties.FromOldest() {
 		if result.Properties == nil {
@@ -743,3 +747,28 @@ func isResolvedUnion(schema GoSchema) bool {
 	}
 	return nonNullCount > 1
 }
+
+// mergeDependentRequired merges the dependentRequired constraints of two schemas,
+// the properties of both being required when a property is present.
+func mergeDependentRequired(a, b *orderedmap.Map[string, []string]) *orderedmap.Map[string, []string] {
+	if a == nil || a.Len() == 0 {
+		return b
+	}
+	if b == nil || b.Len() == 0 {
+		return a
+	}
+
+	res := orderedmap.New[string, []string]()
+	for _, m := range []*orderedmap.Map[string, []string]{a, b} {
+		for name, required := range m.FromOldest() {
+			merged, _ := res.Get(name)
+			for _, r := range required {
+				if !slices.Contains(merged, r) {
+					merged = append(merged, r)
+				}
+			}
+			res.Set(name, merged)
+		}
+	}
+	return res
+}
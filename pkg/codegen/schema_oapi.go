@@ -39,6 +39,16 @@ var goPrimitiveTypes = map[string]bool{
 	"bool":      true,
 	"time.Time": true,
 	"struct{}":  true, // Empty struct - used for empty schemas
+
+	// Decimal types of format: decimal, validated like numbers
+	"decimal.Decimal":       true,
+	"runtime.Decimal":       true,
+	"runtime.DecimalString": true,
+
+	// Duration types of x-go-type-duration, validated like the numbers or strings they are marshaled to
+	"runtime.DurationSeconds": true,
+	"runtime.DurationMillis":  true,
+	"runtime.DurationISO8601": true,
 }
 
 // isPrimitiveType returns true if the given type string is a Go primitive type.
@@ -46,6 +56,20 @@ func isPrimitiveType(typeDef string) bool {
 	return goPrimitiveTypes[typeDef]
 }
 
+// isNumericType returns true if the given type string is a Go integer or float type.
+func isNumericType(typeDef string) bool {
+	return isIntegerType(typeDef) || typeDef == "float32" || typeDef == "float64"
+}
+
+// isIntegerType returns true if the given type string is a Go integer type.
+func isIntegerType(typeDef string) bool {
+	switch typeDef {
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+		return true
+	}
+	return false
+}
+
 // oapiSchemaToGoType converts an OpenApi schema into a Go type definition for
 // all non-object types.
 func oapiSchemaToGoType(schema *base.Schema, options ParseOptions) (GoSchema, error) {
@@ -57,6 +81,7 @@ func oapiSchemaToGoType(schema *base.Schema, options ParseOptions) (GoSchema, er
 	constraints := newConstraints(schema, ConstraintsContext{
 		hasNilType:   slices.Contains(t, "null"),
 		specLocation: options.specLocation,
+		decimalType:  options.DecimalType,
 	})
 
 	// Handle multi-type schemas (union types like ["string", "number"]).
@@ -186,35 +211,28 @@ func oapiSchemaToGoType(schema *base.Schema, options ParseOptions) (GoSchema, er
 		}, nil
 	}
 
+	if extension, ok := extractExtensions(schema.Extensions)[extPropGoTypeDuration]; ok {
+		goType, err := durationGoType(extension, t)
+		if err != nil {
+			return GoSchema{}, err
+		}
+		return GoSchema{
+			GoType:         goType,
+			DefineViaAlias: true,
+			Description:    schema.Description,
+			OpenAPISchema:  schema,
+			Constraints:    constraints,
+		}, nil
+	}
+
 	goType := options.DefaultIntType
 	if goType == "" {
 		goType = "int"
 	}
 
 	if slices.Contains(t, "integer") {
-		switch f {
-		case "int64":
-			goType = "int64"
-		case "int32":
-			goType = "int32"
-		case "int16":
-			goType = "int16"
-		case "int8":
-			goType = "int8"
-		case "uint64":
-			goType = "uint64"
-		case "uint32":
-			goType = "uint32"
-		case "uint16":
-			goType = "uint16"
-		case "uint8":
-			goType = "uint8"
-		case "uint":
-			goType = "uint"
-		}
-
 		return GoSchema{
-			GoType:         goType,
+			GoType:         integerGoType(f, options),
 			DefineViaAlias: true,
 			Description:    schema.Description,
 			OpenAPISchema:  schema,
@@ -233,8 +251,8 @@ func oapiSchemaToGoType(schema *base.Schema, options ParseOptions) (GoSchema, er
 			goType = "float32"
 		case "decimal":
 			// Non-standard format used by some specs to indicate arbitrary precision decimal
-			// Treat as float64 for compatibility
-			goType = "float64"
+			// Treat as float64 for compatibility, unless a decimal type is configured
+			goType = decimalGoType(options.DecimalType, false)
 		case "integer", "int":
 			// Treat type: number, format: integer or format: int as integer type
 			// format: int is non-standard but used by some specs
@@ -299,6 +317,8 @@ func oapiSchemaToGoType(schema *base.Schema, options ParseOptions) (GoSchema, er
 			skipOptionalPointer = true
 		case "binary":
 			goType = "runtime.File"
+		case "decimal":
+			goType = decimalGoType(options.DecimalType, true)
 		case "uuid":
 			// Only use uuid.UUID for standard UUID lengths (32 or 36 chars)
 			// Non-standard lengths should use string to avoid unmarshal errors
@@ -369,3 +389,78 @@ func isStandardUUIDLength(schema *base.Schema) bool {
 
 	return true
 }
+
+// decimalGoType returns the Go type of a `format: decimal` string or number for the DecimalType option.
+func decimalGoType(kind DecimalType, isString bool) string {
+	switch kind {
+	case DecimalTypeShopspring:
+		return "decimal.Decimal"
+	case DecimalTypeBigRat:
+		if isString {
+			return "runtime.DecimalString"
+		}
+		return "runtime.Decimal"
+	default:
+		if isString {
+			return "string"
+		}
+		return "float64"
+	}
+}
+
+// isDecimalType returns true if the given type string is the Go type of a decimal type.
+func isDecimalType(typeDef string) bool {
+	switch typeDef {
+	case "decimal.Decimal", "runtime.Decimal", "runtime.DecimalString":
+		return true
+	}
+	return false
+}
+
+// durationGoType returns the Go type of a schema of the given types with the x-go-type-duration extension:
+// runtime.DurationSeconds and runtime.DurationMillis for integers and numbers with the seconds and ms units,
+// runtime.DurationISO8601 for strings with the iso8601 unit.
+func durationGoType(extension any, types []string) (string, error) {
+	unit, err := parseString(extension)
+	if err != nil {
+		return "", fmt.Errorf("invalid value for %q: %w", extPropGoTypeDuration, err)
+	}
+
+	isNumber := slices.Contains(types, "integer") || slices.Contains(types, "number")
+	switch unit {
+	case "seconds", "s":
+		if isNumber {
+			return "runtime.DurationSeconds", nil
+		}
+	case "ms", "milliseconds":
+		if isNumber {
+			return "runtime.DurationMillis", nil
+		}
+	case "iso8601":
+		if slices.Contains(types, "string") {
+			return "runtime.DurationISO8601", nil
+		}
+	default:
+		return "", fmt.Errorf("invalid value for %q: unsupported unit %q, expected seconds, ms or iso8601", extPropGoTypeDuration, unit)
+	}
+	return "", fmt.Errorf("invalid value for %q: unit %q doesn't apply to type %v", extPropGoTypeDuration, unit, types)
+}
+
+// integerGoType returns the Go type of an integer with the given format.
+// IntTypeByFormat is looked up first, then the built-in formats,
+// and integers without a known format fall back to DefaultIntType.
+func integerGoType(format string, options ParseOptions) string {
+	if goType, ok := options.IntTypeByFormat[format]; ok && format != "" {
+		return goType
+	}
+
+	switch format {
+	case "int64", "int32", "int16", "int8", "uint64", "uint32", "uint16", "uint8", "uint":
+		return format
+	}
+
+	if options.DefaultIntType != "" {
+		return options.DefaultIntType
+	}
+	return "int"
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntegerGoType(t *testing.T) {
+	overrides := map[string]string{
+		"timestamp": "int64",
+		"int32":     "int",
+	}
+
+	tests := []struct {
+		name     string
+		format   string
+		options  ParseOptions
+		expected string
+	}{
+		{name: "no format", format: "", expected: "int"},
+		{name: "no format with default", format: "", options: ParseOptions{DefaultIntType: "int64"}, expected: "int64"},
+		{name: "int64", format: "int64", options: ParseOptions{DefaultIntType: "int32"}, expected: "int64"},
+		{name: "int32", format: "int32", options: ParseOptions{DefaultIntType: "int64"}, expected: "int32"},
+		{name: "int16", format: "int16", expected: "int16"},
+		{name: "int8", format: "int8", expected: "int8"},
+		{name: "uint64", format: "uint64", expected: "uint64"},
+		{name: "uint32", format: "uint32", expected: "uint32"},
+		{name: "uint16", format: "uint16", expected: "uint16"},
+		{name: "uint8", format: "uint8", expected: "uint8"},
+		{name: "uint", format: "uint", expected: "uint"},
+		{name: "unknown format", format: "timestamp", options: ParseOptions{DefaultIntType: "int32"}, expected: "int32"},
+		{name: "mapped format", format: "timestamp", options: ParseOptions{IntTypeByFormat: overrides}, expected: "int64"},
+		{name: "mapped built-in format", format: "int32", options: ParseOptions{IntTypeByFormat: overrides}, expected: "int"},
+		{name: "unmapped format", format: "epoch", options: ParseOptions{DefaultIntType: "int64", IntTypeByFormat: overrides}, expected: "int64"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, integerGoType(tc.format, tc.options))
+		})
+	}
+}
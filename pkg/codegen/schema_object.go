@@ -11,12 +11,14 @@
 package codegen
 
 import (
+	"cmp"
 	"fmt"
 	"slices"
 	"strings"
 
 	"github.com/yorunikakeru4/oapi-codegen-dd/v3/pkg/runtime"
 	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"github.com/pb33f/libopenapi/orderedmap"
 )
 
 func createObjectSchema(schema *base.Schema, options ParseOptions) (GoSchema, error) {
@@ -55,12 +57,22 @@ func createObjectSchema(schema *base.Schema, options ParseOptions) (GoSchema, er
 		t := schema.Type
 		// If the object has no properties or additional properties, we
 		// have some special cases for its type.
-		if slices.Contains(t, "object") {
-			// We have an object with no properties. This is a generic object
-			// expressed as a map.
-			outType = "map[string]any"
+		if slices.Contains(t, "object") && schemaForbidsAdditionalProperties(schema) {
+			// additionalProperties: false without properties is an explicitly empty object.
+			outType = "struct{}"
+			outSchema.GoType = outType
+			outSchema.DefineViaAlias = false
+		} else if slices.Contains(t, "object") {
+			// We have an object with no properties. This is a free-form object,
+			// expressed as configured by FreeFormObjectType.
+			outType = freeFormObjectGoType(options.FreeFormObjectType)
 			outSchema.GoType = outType
 			outSchema.DefineViaAlias = true
+			if outType != "map[string]any" {
+				// json.RawMessage and any are already nillable and have nothing to validate
+				outSchema.SkipOptionalPointer = true
+				outSchema.IsPrimitiveAlias = true
+			}
 		} else { // t == ""
 			// If we don't even have the object designator, we have an empty schema.
 			// Use struct{} instead of any so we can define methods on it.
@@ -126,12 +138,13 @@ func createObjectSchema(schema *base.Schema, options ParseOptions) (GoSchema, er
 
 				hasNilTyp := false
 				if p.Schema() != nil {
-					hasNilTyp = slices.Contains(p.Schema().Type, "null")
+					hasNilTyp = slices.Contains(p.Schema().Type, "null") || isNullableUnion(p.Schema())
 				}
 				constraints := newConstraints(p.Schema(), ConstraintsContext{
 					hasNilType:   hasNilTyp,
 					required:     slices.Contains(required, pName),
 					specLocation: options.specLocation,
+					decimalType:  options.DecimalType,
 				})
 				pSchema.Constraints = constraints
 
@@ -183,7 +196,11 @@ func createObjectSchema(schema *base.Schema, options ParseOptions) (GoSchema, er
 					}
 				}
 
-				pSchema, _ = replaceInlineTypes(pSchema, opts)
+				if options.inlineRefs[pRef] {
+					pSchema.Inlined = true
+				} else {
+					pSchema, _ = replaceInlineTypes(pSchema, opts)
+				}
 
 				// Generate the Go field name and handle conflicts
 				baseGoName := createPropertyGoFieldName(pName, extensions)
@@ -214,6 +231,8 @@ func createObjectSchema(schema *base.Schema, options ParseOptions) (GoSchema, er
 					Constraints:   constraints,
 					SensitiveData: sensitiveData,
 					ParentType:    parentType,
+
+					GenericOptional: options.OptionalType == OptionalTypeGeneric,
 				}
 				outSchema.Properties = append(outSchema.Properties, prop)
 				if len(pSchema.AdditionalTypes) > 0 {
@@ -222,6 +241,14 @@ func createObjectSchema(schema *base.Schema, options ParseOptions) (GoSchema, er
 			}
 		}
 
+		if err := sortPropertiesByOrder(outSchema.Properties); err != nil {
+			return GoSchema{}, err
+		}
+
+		if schema != nil && schema.DependentRequired != nil {
+			outSchema.DependentRequired = newDependentRequired(schema.DependentRequired)
+		}
+
 		fields := genFieldsFromProperties(outSchema.Properties, options)
 		outSchema.GoType = outSchema.createGoStruct(fields)
 
@@ -253,6 +280,41 @@ func createObjectSchema(schema *base.Schema, options ParseOptions) (GoSchema, er
 	return outSchema, nil
 }
 
+// sortPropertiesByOrder sorts the properties with the x-order extension first, by ascending order,
+// the other ones keeping their spec order after them. Properties with the same order keep their spec order too.
+func sortPropertiesByOrder(props []Property) error {
+	orders := make(map[string]int, len(props))
+	for _, p := range props {
+		value, ok := p.Extensions[extOrder]
+		if !ok {
+			continue
+		}
+		order, err := parseOrder(value)
+		if err != nil {
+			return fmt.Errorf("invalid value for %q of property '%s': %w", extOrder, p.JsonFieldName, err)
+		}
+		orders[p.JsonFieldName] = order
+	}
+	if len(orders) == 0 {
+		return nil
+	}
+
+	slices.SortStableFunc(props, func(a, b Property) int {
+		orderA, okA := orders[a.JsonFieldName]
+		orderB, okB := orders[b.JsonFieldName]
+		switch {
+		case okA && okB:
+			return cmp.Compare(orderA, orderB)
+		case okA:
+			return -1
+		case okB:
+			return 1
+		}
+		return 0
+	})
+	return nil
+}
+
 func enhanceSchemaWithAdditionalProperties(out GoSchema, schema *base.Schema, options ParseOptions) (GoSchema, error) {
 	if schema == nil {
 		return out, nil
@@ -347,3 +409,38 @@ func enhanceSchemaWithAdditionalProperties(out GoSchema, schema *base.Schema, op
 
 	return out, nil
 }
+
+// schemaForbidsAdditionalProperties reports whether the schema sets additionalProperties: false.
+func schemaForbidsAdditionalProperties(schema *base.Schema) bool {
+	return schema.AdditionalProperties != nil && schema.AdditionalProperties.IsB() && !schema.AdditionalProperties.B
+}
+
+// freeFormObjectGoType returns the Go type of a free-form object for the FreeFormObjectType option.
+func freeFormObjectGoType(kind FreeFormObjectType) string {
+	switch kind {
+	case FreeFormObjectRawMessage:
+		return "json.RawMessage"
+	case FreeFormObjectAny:
+		return "any"
+	default:
+		return "map[string]any"
+	}
+}
+
+// newDependentRequired returns the dependentRequired constraints of an object. Names can be properties
+// of another allOf element merged into the object, so they're resolved when generating the Validate method.
+func newDependentRequired(dependentRequired *orderedmap.Map[string, []string]) []DependentRequired {
+	var res []DependentRequired
+	for name, required := range dependentRequired.FromOldest() {
+		dep := DependentRequired{Property: name}
+		for _, r := range required {
+			if r != name && !slices.Contains(dep.Required, r) {
+				dep.Required = append(dep.Required, r)
+			}
+		}
+		if len(dep.Required) > 0 {
+			res = append(res, dep)
+		}
+	}
+	return res
+}
@@ -12,7 +12,9 @@ package codegen
 
 import (
 	"fmt"
+	"maps"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/yorunikakeru4/oapi-codegen-dd/v3/pkg/runtime"
@@ -28,6 +30,13 @@ type Property struct {
 	Constraints   Constraints
 	SensitiveData *runtime.SensitiveDataConfig
 	ParentType    string // Name of the parent type (for detecting recursive references)
+
+	// GenericOptional generates runtime.Optional[T] instead of a pointer when the field is optional.
+	GenericOptional bool
+
+	// ForceOptional generates runtime.Optional[T] for slices and maps as well, e.g. in merge patch types
+	// where null and an empty slice have different meanings. Requires GenericOptional.
+	ForceOptional bool
 }
 
 func (p Property) IsEqual(other Property) bool {
@@ -69,25 +78,51 @@ func (p Property) IsFile() bool {
 func (p Property) GoTypeDef() string {
 	typeDef := p.Schema.TypeDecl()
 
+	if p.IsOptionalType() {
+		return "runtime.Optional[" + strings.TrimPrefix(typeDef, "*") + "]"
+	}
 	if p.IsPointerType() {
 		typeDef = "*" + strings.TrimPrefix(typeDef, "*")
 	}
 	return typeDef
 }
 
+// hasMultipleOf returns true if this property is a number, or an alias of one, with a multipleOf constraint,
+// checked by the Validate method of its parent.
+func (p Property) hasMultipleOf() bool {
+	return p.Constraints.MultipleOf != nil && (p.Schema.IsPrimitiveAlias || isNumericType(strings.TrimPrefix(p.Schema.TypeDecl(), "*")))
+}
+
 // IsPointerType returns true if this property's Go type is a pointer.
 func (p Property) IsPointerType() bool {
-	typeDef := p.Schema.TypeDecl()
-
 	// Check for recursive references FIRST: if this property's type is the same as its parent type,
 	// it MUST be a pointer to avoid infinite size structs, even if it has additional properties
-	if p.ParentType != "" {
-		// Check both RefType and GoType for matches
-		if (p.Schema.RefType != "" && p.Schema.RefType == p.ParentType) ||
-			(p.Schema.GoType != "" && p.Schema.GoType == p.ParentType) {
-			return true
-		}
+	if p.isRecursiveRef() {
+		return true
+	}
+
+	return p.isOptional() && !p.GenericOptional
+}
+
+// IsOptionalType returns true if this property's Go type is runtime.Optional[T].
+func (p Property) IsOptionalType() bool {
+	return p.GenericOptional && !p.isRecursiveRef() && (p.ForceOptional || p.isOptional())
+}
+
+// isRecursiveRef returns true if this property's type is the same as its parent type.
+func (p Property) isRecursiveRef() bool {
+	if p.ParentType == "" {
+		return false
 	}
+	// Check both RefType and GoType for matches
+	return (p.Schema.RefType != "" && p.Schema.RefType == p.ParentType) ||
+		(p.Schema.GoType != "" && p.Schema.GoType == p.ParentType)
+}
+
+// isOptional returns true if this property is optional and its type can't represent
+// an absent value by itself, unlike slices and maps.
+func (p Property) isOptional() bool {
+	typeDef := p.Schema.TypeDecl()
 
 	// Arrays, maps, and objects with additional properties are not pointers
 	if p.Schema.OpenAPISchema != nil && slices.Contains(p.Schema.OpenAPISchema.Type, "array") {
@@ -124,6 +159,11 @@ func (p Property) needsCustomValidation() bool {
 		return false
 	}
 
+	// Inlined component schemas are anonymous structs of primitive fields, validated with validator.Struct()
+	if p.Schema.Inlined {
+		return false
+	}
+
 	// Check if it's an array with items that need validation
 	// This must be checked before the general "primitive" check because arrays
 	// of custom types (e.g., []DisputeInfo) need custom validation to iterate
@@ -232,13 +272,26 @@ func genFieldsFromProperties(props []Property, options ParseOptions) []string {
 		goFieldName := p.GoName
 
 		// Add a comment to a field in case we have one, otherwise skip.
-		if !options.OmitDescription && p.Description != "" {
+		comment := p.Description
+		if options.OmitDescription {
+			comment = ""
+		}
+		if options.ConstraintComments {
+			if summary := p.constraintsSummary(); summary != "" {
+				if comment == "" {
+					comment = "constraints: " + summary
+				} else {
+					comment += "\nConstraints: " + summary
+				}
+			}
+		}
+		if comment != "" {
 			// Separate the comment from a previous-defined, unrelated field.
 			// Make sure the actual field is separated by a newline.
 			if i != 0 {
 				field += "\n"
 			}
-			field += fmt.Sprintf("%s\n", stringWithTypeNameToGoComment(p.Description, p.GoName))
+			field += fmt.Sprintf("%s\n", stringWithTypeNameToGoComment(comment, p.GoName))
 		}
 
 		if p.Deprecated {
@@ -263,38 +316,28 @@ func genFieldsFromProperties(props []Property, options ParseOptions) []string {
 
 		field += fmt.Sprintf("    %s %s", goFieldName, p.GoTypeDef())
 
-		c := p.Constraints
-		omitEmpty := c.Nullable != nil && *c.Nullable
-		if p.Schema.SkipOptionalPointer {
-			omitEmpty = false
-		}
-
-		// Support x-omitempty
-		if extOmitEmptyValue, ok := p.Extensions[extPropOmitEmpty]; ok {
-			if extOmitEmpty, err := parseBooleanValue(extOmitEmptyValue); err == nil {
-				omitEmpty = extOmitEmpty
-			}
-		}
-
 		fieldTags := make(map[string]string)
 
-		if !options.SkipValidation && len(p.Constraints.ValidationTags) > 0 {
-			fieldTags["validate"] = strings.Join(c.ValidationTags, ",")
+		// runtime.Optional values are validated by the generated Validate method, not by tags.
+		if !options.SkipValidation && len(p.Constraints.ValidationTags) > 0 && !p.IsOptionalType() {
+			fieldTags["validate"] = strings.Join(p.Constraints.ValidationTags, ",")
 		}
 
-		jsonFieldName := p.JsonFieldName
-		if jsonFieldName == "" {
-			jsonFieldName = "-"
-		}
-		fieldTags["json"] = jsonFieldName
-		if omitEmpty && jsonFieldName != "-" {
-			fieldTags["json"] += ",omitempty"
-		}
+		fieldTags["json"] = p.jsonTag(options.PreserveJSONCase)
 
-		// Support x-go-json-ignore
-		if extension, ok := p.Extensions[extPropGoJsonIgnore]; ok {
-			if goJsonIgnore, err := parseBooleanValue(extension); err == nil && goJsonIgnore {
-				fieldTags["json"] = "-"
+		// Support x-go-tags, merged with the generated tags: validate rules are appended, the json tag is kept
+		if extension, ok := p.Extensions[extPropGoTags]; ok {
+			if tags, err := extExtraTags(extension); err == nil {
+				for _, k := range sortedMapKeys(tags) {
+					switch {
+					case k == "json":
+						continue
+					case k == "validate" && fieldTags[k] != "" && tags[k] != "":
+						fieldTags[k] += "," + tags[k]
+					default:
+						fieldTags[k] = tags[k]
+					}
+				}
 			}
 		}
 
@@ -303,6 +346,10 @@ func genFieldsFromProperties(props []Property, options ParseOptions) []string {
 			if tags, err := extExtraTags(extension); err == nil {
 				keys := sortedMapKeys(tags)
 				for _, k := range keys {
+					// set by jsonTag
+					if k == "json" {
+						continue
+					}
 					fieldTags[k] = tags[k]
 				}
 			}
@@ -346,6 +393,124 @@ func genFieldsFromProperties(props []Property, options ParseOptions) []string {
 	return fields
 }
 
+// jsonTag returns the json struct tag of the property field, e.g. "name,omitempty".
+func (p Property) jsonTag(preserveJSONCase bool) string {
+	omitEmpty := p.Constraints.Nullable != nil && *p.Constraints.Nullable
+	if p.Schema.SkipOptionalPointer {
+		omitEmpty = false
+	}
+
+	// Support x-omitempty
+	if extOmitEmptyValue, ok := p.Extensions[extPropOmitEmpty]; ok {
+		if extOmitEmpty, err := parseBooleanValue(extOmitEmptyValue); err == nil {
+			omitEmpty = extOmitEmpty
+		}
+	}
+
+	tag := p.JsonFieldName
+	if tag == "" {
+		tag = "-"
+	}
+	if omitEmpty && tag != "-" {
+		if p.IsOptionalType() {
+			// runtime.Optional is a struct, omitted when absent through its IsZero method.
+			tag += ",omitzero"
+		} else {
+			tag += ",omitempty"
+		}
+	}
+
+	// Support x-go-json-ignore
+	if extension, ok := p.Extensions[extPropGoJsonIgnore]; ok {
+		if goJsonIgnore, err := parseBooleanValue(extension); err == nil && goJsonIgnore {
+			tag = "-"
+		}
+	}
+
+	// Support the json key of x-oapi-codegen-extra-tags, unless the JSON key must stay the verbatim property name
+	if extension, ok := p.Extensions[extPropExtraTags]; ok && !preserveJSONCase {
+		if tags, err := extExtraTags(extension); err == nil {
+			if jsonTag, found := tags["json"]; found {
+				tag = jsonTag
+			}
+		}
+	}
+
+	return tag
+}
+
+// constraintsSummary returns the constraints of the property, e.g. "required, minLength=3, pattern=^[a-z]+$",
+// for its doc comment. It's empty if the property has none.
+func (p Property) constraintsSummary() string {
+	c := p.Constraints
+	formatFloat := func(v float64) string {
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	hasTag := func(prefix string) bool {
+		return slices.ContainsFunc(c.ValidationTags, func(tag string) bool {
+			return strings.HasPrefix(tag, prefix)
+		})
+	}
+
+	var res []string
+	if deref(c.Required) {
+		res = append(res, "required")
+	}
+	if deref(c.ReadOnly) {
+		res = append(res, "readOnly")
+	}
+	if deref(c.WriteOnly) {
+		res = append(res, "writeOnly")
+	}
+	if c.Min != nil {
+		keyword := "minimum"
+		if hasTag("gt=") {
+			keyword = "exclusiveMinimum"
+		}
+		res = append(res, keyword+"="+formatFloat(*c.Min))
+	}
+	if c.Max != nil {
+		keyword := "maximum"
+		if hasTag("lt=") {
+			keyword = "exclusiveMaximum"
+		}
+		res = append(res, keyword+"="+formatFloat(*c.Max))
+	}
+	if c.MultipleOf != nil {
+		res = append(res, "multipleOf="+formatFloat(*c.MultipleOf))
+	}
+	if c.MinLength != nil {
+		res = append(res, fmt.Sprintf("minLength=%d", *c.MinLength))
+	}
+	if c.MaxLength != nil {
+		res = append(res, fmt.Sprintf("maxLength=%d", *c.MaxLength))
+	}
+	if c.Pattern != nil {
+		res = append(res, "pattern="+*c.Pattern)
+	}
+	if c.MinItems != nil {
+		res = append(res, fmt.Sprintf("minItems=%d", *c.MinItems))
+	}
+	if c.MaxItems != nil {
+		res = append(res, fmt.Sprintf("maxItems=%d", *c.MaxItems))
+	}
+	if deref(c.UniqueItems) {
+		res = append(res, "uniqueItems")
+	}
+	if c.MinProperties != nil {
+		res = append(res, fmt.Sprintf("minProperties=%d", *c.MinProperties))
+	}
+	if c.MaxProperties != nil {
+		res = append(res, fmt.Sprintf("maxProperties=%d", *c.MaxProperties))
+	}
+	if len(p.Schema.EnumValues) > 0 {
+		values := slices.Collect(maps.Values(p.Schema.EnumValues))
+		slices.Sort(values)
+		res = append(res, "enum=["+strings.Join(values, ", ")+"]")
+	}
+	return strings.Join(res, ", ")
+}
+
 // extractPropertyFieldValue extracts a field value from a Property based on the field name.
 // Supported field names:
 // - "description": returns the property description
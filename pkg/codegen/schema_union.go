@@ -41,6 +41,55 @@ func (u UnionElement) Method() string {
 	return method
 }
 
+// isNullSchema reports whether the schema only admits null,
+// e.g. `type: 'null'` in OpenAPI 3.1 or `enum: [null]` in OpenAPI 3.0.
+func isNullSchema(schema *base.Schema) bool {
+	if schema == nil {
+		return false
+	}
+	if len(schema.Type) == 1 && slices.Contains(schema.Type, "null") {
+		return true
+	}
+	if len(schema.Type) == 0 && len(schema.Enum) > 0 {
+		for _, v := range schema.Enum {
+			if v == nil || v.Tag != "!!null" {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// isNullableUnion reports whether the anyOf/oneOf of the schema is a single non-null
+// element plus null, e.g. `anyOf: [{$ref: Pet}, {type: 'null'}]`.
+// Such unions are generated as the non-null type and made optional instead of a union wrapper.
+func isNullableUnion(schema *base.Schema) bool {
+	if schema == nil || schema.Discriminator != nil {
+		return false
+	}
+	for _, elements := range [][]*base.SchemaProxy{schema.AnyOf, schema.OneOf} {
+		if len(elements) < 2 {
+			continue
+		}
+		nonNull, hasNull := 0, false
+		for _, element := range elements {
+			if element == nil {
+				continue
+			}
+			if isNullSchema(element.Schema()) {
+				hasNull = true
+			} else {
+				nonNull++
+			}
+		}
+		if hasNull && nonNull == 1 {
+			return true
+		}
+	}
+	return false
+}
+
 func generateUnion(elements []*base.SchemaProxy, discriminator *base.Discriminator, options ParseOptions) (GoSchema, error) {
 	outSchema := GoSchema{}
 	path := options.path
@@ -93,7 +142,7 @@ func generateUnion(elements []*base.SchemaProxy, discriminator *base.Discriminat
 			continue
 		}
 		// Check if this element is a null type
-		if len(schema.Type) == 1 && slices.Contains(schema.Type, "null") {
+		if isNullSchema(schema) {
 			hasNull = true
 			continue
 		}
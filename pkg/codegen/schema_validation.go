@@ -12,6 +12,8 @@ package codegen
 
 import (
 	"fmt"
+	"math"
+	"strconv"
 	"strings"
 )
 
@@ -30,11 +32,18 @@ const (
 	errMsgArrayMinItems    = "must have at least %d items, got %%d"
 	errMsgArrayMaxItems    = "must have at most %d items, got %%d"
 	errMsgArrayMinItemsNil = "must have at least %d items, got 0"
+	errMsgArrayUniqueItems = "must have unique items, [%d] duplicates [%d]"
 
 	// Map validation error messages
 	errMsgMapMinProps    = "must have at least %d properties, got %%d"
 	errMsgMapMaxProps    = "must have at most %d properties, got %%d"
 	errMsgMapMinPropsNil = "must have at least %d properties, got 0"
+
+	// Numeric validation error messages
+	errMsgMultipleOf = "must be a multiple of %s"
+
+	// Object validation error messages
+	errMsgDependentRequired = "is required when %s is present"
 )
 
 // Code generation helpers
@@ -50,10 +59,55 @@ func delegateToValidator(castExpr string) string {
 	return fmt.Sprintf("if val, ok := any(%s).(runtime.Validator); ok {\n    return val.Validate()\n}\nreturn nil", castExpr)
 }
 
+// multipleOfCondition returns the condition of a value violating multipleOf,
+// with the remainder for integer types and runtime.IsMultipleOf otherwise.
+func multipleOfCondition(value, typeDecl string, multipleOf float64) string {
+	if isIntegerType(typeDecl) && multipleOf == math.Trunc(multipleOf) {
+		return fmt.Sprintf("%s%%%d != 0", value, int64(multipleOf))
+	}
+	return fmt.Sprintf("!runtime.IsMultipleOf(%s, %s)", value, formatMultipleOf(multipleOf))
+}
+
+func formatMultipleOf(multipleOf float64) string {
+	return strconv.FormatFloat(multipleOf, 'g', -1, 64)
+}
+
 func declareErrorsVar() string {
 	return "var errors runtime.ValidationErrors"
 }
 
+// returnErrorsOrContextErr returns the context error before the collected errors,
+// as nested values may have stopped validating because of it.
+func returnErrorsOrContextErr() string {
+	return "if err := ctx.Err(); err != nil {\n    return err\n}\n" + returnNilIfEmptyErrors()
+}
+
+// checkContextThenValidate checks the context once and validates the value with its Validate method,
+// for types whose validation doesn't iterate over slices or maps.
+func checkContextThenValidate(alias string) string {
+	return fmt.Sprintf("if err := ctx.Err(); err != nil {\n    return err\n}\nreturn %s.Validate()", alias)
+}
+
+// checkContextLines returns the lines checking the context periodically in a validation loop,
+// counter being the index of the validated item.
+func checkContextLines(counter string) []string {
+	return []string{
+		fmt.Sprintf("    if err := runtime.CheckValidationContext(ctx, %s); err != nil {", counter),
+		"        return err",
+		"    }",
+	}
+}
+
+// validateContextLines returns the lines validating value with runtime.ValidateContext,
+// appending the error under errKey.
+func validateContextLines(value, errKey string) []string {
+	return []string{
+		fmt.Sprintf("    if err := runtime.ValidateContext(ctx, %s); err != nil {", value),
+		fmt.Sprintf("        errors = errors.Append(%s, err)", errKey),
+		"    }",
+	}
+}
+
 // ValidateDecl generates the body of the Validate() method for this schema.
 // It returns the Go code that should appear inside the Validate() method.
 // The alias parameter is the receiver variable name (e.g., "p" for "func (p Person) Validate()").
@@ -65,8 +119,19 @@ func (s GoSchema) ValidateDecl(alias string, validatorVar string) string {
 // ValidateDeclWithOptions generates the body of the Validate() method for this schema with options.
 // The forceSimple parameter forces the use of simple validation (validate.Struct()) even for complex types.
 func (s GoSchema) ValidateDeclWithOptions(alias string, validatorVar string, forceSimple bool) string {
+	return s.validateDecl(alias, validatorVar, forceSimple, false)
+}
+
+// ValidateConcurrentDecl generates the body of the Validate() method for this schema like ValidateDeclWithOptions,
+// but validates the items of the slices in parallel with runtime.ValidateConcurrently, producing the same errors.
+func (s GoSchema) ValidateConcurrentDecl(alias string, validatorVar string, forceSimple bool) string {
+	return s.validateDecl(alias, validatorVar, forceSimple, true)
+}
+
+// validateDecl generates the body of the Validate() method, validating the items of the slices in parallel if concurrent.
+func (s GoSchema) validateDecl(alias string, validatorVar string, forceSimple, concurrent bool) string {
 	// If forceSimple is true, always use simple validation for structs
-	if forceSimple && s.isStructType() {
+	if forceSimple && s.isStructType() && !s.hasOptionalValidationTags() {
 		return s.generateSimpleStructValidation(alias, validatorVar)
 	}
 
@@ -80,7 +145,7 @@ func (s GoSchema) ValidateDeclWithOptions(alias string, validatorVar string, for
 
 	// Handle array types
 	if s.isArrayType() {
-		return s.generateArrayValidation(alias, validatorVar)
+		return s.generateArrayValidation(alias, validatorVar, false, concurrent)
 	}
 
 	// If this schema has a RefType set, it means it's a reference to another type
@@ -97,7 +162,7 @@ func (s GoSchema) ValidateDeclWithOptions(alias string, validatorVar string, for
 
 	// Handle map types (from additionalProperties)
 	if s.isMapType() && !s.hasCustomValidation() {
-		return s.generateMapValidation(alias, validatorVar)
+		return s.generateMapValidation(alias, validatorVar, false)
 	}
 
 	// For other non-struct types (slices, primitives) without custom validation
@@ -106,7 +171,50 @@ func (s GoSchema) ValidateDeclWithOptions(alias string, validatorVar string, for
 	}
 
 	// Generate custom validation for struct properties
-	return s.generateCustomPropertyValidation(alias, validatorVar)
+	return s.generateCustomPropertyValidation(alias, validatorVar, false, concurrent)
+}
+
+// ValidateContextDecl generates the body of the ValidateContext(ctx) method for this schema.
+// It validates like ValidateDeclWithOptions, but checks the context periodically while iterating
+// over slices and maps, and passes it down to the nested values, so that validating huge payloads
+// stops early once the context is done.
+func (s GoSchema) ValidateContextDecl(alias string, validatorVar string, forceSimple bool) string {
+	if (forceSimple && s.isStructType() && !s.hasOptionalValidationTags()) || s.canUseSimpleStructValidation() {
+		return checkContextThenValidate(alias)
+	}
+
+	if s.isArrayType() {
+		return s.generateArrayValidation(alias, validatorVar, true, false)
+	}
+
+	if s.isRefTypeDelegation() {
+		return fmt.Sprintf("return runtime.ValidateContext(ctx, %s(%s))", s.RefType, alias)
+	}
+
+	if s.isTypeAliasDelegation() {
+		return fmt.Sprintf("return runtime.ValidateContext(ctx, %s(%s))", s.TypeDecl(), alias)
+	}
+
+	if s.isMapType() && !s.hasCustomValidation() {
+		return s.generateMapValidation(alias, validatorVar, true)
+	}
+
+	if !s.hasCustomValidation() {
+		return checkContextThenValidate(alias)
+	}
+
+	return s.generateCustomPropertyValidation(alias, validatorVar, true, false)
+}
+
+// validationMessageExpr returns the Go expression of the error message for a violated minItems, maxItems,
+// minProperties or maxProperties bound: the x-validation-message of the schema with its %d or %s verbs
+// replaced by the bound, or defaultExpr.
+func (s GoSchema) validationMessageExpr(defaultExpr string, bound int64) string {
+	if s.Constraints.ValidationMessage == "" {
+		return defaultExpr
+	}
+	b := strconv.FormatInt(bound, 10)
+	return strconv.Quote(strings.NewReplacer("%%", "%", "%d", b, "%s", b).Replace(s.Constraints.ValidationMessage))
 }
 
 // Validation generators (in order of appearance in ValidateDecl)
@@ -130,8 +238,10 @@ func (s GoSchema) generateTypeAliasDelegation(alias string) string {
 	return delegateToValidator(fmt.Sprintf("%s(%s)", s.TypeDecl(), alias))
 }
 
-// generateArrayValidation generates validation for array types
-func (s GoSchema) generateArrayValidation(alias, validatorVar string) string {
+// generateArrayValidation generates validation for array types.
+// withContext generates the body of ValidateContext, checking the ctx variable,
+// concurrent validates the items in parallel, see concurrentItemLines.
+func (s GoSchema) generateArrayValidation(alias, validatorVar string, withContext, concurrent bool) string {
 	var lines []string
 
 	// Allow nil if:
@@ -152,15 +262,20 @@ func (s GoSchema) generateArrayValidation(alias, validatorVar string) string {
 		lines = append(lines, "}")
 	} else if hasMinItems {
 		// Required array with minItems > 0: nil is invalid
-		errMsg := fmt.Sprintf(errMsgArrayMinItemsNil, *s.Constraints.MinItems)
+		errMsg := s.validationMessageExpr(strconv.Quote(fmt.Sprintf(errMsgArrayMinItemsNil, *s.Constraints.MinItems)), *s.Constraints.MinItems)
 		lines = append(lines, fmt.Sprintf("if %s == nil {", alias))
-		lines = append(lines, fmt.Sprintf("    return runtime.NewValidationError(\"Array\", \"%s\")", errMsg))
+		lines = append(lines, fmt.Sprintf("    return runtime.NewValidationError(\"Array\", %s)", errMsg))
 		lines = append(lines, "}")
 	}
 
 	// Collect all constraint violations
-	needsErrorCollection := (s.Constraints.MinItems != nil && s.Constraints.MaxItems != nil) ||
-		(s.ArrayType != nil && s.ArrayType.NeedsValidation())
+	arrayChecks := 0
+	for _, isSet := range []bool{s.Constraints.MinItems != nil, s.Constraints.MaxItems != nil, s.Constraints.UniqueItems != nil} {
+		if isSet {
+			arrayChecks++
+		}
+	}
+	needsErrorCollection := arrayChecks > 1 || (s.ArrayType != nil && s.ArrayType.NeedsValidation())
 
 	if needsErrorCollection {
 		lines = append(lines, declareErrorsVar())
@@ -168,29 +283,51 @@ func (s GoSchema) generateArrayValidation(alias, validatorVar string) string {
 
 	// Check MinItems constraint
 	if s.Constraints.MinItems != nil {
-		errMsg := fmt.Sprintf(errMsgArrayMinItems, *s.Constraints.MinItems)
+		errMsg := s.validationMessageExpr(
+			fmt.Sprintf("fmt.Sprintf(\"%s\", len(%s))", fmt.Sprintf(errMsgArrayMinItems, *s.Constraints.MinItems), alias), *s.Constraints.MinItems)
 		lines = append(lines, fmt.Sprintf("if len(%s) < %d {", alias, *s.Constraints.MinItems))
 		if needsErrorCollection {
-			lines = append(lines, fmt.Sprintf("    errors = errors.Add(\"Array\", fmt.Sprintf(\"%s\", len(%s)))", errMsg, alias))
+			lines = append(lines, fmt.Sprintf("    errors = errors.Add(\"Array\", %s)", errMsg))
 		} else {
-			lines = append(lines, fmt.Sprintf("    return runtime.NewValidationError(\"Array\", fmt.Sprintf(\"%s\", len(%s)))", errMsg, alias))
+			lines = append(lines, fmt.Sprintf("    return runtime.NewValidationError(\"Array\", %s)", errMsg))
 		}
 		lines = append(lines, "}")
 	}
 	// Check MaxItems constraint
 	if s.Constraints.MaxItems != nil {
-		errMsg := fmt.Sprintf(errMsgArrayMaxItems, *s.Constraints.MaxItems)
+		errMsg := s.validationMessageExpr(
+			fmt.Sprintf("fmt.Sprintf(\"%s\", len(%s))", fmt.Sprintf(errMsgArrayMaxItems, *s.Constraints.MaxItems), alias), *s.Constraints.MaxItems)
 		lines = append(lines, fmt.Sprintf("if len(%s) > %d {", alias, *s.Constraints.MaxItems))
 		if needsErrorCollection {
-			lines = append(lines, fmt.Sprintf("    errors = errors.Add(\"Array\", fmt.Sprintf(\"%s\", len(%s)))", errMsg, alias))
+			lines = append(lines, fmt.Sprintf("    errors = errors.Add(\"Array\", %s)", errMsg))
 		} else {
-			lines = append(lines, fmt.Sprintf("    return runtime.NewValidationError(\"Array\", fmt.Sprintf(\"%s\", len(%s)))", errMsg, alias))
+			lines = append(lines, fmt.Sprintf("    return runtime.NewValidationError(\"Array\", %s)", errMsg))
+		}
+		lines = append(lines, "}")
+	}
+	// Check UniqueItems constraint, with == for primitive items and by JSON encoding otherwise
+	if s.Constraints.UniqueItems != nil && s.ArrayType != nil {
+		duplicateFn := "runtime.DuplicateItemJSON"
+		if itemType := s.ArrayType.TypeDecl(); isPrimitiveType(itemType) && itemType != "time.Time" && !isDecimalType(itemType) {
+			duplicateFn = "runtime.DuplicateItem"
+		}
+		errMsg := fmt.Sprintf("fmt.Sprintf(%q, second, first)", errMsgArrayUniqueItems)
+		lines = append(lines, fmt.Sprintf("if first, second, ok := %s(%s); ok {", duplicateFn, alias))
+		if needsErrorCollection {
+			lines = append(lines, fmt.Sprintf("    errors = errors.Add(\"Array\", %s)", errMsg))
+		} else {
+			lines = append(lines, fmt.Sprintf("    return runtime.NewValidationError(\"Array\", %s)", errMsg))
 		}
 		lines = append(lines, "}")
 	}
 	// Validate array items if they need validation
-	if s.ArrayType != nil && s.ArrayType.NeedsValidation() {
+	if s.ArrayType != nil && s.ArrayType.NeedsValidation() && concurrent {
+		lines = append(lines, concurrentItemLines(alias, "[%d]", *s.ArrayType, validatorVar)...)
+	} else if s.ArrayType != nil && s.ArrayType.NeedsValidation() {
 		lines = append(lines, "for i, item := range "+alias+" {")
+		if withContext {
+			lines = append(lines, checkContextLines("i")...)
+		}
 
 		// If items have validation tags, use validator.Var()
 		if len(s.ArrayType.Constraints.ValidationTags) > 0 {
@@ -198,6 +335,8 @@ func (s GoSchema) generateArrayValidation(alias, validatorVar string) string {
 			lines = append(lines, fmt.Sprintf("    if err := %s.Var(item, \"%s\"); err != nil {", validatorVar, tags))
 			lines = append(lines, "        errors = errors.Append(fmt.Sprintf(\"[%d]\", i), err)")
 			lines = append(lines, "    }")
+		} else if withContext {
+			lines = append(lines, validateContextLines("item", "fmt.Sprintf(\"[%d]\", i)")...)
 		} else {
 			// Otherwise, try to call Validate() method (for RefTypes, structs, unions)
 			lines = append(lines, "    if v, ok := any(item).(runtime.Validator); ok {")
@@ -211,7 +350,9 @@ func (s GoSchema) generateArrayValidation(alias, validatorVar string) string {
 	}
 
 	// Return collected errors or nil
-	if needsErrorCollection {
+	if needsErrorCollection && withContext {
+		lines = append(lines, returnErrorsOrContextErr())
+	} else if needsErrorCollection {
 		lines = append(lines, returnNilIfEmptyErrors())
 	} else {
 		lines = append(lines, returnNil)
@@ -219,9 +360,14 @@ func (s GoSchema) generateArrayValidation(alias, validatorVar string) string {
 	return strings.Join(lines, "\n")
 }
 
-// generateMapValidation generates validation for map types
-func (s GoSchema) generateMapValidation(alias, validatorVar string) string {
+// generateMapValidation generates validation for map types.
+// withContext generates the body of ValidateContext, checking the ctx variable.
+func (s GoSchema) generateMapValidation(alias, validatorVar string, withContext bool) string {
 	var lines []string
+	returnErrors := returnNilIfEmptyErrors()
+	if withContext {
+		returnErrors = returnErrorsOrContextErr()
+	}
 
 	// Only allow nil if explicitly nullable OR if there's no minProperties constraint
 	// If minProperties > 0 and not explicitly nullable, nil is invalid (nil = 0 properties)
@@ -236,9 +382,9 @@ func (s GoSchema) generateMapValidation(alias, validatorVar string) string {
 		lines = append(lines, "}")
 	} else if hasMinProperties {
 		// Not explicitly nullable and has minProperties > 0: nil is invalid
-		errMsg := fmt.Sprintf(errMsgMapMinPropsNil, *s.Constraints.MinProperties)
+		errMsg := s.validationMessageExpr(strconv.Quote(fmt.Sprintf(errMsgMapMinPropsNil, *s.Constraints.MinProperties)), *s.Constraints.MinProperties)
 		lines = append(lines, fmt.Sprintf("if %s == nil {", alias))
-		lines = append(lines, fmt.Sprintf("    return runtime.NewValidationError(\"Map\", \"%s\")", errMsg))
+		lines = append(lines, fmt.Sprintf("    return runtime.NewValidationError(\"Map\", %s)", errMsg))
 		lines = append(lines, "}")
 	}
 
@@ -252,23 +398,25 @@ func (s GoSchema) generateMapValidation(alias, validatorVar string) string {
 
 	// Check MinProperties constraint
 	if s.Constraints.MinProperties != nil {
-		errMsg := fmt.Sprintf(errMsgMapMinProps, *s.Constraints.MinProperties)
+		errMsg := s.validationMessageExpr(
+			fmt.Sprintf("fmt.Sprintf(\"%s\", len(%s))", fmt.Sprintf(errMsgMapMinProps, *s.Constraints.MinProperties), alias), *s.Constraints.MinProperties)
 		lines = append(lines, fmt.Sprintf("if len(%s) < %d {", alias, *s.Constraints.MinProperties))
 		if needsErrorCollection {
-			lines = append(lines, fmt.Sprintf("    errors = errors.Add(\"Map\", fmt.Sprintf(\"%s\", len(%s)))", errMsg, alias))
+			lines = append(lines, fmt.Sprintf("    errors = errors.Add(\"Map\", %s)", errMsg))
 		} else {
-			lines = append(lines, fmt.Sprintf("    return runtime.NewValidationError(\"Map\", fmt.Sprintf(\"%s\", len(%s)))", errMsg, alias))
+			lines = append(lines, fmt.Sprintf("    return runtime.NewValidationError(\"Map\", %s)", errMsg))
 		}
 		lines = append(lines, "}")
 	}
 	// Check MaxProperties constraint
 	if s.Constraints.MaxProperties != nil {
-		errMsg := fmt.Sprintf(errMsgMapMaxProps, *s.Constraints.MaxProperties)
+		errMsg := s.validationMessageExpr(
+			fmt.Sprintf("fmt.Sprintf(\"%s\", len(%s))", fmt.Sprintf(errMsgMapMaxProps, *s.Constraints.MaxProperties), alias), *s.Constraints.MaxProperties)
 		lines = append(lines, fmt.Sprintf("if len(%s) > %d {", alias, *s.Constraints.MaxProperties))
 		if needsErrorCollection {
-			lines = append(lines, fmt.Sprintf("    errors = errors.Add(\"Map\", fmt.Sprintf(\"%s\", len(%s)))", errMsg, alias))
+			lines = append(lines, fmt.Sprintf("    errors = errors.Add(\"Map\", %s)", errMsg))
 		} else {
-			lines = append(lines, fmt.Sprintf("    return runtime.NewValidationError(\"Map\", fmt.Sprintf(\"%s\", len(%s)))", errMsg, alias))
+			lines = append(lines, fmt.Sprintf("    return runtime.NewValidationError(\"Map\", %s)", errMsg))
 		}
 		lines = append(lines, "}")
 	}
@@ -277,12 +425,17 @@ func (s GoSchema) generateMapValidation(alias, validatorVar string) string {
 		// Check if map values have validation tags (for primitive types)
 		if len(s.AdditionalPropertiesType.Constraints.ValidationTags) > 0 {
 			tags := strings.Join(s.AdditionalPropertiesType.Constraints.ValidationTags, ",")
-			lines = append(lines, "for k, v := range "+alias+" {")
+			lines = append(lines, mapLoopLines(alias, "n", withContext)...)
 			lines = append(lines, fmt.Sprintf("    if err := %s.Var(v, \"%s\"); err != nil {", validatorVar, tags))
 			lines = append(lines, "        errors = errors.Append(k, err)")
 			lines = append(lines, "    }")
 			lines = append(lines, "}")
-			lines = append(lines, returnNilIfEmptyErrors())
+			lines = append(lines, returnErrors)
+		} else if s.AdditionalPropertiesType.NeedsValidation() && withContext {
+			lines = append(lines, mapLoopLines(alias, "n", withContext)...)
+			lines = append(lines, validateContextLines("v", "k")...)
+			lines = append(lines, "}")
+			lines = append(lines, returnErrors)
 		} else if s.AdditionalPropertiesType.NeedsValidation() {
 			// For complex types (structs, unions, etc.), call Validate() method
 			lines = append(lines, "for k, v := range "+alias+" {")
@@ -295,19 +448,30 @@ func (s GoSchema) generateMapValidation(alias, validatorVar string) string {
 			lines = append(lines, returnNilIfEmptyErrors())
 		} else if needsErrorCollection {
 			// We have constraints but no value validation
-			lines = append(lines, returnNilIfEmptyErrors())
+			lines = append(lines, returnErrors)
 		} else {
 			lines = append(lines, returnNil)
 		}
 	} else if needsErrorCollection {
 		// We have constraints but no additionalProperties validation
-		lines = append(lines, returnNilIfEmptyErrors())
+		lines = append(lines, returnErrors)
 	} else {
 		lines = append(lines, returnNil)
 	}
 	return strings.Join(lines, "\n")
 }
 
+// mapLoopLines returns the opening lines of the loop over the map entries, as k and v.
+// withContext counts the entries with the counter variable to check the context periodically.
+func mapLoopLines(mapExpr, counter string, withContext bool) []string {
+	if !withContext {
+		return []string{"for k, v := range " + mapExpr + " {"}
+	}
+	lines := []string{counter + " := 0", "for k, v := range " + mapExpr + " {"}
+	lines = append(lines, checkContextLines(counter)...)
+	return append(lines, "    "+counter+"++")
+}
+
 // generateNonStructValidation generates validation for non-struct types (slices, primitives)
 func (s GoSchema) generateNonStructValidation(alias, validatorVar string) string {
 	typeDecl := s.TypeDecl()
@@ -321,6 +485,15 @@ func (s GoSchema) generateNonStructValidation(alias, validatorVar string) string
 			lines = append(lines, fmt.Sprintf("if err := %s.Var(%s, \"%s\"); err != nil {", validatorVar, alias, tags))
 			lines = append(lines, "    return err")
 			lines = append(lines, "}")
+		}
+		// Check MultipleOf constraint, which has no validation tag
+		if s.Constraints.MultipleOf != nil && isNumericType(typeDecl) {
+			multipleOf := *s.Constraints.MultipleOf
+			lines = append(lines, fmt.Sprintf("if %s {", multipleOfCondition(alias, typeDecl, multipleOf)))
+			lines = append(lines, fmt.Sprintf("    return runtime.NewValidationError(\"\", %q)", fmt.Sprintf(errMsgMultipleOf, formatMultipleOf(multipleOf))))
+			lines = append(lines, "}")
+		}
+		if len(lines) > 0 {
 			lines = append(lines, returnNil)
 			return strings.Join(lines, "\n")
 		}
@@ -331,8 +504,20 @@ func (s GoSchema) generateNonStructValidation(alias, validatorVar string) string
 	return returnNilIfNoError(validatorVar, alias)
 }
 
-// generateCustomPropertyValidation generates custom validation for struct properties
-func (s GoSchema) generateCustomPropertyValidation(alias, validatorVar string) string {
+// validationField returns the field of the validation errors of the property.
+// Fields injected for allOf/anyOf/oneOf have no JSON key, their errors are reported on the parent path,
+// e.g. AccountDetails.RoutingNumber rather than AccountDetails.AccountDetails_AnyOf.RoutingNumber.
+func (p Property) validationField() string {
+	if p.JsonFieldName == "" {
+		return ""
+	}
+	return p.GoName
+}
+
+// generateCustomPropertyValidation generates custom validation for struct properties.
+// withContext generates the body of ValidateContext, checking the ctx variable,
+// concurrent validates the items of the slice properties in parallel, see concurrentItemLines.
+func (s GoSchema) generateCustomPropertyValidation(alias, validatorVar string, withContext, concurrent bool) string {
 	var lines []string
 
 	// Generate custom validation for each property
@@ -342,17 +527,34 @@ func (s GoSchema) generateCustomPropertyValidation(alias, validatorVar string) s
 		if prop.needsCustomValidation() {
 			// Check if this is an array property with items that need validation
 			if prop.Schema.ArrayType != nil && prop.Schema.ArrayType.NeedsValidation() {
-				lines = append(lines, generateArrayPropertyValidation(alias, prop, validatorVar)...)
+				lines = append(lines, optionalValueLines(alias, prop, func(fieldAccess string) []string {
+					if concurrent {
+						return concurrentItemLines(fieldAccess, prop.GoName+"[%d]", *prop.Schema.ArrayType, validatorVar)
+					}
+					return generateArrayPropertyValidation(fieldAccess, prop, validatorVar, withContext)
+				})...)
 			} else if prop.Schema.AdditionalPropertiesType != nil && prop.Schema.AdditionalPropertiesType.NeedsValidation() {
 				// Check if this is a map property with values that need validation
-				lines = append(lines, generateMapPropertyValidation(alias, prop, validatorVar)...)
+				lines = append(lines, optionalValueLines(alias, prop, func(fieldAccess string) []string {
+					return generateMapPropertyValidation(fieldAccess, prop, validatorVar, withContext)
+				})...)
+			} else if withContext {
+				// Property needs custom validation - pass the context down
+				fieldAccess := fmt.Sprintf("%s.%s", alias, prop.GoName)
+				if prop.IsPointerType() {
+					lines = append(lines, fmt.Sprintf("if %s != nil {", fieldAccess))
+					lines = append(lines, validateContextLines(fieldAccess, fmt.Sprintf("%q", prop.validationField()))...)
+					lines = append(lines, "}")
+				} else {
+					lines = append(lines, validateContextLines(fieldAccess, fmt.Sprintf("%q", prop.validationField()))...)
+				}
 			} else {
 				// Property needs custom validation - call Validate() method
 				if prop.IsPointerType() {
 					lines = append(lines, fmt.Sprintf("if %s.%s != nil {", alias, prop.GoName))
 					lines = append(lines, fmt.Sprintf("    if v, ok := any(%s.%s).(runtime.Validator); ok {", alias, prop.GoName))
 					lines = append(lines, "        if err := v.Validate(); err != nil {")
-					lines = append(lines, fmt.Sprintf("            errors = errors.Append(\"%s\", err)", prop.GoName))
+					lines = append(lines, fmt.Sprintf("            errors = errors.Append(%q, err)", prop.validationField()))
 					lines = append(lines, "        }")
 					lines = append(lines, "    }")
 					lines = append(lines, "}")
@@ -372,46 +574,174 @@ func (s GoSchema) generateCustomPropertyValidation(alias, validatorVar string) s
 						// If it does and is not nil, validate it
 						lines = append(lines, fmt.Sprintf("if v, ok := any(%s.%s).(runtime.Validator); ok && v != nil {", alias, prop.GoName))
 						lines = append(lines, "    if err := v.Validate(); err != nil {")
-						lines = append(lines, fmt.Sprintf("        errors = errors.Append(\"%s\", err)", prop.GoName))
+						lines = append(lines, fmt.Sprintf("        errors = errors.Append(%q, err)", prop.validationField()))
 						lines = append(lines, "    }")
 						lines = append(lines, "}")
 					} else {
 						lines = append(lines, fmt.Sprintf("if v, ok := any(%s.%s).(runtime.Validator); ok {", alias, prop.GoName))
 						lines = append(lines, "    if err := v.Validate(); err != nil {")
-						lines = append(lines, fmt.Sprintf("        errors = errors.Append(\"%s\", err)", prop.GoName))
+						lines = append(lines, fmt.Sprintf("        errors = errors.Append(%q, err)", prop.validationField()))
 						lines = append(lines, "    }")
 						lines = append(lines, "}")
 					}
 				}
 			}
+		} else if prop.Schema.Inlined {
+			// Anonymous struct of an inlined component schema - use Struct() for its fields
+			lines = append(lines, generateInlinedPropertyValidation(alias, prop, validatorVar)...)
 		} else if len(prop.Constraints.ValidationTags) > 0 {
 			// Property with validation tags - use Var()
 			tags := strings.Join(prop.Constraints.ValidationTags, ",")
-			if prop.IsPointerType() {
+			if prop.IsOptionalType() {
+				lines = append(lines, fmt.Sprintf("if v, ok := %s.%s.Get(); ok {", alias, prop.GoName))
+				lines = append(lines, fmt.Sprintf("    if err := %s.Var(v, \"%s\"); err != nil {", validatorVar, tags))
+				lines = append(lines, fmt.Sprintf("        errors = errors.Append(%q, err)", prop.validationField()))
+				lines = append(lines, "    }")
+				lines = append(lines, "}")
+			} else if prop.IsPointerType() {
 				lines = append(lines, fmt.Sprintf("if %s.%s != nil {", alias, prop.GoName))
 				lines = append(lines, fmt.Sprintf("    if err := %s.Var(%s.%s, \"%s\"); err != nil {", validatorVar, alias, prop.GoName, tags))
-				lines = append(lines, fmt.Sprintf("        errors = errors.Append(\"%s\", err)", prop.GoName))
+				lines = append(lines, fmt.Sprintf("        errors = errors.Append(%q, err)", prop.validationField()))
 				lines = append(lines, "    }")
 				lines = append(lines, "}")
 			} else {
 				lines = append(lines, fmt.Sprintf("if err := %s.Var(%s.%s, \"%s\"); err != nil {", validatorVar, alias, prop.GoName, tags))
-				lines = append(lines, fmt.Sprintf("    errors = errors.Append(\"%s\", err)", prop.GoName))
+				lines = append(lines, fmt.Sprintf("    errors = errors.Append(%q, err)", prop.validationField()))
 				lines = append(lines, "}")
 			}
 		}
+		if !prop.needsCustomValidation() && prop.hasMultipleOf() {
+			lines = append(lines, generateMultipleOfPropertyValidation(alias, prop)...)
+		}
 	}
+	lines = append(lines, s.generateDependentRequiredValidation(alias)...)
 
-	lines = append(lines, returnNilIfEmptyErrors())
+	if withContext {
+		lines = append(lines, returnErrorsOrContextErr())
+	} else {
+		lines = append(lines, returnNilIfEmptyErrors())
+	}
 	return strings.Join(lines, "\n")
 }
 
-// generateArrayPropertyValidation generates validation code for an array property
-func generateArrayPropertyValidation(alias string, prop Property, validatorVar string) []string {
+// generateMultipleOfPropertyValidation generates the check of the multipleOf constraint of a numeric property,
+// skipped when an optional property is not set.
+func generateMultipleOfPropertyValidation(alias string, prop Property) []string {
+	multipleOf := *prop.Constraints.MultipleOf
+	typeDecl := strings.TrimPrefix(prop.Schema.TypeDecl(), "*")
+	errMsg := fmt.Sprintf(errMsgMultipleOf, formatMultipleOf(multipleOf))
+	check := func(value, indent string) []string {
+		return []string{
+			fmt.Sprintf("%sif %s {", indent, multipleOfCondition(value, typeDecl, multipleOf)),
+			fmt.Sprintf("%s    errors = errors.Add(\"%s\", %q)", indent, prop.GoName, errMsg),
+			indent + "}",
+		}
+	}
+
+	fieldAccess := fmt.Sprintf("%s.%s", alias, prop.GoName)
+	var lines []string
+	switch {
+	case prop.IsOptionalType():
+		lines = append(lines, fmt.Sprintf("if v, ok := %s.Get(); ok {", fieldAccess))
+		lines = append(lines, check("v", "    ")...)
+		lines = append(lines, "}")
+	case prop.IsPointerType():
+		lines = append(lines, fmt.Sprintf("if %s != nil {", fieldAccess))
+		lines = append(lines, check("*"+fieldAccess, "    ")...)
+		lines = append(lines, "}")
+	default:
+		lines = append(lines, check(fieldAccess, "")...)
+	}
+	return lines
+}
+
+// generateDependentRequiredValidation generates the checks of the dependentRequired constraints,
+// which have no validation tag, adding an error for each required property absent while its dependency is present.
+func (s GoSchema) generateDependentRequiredValidation(alias string) []string {
+	property := func(name string) (Property, bool) {
+		for _, p := range s.Properties {
+			if p.JsonFieldName == name {
+				return p, true
+			}
+		}
+		return Property{}, false
+	}
+
 	var lines []string
+	for _, dep := range s.DependentRequired {
+		prop, ok := property(dep.Property)
+		if !ok {
+			continue
+		}
+		present, _ := prop.presenceConditions(alias)
+		for _, name := range dep.Required {
+			required, ok := property(name)
+			if !ok {
+				continue
+			}
+			_, absent := required.presenceConditions(alias)
+			if absent == "" {
+				// always present
+				continue
+			}
+			cond := absent
+			if present != "" {
+				cond = present + " && " + absent
+			}
+			lines = append(lines, fmt.Sprintf("if %s {", cond))
+			lines = append(lines, fmt.Sprintf("    errors = errors.Add(%q, %q)", required.GoName, fmt.Sprintf(errMsgDependentRequired, prop.GoName)))
+			lines = append(lines, "}")
+		}
+	}
+	return lines
+}
+
+// presenceConditions returns the conditions of the property being present and absent in a value of its parent,
+// both empty for required properties of non-nillable types, which are always present.
+func (p Property) presenceConditions(alias string) (string, string) {
+	// same as the struct field, see genFieldsFromProperties
+	if extension, ok := p.Extensions[extPropGoTypeSkipOptionalPointer]; ok {
+		if skipOptionalPointer, err := parseBooleanValue(extension); err == nil {
+			p.Schema.SkipOptionalPointer = skipOptionalPointer
+		}
+	}
+
+	fieldAccess := fmt.Sprintf("%s.%s", alias, p.GoName)
+	typeDef := p.GoTypeDef()
+	switch {
+	case p.IsOptionalType():
+		return fieldAccess + ".Present()", "!" + fieldAccess + ".Present()"
+	case strings.HasPrefix(typeDef, "*"), strings.HasPrefix(typeDef, "[]"), strings.HasPrefix(typeDef, "map["), typeDef == "any":
+		return fieldAccess + " != nil", fieldAccess + " == nil"
+	case p.Constraints.Required != nil && *p.Constraints.Required:
+		return "", ""
+	}
+	// named types may be nil-able, e.g. a named slice, values of other types are always present
+	return fmt.Sprintf("!runtime.IsZero(%s)", fieldAccess), fmt.Sprintf("runtime.IsZero(%s)", fieldAccess)
+}
+
+// optionalValueLines returns the lines validating the property value with validate,
+// only if it's present and not null for runtime.Optional properties.
+func optionalValueLines(alias string, prop Property, validate func(fieldAccess string) []string) []string {
 	fieldAccess := fmt.Sprintf("%s.%s", alias, prop.GoName)
+	if !prop.IsOptionalType() {
+		return validate(fieldAccess)
+	}
+
+	lines := []string{fmt.Sprintf("if value, ok := %s.Get(); ok {", fieldAccess)}
+	lines = append(lines, validate("value")...)
+	return append(lines, "}")
+}
+
+// generateArrayPropertyValidation generates validation code for an array property
+func generateArrayPropertyValidation(fieldAccess string, prop Property, validatorVar string, withContext bool) []string {
+	var lines []string
 
 	// Check for nil before iterating
 	lines = append(lines, fmt.Sprintf("for i, item := range %s {", fieldAccess))
+	if withContext {
+		lines = append(lines, checkContextLines("i")...)
+	}
 
 	// If items have validation tags, use validator.Var()
 	if len(prop.Schema.ArrayType.Constraints.ValidationTags) > 0 {
@@ -419,6 +749,8 @@ func generateArrayPropertyValidation(alias string, prop Property, validatorVar s
 		lines = append(lines, fmt.Sprintf("    if err := %s.Var(item, \"%s\"); err != nil {", validatorVar, tags))
 		lines = append(lines, fmt.Sprintf("        errors = errors.Append(fmt.Sprintf(\"%s[%%d]\", i), err)", prop.GoName))
 		lines = append(lines, "    }")
+	} else if withContext {
+		lines = append(lines, validateContextLines("item", fmt.Sprintf("fmt.Sprintf(\"%s[%%d]\", i)", prop.GoName))...)
 	} else {
 		// Otherwise, try to call Validate() method (for RefTypes, structs, unions)
 		lines = append(lines, "    if v, ok := any(item).(runtime.Validator); ok {")
@@ -432,13 +764,36 @@ func generateArrayPropertyValidation(alias string, prop Property, validatorVar s
 	return lines
 }
 
+// concurrentItemLines returns the lines validating the items of sliceExpr in parallel with runtime.ValidateConcurrently,
+// like the sequential loops: with validator.Var() if the items have validation tags, or else their Validate() method.
+// The error of each invalid item is appended under errKeyFormat formatted with its index, in the order of the items.
+func concurrentItemLines(sliceExpr, errKeyFormat string, itemSchema GoSchema, validatorVar string) []string {
+	// The closure parameter is not named i, which would shadow the receiver of the type named I.
+	item := sliceExpr + "[idx]"
+	lines := []string{fmt.Sprintf("for i, err := range runtime.ValidateConcurrently(len(%s), func(idx int) error {", sliceExpr)}
+	if len(itemSchema.Constraints.ValidationTags) > 0 {
+		tags := strings.Join(itemSchema.Constraints.ValidationTags, ",")
+		lines = append(lines, fmt.Sprintf("    return %s.Var(%s, \"%s\")", validatorVar, item, tags))
+	} else {
+		lines = append(lines, fmt.Sprintf("    if v, ok := any(%s).(runtime.Validator); ok {", item))
+		lines = append(lines, "        return v.Validate()")
+		lines = append(lines, "    }")
+		lines = append(lines, "    return nil")
+	}
+	lines = append(lines, "}) {")
+	lines = append(lines, "    if err != nil {")
+	lines = append(lines, fmt.Sprintf("        errors = errors.Append(fmt.Sprintf(%q, i), err)", errKeyFormat))
+	lines = append(lines, "    }")
+	lines = append(lines, "}")
+	return lines
+}
+
 // generateMapPropertyValidation generates validation code for a map property
-func generateMapPropertyValidation(alias string, prop Property, validatorVar string) []string {
+func generateMapPropertyValidation(fieldAccess string, prop Property, validatorVar string, withContext bool) []string {
 	var lines []string
-	fieldAccess := fmt.Sprintf("%s.%s", alias, prop.GoName)
 
 	// Iterate over map values
-	lines = append(lines, fmt.Sprintf("for k, v := range %s {", fieldAccess))
+	lines = append(lines, mapLoopLines(fieldAccess, "n"+prop.GoName, withContext)...)
 
 	// If values have validation tags, use validator.Var()
 	if len(prop.Schema.AdditionalPropertiesType.Constraints.ValidationTags) > 0 {
@@ -446,6 +801,8 @@ func generateMapPropertyValidation(alias string, prop Property, validatorVar str
 		lines = append(lines, fmt.Sprintf("    if err := %s.Var(v, \"%s\"); err != nil {", validatorVar, tags))
 		lines = append(lines, fmt.Sprintf("        errors = errors.Append(fmt.Sprintf(\"%s[%%s]\", k), err)", prop.GoName))
 		lines = append(lines, "    }")
+	} else if withContext {
+		lines = append(lines, validateContextLines("v", fmt.Sprintf("fmt.Sprintf(\"%s[%%s]\", k)", prop.GoName))...)
 	} else {
 		// Otherwise, try to call Validate() method (for RefTypes, structs, unions)
 		lines = append(lines, "    if validator, ok := any(v).(runtime.Validator); ok {")
@@ -459,6 +816,40 @@ func generateMapPropertyValidation(alias string, prop Property, validatorVar str
 	return lines
 }
 
+// generateInlinedPropertyValidation generates the validation of a property holding an inlined component schema:
+// its own validation tags with Var(), then the fields of the anonymous struct with Struct().
+func generateInlinedPropertyValidation(alias string, prop Property, validatorVar string) []string {
+	var lines []string
+	fieldAccess := fmt.Sprintf("%s.%s", alias, prop.GoName)
+	if len(prop.Constraints.ValidationTags) > 0 && !prop.IsOptionalType() {
+		tags := strings.Join(prop.Constraints.ValidationTags, ",")
+		lines = append(lines, fmt.Sprintf("if err := %s.Var(%s, \"%s\"); err != nil {", validatorVar, fieldAccess, tags))
+		lines = append(lines, fmt.Sprintf("    errors = errors.Append(%q, err)", prop.validationField()))
+		lines = append(lines, "}")
+	}
+
+	structLines := func(value string) []string {
+		return []string{
+			fmt.Sprintf("if err := %s.Struct(%s); err != nil {", validatorVar, value),
+			fmt.Sprintf("    errors = errors.Append(%q, runtime.ConvertValidatorError(err))", prop.validationField()),
+			"}",
+		}
+	}
+	switch {
+	case prop.IsOptionalType():
+		lines = append(lines, fmt.Sprintf("if v, ok := %s.Get(); ok {", fieldAccess))
+		lines = append(lines, structLines("v")...)
+		lines = append(lines, "}")
+	case prop.IsPointerType():
+		lines = append(lines, fmt.Sprintf("if %s != nil {", fieldAccess))
+		lines = append(lines, structLines(fieldAccess)...)
+		lines = append(lines, "}")
+	default:
+		lines = append(lines, structLines(fieldAccess)...)
+	}
+	return lines
+}
+
 // Helper predicates
 
 // isStructType checks if this schema represents a struct type
@@ -470,7 +861,8 @@ func (s GoSchema) isStructType() bool {
 // canUseSimpleStructValidation checks if we can use the optimized validator.Struct() approach
 func (s GoSchema) canUseSimpleStructValidation() bool {
 	typeDecl := s.TypeDecl()
-	if !strings.HasPrefix(typeDecl, "struct") || len(s.Properties) == 0 || s.ContainsUnions() {
+	if !strings.HasPrefix(typeDecl, "struct") || len(s.Properties) == 0 || s.ContainsUnions() ||
+		s.hasOptionalValidationTags() || s.hasMultipleOfProperties() || len(s.DependentRequired) > 0 {
 		return false
 	}
 	// Check if any property needs custom validation
@@ -514,5 +906,27 @@ func (s GoSchema) hasCustomValidation() bool {
 			return true
 		}
 	}
+	return s.hasOptionalValidationTags() || s.hasMultipleOfProperties() || len(s.DependentRequired) > 0
+}
+
+// hasMultipleOfProperties checks if any numeric property has a multipleOf constraint,
+// which validator.Struct() can't check as there is no validation tag for it.
+func (s GoSchema) hasMultipleOfProperties() bool {
+	for _, prop := range s.Properties {
+		if !prop.needsCustomValidation() && prop.hasMultipleOf() {
+			return true
+		}
+	}
+	return false
+}
+
+// hasOptionalValidationTags checks if any runtime.Optional property has validation tags, or wraps an inlined
+// anonymous struct, which validator.Struct() can't apply to the wrapped value.
+func (s GoSchema) hasOptionalValidationTags() bool {
+	for _, prop := range s.Properties {
+		if prop.IsOptionalType() && (len(prop.Constraints.ValidationTags) > 0 || prop.Schema.Inlined) {
+			return true
+		}
+	}
 	return false
 }
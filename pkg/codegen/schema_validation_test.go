@@ -150,6 +150,67 @@ func TestGoSchema_ValidateDecl_ArrayWithMinAndMaxItems(t *testing.T) {
 	assertCodeEqual(t, expected, result)
 }
 
+func TestGoSchema_ValidateDecl_ArrayWithUniqueItems(t *testing.T) {
+	schema := GoSchema{
+		GoType: "[]string",
+		ArrayType: &GoSchema{
+			GoType: "string",
+		},
+		Constraints: Constraints{
+			UniqueItems: ptr(true),
+		},
+	}
+
+	result := schema.ValidateDecl("p", "validate")
+	expected := `
+		if first, second, ok := runtime.DuplicateItem(p); ok {
+			return runtime.NewValidationError("Array", fmt.Sprintf("must have unique items, [%d] duplicates [%d]", second, first))
+		}
+		return nil
+	`
+	assertCodeEqual(t, expected, result)
+}
+
+func TestGoSchema_ValidateDecl_ArrayWithUniqueRefTypeItems(t *testing.T) {
+	minItems := int64(1)
+	schema := GoSchema{
+		GoType: "[]Payment",
+		ArrayType: &GoSchema{
+			RefType: "Payment",
+		},
+		Constraints: Constraints{
+			MinItems:    &minItems,
+			UniqueItems: ptr(true),
+		},
+	}
+
+	result := schema.ValidateDecl("p", "validate")
+	expected := `
+		if p == nil {
+			return runtime.NewValidationError("Array", "must have at least 1 items, got 0")
+		}
+		var errors runtime.ValidationErrors
+		if len(p) < 1 {
+			errors = errors.Add("Array", fmt.Sprintf("must have at least 1 items, got %d", len(p)))
+		}
+		if first, second, ok := runtime.DuplicateItemJSON(p); ok {
+			errors = errors.Add("Array", fmt.Sprintf("must have unique items, [%d] duplicates [%d]", second, first))
+		}
+		for i, item := range p {
+			if v, ok := any(item).(runtime.Validator); ok {
+				if err := v.Validate(); err != nil {
+					errors = errors.Append(fmt.Sprintf("[%d]", i), err)
+				}
+			}
+		}
+		if len(errors) == 0 {
+			return nil
+		}
+		return errors
+	`
+	assertCodeEqual(t, expected, result)
+}
+
 func TestGoSchema_ValidateDecl_NullableArrayWithConstraints(t *testing.T) {
 	minItems := int64(1)
 	nullable := true
@@ -360,6 +421,64 @@ func TestGoSchema_ValidateDecl_MapWithMinAndMaxProperties(t *testing.T) {
 	assertCodeEqual(t, expected, result)
 }
 
+func TestGoSchema_ValidateDecl_ArrayWithValidationMessage(t *testing.T) {
+	minItems := int64(1)
+	maxItems := int64(5)
+	schema := GoSchema{
+		GoType: "[]string",
+		ArrayType: &GoSchema{
+			GoType: "string",
+		},
+		Constraints: Constraints{
+			MinItems:          &minItems,
+			MaxItems:          &maxItems,
+			ValidationMessage: "pick between 1 and 5 tags, %d is the limit, not 100%%",
+		},
+	}
+
+	result := schema.ValidateDecl("p", "validate")
+	expected := `
+		if p == nil {
+			return runtime.NewValidationError("Array", "pick between 1 and 5 tags, 1 is the limit, not 100%")
+		}
+		var errors runtime.ValidationErrors
+		if len(p) < 1 {
+			errors = errors.Add("Array", "pick between 1 and 5 tags, 1 is the limit, not 100%")
+		}
+		if len(p) > 5 {
+			errors = errors.Add("Array", "pick between 1 and 5 tags, 5 is the limit, not 100%")
+		}
+		if len(errors) == 0 {
+			return nil
+		}
+		return errors
+	`
+	assertCodeEqual(t, expected, result)
+}
+
+func TestGoSchema_ValidateDecl_MapWithValidationMessage(t *testing.T) {
+	minProps := int64(2)
+	schema := GoSchema{
+		GoType: "map[string]any",
+		Constraints: Constraints{
+			MinProperties:     &minProps,
+			ValidationMessage: `at least %s "labels" are needed`,
+		},
+	}
+
+	result := schema.ValidateDecl("m", "validate")
+	expected := `
+		if m == nil {
+			return runtime.NewValidationError("Map", "at least 2 \"labels\" are needed")
+		}
+		if len(m) < 2 {
+			return runtime.NewValidationError("Map", "at least 2 \"labels\" are needed")
+		}
+		return nil
+	`
+	assertCodeEqual(t, expected, result)
+}
+
 func TestGoSchema_ValidateDecl_NullableMapWithConstraints(t *testing.T) {
 	minProps := int64(2)
 	nullable := true
@@ -447,6 +566,17 @@ func TestGoSchema_ValidateDecl_EmptyStruct(t *testing.T) {
 	assertCodeEqual(t, expected, result)
 }
 
+func TestGoSchema_ValidateDecl_FreeFormMap(t *testing.T) {
+	schema := GoSchema{
+		GoType:         "map[string]any",
+		DefineViaAlias: true,
+	}
+
+	result := schema.ValidateDecl("m", "validate")
+	expected := `return nil`
+	assertCodeEqual(t, expected, result)
+}
+
 func TestGoSchema_ValidateDecl_StructWithValidatorStruct(t *testing.T) {
 	schema := GoSchema{
 		GoType: "struct { Name string `json:\"name\" validate:\"required\"` }",
@@ -1067,6 +1197,91 @@ func TestGoSchema_ValidateDecl_IntegerWithValidationTags(t *testing.T) {
 	assertCodeEqual(t, expected, result)
 }
 
+func TestGoSchema_ValidateDecl_IntegerWithMultipleOf(t *testing.T) {
+	schema := GoSchema{
+		GoType: "int32",
+		Constraints: Constraints{
+			ValidationTags: []string{"gte=0"},
+			MultipleOf:     ptr(5.0),
+		},
+	}
+	if !schema.NeedsValidation() {
+		t.Error("Expected NeedsValidation() to return true for multipleOf")
+	}
+
+	result := schema.ValidateDecl("i", "schemaTypesValidate")
+	expected := `
+		if err := schemaTypesValidate.Var(i, "gte=0"); err != nil {
+			return err
+		}
+		if i%5 != 0 {
+			return runtime.NewValidationError("", "must be a multiple of 5")
+		}
+		return nil
+	`
+	assertCodeEqual(t, expected, result)
+}
+
+func TestGoSchema_ValidateDecl_FloatWithMultipleOf(t *testing.T) {
+	schema := GoSchema{
+		GoType: "float64",
+		Constraints: Constraints{
+			MultipleOf: ptr(0.25),
+		},
+	}
+	if !schema.NeedsValidation() {
+		t.Error("Expected NeedsValidation() to return true for multipleOf")
+	}
+
+	result := schema.ValidateDecl("f", "schemaTypesValidate")
+	expected := `
+		if !runtime.IsMultipleOf(f, 0.25) {
+			return runtime.NewValidationError("", "must be a multiple of 0.25")
+		}
+		return nil
+	`
+	assertCodeEqual(t, expected, result)
+}
+
+func TestGoSchema_ValidateDecl_StructWithMultipleOfProperties(t *testing.T) {
+	schema := GoSchema{
+		GoType: "struct { Count int; Ratio *float32 }",
+		Properties: []Property{
+			{
+				GoName:      "Count",
+				Schema:      GoSchema{GoType: "int"},
+				Constraints: Constraints{MultipleOf: ptr(2.0)},
+			},
+			{
+				GoName:      "Ratio",
+				Schema:      GoSchema{GoType: "float32"},
+				Constraints: Constraints{Nullable: ptr(true), MultipleOf: ptr(0.1)},
+			},
+		},
+	}
+	if !schema.NeedsValidation() {
+		t.Error("Expected NeedsValidation() to return true for multipleOf")
+	}
+
+	result := schema.ValidateDecl("s", "schemaTypesValidate")
+	expected := `
+		var errors runtime.ValidationErrors
+		if s.Count%2 != 0 {
+			errors = errors.Add("Count", "must be a multiple of 2")
+		}
+		if s.Ratio != nil {
+			if !runtime.IsMultipleOf(*s.Ratio, 0.1) {
+				errors = errors.Add("Ratio", "must be a multiple of 0.1")
+			}
+		}
+		if len(errors) == 0 {
+			return nil
+		}
+		return errors
+	`
+	assertCodeEqual(t, expected, result)
+}
+
 // TestGoSchema_NeedsValidation_StructWithArrayOfCustomTypes tests that a struct
 // with only array properties whose item types need validation correctly returns
 // true for NeedsValidation(). This is a regression test for the bug where
@@ -1239,3 +1454,44 @@ func TestGoSchema_ValidateDecl_StructWithMapOfCustomTypes(t *testing.T) {
 	`
 	assertCodeEqual(t, expected, result)
 }
+
+func TestGoSchema_ValidateDecl_StructWithUnionField(t *testing.T) {
+	// Simulate the struct of an object with anyOf, the union being injected as a field without a JSON key
+	schema := GoSchema{
+		GoType: "struct { Name string; Account_AnyOf *Account_AnyOf }",
+		Properties: []Property{
+			{
+				GoName:        "Name",
+				JsonFieldName: "name",
+				Schema:        GoSchema{RefType: "Name"},
+			},
+			{
+				GoName:      "Account_AnyOf",
+				Schema:      GoSchema{RefType: "Account_AnyOf"},
+				Constraints: Constraints{Nullable: ptr(true)},
+			},
+		},
+	}
+
+	result := schema.ValidateDecl("a", "typesValidator")
+	expected := `
+		var errors runtime.ValidationErrors
+		if v, ok := any(a.Name).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Name", err)
+			}
+		}
+		if a.Account_AnyOf != nil {
+			if v, ok := any(a.Account_AnyOf).(runtime.Validator); ok {
+				if err := v.Validate(); err != nil {
+					errors = errors.Append("", err)
+				}
+			}
+		}
+		if len(errors) == 0 {
+			return nil
+		}
+		return errors
+	`
+	assertCodeEqual(t, expected, result)
+}
@@ -0,0 +1,358 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+// subPackagePrefix is the prefix used to identify the files of the sub-packages in GeneratedCode.
+const subPackagePrefix = "package:"
+
+// IsSubPackageFile returns true if the file name indicates a file of a sub-package of the generated package.
+func IsSubPackageFile(name string) bool {
+	return strings.HasPrefix(name, subPackagePrefix)
+}
+
+// SubPackageFileName returns the path of the file, relative to the directory of the generated package,
+// without the sub-package prefix, e.g. types/types.
+func SubPackageFileName(name string) string {
+	return strings.TrimPrefix(name, subPackagePrefix)
+}
+
+// specLocationPackages are the files of the spec locations moved to their own package, in order of precedence
+// for naming packages merged because of mutual references. Enums go along with the component schemas.
+var specLocationPackages = []string{
+	getSpecLocationOutName(SpecLocationSchema),
+	getSpecLocationOutName(SpecLocationUnion),
+	getSpecLocationOutName(SpecLocationPath),
+	getSpecLocationOutName(SpecLocationQuery),
+	getSpecLocationOutName(SpecLocationHeader),
+	getSpecLocationOutName(SpecLocationBody),
+	getSpecLocationOutName(SpecLocationResponse),
+}
+
+// sharedValidatorFile is the file declaring typesValidator, copied into every package using it.
+const sharedValidatorFile = "common"
+
+// splitSpecLocationPackages moves the files of the spec locations into sub-packages of the generated package,
+// qualifying the references between packages with the imports of the sub-packages under importPath.
+// Spec locations referencing each other, which Go can't import both ways, share a package, and so do the spec locations
+// referencing unexported declarations of the root package or declaring methods on its types. The other files stay in the root package.
+func splitSpecLocationPackages(files GeneratedCode, importPath string, format func(string) (string, error)) (GeneratedCode, error) {
+	fset := token.NewFileSet()
+	parsed := map[string]*ast.File{}
+	var names []string
+	for name, src := range files {
+		if strings.Contains(name, ":") || strings.Contains(name, "/") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, name+".go", src, parser.ParseComments)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing %s: %w", name, err)
+		}
+		parsed[name] = f
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fileGroup := func(name string) string {
+		if name == "enums" {
+			return specLocationPackages[0]
+		}
+		if slices.Contains(specLocationPackages, name) {
+			return name
+		}
+		return ""
+	}
+
+	// declaredIn maps the top-level declarations to their file, aliasOf the type aliases to the aliased type
+	declaredIn := map[string]string{}
+	aliasOf := map[string]string{}
+	for _, name := range names {
+		for _, decl := range parsed[name].Decls {
+			for _, ident := range declaredNames(decl) {
+				if ident != "_" && ident != "init" {
+					declaredIn[ident] = name
+				}
+			}
+			for alias, target := range typeAliases(decl) {
+				aliasOf[alias] = target
+			}
+		}
+	}
+
+	// references between groups, the root group being ""
+	groups := append([]string{""}, specLocationPackages...)
+	refs := map[string]map[string]bool{}
+	addRef := func(from, to string) {
+		if refs[from] == nil {
+			refs[from] = map[string]bool{}
+		}
+		refs[from][to] = true
+	}
+	usesValidator := map[string]bool{}
+	for _, name := range names {
+		from := fileGroup(name)
+		for _, ident := range parsed[name].Unresolved {
+			declFile, ok := declaredIn[ident.Name]
+			if !ok {
+				continue
+			}
+			if declFile == sharedValidatorFile {
+				usesValidator[from] = true
+				continue
+			}
+			to := fileGroup(declFile)
+			if to == from {
+				continue
+			}
+			addRef(from, to)
+			if !ast.IsExported(ident.Name) {
+				addRef(to, from)
+			}
+		}
+		// methods can only be declared on the types of the package, aliases included
+		for _, recv := range receiverTypeNames(parsed[name]) {
+			for typeName := recv; typeName != ""; typeName = aliasOf[typeName] {
+				if declFile, ok := declaredIn[typeName]; ok && fileGroup(declFile) != from {
+					addRef(from, fileGroup(declFile))
+					addRef(fileGroup(declFile), from)
+				}
+				if typeName == aliasOf[typeName] {
+					break
+				}
+			}
+		}
+	}
+
+	// merge the groups reaching each other into the package of the first one, the root package first
+	reaches := func(from, to string) bool {
+		seen := map[string]bool{from: true}
+		queue := []string{from}
+		for len(queue) > 0 {
+			g := queue[0]
+			queue = queue[1:]
+			for next := range refs[g] {
+				if next == to {
+					return true
+				}
+				if !seen[next] {
+					seen[next] = true
+					queue = append(queue, next)
+				}
+			}
+		}
+		return false
+	}
+	pkgOf := map[string]string{}
+	for _, g := range groups {
+		pkgOf[g] = g
+		for _, other := range groups {
+			if other == g {
+				break
+			}
+			if reaches(g, other) && reaches(other, g) {
+				pkgOf[g] = pkgOf[other]
+				break
+			}
+		}
+	}
+	filePkg := func(name string) string {
+		return pkgOf[fileGroup(name)]
+	}
+
+	res := GeneratedCode{}
+	for name, src := range files {
+		if _, ok := parsed[name]; !ok {
+			res[name] = src
+		}
+	}
+
+	validatorPkgs := map[string]bool{}
+	for _, name := range names {
+		f := parsed[name]
+		pkg := filePkg(name)
+		if name == sharedValidatorFile {
+			continue
+		}
+		if usesValidator[fileGroup(name)] {
+			validatorPkgs[pkg] = true
+		}
+
+		rootName := f.Name.Name
+		qualifiers := map[string]string{}
+		rewritten := false
+		for _, ident := range f.Unresolved {
+			declFile, ok := declaredIn[ident.Name]
+			if !ok || declFile == sharedValidatorFile {
+				continue
+			}
+			declPkg := filePkg(declFile)
+			if declPkg == pkg {
+				continue
+			}
+
+			qualifier, ok := qualifiers[declPkg]
+			if !ok {
+				importName, importPkg := declPkg, path.Join(importPath, declPkg)
+				if declPkg == "" {
+					importName, importPkg = rootName, importPath
+				}
+				qualifier = importName
+				if hasIdent(f, importName) {
+					qualifier = importName + "pkg"
+					astutil.AddNamedImport(fset, f, qualifier, importPkg)
+				} else {
+					astutil.AddImport(fset, f, importPkg)
+				}
+				qualifiers[declPkg] = qualifier
+			}
+			ident.Name = qualifier + "." + ident.Name
+			rewritten = true
+		}
+
+		if pkg == "" && !rewritten {
+			res[name] = files[name]
+			continue
+		}
+		if pkg != "" {
+			f.Name.Name = pkg
+		}
+
+		var buf bytes.Buffer
+		if err := printer.Fprint(&buf, fset, f); err != nil {
+			return nil, fmt.Errorf("error printing %s: %w", name, err)
+		}
+		code, err := format(buf.String())
+		if err != nil {
+			return nil, fmt.Errorf("error formatting %s: %w", name, err)
+		}
+		if pkg == "" {
+			res[name] = code
+		} else {
+			res[subPackagePrefix+pkg+"/"+name] = code
+		}
+	}
+
+	if src, ok := files[sharedValidatorFile]; ok {
+		for pkg := range validatorPkgs {
+			if pkg == "" {
+				continue
+			}
+			f := parsed[sharedValidatorFile]
+			f.Name.Name = pkg
+			var buf bytes.Buffer
+			if err := printer.Fprint(&buf, fset, f); err != nil {
+				return nil, fmt.Errorf("error printing %s: %w", sharedValidatorFile, err)
+			}
+			code, err := format(buf.String())
+			if err != nil {
+				return nil, fmt.Errorf("error formatting %s: %w", sharedValidatorFile, err)
+			}
+			res[subPackagePrefix+pkg+"/"+sharedValidatorFile] = code
+		}
+		res[sharedValidatorFile] = src
+	}
+
+	return res, nil
+}
+
+// declaredNames returns the names of the top-level declarations of decl, methods excluded.
+func declaredNames(decl ast.Decl) []string {
+	var res []string
+	switch d := decl.(type) {
+	case *ast.FuncDecl:
+		if d.Recv == nil {
+			res = append(res, d.Name.Name)
+		}
+	case *ast.GenDecl:
+		for _, spec := range d.Specs {
+			switch s := spec.(type) {
+			case *ast.TypeSpec:
+				res = append(res, s.Name.Name)
+			case *ast.ValueSpec:
+				for _, n := range s.Names {
+					res = append(res, n.Name)
+				}
+			}
+		}
+	}
+	return res
+}
+
+// typeAliases returns the type aliases of decl with the name of their aliased type, if it's a named type.
+func typeAliases(decl ast.Decl) map[string]string {
+	res := map[string]string{}
+	gen, ok := decl.(*ast.GenDecl)
+	if !ok {
+		return res
+	}
+	for _, spec := range gen.Specs {
+		ts, ok := spec.(*ast.TypeSpec)
+		if !ok || !ts.Assign.IsValid() {
+			continue
+		}
+		typ := ts.Type
+		if star, ok := typ.(*ast.StarExpr); ok {
+			typ = star.X
+		}
+		if ident, ok := typ.(*ast.Ident); ok {
+			res[ts.Name.Name] = ident.Name
+		}
+	}
+	return res
+}
+
+// receiverTypeNames returns the receiver types of the methods of f.
+func receiverTypeNames(f *ast.File) []string {
+	var res []string
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || len(fn.Recv.List) == 0 {
+			continue
+		}
+		typ := fn.Recv.List[0].Type
+		if star, ok := typ.(*ast.StarExpr); ok {
+			typ = star.X
+		}
+		if idx, ok := typ.(*ast.IndexExpr); ok {
+			typ = idx.X
+		}
+		if ident, ok := typ.(*ast.Ident); ok {
+			res = append(res, ident.Name)
+		}
+	}
+	return res
+}
+
+// hasIdent returns true if f has an identifier with the name, which would shadow an import of that name.
+func hasIdent(f *ast.File, name string) bool {
+	found := false
+	ast.Inspect(f, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok && ident.Name == name && n != f.Name {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
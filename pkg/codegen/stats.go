@@ -0,0 +1,166 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"sort"
+)
+
+// TypeStats holds the size metrics of a generated type.
+type TypeStats struct {
+	Name         string
+	SpecLocation SpecLocation
+
+	// Fields is the number of fields declared by the type itself.
+	Fields int
+
+	// TotalFields is the number of fields of the type and of all the types reachable from it,
+	// each type being counted once.
+	TotalFields int
+
+	// Depth is the maximum nesting of objects, 1 for an object with scalar fields only.
+	// Arrays and maps do not add a level.
+	Depth int
+
+	// HasUnions is true if the type is or contains a oneOf/anyOf union.
+	HasUnions bool
+}
+
+// AnalyzeTypes returns the size metrics of the generated types, sorted by name.
+// It does not change the generated code.
+func AnalyzeTypes(ctx *ParseContext) []TypeStats {
+	if ctx == nil {
+		return nil
+	}
+
+	typeDefs := parseContextTypeDefinitions(ctx)
+	byName := make(map[string]TypeDefinition, len(typeDefs))
+	for _, td := range typeDefs {
+		if _, found := byName[td.Name]; !found {
+			byName[td.Name] = td
+		}
+	}
+
+	res := make([]TypeStats, 0, len(byName))
+	for name, td := range byName {
+		a := &typeAnalyzer{types: byName, visited: map[string]bool{name: true}}
+		a.walk(td.Schema)
+
+		res = append(res, TypeStats{
+			Name:         name,
+			SpecLocation: td.SpecLocation,
+			Fields:       len(td.Schema.Properties),
+			TotalFields:  a.fields,
+			Depth:        schemaDepth(td.Schema, byName, map[string]bool{name: true}),
+			HasUnions:    a.unions,
+		})
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+
+	return res
+}
+
+// parseContextTypeDefinitions returns all the type definitions of the context, including unions.
+func parseContextTypeDefinitions(ctx *ParseContext) []TypeDefinition {
+	var res []TypeDefinition
+	for _, tds := range ctx.TypeDefinitions {
+		res = append(res, tds...)
+	}
+	return append(res, ctx.UnionTypes...)
+}
+
+// typeAnalyzer counts the fields and detects the unions reachable from a schema,
+// following references to other generated types once.
+type typeAnalyzer struct {
+	types   map[string]TypeDefinition
+	visited map[string]bool
+	fields  int
+	unions  bool
+}
+
+func (a *typeAnalyzer) walk(s GoSchema) {
+	if name, isRef := referencedTypeName(s, a.types); isRef {
+		td, found := a.types[name]
+		if !found || a.visited[name] {
+			return
+		}
+		a.visited[name] = true
+		a.walk(td.Schema)
+		return
+	}
+
+	if s.IsUnionWrapper || len(s.UnionElements) > 0 {
+		a.unions = true
+	}
+
+	a.fields += len(s.Properties)
+	for _, prop := range s.Properties {
+		a.walk(prop.Schema)
+	}
+	for _, elem := range s.UnionElements {
+		a.walk(elem.Schema)
+	}
+	if s.ArrayType != nil {
+		a.walk(*s.ArrayType)
+	}
+	if s.AdditionalPropertiesType != nil {
+		a.walk(*s.AdditionalPropertiesType)
+	}
+}
+
+// schemaDepth returns the object nesting depth of the schema.
+// Types in path are already being measured, so recursive references do not add levels.
+func schemaDepth(s GoSchema, types map[string]TypeDefinition, path map[string]bool) int {
+	if name, isRef := referencedTypeName(s, types); isRef {
+		td, found := types[name]
+		if !found || path[name] {
+			return 0
+		}
+		path[name] = true
+		defer delete(path, name)
+		return schemaDepth(td.Schema, types, path)
+	}
+
+	depth := 0
+	for _, prop := range s.Properties {
+		depth = max(depth, schemaDepth(prop.Schema, types, path))
+	}
+	for _, elem := range s.UnionElements {
+		depth = max(depth, schemaDepth(elem.Schema, types, path))
+	}
+	if len(s.Properties) > 0 {
+		depth++
+	}
+
+	if s.ArrayType != nil {
+		depth = max(depth, schemaDepth(*s.ArrayType, types, path))
+	}
+	if s.AdditionalPropertiesType != nil {
+		depth = max(depth, schemaDepth(*s.AdditionalPropertiesType, types, path))
+	}
+
+	return depth
+}
+
+// referencedTypeName returns the name of the type the schema refers to,
+// either through a reference or as an alias of another generated type.
+func referencedTypeName(s GoSchema, types map[string]TypeDefinition) (string, bool) {
+	if s.IsRef() {
+		return s.RefType, true
+	}
+	if _, found := types[s.GoType]; found && s.DefineViaAlias {
+		return s.GoType, true
+	}
+	return "", false
+}
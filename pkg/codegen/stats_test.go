@@ -0,0 +1,92 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzeTypes(t *testing.T) {
+	statsByName := func(t *testing.T, spec string) map[string]TypeStats {
+		ctx, errs := CreateParseContext([]byte(spec), Configuration{PackageName: "stats", SkipPrune: true})
+		require.Nil(t, errs)
+
+		res := map[string]TypeStats{}
+		for _, s := range AnalyzeTypes(ctx) {
+			res[s.Name] = s
+		}
+		return res
+	}
+
+	t.Run("nested objects", func(t *testing.T) {
+		stats := statsByName(t, readTestdata(t, "lint.yml"))
+
+		assert.Equal(t, TypeStats{Name: "Customer", SpecLocation: SpecLocationSchema, Fields: 1, TotalFields: 1, Depth: 1}, stats["Customer"])
+		assert.Equal(t, TypeStats{Name: "Order", SpecLocation: SpecLocationSchema, Fields: 3, TotalFields: 5, Depth: 2}, stats["Order"])
+		assert.Equal(t, TypeStats{Name: "CreateOrderResponse", SpecLocation: SpecLocationResponse, TotalFields: 5, Depth: 2}, stats["CreateOrderResponse"])
+	})
+
+	t.Run("unions and recursion", func(t *testing.T) {
+		stats := statsByName(t, `
+openapi: 3.0.0
+info:
+  title: Stats
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Node:
+      type: object
+      properties:
+        name:
+          type: string
+        children:
+          type: array
+          items:
+            $ref: '#/components/schemas/Node'
+    Pet:
+      type: object
+      properties:
+        kind:
+          oneOf:
+            - $ref: '#/components/schemas/Cat'
+            - $ref: '#/components/schemas/Dog'
+    Cat:
+      type: object
+      properties:
+        lives:
+          type: integer
+    Dog:
+      type: object
+      properties:
+        breed:
+          type: string
+        color:
+          type: string
+`)
+
+		assert.Equal(t, TypeStats{Name: "Node", SpecLocation: SpecLocationSchema, Fields: 2, TotalFields: 2, Depth: 1}, stats["Node"])
+
+		// the union is generated as the Pet_Kind wrapper type, adding a field and a level
+		pet := stats["Pet"]
+		assert.True(t, pet.HasUnions)
+		assert.Equal(t, 5, pet.TotalFields)
+		assert.Equal(t, 3, pet.Depth)
+		assert.False(t, stats["Cat"].HasUnions)
+	})
+
+	t.Run("nil context", func(t *testing.T) {
+		assert.Nil(t, AnalyzeTypes(nil))
+	})
+}
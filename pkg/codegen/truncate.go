@@ -0,0 +1,184 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"fmt"
+	"strings"
+)
+
+// truncatableTypes returns the names of the struct types that get a Truncate method:
+// those with string fields constrained by maxLength, directly or through the fields of nested types.
+func truncatableTypes(types map[string]GoSchema) map[string]bool {
+	res := make(map[string]bool)
+
+	// A type with fields of truncatable types is truncatable too, so repeat until nothing changes.
+	for changed := true; changed; {
+		changed = false
+		for name, s := range types {
+			if !res[name] && s.TruncateDecl("x", res) != "" {
+				res[name] = true
+				changed = true
+			}
+		}
+	}
+	return res
+}
+
+// canTruncate returns true if a Truncate method can be generated for the schema,
+// a struct without a field named Truncate.
+func canTruncate(s GoSchema) bool {
+	if !s.isStructType() {
+		return false
+	}
+	for _, p := range s.Properties {
+		if p.GoName == "Truncate" {
+			return false
+		}
+	}
+	return true
+}
+
+// truncateStringLength returns the maxLength of a string property, -1 if it isn't one or has none.
+func truncateStringLength(p Property) int64 {
+	if p.Constraints.MaxLength == nil || p.Schema.ArrayType != nil || p.Schema.AdditionalPropertiesType != nil ||
+		len(p.Schema.Properties) > 0 || len(p.Schema.UnionElements) > 0 {
+		return -1
+	}
+	return *p.Constraints.MaxLength
+}
+
+// truncateItemLength returns the maxLength of the string items of an array property, -1 if they have none.
+func truncateItemLength(p Property) int64 {
+	item := p.Schema.ArrayType
+	if item.Constraints.MaxLength == nil || item.ArrayType != nil || item.AdditionalPropertiesType != nil ||
+		len(item.Properties) > 0 || len(item.UnionElements) > 0 || strings.HasPrefix(item.TypeDecl(), "*") {
+		return -1
+	}
+	return *item.Constraints.MaxLength
+}
+
+// truncateNestedType returns the name of the type referenced by the schema.
+func truncateNestedType(s GoSchema) string {
+	if s.RefType != "" {
+		return s.RefType
+	}
+	return strings.TrimPrefix(s.GoType, "*")
+}
+
+// truncateExpr returns the expression clamping the value of a string type to maxLength.
+func truncateExpr(typeDecl, value string, maxLength int64) string {
+	if typeDecl == "string" {
+		return fmt.Sprintf("runtime.TruncateString(%s, %d)", value, maxLength)
+	}
+	return fmt.Sprintf("%s(runtime.TruncateString(string(%s), %d))", typeDecl, value, maxLength)
+}
+
+// TruncateDecl generates the body of the Truncate method of a struct type, clamping its string fields
+// to their maxLength and truncating the fields of the truncatable nested types.
+// It returns an empty string if there is nothing to truncate.
+func (s GoSchema) TruncateDecl(alias string, truncatable map[string]bool) string {
+	if !canTruncate(s) {
+		return ""
+	}
+
+	var lines []string
+	for _, p := range s.Properties {
+		field := alias + "." + p.GoName
+		typeDecl := strings.TrimPrefix(p.Schema.TypeDecl(), "*")
+
+		if maxLength := truncateStringLength(p); maxLength >= 0 {
+			switch {
+			case p.IsOptionalType():
+				lines = append(lines,
+					fmt.Sprintf("if val, ok := %s.Get(); ok {", field),
+					fmt.Sprintf("%s.Set(%s)", field, truncateExpr(typeDecl, "val", maxLength)),
+					"}")
+			case p.IsPointerType() || strings.HasPrefix(p.Schema.TypeDecl(), "*"):
+				lines = append(lines,
+					fmt.Sprintf("if %s != nil {", field),
+					fmt.Sprintf("val := %s", truncateExpr(typeDecl, "*"+field, maxLength)),
+					fmt.Sprintf("%s = &val", field),
+					"}")
+			default:
+				lines = append(lines, fmt.Sprintf("%s = %s", field, truncateExpr(typeDecl, field, maxLength)))
+			}
+			continue
+		}
+
+		if p.Schema.ArrayType != nil {
+			if p.IsOptionalType() {
+				continue
+			}
+			item := p.Schema.ArrayType
+			if maxLength := truncateItemLength(p); maxLength >= 0 {
+				lines = append(lines,
+					fmt.Sprintf("for idx := range %s {", field),
+					fmt.Sprintf("%s[idx] = %s", field, truncateExpr(item.TypeDecl(), field+"[idx]", maxLength)),
+					"}")
+			} else if truncatable[truncateNestedType(*item)] {
+				if strings.HasPrefix(item.TypeDecl(), "*") {
+					lines = append(lines,
+						fmt.Sprintf("for _, elem := range %s {", field),
+						"if elem != nil {",
+						"elem.Truncate()",
+						"}",
+						"}")
+				} else {
+					lines = append(lines,
+						fmt.Sprintf("for idx := range %s {", field),
+						fmt.Sprintf("%s[idx].Truncate()", field),
+						"}")
+				}
+			}
+			continue
+		}
+
+		if p.Schema.Inlined {
+			// the fields of the anonymous struct of an inlined component schema are clamped in place
+			decl := p.Schema.TruncateDecl(field, truncatable)
+			switch {
+			case decl == "":
+			case p.IsOptionalType():
+				lines = append(lines,
+					fmt.Sprintf("if inlined, ok := %s.Get(); ok {", field),
+					p.Schema.TruncateDecl("inlined", truncatable),
+					fmt.Sprintf("%s.Set(inlined)", field),
+					"}")
+			case p.IsPointerType():
+				lines = append(lines, fmt.Sprintf("if %s != nil {", field), decl, "}")
+			default:
+				lines = append(lines, decl)
+			}
+			continue
+		}
+
+		if !truncatable[truncateNestedType(p.Schema)] {
+			continue
+		}
+		switch {
+		case p.IsOptionalType():
+			lines = append(lines,
+				fmt.Sprintf("if val, ok := %s.Get(); ok {", field),
+				"val.Truncate()",
+				fmt.Sprintf("%s.Set(val)", field),
+				"}")
+		case p.IsPointerType() || strings.HasPrefix(p.Schema.TypeDecl(), "*"):
+			lines = append(lines,
+				fmt.Sprintf("if %s != nil {", field),
+				fmt.Sprintf("%s.Truncate()", field),
+				"}")
+		default:
+			lines = append(lines, fmt.Sprintf("%s.Truncate()", field))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -34,15 +34,23 @@ type TypeTracker struct {
 	// needsErrorMethod tracks which types need an Error() method generated.
 	// This is used for error response types that must implement the error interface.
 	needsErrorMethod map[string]bool
+
+	// mergePatches maps a type name to the name of its merge patch type.
+	mergePatches map[string]string
+
+	// jsonPatchBuilders maps a type name to the name of its JSON Patch builder.
+	jsonPatchBuilders map[string]string
 }
 
 // newTypeTracker creates a new TypeTracker.
 func newTypeTracker() *TypeTracker {
 	return &TypeTracker{
-		byName:           make(map[string]*TypeDefinition),
-		byRef:            make(map[string]string),
-		counters:         make(map[string]int),
-		needsErrorMethod: make(map[string]bool),
+		byName:            make(map[string]*TypeDefinition),
+		byRef:             make(map[string]string),
+		counters:          make(map[string]int),
+		needsErrorMethod:  make(map[string]bool),
+		mergePatches:      make(map[string]string),
+		jsonPatchBuilders: make(map[string]string),
 	}
 }
 
@@ -42,6 +42,14 @@ type TypeDefinition struct {
 	SpecLocation     SpecLocation
 	NeedsMarshaler   bool
 	HasSensitiveData bool
+
+	// MergePatchOf is the name of the type patched by this JSON Merge Patch type.
+	MergePatchOf string
+
+	// JSONPatchOf is the name of the type patched by this JSON Patch builder,
+	// JSONPatchPaths the Go statements returning its *runtime.JSONPatchPath.
+	JSONPatchOf    string
+	JSONPatchPaths string
 }
 
 func (t TypeDefinition) IsAlias() bool {
@@ -64,6 +72,40 @@ func (t TypeDefinition) GetErrorResponse(errTypes map[string]string, alias strin
 		return unknownRes
 	}
 
+	code, result := fieldAccessCode(fields, alias, unknownRes)
+	code = append(code, fmt.Sprintf("return %s", result))
+	return strings.Join(code, "\n")
+}
+
+// GetFieldResolver generates the Resolve<Fields> method of the type, returning the value at the spec field path
+// and false if a nullable value or an array on the path is missing, like runtime.ResolveField but type-safe.
+// The path must resolve, see resolveFieldPath.
+func (t TypeDefinition) GetFieldResolver(path, alias string, typeSchemaMap map[string]GoSchema) string {
+	fields := resolveFieldPath(t.Name, path, t.Schema, typeSchemaMap)
+	if fields == nil {
+		return ""
+	}
+
+	last := fields[len(fields)-1]
+	resultType := last.goType
+	if last.isArrayIndex && last.arrayType != "" {
+		resultType = last.arrayType
+	}
+
+	name := "Resolve"
+	for _, f := range fields {
+		name += f.goName
+	}
+
+	code, result := fieldAccessCode(fields, alias, "var zero "+resultType+"\nreturn zero, false")
+	code = append(code, fmt.Sprintf("return %s, true", result))
+	return fmt.Sprintf("// %s returns the %s field of %s, false if a value on the path is missing.\nfunc (%s %s) %s() (%s, bool) {\n%s\n}",
+		name, path, t.Name, alias, t.Name, name, resultType, strings.Join(code, "\n"))
+}
+
+// fieldAccessCode generates the Go statements accessing the resolved fields from alias, running missing
+// when a nullable value or an array on the path is missing. It returns the statements and the variable of the value.
+func fieldAccessCode(fields []resolvedField, alias, missing string) ([]string, string) {
 	var (
 		code     []string
 		prevVar  = alias
@@ -77,8 +119,14 @@ func (t TypeDefinition) GetErrorResponse(errTypes map[string]string, alias strin
 
 		// For nullable non-array types, add nil check and dereference
 		// For arrays, we handle nil check in the array access section (via len check)
-		if entry.isNullable && !entry.isArray {
-			code = append(code, fmt.Sprintf("if %s == nil { %s }", varName, unknownRes))
+		if entry.isOptional {
+			varIndex++
+			valueVar := fmt.Sprintf("res%d", varIndex)
+			code = append(code, fmt.Sprintf("%s, ok := %s.Get()", valueVar, varName))
+			code = append(code, fmt.Sprintf("if !ok { %s }", missing))
+			prevVar = valueVar
+		} else if entry.isNullable && !entry.isArray {
+			code = append(code, fmt.Sprintf("if %s == nil { %s }", varName, missing))
 
 			// Prepare for next access with dereference
 			varIndex++
@@ -93,7 +141,7 @@ func (t TypeDefinition) GetErrorResponse(errTypes map[string]string, alias strin
 
 		// Handle array access
 		if entry.isArrayIndex {
-			code = append(code, fmt.Sprintf("if len(%s) == 0 { %s }", prevVar, unknownRes))
+			code = append(code, fmt.Sprintf("if len(%s) == 0 { %s }", prevVar, missing))
 
 			varName = fmt.Sprintf("res%d", varIndex)
 			code = append(code, fmt.Sprintf("%s := %s[0]", varName, prevVar))
@@ -102,8 +150,7 @@ func (t TypeDefinition) GetErrorResponse(errTypes map[string]string, alias strin
 		}
 	}
 
-	code = append(code, fmt.Sprintf("return %s", prevVar))
-	return strings.Join(code, "\n")
+	return code, prevVar
 }
 
 // GetErrorConstructor generates a Go constructor function for an error type.
@@ -142,6 +189,13 @@ func (t TypeDefinition) GetErrorConstructor(errTypes map[string]string, typeSche
 			} else {
 				innerExpr = fmt.Sprintf("[]%s{%s}", f.goType, innerExpr)
 			}
+		} else if f.isOptional {
+			// runtime.Optional field
+			if nextFieldName != "" {
+				innerExpr = fmt.Sprintf("runtime.NewOptional(%s{%s: %s})", f.goType, nextFieldName, innerExpr)
+			} else {
+				innerExpr = fmt.Sprintf("runtime.NewOptional(%s)", innerExpr)
+			}
 		} else if f.isNullable && !f.isArray {
 			// Pointer field - need to take address of struct literal or use runtime.Ptr for primitives
 			if nextFieldName != "" {
@@ -159,21 +213,21 @@ func (t TypeDefinition) GetErrorConstructor(errTypes map[string]string, typeSche
 		t.Name, t.Name, t.Name, fields[0].goName, innerExpr)
 }
 
-// errorPathSegment represents a parsed segment of an error mapping path.
-type errorPathSegment struct {
+// fieldPathSegment represents a parsed segment of a spec field path, e.g. of an error mapping.
+type fieldPathSegment struct {
 	propertyName string
 	isArrayIndex bool
 }
 
-// parseErrorPath parses an error mapping path like "data[].message[]" into segments.
-func parseErrorPath(path string) []errorPathSegment {
+// parseFieldPath parses a spec field path like "data[].message[]" into segments.
+func parseFieldPath(path string) []fieldPathSegment {
 	parts := strings.Split(path, ".")
-	segments := make([]errorPathSegment, 0, len(parts))
+	segments := make([]fieldPathSegment, 0, len(parts))
 
 	for _, part := range parts {
 		isArray := strings.HasSuffix(part, "[]")
 		propName := strings.TrimSuffix(part, "[]")
-		segments = append(segments, errorPathSegment{
+		segments = append(segments, fieldPathSegment{
 			propertyName: propName,
 			isArrayIndex: isArray,
 		})
@@ -182,27 +236,36 @@ func parseErrorPath(path string) []errorPathSegment {
 	return segments
 }
 
-// resolvedField contains all info needed for both Get and Set error response methods.
+// resolvedField contains all info needed for both Get and Set error response methods, and the field resolvers.
 type resolvedField struct {
 	goName        string
 	goType        string
 	containerType string // The type of the struct that contains this field (for nested struct literals)
 	isNullable    bool
+	isOptional    bool
 	isArray       bool
 	arrayType     string
 	isArrayIndex  bool
 	prop          Property
 }
 
-// resolveErrorPath traverses the schema following the error-mapping path and returns
-// resolved field info for each segment. Returns nil if path not found or invalid.
+// resolveErrorPath resolves the error-mapping path of the type, see resolveFieldPath.
 func resolveErrorPath(typeName string, errTypes map[string]string, schema GoSchema, typeSchemaMap map[string]GoSchema) []resolvedField {
 	path, ok := errTypes[typeName]
-	if !ok || path == "" {
+	if !ok {
 		return nil
 	}
+	return resolveFieldPath(typeName, path, schema, typeSchemaMap)
+}
 
-	segments := parseErrorPath(path)
+// resolveFieldPath traverses the schema of the type following the spec field path and returns
+// resolved field info for each segment. Returns nil if path not found or invalid.
+func resolveFieldPath(typeName, path string, schema GoSchema, typeSchemaMap map[string]GoSchema) []resolvedField {
+	if path == "" {
+		return nil
+	}
+
+	segments := parseFieldPath(path)
 	if len(segments) == 0 {
 		return nil
 	}
@@ -223,6 +286,7 @@ func resolveErrorPath(typeName string, errTypes map[string]string, schema GoSche
 					goType:        prop.Schema.GoType,
 					containerType: currentContainerType,
 					isNullable:    isNullable,
+					isOptional:    prop.IsOptionalType(),
 					isArray:       isArray,
 					isArrayIndex:  seg.isArrayIndex,
 					prop:          prop,
@@ -267,3 +331,29 @@ func resolveErrorPath(typeName string, errTypes map[string]string, schema GoSche
 
 	return fields
 }
+
+// checkFieldPaths returns an error if a type of the field paths isn't a defined type, or one of its paths doesn't resolve.
+func checkFieldPaths(fieldPaths map[string][]string, typeDefs map[SpecLocation][]TypeDefinition, typeSchemaMap map[string]GoSchema) error {
+	aliases := make(map[string]bool)
+	for _, tds := range typeDefs {
+		for _, td := range tds {
+			aliases[td.Name] = td.IsAlias()
+		}
+	}
+
+	for typeName, paths := range fieldPaths {
+		schema, ok := typeSchemaMap[typeName]
+		if !ok {
+			return fmt.Errorf("%w: unknown type %s", ErrFieldPathNotFound, typeName)
+		}
+		if aliases[typeName] {
+			return fmt.Errorf("%w: %s is a type alias, use the aliased type", ErrFieldPathNotFound, typeName)
+		}
+		for _, path := range paths {
+			if resolveFieldPath(typeName, path, schema, typeSchemaMap) == nil {
+				return fmt.Errorf("%w: %s in %s", ErrFieldPathNotFound, path, typeName)
+			}
+		}
+	}
+	return nil
+}
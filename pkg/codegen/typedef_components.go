@@ -25,13 +25,35 @@ import (
 // (potentially renamed) type name.
 func preRegisterSchemaNames(schemas *orderedmap.Map[string, *base.SchemaProxy], options ParseOptions) (map[string]string, error) {
 	schemaNames := make(map[string]string) // schemaName -> goTypeName
+	trimmed := make(map[string]string)     // trimmed goTypeName -> schemaName
 
 	for schemaName, schemaRef := range schemas.FromOldest() {
-		goTypeName, err := renameComponent(schemaNameToTypeName(schemaName), schemaRef)
+		if options.inlineRefs["#/components/schemas/"+schemaName] {
+			continue
+		}
+		typeName := schemaNameToTypeName(schemaName)
+		trimmedName := trimTypeNamePrefix(typeName, options.TrimTypePrefix)
+		goTypeName, err := renameComponent(trimmedName, schemaRef)
 		if err != nil {
 			return nil, fmt.Errorf("error making name for components/schemas/%s: %w", schemaName, err)
 		}
 
+		// Names shortened by TrimTypePrefix must not silently collide with another schema,
+		// a numeric suffix would defeat the purpose of trimming.
+		if other, ok := trimmed[goTypeName]; ok {
+			return nil, fmt.Errorf("%w: components/schemas/%s and components/schemas/%s are both named %s",
+				ErrTrimmedTypeNameCollision, other, schemaName, goTypeName)
+		}
+		if trimmedName != typeName && goTypeName == trimmedName {
+			for other, name := range schemaNames {
+				if name == goTypeName {
+					return nil, fmt.Errorf("%w: components/schemas/%s and components/schemas/%s are both named %s",
+						ErrTrimmedTypeNameCollision, other, schemaName, goTypeName)
+				}
+			}
+			trimmed[goTypeName] = schemaName
+		}
+
 		// Check if a type with the same name already exists.
 		// If it does, generate a unique name to avoid conflicts.
 		if options.typeTracker.Exists(goTypeName) {
@@ -54,6 +76,9 @@ func generateSchemaDefinitions(schemas *orderedmap.Map[string, *base.SchemaProxy
 	types := make([]TypeDefinition, 0)
 
 	for schemaName, schemaRef := range schemas.FromOldest() {
+		if options.inlineRefs["#/components/schemas/"+schemaName] {
+			continue
+		}
 		ref := schemaRef.GoLow().GetReference()
 		opts := options.WithReference(ref).WithPath([]string{schemaName})
 		goSchema, err := GenerateGoSchema(schemaRef, opts)
@@ -178,7 +203,7 @@ func getComponentsRequestBodies(bodies *orderedmap.Map[string, *v3high.RequestBo
 				continue
 			}
 
-			goTypeName, err := renameComponent(schemaNameToTypeName(requestBodyName), body.Schema)
+			goTypeName, err := renameComponent(trimTypeNamePrefix(schemaNameToTypeName(requestBodyName), options.TrimTypePrefix), body.Schema)
 			if err != nil {
 				return nil, fmt.Errorf("error making name for components/schemas/%s: %w", requestBodyName, err)
 			}
@@ -249,7 +274,7 @@ func getComponentResponses(responses *orderedmap.Map[string, *v3high.Response],
 				return nil, fmt.Errorf("error generating Go type for schema in response %s: %w", responseName, err)
 			}
 
-			goTypeName, err := renameComponent(schemaNameToTypeName(responseName), content.Schema)
+			goTypeName, err := renameComponent(trimTypeNamePrefix(schemaNameToTypeName(responseName), options.TrimTypePrefix), content.Schema)
 			if err != nil {
 				return nil, fmt.Errorf("error making name for components/responses/%s: %w", responseName, err)
 			}
@@ -28,13 +28,24 @@ type RequestParametersDefinition struct {
 	TypeDef  TypeDefinition
 }
 
+// HasArrayParams returns true if any of the parameters is an array.
+func (r RequestParametersDefinition) HasArrayParams() bool {
+	for _, p := range r.Params {
+		if p.Schema.ArrayType != nil {
+			return true
+		}
+	}
+	return false
+}
+
 // ParameterEncoding describes the encoding options for a request body.
 // @see https://spec.openapis.org/oas/v3.1.0#style-examples
 type ParameterEncoding struct {
-	Style         string
-	Explode       *bool
-	Required      bool
-	AllowReserved bool
+	Style           string
+	Explode         *bool
+	Required        bool
+	AllowReserved   bool
+	AllowEmptyValue bool
 }
 
 // ParameterDefinition is a struct that represents a parameter in an operation.
@@ -253,11 +264,16 @@ func describeOperationParameters(params []*v3high.Parameter, options ParseOption
 			// name as the type. $ref: "#/components/schemas/custom_type" becomes "CustomType".
 			// However, for deep path references (e.g., #/paths/.../parameters/1/schema),
 			// GenerateGoSchema has already created the type definition, so we don't override it.
-			goType, err := refPathToGoType(schemaRef)
-			if err != nil {
-				return nil, fmt.Errorf("error dereferencing (%s) for param (%s): %s", schemaRef, param.Name, err)
+			// The registered name accounts for x-go-name and trim-type-prefix.
+			if registeredName, found := options.typeTracker.LookupByRef(schemaRef); found {
+				pd.Schema.GoType = registeredName
+			} else {
+				goType, err := refPathToGoType(schemaRef)
+				if err != nil {
+					return nil, fmt.Errorf("error dereferencing (%s) for param (%s): %s", schemaRef, param.Name, err)
+				}
+				pd.Schema.GoType = goType
 			}
-			pd.Schema.GoType = goType
 		}
 		outParams = append(outParams, pd)
 	}
@@ -366,14 +382,16 @@ func generateParamsTypes(objectParams []ParameterDefinition, typeName string, op
 			Constraints: newConstraints(oapiSchema, ConstraintsContext{
 				required:     param.Required,
 				specLocation: specLocation,
+				decimalType:  options.DecimalType,
 			}),
 		})
 		imports = append(imports, pSchema)
 		encodings[param.ParamName] = ParameterEncoding{
-			Style:         param.Spec.Style,
-			Explode:       param.Spec.Explode,
-			Required:      param.Required,
-			AllowReserved: param.Spec.AllowReserved,
+			Style:           param.Spec.Style,
+			Explode:         param.Spec.Explode,
+			Required:        param.Required,
+			AllowReserved:   param.Spec.AllowReserved,
+			AllowEmptyValue: param.Spec.AllowEmptyValue,
 		}
 	}
 
@@ -11,11 +11,13 @@
 package codegen
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"go.yaml.in/yaml/v4"
 )
 
 // RequestBodyDefinition describes a request body.
@@ -35,6 +37,21 @@ type RequestBodyDefinition struct {
 	ContentType string
 	Default     bool
 	Encoding    map[string]RequestBodyEncoding
+
+	// JSONSchema is the self-contained JSON Schema of a JSON body.
+	// Only set when handler schema validation is enabled.
+	JSONSchema string
+
+	// Union is set when the body is a single oneOf or anyOf union, see BodyUnion.
+	Union *BodyUnion
+
+	// Example is the JSON encoded example of a JSON body, taken from the media type or its schema.
+	// Only set when MCP server generation is enabled.
+	Example string
+
+	// Examples are all the JSON encoded examples of a JSON body, in the order of mediaTypeExamples.
+	// Only set when fuzz seed generation is enabled.
+	Examples []string
 }
 
 // TypeDef returns the Go type definition for a request body
@@ -46,6 +63,11 @@ func (r RequestBodyDefinition) TypeDef(opID string) TypeDefinition {
 	}
 }
 
+// IsBinary returns true for binary bodies, e.g. application/octet-stream, held by an io.Reader.
+func (r RequestBodyDefinition) IsBinary() bool {
+	return r.NameTag == "Binary"
+}
+
 func (r RequestBodyDefinition) IsOptional() bool {
 	return r.Schema.Constraints.Required == nil || !*r.Schema.Constraints.Required
 }
@@ -99,6 +121,8 @@ func createBodyDefinition(operationID string, body *v3high.RequestBody, options
 		tag = "Text"
 	case contentType == "text/html":
 		tag = "HTML"
+	case isBinaryContent(contentType, schemaProxy):
+		tag = "Binary"
 	default:
 		// For unsupported content types (XML, binary, etc.), create a "Raw" body definition.
 		// This ensures opts are generated so users can access RawRequest for custom parsing.
@@ -127,6 +151,45 @@ func createBodyDefinition(operationID string, body *v3high.RequestBody, options
 		return nil, nil, fmt.Errorf("error generating request body definition: %w", err)
 	}
 
+	// Binary bodies are streamed from an io.Reader, whatever their schema.
+	if tag == "Binary" {
+		bodySchema = GoSchema{
+			GoType:         "io.Reader",
+			DefineViaAlias: true,
+			Description:    bodySchema.Description,
+			OpenAPISchema:  bodySchema.OpenAPISchema,
+		}
+	}
+
+	// JSON Merge Patch bodies of a component schema use its merge patch type.
+	if options.MergePatch && isMediaTypeMergePatch(contentType) && ref != "" {
+		if typeName, found := options.typeTracker.LookupByRef(ref); found {
+			if patchName, patchTds, ok := createMergePatchType(typeName, options); ok {
+				bodySchema = GoSchema{
+					GoType:          patchName,
+					DefineViaAlias:  true,
+					Description:     bodySchema.Description,
+					OpenAPISchema:   bodySchema.OpenAPISchema,
+					AdditionalTypes: patchTds,
+				}
+			}
+		}
+	}
+
+	// JSON Patch bodies of a known target are built with its JSON Patch builder.
+	if options.JSONPatch && isMediaTypeJSONPatch(contentType) {
+		if typeName, found := jsonPatchTarget(content, ref, options); found {
+			_, builderTds := createJSONPatchBuilder(typeName, options)
+			bodySchema = GoSchema{
+				GoType:          "runtime.JSONPatch",
+				DefineViaAlias:  true,
+				Description:     bodySchema.Description,
+				OpenAPISchema:   bodySchema.OpenAPISchema,
+				AdditionalTypes: builderTds,
+			}
+		}
+	}
+
 	td := TypeDefinition{
 		Name:             bodyTypeName,
 		Schema:           bodySchema,
@@ -154,6 +217,31 @@ func createBodyDefinition(operationID string, body *v3high.RequestBody, options
 		Default:     defaultBody,
 	}
 
+	if isMediaTypeJson(contentType) {
+		bd.Union = createBodyUnion(td, options)
+	}
+
+	if options.EmbedJSONSchemas && isMediaTypeJson(contentType) {
+		bd.JSONSchema, err = buildJSONSchema(schemaProxy, options.model, SpecLocationBody)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error building JSON schema for %s: %w", bodyTypeName, err)
+		}
+	}
+
+	if options.EmbedExamples && isMediaTypeJson(contentType) {
+		bd.Example, err = mediaTypeExample(content)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error reading example for %s: %w", bodyTypeName, err)
+		}
+	}
+
+	if options.FuzzSeeds && isMediaTypeJson(contentType) {
+		bd.Examples, err = mediaTypeExamples(content)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error reading examples for %s: %w", bodyTypeName, err)
+		}
+	}
+
 	if content.Encoding.Len() != 0 {
 		bd.Encoding = make(map[string]RequestBodyEncoding)
 		for k, v := range content.Encoding.FromOldest() {
@@ -169,6 +257,54 @@ func createBodyDefinition(operationID string, body *v3high.RequestBody, options
 	return bd, &td, nil
 }
 
+// mediaTypeExample returns the JSON encoded example of the media type, the first of mediaTypeExamples.
+// Returns an empty string when no example is declared.
+func mediaTypeExample(content *v3high.MediaType) (string, error) {
+	examples, err := mediaTypeExamples(content)
+	if err != nil || len(examples) == 0 {
+		return "", err
+	}
+	return examples[0], nil
+}
+
+// mediaTypeExamples returns the JSON encoded examples of the media type.
+// The media type example comes first, then its named examples, then the schema examples.
+func mediaTypeExamples(content *v3high.MediaType) ([]string, error) {
+	var nodes []*yaml.Node
+	if content.Example != nil {
+		nodes = append(nodes, content.Example)
+	}
+	if content.Examples != nil {
+		for _, example := range content.Examples.FromOldest() {
+			if example != nil && example.Value != nil {
+				nodes = append(nodes, example.Value)
+			}
+		}
+	}
+	if content.Schema != nil {
+		if schema := content.Schema.Schema(); schema != nil {
+			if schema.Example != nil {
+				nodes = append(nodes, schema.Example)
+			}
+			nodes = append(nodes, schema.Examples...)
+		}
+	}
+
+	var res []string
+	for _, node := range nodes {
+		value, err := decodeYAMLNode(node)
+		if err != nil {
+			return nil, err
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, string(data))
+	}
+	return res, nil
+}
+
 // filterReadOnlyFromRequired removes readOnly properties from the required list
 // in request body schemas. ReadOnly properties should only be required in responses,
 // not in requests. Returns true if any readOnly required fields were found and filtered.
@@ -259,3 +395,16 @@ func filterReadOnlyFromRequiredWithVisited(schemaProxy *base.SchemaProxy, visite
 
 	return hasReadOnlyRequired
 }
+
+// isBinaryContent returns true for the application/octet-stream content,
+// and the content of other media types, e.g. image/png, with a binary string schema.
+func isBinaryContent(contentType string, schemaProxy *base.SchemaProxy) bool {
+	if isMediaTypeBinary(contentType) {
+		return true
+	}
+	if schemaProxy == nil {
+		return false
+	}
+	schema := schemaProxy.Schema()
+	return schema != nil && schema.Format == "binary"
+}
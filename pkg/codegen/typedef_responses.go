@@ -13,6 +13,8 @@ package codegen
 import (
 	"fmt"
 	"iter"
+	"maps"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -25,6 +27,14 @@ type ResponseDefinition struct {
 	Success           *ResponseContentDefinition
 	Error             *ResponseContentDefinition
 	All               map[int]*ResponseContentDefinition
+
+	// Successes are the success responses ordered by status code,
+	// set when the operation declares more than one.
+	Successes []*ResponseContentDefinition
+
+	// ResultName is the name of the client result type carrying the status code
+	// and the body of the received success response, set along with Successes.
+	ResultName string
 }
 
 // ResponseContentDefinition describes Operation response.
@@ -35,6 +45,7 @@ type ResponseDefinition struct {
 // Description is the description of the response.
 // Ref is the reference to the response.
 // IsSuccess is true if the response is a success response.
+// JSONSchema is the self-contained JSON Schema of a JSON success response, set when handler schema validation is enabled.
 type ResponseContentDefinition struct {
 	Schema      GoSchema
 	ContentType string
@@ -49,6 +60,136 @@ type ResponseContentDefinition struct {
 	// IsRaw is true for unsupported content types (XML, form-urlencoded, etc.)
 	// that require the user to handle marshaling manually.
 	IsRaw bool
+
+	JSONSchema string
+
+	// BodyUnion is the oneOf/anyOf union of the body, set for success responses whose body is a union.
+	BodyUnion *ResponseBodyUnion
+
+	// IsProblemDetails is true for application/problem+json error responses decoded into runtime.ProblemDetails.
+	IsProblemDetails bool
+}
+
+// ResponseHeader is a header declared by a response.
+// Schema.Constraints.Required tells whether the header is required.
+type ResponseHeader struct {
+	Name   string
+	Schema GoSchema
+}
+
+// SortedHeaders returns the headers declared by the response, sorted by name.
+func (r ResponseContentDefinition) SortedHeaders() []ResponseHeader {
+	res := make([]ResponseHeader, 0, len(r.Headers))
+	for _, name := range slices.Sorted(maps.Keys(r.Headers)) {
+		res = append(res, ResponseHeader{Name: name, Schema: r.Headers[name]})
+	}
+	return res
+}
+
+// Required returns true if the header must be set on the response.
+func (h ResponseHeader) Required() bool {
+	return h.Schema.Constraints.Required != nil && *h.Schema.Constraints.Required
+}
+
+// ParseType returns the Go type the header value is parsed into when validating the response.
+// Headers of other types, i.e. arrays and objects, are only checked for presence as a string.
+func (h ResponseHeader) ParseType() string {
+	switch h.Schema.GoType {
+	case "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64",
+		"float32", "float64", "bool", "string":
+		return h.Schema.GoType
+	}
+	return "string"
+}
+
+// ResponseBodyUnion describes the oneOf/anyOf union held by a response body type.
+// Field is the body type field holding the union.
+// TypeName is the name of the union type.
+// Elements are the variants of the union.
+type ResponseBodyUnion struct {
+	Field    Property
+	TypeName string
+	Elements []UnionElement
+}
+
+// IsBinary returns true if the body is binary content, e.g. application/octet-stream, held by a runtime.File.
+func (r ResponseContentDefinition) IsBinary() bool {
+	return r.Schema.GoType == "runtime.File"
+}
+
+// BinaryStatusCodes returns the status codes of the success responses with a binary body, streamed by the client.
+func (r ResponseDefinition) BinaryStatusCodes() []int {
+	if r.ResultName == "" {
+		if r.Success != nil && r.Success.IsBinary() {
+			return []int{r.SuccessStatusCode}
+		}
+		return nil
+	}
+
+	var codes []int
+	for _, success := range r.Successes {
+		if success.IsBinary() {
+			codes = append(codes, success.StatusCode)
+		}
+	}
+	return codes
+}
+
+// HasBody returns true if the response has content.
+func (r ResponseContentDefinition) HasBody() bool {
+	return r.ResponseName != "" && r.ResponseName != "struct{}"
+}
+
+// setSuccessBodyUnions sets the BodyUnion of the operations success responses whose body is a union,
+// so that the handler response data can be created from each variant.
+func setSuccessBodyUnions(operations []OperationDefinition, typeDefs []TypeDefinition) {
+	types := make(map[string]TypeDefinition, len(typeDefs))
+	for _, td := range typeDefs {
+		if _, found := types[td.Name]; !found {
+			types[td.Name] = td
+		}
+	}
+
+	for _, op := range operations {
+		if op.Response.Success == nil || op.Response.Success.IsRaw {
+			continue
+		}
+		op.Response.Success.BodyUnion = findBodyUnion(op.Response.Success.Schema, types, map[string]bool{})
+	}
+}
+
+// findBodyUnion returns the union of a body schema made of a single union field,
+// following the named types it refers to.
+func findBodyUnion(s GoSchema, types map[string]TypeDefinition, visited map[string]bool) *ResponseBodyUnion {
+	if len(s.Properties) == 0 {
+		name := s.RefType
+		if name == "" {
+			name = s.GoType
+		}
+		td, found := types[name]
+		if !found || visited[name] {
+			return nil
+		}
+		visited[name] = true
+		return findBodyUnion(td.Schema, types, visited)
+	}
+
+	if len(s.Properties) != 1 {
+		return nil
+	}
+
+	field := s.Properties[0]
+	union, found := types[field.Schema.RefType]
+	if !found || len(union.Schema.UnionElements) == 0 {
+		return nil
+	}
+
+	return &ResponseBodyUnion{
+		Field:    field,
+		TypeName: union.Name,
+		Elements: union.Schema.UnionElements,
+	}
 }
 
 func getOperationResponses(operationID string, responses *v3high.Responses, options ParseOptions) (*ResponseDefinition, []TypeDefinition, error) {
@@ -187,6 +328,17 @@ func getOperationResponses(operationID string, responses *v3high.Responses, opti
 		if !isFirstOfKind {
 			pathParts = append(pathParts, statusCode)
 		}
+
+		if !isSuccess && options.ProblemDetails && isMediaTypeProblemJSON(contentType) {
+			rcd, td := problemDetailsResponse(strings.Join(pathParts, ""), contentType, options)
+			rcd.Description = response.Description
+			rcd.Ref = refType
+			rcd.StatusCode = status
+			rcd.Headers = headers
+			typeDefinitions = append(typeDefinitions, td)
+			all[status] = rcd
+			continue
+		}
 		options = options.
 			WithReference("").
 			WithPath(pathParts).
@@ -199,9 +351,17 @@ func getOperationResponses(operationID string, responses *v3high.Responses, opti
 			continue
 		}
 
+		// Binary content is held by a runtime.File, which can be streamed.
 		// For raw content types (XML, YAML, etc.), override the schema to []byte
 		// since we can't automatically unmarshal these formats.
-		if isRawContentType(contentType) {
+		isBinary := isBinaryContent(contentType, content.Schema)
+		if isBinary {
+			contentSchema = GoSchema{
+				GoType:         "runtime.File",
+				DefineViaAlias: true,
+				Description:    contentSchema.Description,
+			}
+		} else if isRawContentType(contentType) {
 			contentSchema = GoSchema{
 				GoType:         "[]byte",
 				DefineViaAlias: true,
@@ -382,7 +542,7 @@ func getOperationResponses(operationID string, responses *v3high.Responses, opti
 
 		// IsRaw is true for unsupported content types that require manual marshaling
 		// Use HasPrefix to handle content types with parameters (e.g., "text/html; charset=UTF-8")
-		isRaw := isRawContentType(contentType)
+		isRaw := !isBinary && isRawContentType(contentType)
 
 		rcd := &ResponseContentDefinition{
 			ResponseName: responseName,
@@ -396,6 +556,13 @@ func getOperationResponses(operationID string, responses *v3high.Responses, opti
 			Headers:      headers,
 			IsRaw:        isRaw,
 		}
+
+		if options.EmbedJSONSchemas && isSuccess && !isRaw && isMediaTypeJson(contentType) {
+			rcd.JSONSchema, err = buildJSONSchema(content.Schema, options.model, SpecLocationResponse)
+			if err != nil {
+				return nil, nil, fmt.Errorf("error building JSON schema for %s: %w", responseName, err)
+			}
+		}
 		all[status] = rcd
 	}
 
@@ -428,7 +595,20 @@ func getOperationResponses(operationID string, responses *v3high.Responses, opti
 
 		if content != nil {
 			contentType, contentVal = content.Key(), content.Value()
-			if contentVal.Schema != nil {
+			if options.ProblemDetails && isMediaTypeProblemJSON(contentType) {
+				errHeaders, err := generateResponseHeadersSchema(defaultResponse.Headers.FromOldest(), operationID, options)
+				if err != nil {
+					return nil, nil, fmt.Errorf("error generating response headers schema: %w", err)
+				}
+				rcd, td := problemDetailsResponse(operationID+typeSuffix, contentType, options)
+				rcd.Description = defaultResponse.Description
+				rcd.StatusCode = errorCode
+				rcd.Headers = errHeaders
+				typeDefinitions = append(typeDefinitions, td)
+				all[errorCode] = rcd
+				contentVal = nil
+			}
+			if contentVal != nil && contentVal.Schema != nil {
 				ref = contentVal.Schema.GetReference()
 
 				opts := options.WithReference(ref).WithPath([]string{operationID, typeSuffix})
@@ -497,9 +677,41 @@ func getOperationResponses(operationID string, responses *v3high.Responses, opti
 		All:               all,
 	}
 
+	for _, status := range slices.Sorted(maps.Keys(all)) {
+		if all[status].IsSuccess {
+			res.Successes = append(res.Successes, all[status])
+		}
+	}
+	if len(res.Successes) > 1 {
+		res.ResultName = options.typeTracker.generateUniqueName(operationID + "Result")
+		options.typeTracker.registerName(res.ResultName)
+	} else {
+		res.Successes = nil
+	}
+
 	return res, typeDefinitions, nil
 }
 
+// problemDetailsResponse returns an error response decoded into runtime.ProblemDetails,
+// with its type definition aliasing runtime.ProblemDetails.
+func problemDetailsResponse(name, contentType string, options ParseOptions) (*ResponseContentDefinition, TypeDefinition) {
+	name = options.typeTracker.generateUniqueName(name)
+	td := TypeDefinition{
+		Name:         name,
+		Schema:       GoSchema{GoType: "runtime.ProblemDetails", DefineViaAlias: true},
+		SpecLocation: SpecLocationResponse,
+	}
+	options.typeTracker.register(td, "")
+
+	return &ResponseContentDefinition{
+		ResponseName:     name,
+		Schema:           td.Schema,
+		ContentType:      contentType,
+		NameTag:          mediaTypeToCamelCase(contentType),
+		IsProblemDetails: true,
+	}, td
+}
+
 func generateResponseHeadersSchema(headers iter.Seq2[string, *v3high.Header], operationID string, options ParseOptions) (map[string]GoSchema, error) {
 	res := make(map[string]GoSchema)
 	opts := options.WithReference("").WithPath([]string{operationID, "Header"})
@@ -509,6 +721,7 @@ func generateResponseHeadersSchema(headers iter.Seq2[string, *v3high.Header], op
 		if err != nil {
 			return nil, err
 		}
+		hSchema.Constraints.Required = ptr(hdrs.Required)
 		res[hName] = hSchema
 	}
 	return res, nil
@@ -72,6 +72,33 @@ return res1`
 		assert.Equal(t, expected, res)
 	})
 
+	t.Run("single property with runtime.Optional", func(t *testing.T) {
+		typ := TypeDefinition{
+			Name: "ResError",
+			Schema: GoSchema{
+				Properties: []Property{
+					{
+						GoName:        "Details",
+						JsonFieldName: "details",
+						Schema: GoSchema{
+							GoType: "string",
+						},
+						Constraints: Constraints{
+							Nullable: ptr(true),
+						},
+						GenericOptional: true,
+					},
+				},
+			},
+		}
+		res := typ.GetErrorResponse(map[string]string{"ResError": "details"}, "e", map[string]GoSchema{})
+		expected := `res0 := e.Details
+res1, ok := res0.Get()
+if !ok { return "unknown error" }
+return res1`
+		assert.Equal(t, expected, res)
+	})
+
 	t.Run("property with name error", func(t *testing.T) {
 		typ := TypeDefinition{
 			Name: "ResError",
@@ -371,6 +398,32 @@ func TestTypeDefinition_GetErrorConstructor(t *testing.T) {
 		assert.Equal(t, expected, res)
 	})
 
+	t.Run("single property with runtime.Optional", func(t *testing.T) {
+		typ := TypeDefinition{
+			Name: "ResError",
+			Schema: GoSchema{
+				Properties: []Property{
+					{
+						GoName:        "Details",
+						JsonFieldName: "details",
+						Schema: GoSchema{
+							GoType: "string",
+						},
+						Constraints: Constraints{
+							Nullable: ptr(true),
+						},
+						GenericOptional: true,
+					},
+				},
+			},
+		}
+		res := typ.GetErrorConstructor(map[string]string{"ResError": "details"}, map[string]GoSchema{})
+		expected := `func NewResError(message string) ResError {
+	return ResError{Details: runtime.NewOptional(message)}
+}`
+		assert.Equal(t, expected, res)
+	})
+
 	t.Run("nested property with pointer", func(t *testing.T) {
 		// Define the referenced type
 		errorDataType := TypeDefinition{
@@ -0,0 +1,259 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pb33f/libopenapi/orderedmap"
+	"go.yaml.in/yaml/v4"
+)
+
+// xConfigTypeName is the name of the struct generated from the x-config extension.
+const xConfigTypeName = "Config"
+
+// XConfigDefinition describes the Config struct generated from the top-level x-config extension.
+type XConfigDefinition struct {
+	// Structs are the Config struct followed by the structs of its nested objects.
+	Structs []XConfigStruct
+
+	// Default is the Go composite literal of the extension values, returned by DefaultConfig.
+	Default string
+}
+
+// XConfigStruct is a struct of the x-config extension, one per object.
+type XConfigStruct struct {
+	Name   string
+	Fields []XConfigField
+}
+
+// XConfigField is a field of an x-config struct, one per key.
+type XConfigField struct {
+	GoName   string
+	JSONName string
+	GoType   string
+}
+
+// newXConfigDefinition creates the Config struct from the x-config extension of the spec.
+// Objects become nested structs named after their path, e.g. ConfigDatabase, arrays become slices
+// of the type of their items, or []any when they have items of different types.
+// It returns nil if the spec has no x-config extension.
+func newXConfigDefinition(extensions *orderedmap.Map[string, *yaml.Node], typeTracker *TypeTracker) (*XConfigDefinition, error) {
+	if extensions == nil {
+		return nil, nil
+	}
+	node, ok := extensions.Get(extXConfig)
+	if !ok || node == nil {
+		return nil, nil
+	}
+
+	node = resolveXConfigNode(node)
+	if node.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("%s must be an object", extXConfig)
+	}
+
+	def := &XConfigDefinition{}
+	literal, err := def.addStruct(xConfigTypeName, node)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", extXConfig, err)
+	}
+	def.Default = literal
+
+	for _, s := range def.Structs {
+		if typeTracker != nil && typeTracker.Exists(s.Name) {
+			return nil, fmt.Errorf("%s: type %s collides with a generated type", extXConfig, s.Name)
+		}
+	}
+
+	return def, nil
+}
+
+// addStruct adds the struct of an object and returns its composite literal.
+func (d *XConfigDefinition) addStruct(name string, node *yaml.Node) (string, error) {
+	idx := len(d.Structs)
+	d.Structs = append(d.Structs, XConfigStruct{Name: name})
+
+	var (
+		fields []XConfigField
+		values []string
+	)
+	seen := map[string]string{}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key := node.Content[i].Value
+		goName := createPropertyGoFieldName(key, nil)
+		if other, found := seen[goName]; found {
+			return "", fmt.Errorf("keys '%s' and '%s' of %s have the same field name %s", other, key, name, goName)
+		}
+		seen[goName] = key
+
+		goType, literal, err := d.value(name+goName, node.Content[i+1])
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", key, err)
+		}
+		fields = append(fields, XConfigField{GoName: goName, JSONName: key, GoType: goType})
+		if literal != "" {
+			values = append(values, goName+": "+literal+",\n")
+		}
+	}
+	d.Structs[idx].Fields = fields
+
+	if len(values) == 0 {
+		return name + "{}", nil
+	}
+	return name + "{\n" + strings.Join(values, "") + "}", nil
+}
+
+// value returns the Go type and the literal of a value, the literal being empty for the zero value.
+func (d *XConfigDefinition) value(typeName string, node *yaml.Node) (string, string, error) {
+	node = resolveXConfigNode(node)
+
+	switch node.Kind {
+	case yaml.MappingNode:
+		literal, err := d.addStruct(typeName, node)
+		if err != nil {
+			return "", "", err
+		}
+		return typeName, literal, nil
+
+	case yaml.SequenceNode:
+		if len(node.Content) == 0 {
+			return "[]any", "", nil
+		}
+
+		itemType := ""
+		for _, item := range node.Content {
+			t, _, err := xConfigScalar(resolveXConfigNode(item))
+			if err != nil || (itemType != "" && t != itemType) {
+				itemType = "any"
+				break
+			}
+			itemType = t
+		}
+
+		var items []string
+		for _, item := range node.Content {
+			var (
+				literal string
+				err     error
+			)
+			if itemType == "any" {
+				literal, err = xConfigAnyLiteral(item)
+			} else {
+				_, literal, err = xConfigScalar(resolveXConfigNode(item))
+			}
+			if err != nil {
+				return "", "", err
+			}
+			items = append(items, literal+",\n")
+		}
+		goType := "[]" + itemType
+		return goType, goType + "{\n" + strings.Join(items, "") + "}", nil
+
+	case yaml.ScalarNode:
+		return xConfigScalar(node)
+	}
+
+	return "", "", fmt.Errorf("unsupported value")
+}
+
+// xConfigScalar returns the Go type and the literal of a scalar value. Null values are of type any.
+func xConfigScalar(node *yaml.Node) (string, string, error) {
+	if node.Kind != yaml.ScalarNode {
+		return "", "", fmt.Errorf("not a scalar value")
+	}
+
+	switch node.ShortTag() {
+	case "!!null":
+		return "any", "", nil
+	case "!!bool":
+		var v bool
+		if err := node.Decode(&v); err != nil {
+			return "", "", err
+		}
+		return "bool", strconv.FormatBool(v), nil
+	case "!!int":
+		var v int64
+		if err := node.Decode(&v); err != nil {
+			return "", "", err
+		}
+		return "int", strconv.FormatInt(v, 10), nil
+	case "!!float":
+		var v float64
+		if err := node.Decode(&v); err != nil {
+			return "", "", err
+		}
+		if math.IsInf(v, 0) || math.IsNaN(v) {
+			return "", "", fmt.Errorf("unsupported float value %s", node.Value)
+		}
+		literal := strconv.FormatFloat(v, 'g', -1, 64)
+		if !strings.ContainsAny(literal, ".e") {
+			literal += ".0"
+		}
+		return "float64", literal, nil
+	}
+
+	return "string", strconv.Quote(node.Value), nil
+}
+
+// xConfigAnyLiteral returns the literal of a value of type any: objects become map[string]any and arrays []any.
+func xConfigAnyLiteral(node *yaml.Node) (string, error) {
+	node = resolveXConfigNode(node)
+
+	switch node.Kind {
+	case yaml.MappingNode:
+		var entries []string
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			literal, err := xConfigAnyLiteral(node.Content[i+1])
+			if err != nil {
+				return "", err
+			}
+			entries = append(entries, strconv.Quote(node.Content[i].Value)+": "+literal+",\n")
+		}
+		return "map[string]any{\n" + strings.Join(entries, "") + "}", nil
+
+	case yaml.SequenceNode:
+		var items []string
+		for _, item := range node.Content {
+			literal, err := xConfigAnyLiteral(item)
+			if err != nil {
+				return "", err
+			}
+			items = append(items, literal+",\n")
+		}
+		return "[]any{\n" + strings.Join(items, "") + "}", nil
+	}
+
+	_, literal, err := xConfigScalar(node)
+	if err != nil {
+		return "", err
+	}
+	if literal == "" {
+		return "nil", nil
+	}
+	return literal, nil
+}
+
+// resolveXConfigNode returns the node holding the value of a document or alias node.
+func resolveXConfigNode(node *yaml.Node) *yaml.Node {
+	for {
+		switch {
+		case node.Kind == yaml.DocumentNode && len(node.Content) > 0:
+			node = node.Content[0]
+		case node.Kind == yaml.AliasNode && node.Alias != nil:
+			node = node.Alias
+		default:
+			return node
+		}
+	}
+}
@@ -0,0 +1,316 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// BatchEnvelope names the JSON properties of the sub-requests and sub-responses of a batch operation.
+// Empty names are left out of the sub-requests.
+type BatchEnvelope struct {
+	// ID correlates the sub-responses with the sub-requests. When empty, they are correlated by position.
+	ID string
+
+	Method string
+
+	// Path holds the path and query of a sub-request, relative to the base URL of the client.
+	Path string
+
+	Headers string
+	Body    string
+
+	// Status holds the status code of a sub-response.
+	Status string
+}
+
+// BatchCaller is a call sent as a sub-request of a batch, see BatchCall.
+type BatchCaller interface {
+	run(ctx context.Context, apiClient APIClient) error
+	resolve(ctx context.Context, apiClient APIClient, err error)
+}
+
+// BatchCall is the call of an operation returning a T, sent in a batch by the Batch method of a generated client.
+type BatchCall[T any] struct {
+	do    func(ctx context.Context, apiClient APIClient) (*T, error)
+	value *T
+	err   error
+	sent  bool
+}
+
+// NewBatchCall returns the call running do, the client method of an operation using apiClient.
+func NewBatchCall[T any](do func(ctx context.Context, apiClient APIClient) (*T, error)) *BatchCall[T] {
+	return &BatchCall[T]{do: do}
+}
+
+// Result returns the decoded sub-response of the call, or its error like the client method would,
+// e.g. a ClientAPIError for an error status code. It returns ErrBatchNotSent until the batch is sent.
+func (c *BatchCall[T]) Result() (*T, error) {
+	if !c.sent {
+		return nil, ErrBatchNotSent
+	}
+	return c.value, c.err
+}
+
+func (c *BatchCall[T]) run(ctx context.Context, apiClient APIClient) error {
+	_, err := c.do(ctx, apiClient)
+	return err
+}
+
+func (c *BatchCall[T]) resolve(ctx context.Context, apiClient APIClient, err error) {
+	c.sent = true
+	if err != nil {
+		c.err = err
+		return
+	}
+	c.value, c.err = c.do(ctx, apiClient)
+}
+
+var (
+	// ErrBatchNotSent is the result of a batch call whose batch wasn't sent, or failed.
+	ErrBatchNotSent = errors.New("batch not sent")
+
+	// ErrBatchResponseMissing is the result of a batch call without a sub-response.
+	ErrBatchResponseMissing = errors.New("batch sub-response missing")
+
+	errBatchRecorded = errors.New("batch sub-request recorded")
+)
+
+// batchAPIClient is the APIClient of the calls of a batch. Instead of sending the request of a call,
+// it records it, then returns the sub-response when the call is resolved.
+type batchAPIClient struct {
+	APIClient
+
+	mu   sync.Mutex
+	req  *http.Request
+	resp *Response
+}
+
+func (c *batchAPIClient) CreateRequest(ctx context.Context, params RequestOptionsParameters, reqEditors ...RequestEditorFn) (*http.Request, error) {
+	if c.resp != nil {
+		// The request was recorded, so the request editors don't run twice.
+		return c.req, nil
+	}
+	return c.APIClient.CreateRequest(ctx, params, reqEditors...)
+}
+
+func (c *batchAPIClient) ExecuteRequest(_ context.Context, req *http.Request, _ string) (*Response, error) {
+	if c.resp != nil {
+		return c.resp, nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.req == nil {
+		c.req = req
+	}
+	return nil, errBatchRecorded
+}
+
+// EditBody runs the body editors of the client when the request is recorded.
+func (c *batchAPIClient) EditBody(ctx context.Context, body any) error {
+	if editor, ok := c.APIClient.(BodyEditor); ok && c.resp == nil {
+		return editor.EditBody(ctx, body)
+	}
+	return nil
+}
+
+// ExecuteBatch sends calls as the sub-requests of a single batch request, then resolves them with the sub-responses.
+// The requests of the calls are created by apiClient, with the request editors, but aren't sent on their own:
+// they are packed as sub-requests named by envelope and passed to send, which sends the batch request
+// and returns the sub-responses. A call without a sub-response gets ErrBatchResponseMissing.
+func ExecuteBatch(ctx context.Context, apiClient APIClient, envelope BatchEnvelope, calls []BatchCaller,
+	send func(ctx context.Context, subRequests []map[string]any) ([]json.RawMessage, error)) error {
+	reqs := make([]*http.Request, len(calls))
+	subRequests := make([]map[string]any, len(calls))
+	for i, call := range calls {
+		recorder := &batchAPIClient{APIClient: apiClient}
+		if err := call.run(ctx, recorder); recorder.req == nil {
+			return fmt.Errorf("error creating batch sub-request %d: %w", i, err)
+		}
+		subRequest, err := newBatchSubRequest(recorder.req, apiClient.GetBaseURL(), envelope)
+		if err != nil {
+			return fmt.Errorf("error creating batch sub-request %d: %w", i, err)
+		}
+		if envelope.ID != "" {
+			subRequest[envelope.ID] = strconv.Itoa(i)
+		}
+		reqs[i] = recorder.req
+		subRequests[i] = subRequest
+	}
+
+	subResponses, err := send(ctx, subRequests)
+	if err != nil {
+		return err
+	}
+
+	resolved := make([]bool, len(calls))
+	for pos, raw := range subResponses {
+		i, resp, err := parseBatchSubResponse(raw, pos, envelope)
+		if err != nil {
+			return fmt.Errorf("error decoding batch sub-response %d: %w", pos, err)
+		}
+		if i < 0 || i >= len(calls) || resolved[i] {
+			continue
+		}
+		resolved[i] = true
+		calls[i].resolve(ctx, &batchAPIClient{APIClient: apiClient, req: reqs[i], resp: resp}, nil)
+	}
+	for i, call := range calls {
+		if !resolved[i] {
+			call.resolve(ctx, nil, ErrBatchResponseMissing)
+		}
+	}
+	return nil
+}
+
+// newBatchSubRequest returns the sub-request sending req, with its path relative to baseURL.
+// A JSON body is embedded as is, other bodies as a string.
+func newBatchSubRequest(req *http.Request, baseURL string, envelope BatchEnvelope) (map[string]any, error) {
+	path := req.URL.EscapedPath()
+	if base, err := url.Parse(baseURL); err == nil {
+		basePath := strings.TrimSuffix(base.EscapedPath(), "/")
+		if rest, found := strings.CutPrefix(path, basePath); found && (rest == "" || rest[0] == '/') {
+			path = "/" + strings.TrimPrefix(rest, "/")
+		}
+	}
+	if req.URL.RawQuery != "" {
+		path += "?" + req.URL.RawQuery
+	}
+
+	subRequest := map[string]any{
+		envelope.Method: req.Method,
+		envelope.Path:   path,
+	}
+
+	if len(req.Header) > 0 && envelope.Headers != "" {
+		headers := make(map[string]string, len(req.Header))
+		for name, values := range req.Header {
+			headers[name] = strings.Join(values, ", ")
+		}
+		subRequest[envelope.Headers] = headers
+	}
+
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, fmt.Errorf("error reading request body: %w", err)
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		if len(body) > 0 {
+			if envelope.Body == "" {
+				return nil, errors.New("the batch sub-requests have no body")
+			}
+			if strings.Contains(req.Header.Get("Content-Type"), "json") && json.Valid(body) {
+				subRequest[envelope.Body] = json.RawMessage(body)
+			} else {
+				subRequest[envelope.Body] = string(body)
+			}
+		}
+	}
+	return subRequest, nil
+}
+
+// parseBatchSubResponse returns the index of the call of the sub-response at position pos, and its response.
+// A string body is unquoted, unless the sub-response is JSON.
+func parseBatchSubResponse(raw json.RawMessage, pos int, envelope BatchEnvelope) (int, *Response, error) {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &members); err != nil {
+		return 0, nil, err
+	}
+
+	index := pos
+	if envelope.ID != "" {
+		var id any
+		if err := json.Unmarshal(members[envelope.ID], &id); err != nil {
+			return 0, nil, fmt.Errorf("invalid %s: %w", envelope.ID, err)
+		}
+		var err error
+		if index, err = strconv.Atoi(fmt.Sprint(id)); err != nil {
+			return -1, nil, nil
+		}
+	}
+
+	resp := &Response{Headers: http.Header{}}
+	if err := json.Unmarshal(members[envelope.Status], &resp.StatusCode); err != nil {
+		return 0, nil, fmt.Errorf("invalid %s: %w", envelope.Status, err)
+	}
+	if headers, found := members[envelope.Headers]; found && envelope.Headers != "" {
+		var values map[string]string
+		if err := json.Unmarshal(headers, &values); err != nil {
+			return 0, nil, fmt.Errorf("invalid %s: %w", envelope.Headers, err)
+		}
+		for name, value := range values {
+			resp.Headers.Set(name, value)
+		}
+	}
+
+	body := members[envelope.Body]
+	if bytes.Equal(body, []byte("null")) {
+		body = nil
+	}
+	if len(body) > 0 && body[0] == '"' && !strings.Contains(resp.Headers.Get("Content-Type"), "json") {
+		var s string
+		if err := json.Unmarshal(body, &s); err != nil {
+			return 0, nil, fmt.Errorf("invalid %s: %w", envelope.Body, err)
+		}
+		body = []byte(s)
+	}
+	resp.Content = body
+	return index, resp, nil
+}
+
+// ConvertBatchItems sets target, the body of a batch request, from its items:
+// target is the array of items or, when property is set, an object holding them in property.
+func ConvertBatchItems(items any, property string, target any) error {
+	if property != "" {
+		items = map[string]any{property: items}
+	}
+	data, err := json.Marshal(items)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, target)
+}
+
+// BatchItems returns the items of response, the response of a batch request:
+// response is the array of items or, when property is set, an object holding them in property.
+func BatchItems(response any, property string) ([]json.RawMessage, error) {
+	data, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+	if property != "" {
+		var members map[string]json.RawMessage
+		if err = json.Unmarshal(data, &members); err != nil {
+			return nil, err
+		}
+		data = members[property]
+	}
+
+	var items []json.RawMessage
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return nil, nil
+	}
+	if err = json.Unmarshal(data, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,209 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testBatchEnvelope = BatchEnvelope{ID: "id", Method: "method", Path: "path", Headers: "headers", Body: "body", Status: "status"}
+
+// getBatchCall returns the call of GET path, returning the body of a 200 response.
+func getBatchCall(path string) *BatchCall[string] {
+	return NewBatchCall(func(ctx context.Context, apiClient APIClient) (*string, error) {
+		req, err := apiClient.CreateRequest(ctx, RequestOptionsParameters{RequestURL: apiClient.GetBaseURL() + path, Method: http.MethodGet})
+		if err != nil {
+			return nil, err
+		}
+		resp, err := apiClient.ExecuteRequest(ctx, req, path)
+		if err != nil {
+			return nil, fmt.Errorf("error executing request: %w", err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			return nil, NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode), WithStatusCode(resp.StatusCode))
+		}
+		body := string(resp.Content)
+		return &body, nil
+	})
+}
+
+func TestExecuteBatch(t *testing.T) {
+	client, err := NewAPIClient("https://example.com/v1", WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer token")
+		return nil
+	}))
+	require.NoError(t, err)
+
+	t.Run("sub-responses are matched by id", func(t *testing.T) {
+		first, second := getBatchCall("/users/1"), getBatchCall("/users/2?fields=name")
+
+		var sent []map[string]any
+		err := ExecuteBatch(context.Background(), client, testBatchEnvelope, []BatchCaller{first, second},
+			func(ctx context.Context, subRequests []map[string]any) ([]json.RawMessage, error) {
+				sent = subRequests
+				return []json.RawMessage{
+					json.RawMessage(`{"id": "1", "status": 404}`),
+					json.RawMessage(`{"id": "0", "status": 200, "headers": {"Content-Type": "application/json"}, "body": {"name": "Alice"}}`),
+				}, nil
+			})
+		require.NoError(t, err)
+
+		require.Len(t, sent, 2)
+		assert.Equal(t, "0", sent[0]["id"])
+		assert.Equal(t, "GET", sent[0]["method"])
+		assert.Equal(t, "/users/1", sent[0]["path"])
+		assert.Equal(t, "Bearer token", sent[0]["headers"].(map[string]string)["Authorization"], "the request editors run")
+		assert.Equal(t, "1", sent[1]["id"])
+		assert.Equal(t, "/users/2?fields=name", sent[1]["path"])
+		assert.NotContains(t, sent[1], "body")
+
+		body, err := first.Result()
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name": "Alice"}`, *body)
+
+		_, err = second.Result()
+		var apiErr *ClientAPIError
+		require.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, http.StatusNotFound, apiErr.StatusCode())
+	})
+
+	t.Run("sub-responses are matched by position without id", func(t *testing.T) {
+		envelope := testBatchEnvelope
+		envelope.ID = ""
+		first, second := getBatchCall("/users/1"), getBatchCall("/users/2")
+
+		err := ExecuteBatch(context.Background(), client, envelope, []BatchCaller{first, second},
+			func(ctx context.Context, subRequests []map[string]any) ([]json.RawMessage, error) {
+				assert.NotContains(t, subRequests[0], "id")
+				return []json.RawMessage{json.RawMessage(`{"status": 200, "body": "plain text"}`)}, nil
+			})
+		require.NoError(t, err)
+
+		body, err := first.Result()
+		require.NoError(t, err)
+		assert.Equal(t, "plain text", *body)
+
+		_, err = second.Result()
+		assert.ErrorIs(t, err, ErrBatchResponseMissing)
+	})
+
+	t.Run("batch request fails", func(t *testing.T) {
+		call := getBatchCall("/users/1")
+		_, err := call.Result()
+		require.ErrorIs(t, err, ErrBatchNotSent)
+
+		err = ExecuteBatch(context.Background(), client, testBatchEnvelope, []BatchCaller{call},
+			func(ctx context.Context, subRequests []map[string]any) ([]json.RawMessage, error) {
+				return nil, errors.New("unavailable")
+			})
+		require.EqualError(t, err, "unavailable")
+
+		_, err = call.Result()
+		assert.ErrorIs(t, err, ErrBatchNotSent)
+	})
+
+	t.Run("request editor fails", func(t *testing.T) {
+		failing, err := NewAPIClient("https://example.com", WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+			return errors.New("no token")
+		}))
+		require.NoError(t, err)
+
+		err = ExecuteBatch(context.Background(), failing, testBatchEnvelope, []BatchCaller{getBatchCall("/users/1")},
+			func(ctx context.Context, subRequests []map[string]any) ([]json.RawMessage, error) {
+				t.Fatal("the batch must not be sent")
+				return nil, nil
+			})
+		require.ErrorContains(t, err, "error creating batch sub-request 0")
+		require.ErrorContains(t, err, "no token")
+	})
+}
+
+func TestNewBatchSubRequest(t *testing.T) {
+	t.Run("JSON body", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodPost, "https://example.com/v1/users", strings.NewReader(`{"name":"Bob"}`))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		subRequest, err := newBatchSubRequest(req, "https://example.com/v1/", testBatchEnvelope)
+		require.NoError(t, err)
+
+		data, err := json.Marshal(subRequest)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"method": "POST", "path": "/users", "headers": {"Content-Type": "application/json"}, "body": {"name": "Bob"}}`, string(data))
+	})
+
+	t.Run("text body", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodPut, "https://example.com/notes/1", strings.NewReader("hello"))
+		require.NoError(t, err)
+
+		subRequest, err := newBatchSubRequest(req, "https://example.com", testBatchEnvelope)
+		require.NoError(t, err)
+		assert.Equal(t, "/notes/1", subRequest["path"])
+		assert.Equal(t, "hello", subRequest["body"])
+	})
+
+	t.Run("path outside of the base path", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, "https://example.com/v10/users", nil)
+		require.NoError(t, err)
+
+		subRequest, err := newBatchSubRequest(req, "https://example.com/v1", testBatchEnvelope)
+		require.NoError(t, err)
+		assert.Equal(t, "/v10/users", subRequest["path"])
+	})
+
+	t.Run("body without envelope property", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodPost, "https://example.com/users", strings.NewReader("{}"))
+		require.NoError(t, err)
+
+		_, err = newBatchSubRequest(req, "https://example.com", BatchEnvelope{Method: "method", Path: "path"})
+		require.Error(t, err)
+	})
+}
+
+func TestBatchItems(t *testing.T) {
+	t.Run("object", func(t *testing.T) {
+		var body struct {
+			Requests []map[string]any `json:"requests"`
+		}
+		require.NoError(t, ConvertBatchItems([]map[string]any{{"method": "GET"}}, "requests", &body))
+		assert.Equal(t, "GET", body.Requests[0]["method"])
+
+		items, err := BatchItems(map[string]any{"responses": []any{map[string]any{"status": 200}}}, "responses")
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.JSONEq(t, `{"status": 200}`, string(items[0]))
+	})
+
+	t.Run("array", func(t *testing.T) {
+		var body []map[string]any
+		require.NoError(t, ConvertBatchItems([]map[string]any{{"method": "GET"}}, "", &body))
+		assert.Len(t, body, 1)
+
+		items, err := BatchItems([]any{1, 2}, "")
+		require.NoError(t, err)
+		assert.Len(t, items, 2)
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		items, err := BatchItems(map[string]any{}, "responses")
+		require.NoError(t, err)
+		assert.Empty(t, items)
+	})
+}
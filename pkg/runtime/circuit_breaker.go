@@ -0,0 +1,223 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned by the client, without sending the request, while the circuit breaker of the operation is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitState is the state of a circuit breaker.
+type CircuitState int
+
+const (
+	// CircuitClosed lets the requests through, counting the consecutive failures.
+	CircuitClosed CircuitState = iota
+
+	// CircuitOpen fails the requests fast, until the open duration elapses.
+	CircuitOpen
+
+	// CircuitHalfOpen lets a single trial request through: the breaker closes if it succeeds and opens again otherwise.
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return fmt.Sprintf("CircuitState(%d)", int(s))
+	}
+}
+
+// CircuitBreakerPolicy configures the circuit breakers of the operations, see WithCircuitBreaker.
+// FailureThreshold is the number of consecutive failures opening the breaker, 5 if zero.
+// OpenDuration is how long the breaker stays open before letting a trial request through, 30 seconds if zero.
+// OnStateChange, if set, is called with the operation path on every state change, e.g. to report metrics.
+type CircuitBreakerPolicy struct {
+	FailureThreshold int
+	OpenDuration     time.Duration
+	OnStateChange    func(operationPath string, from, to CircuitState)
+}
+
+// CircuitBreakerState is the state of the circuit breaker of an operation.
+type CircuitBreakerState struct {
+	State CircuitState
+
+	// Failures is the number of consecutive failures.
+	Failures int
+
+	// OpenedAt is when the breaker last opened, zero if it never did.
+	OpenedAt time.Time
+}
+
+// CircuitBreakerReporter is implemented by API clients keeping a circuit breaker per operation.
+type CircuitBreakerReporter interface {
+	CircuitBreaker(operationPath string) (CircuitBreakerState, bool)
+}
+
+// circuitBreaker is the circuit breaker of an operation.
+type circuitBreaker struct {
+	policy        CircuitBreakerPolicy
+	operationPath string
+
+	mu            sync.Mutex
+	state         CircuitBreakerState
+	trialInFlight bool
+}
+
+// allow returns ErrCircuitOpen if the request can't be sent at now.
+// An allowed request must be followed by a call to done.
+func (b *circuitBreaker) allow(now time.Time) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state.State {
+	case CircuitOpen:
+		openDuration := b.policy.OpenDuration
+		if openDuration <= 0 {
+			openDuration = 30 * time.Second
+		}
+		if now.Sub(b.state.OpenedAt) < openDuration {
+			return ErrCircuitOpen
+		}
+		b.setState(CircuitHalfOpen)
+		b.trialInFlight = true
+	case CircuitHalfOpen:
+		if b.trialInFlight {
+			return ErrCircuitOpen
+		}
+		b.trialInFlight = true
+	}
+	return nil
+}
+
+// done records the outcome of an allowed request at now. Requests abandoned by the caller, e.g. canceled,
+// are neither successes nor failures, they only let another trial request through.
+func (b *circuitBreaker) done(now time.Time, success, abandoned bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	trial := b.trialInFlight
+	b.trialInFlight = false
+	if abandoned {
+		return
+	}
+
+	if success {
+		b.state.Failures = 0
+		if b.state.State != CircuitClosed {
+			b.setState(CircuitClosed)
+		}
+		return
+	}
+
+	b.state.Failures++
+	threshold := b.policy.FailureThreshold
+	if threshold <= 0 {
+		threshold = 5
+	}
+	if trial || (b.state.State == CircuitClosed && b.state.Failures >= threshold) {
+		b.state.OpenedAt = now
+		b.setState(CircuitOpen)
+	}
+}
+
+// setState changes the state, calling OnStateChange. b.mu must be held.
+func (b *circuitBreaker) setState(state CircuitState) {
+	from := b.state.State
+	if from == state {
+		return
+	}
+	b.state.State = state
+	if b.policy.OnStateChange != nil {
+		b.policy.OnStateChange(b.operationPath, from, state)
+	}
+}
+
+// snapshot returns the state of the breaker.
+func (b *circuitBreaker) snapshot() CircuitBreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// WithCircuitBreaker gives the client a circuit breaker per operation: it opens after FailureThreshold consecutive
+// transport errors or non-2xx responses, 304 Not Modified excepted, failing the requests with ErrCircuitOpen
+// until OpenDuration elapses. A trial request then closes it if it succeeds, or opens it again.
+func WithCircuitBreaker(policy CircuitBreakerPolicy) APIClientOption {
+	return func(c *Client) error {
+		c.circuitBreakerPolicy = &policy
+		return nil
+	}
+}
+
+// CircuitBreaker returns the state of the circuit breaker of the operation at operationPath, e.g. /pets/{id}.
+// It returns false if the client has no circuit breakers or hasn't sent a request of the operation yet.
+func (c *Client) CircuitBreaker(operationPath string) (CircuitBreakerState, bool) {
+	c.circuitBreakersMu.Lock()
+	b, ok := c.circuitBreakers[operationPath]
+	c.circuitBreakersMu.Unlock()
+	if !ok {
+		return CircuitBreakerState{}, false
+	}
+	return b.snapshot(), true
+}
+
+// circuitBreaker returns the circuit breaker of the operation at operationPath, nil if the client has none.
+func (c *Client) circuitBreaker(operationPath string) *circuitBreaker {
+	if c.circuitBreakerPolicy == nil {
+		return nil
+	}
+	c.circuitBreakersMu.Lock()
+	defer c.circuitBreakersMu.Unlock()
+	if c.circuitBreakers == nil {
+		c.circuitBreakers = make(map[string]*circuitBreaker)
+	}
+	b, ok := c.circuitBreakers[operationPath]
+	if !ok {
+		b = &circuitBreaker{policy: *c.circuitBreakerPolicy, operationPath: operationPath}
+		c.circuitBreakers[operationPath] = b
+	}
+	return b
+}
+
+// isCircuitBreakerSuccess returns true if the response doesn't count as a failure of the circuit breaker.
+func isCircuitBreakerSuccess(statusCode int) bool {
+	return (statusCode >= 200 && statusCode < 300) || statusCode == http.StatusNotModified
+}
+
+// executeWithCircuitBreaker runs send through the circuit breaker of the operation at operationPath, if the client has one.
+func (c *Client) executeWithCircuitBreaker(ctx context.Context, operationPath string, send func() (*Response, error)) (*Response, error) {
+	b := c.circuitBreaker(operationPath)
+	if b == nil {
+		return send()
+	}
+	if err := b.allow(time.Now()); err != nil {
+		return nil, fmt.Errorf("%w: %s", err, operationPath)
+	}
+
+	resp, err := send()
+	abandoned := err != nil && ctx.Err() != nil
+	b.done(time.Now(), err == nil && (resp == nil || isCircuitBreakerSuccess(resp.StatusCode)), abandoned)
+	return resp, err
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	var changes []string
+	b := &circuitBreaker{
+		policy: CircuitBreakerPolicy{
+			FailureThreshold: 2,
+			OpenDuration:     time.Minute,
+			OnStateChange: func(operationPath string, from, to CircuitState) {
+				changes = append(changes, operationPath+": "+from.String()+" -> "+to.String())
+			},
+		},
+		operationPath: "/pets",
+	}
+	now := time.Now()
+
+	require.NoError(t, b.allow(now))
+	b.done(now, false, false)
+	require.NoError(t, b.allow(now))
+	b.done(now, true, false)
+	assert.Equal(t, 0, b.snapshot().Failures)
+
+	// consecutive failures open the breaker
+	for range 2 {
+		require.NoError(t, b.allow(now))
+		b.done(now, false, false)
+	}
+	assert.Equal(t, CircuitBreakerState{State: CircuitOpen, Failures: 2, OpenedAt: now}, b.snapshot())
+	assert.ErrorIs(t, b.allow(now.Add(30*time.Second)), ErrCircuitOpen)
+
+	// a single trial request once the open duration elapsed, opening the breaker again if it fails
+	later := now.Add(time.Minute)
+	require.NoError(t, b.allow(later))
+	assert.Equal(t, CircuitHalfOpen, b.snapshot().State)
+	assert.ErrorIs(t, b.allow(later), ErrCircuitOpen)
+	b.done(later, false, false)
+	assert.Equal(t, CircuitOpen, b.snapshot().State)
+	assert.Equal(t, later, b.snapshot().OpenedAt)
+
+	// an abandoned trial lets another one through
+	later = later.Add(time.Minute)
+	require.NoError(t, b.allow(later))
+	b.done(later, false, true)
+	assert.Equal(t, CircuitHalfOpen, b.snapshot().State)
+	require.NoError(t, b.allow(later))
+	b.done(later, true, false)
+	assert.Equal(t, CircuitBreakerState{State: CircuitClosed, OpenedAt: later.Add(-time.Minute)}, b.snapshot())
+
+	assert.Equal(t, []string{
+		"/pets: closed -> open",
+		"/pets: open -> half-open",
+		"/pets: half-open -> open",
+		"/pets: open -> half-open",
+		"/pets: half-open -> closed",
+	}, changes)
+}
+
+func TestClient_CircuitBreaker(t *testing.T) {
+	var (
+		failing  atomic.Bool
+		requests atomic.Int32
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if failing.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	send := func(c *Client, operationPath string) (*Response, error) {
+		req, err := c.CreateRequest(context.Background(), RequestOptionsParameters{RequestURL: c.GetBaseURL() + operationPath, Method: http.MethodGet})
+		require.NoError(t, err)
+		return c.ExecuteRequest(context.Background(), req, operationPath)
+	}
+
+	c, err := NewAPIClient(server.URL, WithHTTPClient(HTTPClientDoer{Client: server.Client()}),
+		WithCircuitBreaker(CircuitBreakerPolicy{FailureThreshold: 3, OpenDuration: 50 * time.Millisecond}))
+	require.NoError(t, err)
+
+	_, ok := c.CircuitBreaker("/pets")
+	assert.False(t, ok)
+
+	failing.Store(true)
+	for range 3 {
+		resp, err := send(c, "/pets")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	}
+	state, ok := c.CircuitBreaker("/pets")
+	require.True(t, ok)
+	assert.Equal(t, CircuitOpen, state.State)
+	assert.Equal(t, 3, state.Failures)
+
+	// fails fast while open, other operations are not affected
+	_, err = send(c, "/pets")
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, int32(3), requests.Load())
+	_, err = send(c, "/users")
+	require.NoError(t, err)
+
+	// recovers after the open window
+	failing.Store(false)
+	time.Sleep(60 * time.Millisecond)
+	resp, err := send(c, "/pets")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	state, _ = c.CircuitBreaker("/pets")
+	assert.Equal(t, CircuitClosed, state.State)
+	assert.Equal(t, 0, state.Failures)
+}
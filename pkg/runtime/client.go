@@ -20,6 +20,9 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
+
+	"github.com/google/uuid"
 )
 
 type RequestOptions interface {
@@ -37,20 +40,91 @@ type RequestOptionsParameters struct {
 	ContentType   string
 	BodyEncoding  map[string]FieldEncoding
 	QueryEncoding map[string]QueryEncoding
+
+	// CompressBody encodes the body with the Content-Encoding of the client, if it has one, see WithContentEncoder.
+	CompressBody bool
+
+	// IdempotencyKey sets the IdempotencyKeyHeader to a random key, unless the request already has one.
+	// The retries of the request keep it, see WithRetry.
+	IdempotencyKey bool
 }
 
+// IdempotencyKeyHeader is the header of the idempotency key of the requests, see RequestOptionsParameters.IdempotencyKey.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
 // RequestEditorFn is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
+// BodyEditorFn is the function signature for the BodyEditor callback function.
+// It receives the pointer to the typed request body before it is serialized,
+// and can mutate it, e.g. to set a field on every request.
+type BodyEditorFn func(ctx context.Context, body any) error
+
+// BodyEditor is implemented by API clients applying body editors.
+// Generated clients call it before creating the request, when body editors are enabled.
+type BodyEditor interface {
+	EditBody(ctx context.Context, body any) error
+}
+
+// JSONCodec marshals request bodies and unmarshals responses.
+// It allows replacing encoding/json with a faster library,
+// which must honor the json.Marshaler and json.Unmarshaler implementations of the generated types.
+type JSONCodec interface {
+	Marshal(v any) ([]byte, error)
+	Unmarshal(data []byte, v any) error
+}
+
+// StdJSONCodec is the JSONCodec using encoding/json, used by default.
+type StdJSONCodec struct{}
+
+// Marshal returns the JSON encoding of v.
+func (StdJSONCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// Unmarshal parses the JSON-encoded data and stores the result in the value pointed to by v.
+func (StdJSONCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
 type HttpRequestDoer interface {
 	Do(context context.Context, req *http.Request) (*http.Response, error)
 }
 
+// HTTPClientDoer is an HttpRequestDoer sending the requests with an http.Client,
+// e.g. one with a custom transport. The zero value uses http.DefaultClient.
+type HTTPClientDoer struct {
+	Client *http.Client
+}
+
+// Do sends the request with the given context.
+func (d HTTPClientDoer) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	client := d.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return client.Do(req.WithContext(ctx))
+}
+
 type Response struct {
 	Content    []byte
 	StatusCode int
 	Headers    http.Header
 	Raw        *http.Response
+
+	// Body is the undrained body of a streamed response, see WithStreamedResponse, Content being nil then.
+	// The caller closes it.
+	Body io.ReadCloser
+
+	// RateLimit is the rate limit parsed from the headers, nil if the response has none.
+	RateLimit *RateLimit
+}
+
+// NoContent is returned by the client methods of operations whose success response has no body,
+// e.g. 204 No Content, with the status code and the headers of the received response.
+type NoContent struct {
+	StatusCode int         `json:"statusCode"`
+	Headers    http.Header `json:"headers,omitempty"`
 }
 
 type APIClient interface {
@@ -63,10 +137,42 @@ type APIClient interface {
 // BaseURL is the base URL for the API.
 // httpClient is the HTTP client to use for making requests.
 // requestEditors is a list of callbacks for modifying requests which are generated before sending over the network.
+// bodyEditors is a list of callbacks for modifying the typed request bodies before they are serialized.
+// jsonCodec marshals the JSON request bodies, encoding/json if nil.
+// rateLimits is the rate limit of the last response of each operation, delaying the requests if respectRateLimit is set.
+// methodOverride sends the PUT, PATCH and DELETE requests as POST, see WithMethodOverride.
+// contentDecoders decode the response bodies by Content-Encoding, advertised in acceptEncoding, see WithContentDecoders.
+// contentEncoder encodes the request bodies with contentEncoding, see WithContentEncoder.
+// signer signs the requests right before they're sent, see WithRequestSigner.
+// circuitBreakers are the circuit breakers of the operations, created with circuitBreakerPolicy, see WithCircuitBreaker.
+// interceptors wrap the execution of the requests, see WithInterceptors.
+// retryPolicy sends the requests again while retryClassifier reports them as retryable, see WithRetry.
 type Client struct {
 	baseURL        string
 	httpClient     HttpRequestDoer
 	requestEditors []RequestEditorFn
+	bodyEditors    []BodyEditorFn
+	jsonCodec      JSONCodec
+	methodOverride bool
+
+	contentDecoders map[string]ContentDecoder
+	acceptEncoding  string
+	contentEncoder  ContentEncoder
+	contentEncoding string
+	signer          RequestSigner
+
+	respectRateLimit bool
+	rateLimitsMu     sync.Mutex
+	rateLimits       map[string]RateLimit
+
+	circuitBreakerPolicy *CircuitBreakerPolicy
+	circuitBreakersMu    sync.Mutex
+	circuitBreakers      map[string]*circuitBreaker
+
+	interceptors []Interceptor
+
+	retryPolicy     *RetryPolicy
+	retryClassifier RetryClassifier
 }
 
 // GetBaseURL returns the base URL of the API client.
@@ -77,21 +183,66 @@ func (c *Client) GetBaseURL() string {
 // CreateRequest creates a new HTTP request with the given parameters and applies any request editors.
 // It returns the created request or an error if the request could not be created.
 func (c *Client) CreateRequest(ctx context.Context, params RequestOptionsParameters, reqEditors ...RequestEditorFn) (*http.Request, error) {
-	req, err := createRequest(ctx, params)
+	req, err := createRequest(ctx, params, c.jsonCodec)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
 
+	if c.methodOverride {
+		overrideMethod(req)
+	}
+
+	if c.acceptEncoding != "" {
+		req.Header.Set("Accept-Encoding", c.acceptEncoding)
+	}
+
+	if params.IdempotencyKey && req.Header.Get(IdempotencyKeyHeader) == "" {
+		req.Header.Set(IdempotencyKeyHeader, uuid.NewString())
+	}
+
 	if err = c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, fmt.Errorf("error applying request editors: %w", err)
 	}
 
+	if params.CompressBody && c.contentEncoder != nil {
+		if err = encodeRequestBody(req, c.contentEncoding, c.contentEncoder); err != nil {
+			return nil, err
+		}
+	}
+
 	return req, nil
 }
 
 // ExecuteRequest sends the HTTP request and returns the response.
 // It records the HTTP call with latency if an HTTPCallRecorder is set.
+// The rate limit of the response is kept per operationPath, see RateLimit.
+// The request is signed right before it's sent, see WithRequestSigner.
+// It fails with ErrCircuitOpen while the circuit breaker of the operation is open, see WithCircuitBreaker.
+// Retryable attempts are sent again, each going through the rate limit and the circuit breaker, see WithRetry.
+// The interceptors of the client wrap all of it, see WithInterceptors.
+// The download of the response body is reported to the progress callback of ctx, see WithDownloadProgress.
 func (c *Client) ExecuteRequest(ctx context.Context, req *http.Request, operationPath string) (*Response, error) {
+	return c.intercept(ctx, req, operationPath, func(ctx context.Context, req *http.Request) (*Response, error) {
+		return c.executeWithRetry(ctx, req, func(ctx context.Context, req *http.Request) (*Response, error) {
+			if err := c.waitRateLimit(ctx, operationPath); err != nil {
+				return nil, fmt.Errorf("error waiting for rate limit: %w", err)
+			}
+
+			return c.executeWithCircuitBreaker(ctx, operationPath, func() (*Response, error) {
+				return c.sendRequest(ctx, req, operationPath)
+			})
+		})
+	})
+}
+
+// sendRequest signs and sends the request, and reads the response, unless it's streamed, see WithStreamedResponse.
+func (c *Client) sendRequest(ctx context.Context, req *http.Request, operationPath string) (*Response, error) {
+	if c.signer != nil {
+		if err := signRequest(ctx, c.signer, req); err != nil {
+			return nil, err
+		}
+	}
+
 	resp, err := c.httpClient.Do(ctx, req)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
@@ -101,22 +252,41 @@ func (c *Client) ExecuteRequest(ctx context.Context, req *http.Request, operatio
 		return nil, nil
 	}
 
-	var bodyBytes []byte
+	res := &Response{
+		StatusCode: resp.StatusCode,
+		Headers:    resp.Header,
+		Raw:        resp,
+	}
 	if resp.Body != nil {
-		defer func() { _ = resp.Body.Close() }()
-		var err error
-		bodyBytes, err = io.ReadAll(resp.Body)
+		// The progress counts the bytes received, before decoding, like the Content-Length.
+		if progress := downloadProgressFromContext(ctx); progress != nil {
+			resp.Body = &progressReader{ReadCloser: resp.Body, total: resp.ContentLength, progress: progress}
+		}
+		body, decoded, err := decodeResponseBody(resp, c.contentDecoders)
 		if err != nil {
-			return nil, fmt.Errorf("error reading response body: %w", err)
+			_ = resp.Body.Close()
+			return nil, err
+		}
+		if decoded {
+			body = &decodedBody{ReadCloser: body, body: resp.Body}
+		}
+
+		if isStreamedResponse(ctx, resp.StatusCode) {
+			res.Body = body
+		} else {
+			res.Content, err = io.ReadAll(body)
+			_ = body.Close()
+			if err != nil {
+				return nil, fmt.Errorf("error reading response body: %w", err)
+			}
 		}
 	}
 
-	return &Response{
-		Content:    bodyBytes,
-		StatusCode: resp.StatusCode,
-		Headers:    resp.Header,
-		Raw:        resp,
-	}, nil
+	if rl, ok := ParseRateLimit(resp.Header); ok {
+		res.RateLimit = &rl
+		c.setRateLimit(operationPath, rl)
+	}
+	return res, nil
 }
 
 // applyEditors applies all the request editors to the request.
@@ -135,6 +305,20 @@ func (c *Client) applyEditors(ctx context.Context, req *http.Request, additional
 	return nil
 }
 
+// EditBody applies all the body editors to the request body.
+// Nil bodies are left untouched.
+func (c *Client) EditBody(ctx context.Context, body any) error {
+	if body == nil {
+		return nil
+	}
+	for _, fn := range c.bodyEditors {
+		if err := fn(ctx, body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // APIClientOption allows setting custom parameters during construction.
 type APIClientOption func(*Client) error
 
@@ -172,8 +356,51 @@ func WithRequestEditorFn(fn RequestEditorFn) APIClientOption {
 	}
 }
 
+// WithBodyEditorFn allows setting up a callback function, which will be
+// called with the typed request body before it is serialized. This can be used to mutate the body.
+// It is only called by clients generated with body editors enabled.
+func WithBodyEditorFn(fn BodyEditorFn) APIClientOption {
+	return func(c *Client) error {
+		c.bodyEditors = append(c.bodyEditors, fn)
+		return nil
+	}
+}
+
+// WithJSONCodec allows replacing encoding/json for marshaling the JSON request bodies.
+// Generated clients configured with a JSON library set it in NewDefault<Client>.
+func WithJSONCodec(codec JSONCodec) APIClientOption {
+	return func(c *Client) error {
+		c.jsonCodec = codec
+		return nil
+	}
+}
+
+// MethodOverrideHeader is the header of the method of the requests sent as POST, see WithMethodOverride.
+const MethodOverrideHeader = "X-HTTP-Method-Override"
+
+// WithMethodOverride allows sending the PUT, PATCH and DELETE requests as POST requests,
+// with their method in the X-HTTP-Method-Override header, for gateways only letting GET and POST through.
+// The request editors get the overridden request.
+func WithMethodOverride(enabled bool) APIClientOption {
+	return func(c *Client) error {
+		c.methodOverride = enabled
+		return nil
+	}
+}
+
+// overrideMethod sends req as POST with its method in the MethodOverrideHeader, unless it's a GET, HEAD or POST request.
+func overrideMethod(req *http.Request) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPost:
+		return
+	}
+	req.Header.Set(MethodOverrideHeader, req.Method)
+	req.Method = http.MethodPost
+}
+
 // createRequest creates a new POST request with the given URL, payload and headers.
-func createRequest(ctx context.Context, params RequestOptionsParameters) (*http.Request, error) {
+// JSON payloads are marshaled with the codec, encoding/json if nil.
+func createRequest(ctx context.Context, params RequestOptionsParameters, codec JSONCodec) (*http.Request, error) {
 	options := params.Options
 
 	var (
@@ -251,7 +478,10 @@ func createRequest(ctx context.Context, params RequestOptionsParameters) (*http.
 	)
 
 	// Encode payload according to decided contentType
-	if payload != nil {
+	if reader, ok := payload.(io.Reader); ok {
+		// Binary bodies are streamed as they are
+		bodyReader = reader
+	} else if payload != nil {
 		ctLower := strings.ToLower(strings.TrimSpace(contentType))
 		switch {
 		case strings.HasPrefix(ctLower, "application/x-www-form-urlencoded"):
@@ -262,7 +492,10 @@ func createRequest(ctx context.Context, params RequestOptionsParameters) (*http.
 			bodyBytes = []byte(encodedPayload)
 		default:
 			// Default: treat as JSON
-			bodyBytes, err = json.Marshal(payload)
+			if codec == nil {
+				codec = StdJSONCodec{}
+			}
+			bodyBytes, err = codec.Marshal(payload)
 			if err != nil {
 				return nil, err
 			}
@@ -302,4 +535,9 @@ func replacePathPlaceholders(reqURL string, pathParams map[string]any) string {
 	return reqURL
 }
 
-var _ APIClient = (*Client)(nil)
+var (
+	_ APIClient              = (*Client)(nil)
+	_ BodyEditor             = (*Client)(nil)
+	_ RateLimitReporter      = (*Client)(nil)
+	_ CircuitBreakerReporter = (*Client)(nil)
+)
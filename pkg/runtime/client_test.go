@@ -11,7 +11,10 @@
 package runtime
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -80,6 +83,23 @@ func TestClient_CreateRequest(t *testing.T) {
 			expectedError:       false,
 			expectedContentType: "application/json",
 		},
+		{
+			name: "keeps reserved characters of allowReserved params unescaped",
+			params: RequestOptionsParameters{
+				Options: mockRequestOptions{
+					query: map[string]any{"prefix": "docs/2026:q1", "cursor": "a/b", "recursive": ""},
+				},
+				RequestURL: "https://api.example.com/files",
+				Method:     "GET",
+				QueryEncoding: map[string]QueryEncoding{
+					"prefix":    {Style: "form", AllowReserved: true},
+					"recursive": {Style: "form", AllowEmptyValue: true},
+				},
+			},
+			expectedMethod:      "GET",
+			expectedURL:         "https://api.example.com/files?cursor=a%2Fb&prefix=docs/2026:q1&recursive",
+			expectedContentType: "application/json",
+		},
 		{
 			name: "creates POST request with body",
 			params: RequestOptionsParameters{
@@ -268,6 +288,58 @@ func TestWithHTTPClient(t *testing.T) {
 	assert.Equal(t, mockDoer, client.httpClient)
 }
 
+// roundTripperFunc is an http.RoundTripper recording the requests instead of sending them.
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestHTTPClientDoer(t *testing.T) {
+	t.Run("uses the transport of the http client", func(t *testing.T) {
+		type ctxKey struct{}
+		var sent *http.Request
+		httpClient := &http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			sent = req
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"ok":true}`)),
+				Header:     http.Header{},
+			}, nil
+		})}
+
+		client, err := NewAPIClient("https://api.example.com", WithHTTPClient(HTTPClientDoer{Client: httpClient}))
+		require.NoError(t, err)
+
+		ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+		req, err := client.CreateRequest(ctx, RequestOptionsParameters{
+			RequestURL: "https://api.example.com/pets",
+			Method:     http.MethodGet,
+		})
+		require.NoError(t, err)
+
+		resp, err := client.ExecuteRequest(ctx, req, "/pets")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, `{"ok":true}`, string(resp.Content))
+
+		require.NotNil(t, sent)
+		assert.Equal(t, "/pets", sent.URL.Path)
+		assert.Equal(t, "value", sent.Context().Value(ctxKey{}))
+	})
+
+	t.Run("zero value uses the default client", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		req, err := http.NewRequest(http.MethodGet, "http://127.0.0.1:0/pets", nil)
+		require.NoError(t, err)
+
+		_, err = HTTPClientDoer{}.Do(ctx, req)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
 func TestWithRequestEditorFn(t *testing.T) {
 	editor := func(ctx context.Context, req *http.Request) error { return nil }
 	client := &Client{}
@@ -277,6 +349,178 @@ func TestWithRequestEditorFn(t *testing.T) {
 	assert.Len(t, client.requestEditors, 1)
 }
 
+func TestWithBodyEditorFn(t *testing.T) {
+	editor := func(ctx context.Context, body any) error { return nil }
+	client := &Client{}
+
+	err := WithBodyEditorFn(editor)(client)
+	assert.NoError(t, err)
+	assert.Len(t, client.bodyEditors, 1)
+}
+
+// upperJSONCodec wraps encoding/json and upper-cases the marshaled bodies, to tell it apart.
+type upperJSONCodec struct{}
+
+func (upperJSONCodec) Marshal(v any) ([]byte, error) {
+	data, err := json.Marshal(v)
+	return bytes.ToUpper(data), err
+}
+
+func (upperJSONCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+func TestWithJSONCodec(t *testing.T) {
+	params := RequestOptionsParameters{
+		Options:     mockRequestOptions{body: map[string]string{"name": "test"}},
+		RequestURL:  "https://api.example.com/users",
+		Method:      "POST",
+		ContentType: "application/json",
+	}
+
+	t.Run("marshals body with codec", func(t *testing.T) {
+		client, err := NewAPIClient("https://api.example.com", WithJSONCodec(upperJSONCodec{}))
+		require.NoError(t, err)
+
+		req, err := client.CreateRequest(context.Background(), params)
+		require.NoError(t, err)
+
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		assert.Equal(t, `{"NAME":"TEST"}`, string(body))
+	})
+
+	t.Run("defaults to encoding/json", func(t *testing.T) {
+		req, err := (&Client{}).CreateRequest(context.Background(), params)
+		require.NoError(t, err)
+
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		assert.Equal(t, `{"name":"test"}`, string(body))
+	})
+}
+
+func TestCreateRequest_ReaderBody(t *testing.T) {
+	params := RequestOptionsParameters{
+		Options:     mockRequestOptions{body: strings.NewReader("binary-data")},
+		RequestURL:  "https://api.example.com/avatar",
+		Method:      "PUT",
+		ContentType: "application/octet-stream",
+	}
+
+	req, err := (&Client{}).CreateRequest(context.Background(), params)
+	require.NoError(t, err)
+
+	assert.Equal(t, "application/octet-stream", req.Header.Get("Content-Type"))
+	assert.Equal(t, int64(len("binary-data")), req.ContentLength)
+	body, err := io.ReadAll(req.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "binary-data", string(body))
+}
+
+func TestWithMethodOverride(t *testing.T) {
+	newRequest := func(t *testing.T, method string, opts ...APIClientOption) *http.Request {
+		t.Helper()
+		client, err := NewAPIClient("https://api.example.com", opts...)
+		require.NoError(t, err)
+		req, err := client.CreateRequest(context.Background(), RequestOptionsParameters{
+			RequestURL: "https://api.example.com/pets/1",
+			Method:     method,
+		})
+		require.NoError(t, err)
+		return req
+	}
+
+	t.Run("tunnels through POST", func(t *testing.T) {
+		for _, method := range []string{http.MethodPut, http.MethodPatch, http.MethodDelete} {
+			req := newRequest(t, method, WithMethodOverride(true))
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, method, req.Header.Get(MethodOverrideHeader))
+		}
+	})
+
+	t.Run("keeps GET and POST", func(t *testing.T) {
+		for _, method := range []string{http.MethodGet, http.MethodPost} {
+			req := newRequest(t, method, WithMethodOverride(true))
+			assert.Equal(t, method, req.Method)
+			assert.Empty(t, req.Header.Get(MethodOverrideHeader))
+		}
+	})
+
+	t.Run("editors see the overridden request", func(t *testing.T) {
+		var seen string
+		req := newRequest(t, http.MethodPatch, WithMethodOverride(true), WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+			seen = req.Method + " " + req.Header.Get(MethodOverrideHeader)
+			return nil
+		}))
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, "POST PATCH", seen)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		req := newRequest(t, http.MethodDelete, WithMethodOverride(false))
+		assert.Equal(t, http.MethodDelete, req.Method)
+		assert.Empty(t, req.Header.Get(MethodOverrideHeader))
+	})
+}
+
+func TestClient_EditBody(t *testing.T) {
+	type payload struct {
+		Tenant string
+		Steps  []string
+	}
+
+	t.Run("applies editors in order", func(t *testing.T) {
+		client, err := NewAPIClient("https://example.com",
+			WithBodyEditorFn(func(_ context.Context, body any) error {
+				p := body.(*payload)
+				p.Tenant = "acme"
+				p.Steps = append(p.Steps, "first")
+				return nil
+			}),
+			WithBodyEditorFn(func(_ context.Context, body any) error {
+				p := body.(*payload)
+				p.Steps = append(p.Steps, "second")
+				return nil
+			}),
+		)
+		require.NoError(t, err)
+
+		body := &payload{}
+		require.NoError(t, client.EditBody(context.Background(), body))
+		assert.Equal(t, &payload{Tenant: "acme", Steps: []string{"first", "second"}}, body)
+	})
+
+	t.Run("stops on error", func(t *testing.T) {
+		called := false
+		client, err := NewAPIClient("https://example.com",
+			WithBodyEditorFn(func(_ context.Context, _ any) error {
+				return errors.New("boom")
+			}),
+			WithBodyEditorFn(func(_ context.Context, _ any) error {
+				called = true
+				return nil
+			}),
+		)
+		require.NoError(t, err)
+
+		err = client.EditBody(context.Background(), &payload{})
+		assert.EqualError(t, err, "boom")
+		assert.False(t, called)
+	})
+
+	t.Run("nil body", func(t *testing.T) {
+		client, err := NewAPIClient("https://example.com",
+			WithBodyEditorFn(func(_ context.Context, _ any) error {
+				t.Fatal("editor must not be called")
+				return nil
+			}),
+		)
+		require.NoError(t, err)
+		assert.NoError(t, client.EditBody(context.Background(), nil))
+	})
+}
+
 func TestReplacePathPlaceholders(t *testing.T) {
 	tests := []struct {
 		name           string
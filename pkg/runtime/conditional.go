@@ -0,0 +1,98 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+// ConditionalResult is the result of a conditional request, sent with If-None-Match.
+type ConditionalResult[T any] struct {
+	// ETag is the ETag header of the response, to send with the next request.
+	ETag string
+
+	// NotModified is true on a 304 Not Modified response, which has no body:
+	// the resource didn't change since the ETag sent with the request.
+	NotModified bool
+
+	// Body is the response body, nil when NotModified is true.
+	Body *T
+}
+
+// Conditional runs call, sending its request with the If-None-Match header set to etag when it's not empty,
+// and returns the response body along with the ETag header of the response.
+// A 304 Not Modified response gives a result with NotModified set instead of an error.
+func Conditional[T any](ctx context.Context, apiClient APIClient, etag string,
+	call func(ctx context.Context, apiClient APIClient) (*T, error)) (*ConditionalResult[T], error) {
+	conditional := &conditionalAPIClient{APIClient: apiClient, etag: etag}
+	body, err := call(ctx, conditional)
+
+	resp := conditional.response()
+	if resp == nil {
+		return nil, err
+	}
+	res := &ConditionalResult[T]{ETag: resp.Headers.Get("ETag")}
+	if resp.StatusCode == http.StatusNotModified {
+		res.NotModified = true
+		return res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	res.Body = body
+	return res, nil
+}
+
+// conditionalAPIClient is the APIClient of Conditional. It sets the If-None-Match header of the request
+// and keeps the response.
+type conditionalAPIClient struct {
+	APIClient
+	etag string
+
+	mu   sync.Mutex
+	resp *Response
+}
+
+func (c *conditionalAPIClient) CreateRequest(ctx context.Context, params RequestOptionsParameters, reqEditors ...RequestEditorFn) (*http.Request, error) {
+	if c.etag != "" {
+		reqEditors = append(reqEditors, func(_ context.Context, req *http.Request) error {
+			req.Header.Set("If-None-Match", c.etag)
+			return nil
+		})
+	}
+	return c.APIClient.CreateRequest(ctx, params, reqEditors...)
+}
+
+func (c *conditionalAPIClient) ExecuteRequest(ctx context.Context, req *http.Request, operationPath string) (*Response, error) {
+	resp, err := c.APIClient.ExecuteRequest(ctx, req, operationPath)
+	if err == nil && resp != nil {
+		c.mu.Lock()
+		c.resp = resp
+		c.mu.Unlock()
+	}
+	return resp, err
+}
+
+// EditBody runs the body editors of the client.
+func (c *conditionalAPIClient) EditBody(ctx context.Context, body any) error {
+	if editor, ok := c.APIClient.(BodyEditor); ok {
+		return editor.EditBody(ctx, body)
+	}
+	return nil
+}
+
+func (c *conditionalAPIClient) response() *Response {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.resp
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// getConditionalCall returns the call of GET path, returning the body of a 200 response.
+func getConditionalCall(path string) func(ctx context.Context, apiClient APIClient) (*string, error) {
+	return func(ctx context.Context, apiClient APIClient) (*string, error) {
+		req, err := apiClient.CreateRequest(ctx, RequestOptionsParameters{RequestURL: apiClient.GetBaseURL() + path, Method: http.MethodGet})
+		if err != nil {
+			return nil, err
+		}
+		resp, err := apiClient.ExecuteRequest(ctx, req, path)
+		if err != nil {
+			return nil, fmt.Errorf("error executing request: %w", err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			return nil, NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode), WithStatusCode(resp.StatusCode))
+		}
+		body := string(resp.Content)
+		return &body, nil
+	}
+}
+
+func TestConditional(t *testing.T) {
+	const etag = `"v1"`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Query().Get("missing") != "":
+			w.WriteHeader(http.StatusNotFound)
+		case r.Header.Get("If-None-Match") == etag:
+			w.Header().Set("ETag", etag)
+			w.WriteHeader(http.StatusNotModified)
+		default:
+			w.Header().Set("ETag", etag)
+			_, _ = w.Write([]byte(`{"name": "Rex"}`))
+		}
+	}))
+	defer srv.Close()
+
+	client, err := NewAPIClient(srv.URL, WithHTTPClient(HTTPClientDoer{Client: srv.Client()}))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	t.Run("without etag", func(t *testing.T) {
+		res, err := Conditional(ctx, client, "", getConditionalCall("/pet"))
+		require.NoError(t, err)
+		assert.Equal(t, etag, res.ETag)
+		assert.False(t, res.NotModified)
+		require.NotNil(t, res.Body)
+		assert.JSONEq(t, `{"name": "Rex"}`, *res.Body)
+	})
+
+	t.Run("not modified", func(t *testing.T) {
+		res, err := Conditional(ctx, client, etag, getConditionalCall("/pet"))
+		require.NoError(t, err)
+		assert.Equal(t, &ConditionalResult[string]{ETag: etag, NotModified: true}, res)
+	})
+
+	t.Run("modified", func(t *testing.T) {
+		res, err := Conditional(ctx, client, `"v0"`, getConditionalCall("/pet"))
+		require.NoError(t, err)
+		assert.False(t, res.NotModified)
+		require.NotNil(t, res.Body)
+	})
+
+	t.Run("error response", func(t *testing.T) {
+		_, err := Conditional(ctx, client, etag, getConditionalCall("/pet?missing=1"))
+		var apiErr *ClientAPIError
+		require.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, http.StatusNotFound, apiErr.StatusCode())
+	})
+
+	t.Run("request not sent", func(t *testing.T) {
+		_, err := Conditional(ctx, client, etag, func(ctx context.Context, apiClient APIClient) (*string, error) {
+			return nil, errors.New("invalid options")
+		})
+		require.EqualError(t, err, "invalid options")
+	})
+}
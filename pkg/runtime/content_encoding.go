@@ -0,0 +1,126 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// ContentDecoder decodes a response body encoded with a Content-Encoding, e.g. br.
+type ContentDecoder func(body io.Reader) (io.ReadCloser, error)
+
+// GzipDecoder is the ContentDecoder of the gzip Content-Encoding.
+func GzipDecoder(body io.Reader) (io.ReadCloser, error) {
+	return gzip.NewReader(body)
+}
+
+// WithContentDecoders makes the client decode the response bodies with the decoder of their Content-Encoding,
+// keyed by encoding, e.g. "br". The encodings are sent in the Accept-Encoding header of the requests,
+// unless a request editor sets it. Responses with other encodings are returned as received.
+func WithContentDecoders(decoders map[string]ContentDecoder) APIClientOption {
+	return func(c *Client) error {
+		c.contentDecoders = make(map[string]ContentDecoder, len(decoders))
+		encodings := make([]string, 0, len(decoders))
+		for encoding, decoder := range decoders {
+			if decoder == nil {
+				return fmt.Errorf("nil decoder of content encoding %q", encoding)
+			}
+			encoding = strings.ToLower(encoding)
+			c.contentDecoders[encoding] = decoder
+			encodings = append(encodings, encoding)
+		}
+		sort.Strings(encodings)
+		c.acceptEncoding = strings.Join(encodings, ", ")
+		return nil
+	}
+}
+
+// ContentEncoder encodes a request body with a Content-Encoding, e.g. br, writing the encoded body to w.
+type ContentEncoder func(w io.Writer) (io.WriteCloser, error)
+
+// GzipEncoder is the ContentEncoder of the gzip Content-Encoding.
+func GzipEncoder(w io.Writer) (io.WriteCloser, error) {
+	return gzip.NewWriter(w), nil
+}
+
+// WithContentEncoder makes the client encode the bodies of the requests created with CompressBody set,
+// setting their Content-Encoding header to encoding, e.g. "gzip".
+func WithContentEncoder(encoding string, encoder ContentEncoder) APIClientOption {
+	return func(c *Client) error {
+		if encoder == nil {
+			return fmt.Errorf("nil encoder of content encoding %q", encoding)
+		}
+		c.contentEncoding = strings.ToLower(encoding)
+		c.contentEncoder = encoder
+		return nil
+	}
+}
+
+// encodeRequestBody replaces the body of req with its encoding. Requests without a body,
+// or with a Content-Encoding already set, e.g. by a request editor, are left untouched.
+func encodeRequestBody(req *http.Request, encoding string, encoder ContentEncoder) error {
+	if req.Body == nil || req.Body == http.NoBody || req.Header.Get("Content-Encoding") != "" {
+		return nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return fmt.Errorf("error reading request body: %w", err)
+	}
+
+	var buf bytes.Buffer
+	w, err := encoder(&buf)
+	if err != nil {
+		return fmt.Errorf("error encoding %s request body: %w", encoding, err)
+	}
+	if _, err = w.Write(body); err == nil {
+		err = w.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("error encoding %s request body: %w", encoding, err)
+	}
+
+	encoded := buf.Bytes()
+	req.Body = io.NopCloser(bytes.NewReader(encoded))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(encoded)), nil
+	}
+	req.ContentLength = int64(len(encoded))
+	req.Header.Set("Content-Encoding", encoding)
+	return nil
+}
+
+// decodeResponseBody returns the reader of the decoded body of resp, and true if it has a Content-Encoding of decoders.
+// The Content-Encoding and Content-Length headers of a decoded response are removed, as they no longer apply.
+func decodeResponseBody(resp *http.Response, decoders map[string]ContentDecoder) (io.ReadCloser, bool, error) {
+	encoding := strings.ToLower(strings.TrimSpace(resp.Header.Get("Content-Encoding")))
+	decoder, ok := decoders[encoding]
+	if encoding == "" || !ok {
+		return resp.Body, false, nil
+	}
+
+	body, err := decoder(resp.Body)
+	if err != nil {
+		return nil, false, fmt.Errorf("error decoding %s response body: %w", encoding, err)
+	}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return body, true, nil
+}
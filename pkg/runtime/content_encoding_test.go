@@ -0,0 +1,158 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithContentDecoders(t *testing.T) {
+	var gzipped bytes.Buffer
+	zw := gzip.NewWriter(&gzipped)
+	_, _ = zw.Write([]byte(`{"name":"Rex"}`))
+	require.NoError(t, zw.Close())
+
+	var acceptEncoding string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		acceptEncoding = r.Header.Get("Accept-Encoding")
+		switch r.URL.Path {
+		case "/gzip":
+			w.Header().Set("Content-Encoding", "gzip")
+			_, _ = w.Write(gzipped.Bytes())
+		case "/corrupt":
+			w.Header().Set("Content-Encoding", "gzip")
+			_, _ = w.Write([]byte("not gzip"))
+		case "/unknown":
+			w.Header().Set("Content-Encoding", "compress")
+			_, _ = w.Write([]byte("raw"))
+		}
+	}))
+	defer srv.Close()
+
+	identity := func(body io.Reader) (io.ReadCloser, error) {
+		return io.NopCloser(body), nil
+	}
+
+	execute := func(t *testing.T, path string, opts ...APIClientOption) *Response {
+		t.Helper()
+		client, err := NewAPIClient(srv.URL, append(opts, WithHTTPClient(HTTPClientDoer{Client: srv.Client()}))...)
+		require.NoError(t, err)
+		req, err := client.CreateRequest(context.Background(), RequestOptionsParameters{
+			RequestURL: srv.URL + path,
+			Method:     http.MethodGet,
+		})
+		require.NoError(t, err)
+		res, err := client.ExecuteRequest(context.Background(), req, path)
+		require.NoError(t, err)
+		return res
+	}
+
+	t.Run("decodes the response body", func(t *testing.T) {
+		res := execute(t, "/gzip", WithContentDecoders(map[string]ContentDecoder{"gzip": GzipDecoder, "X-Identity": identity}))
+		assert.Equal(t, `{"name":"Rex"}`, string(res.Content))
+		assert.Equal(t, "gzip, x-identity", acceptEncoding)
+		assert.Empty(t, res.Headers.Get("Content-Encoding"))
+	})
+
+	t.Run("keeps other encodings", func(t *testing.T) {
+		res := execute(t, "/unknown", WithContentDecoders(map[string]ContentDecoder{"gzip": GzipDecoder}))
+		assert.Equal(t, "raw", string(res.Content))
+		assert.Equal(t, "compress", res.Headers.Get("Content-Encoding"))
+	})
+
+	t.Run("corrupt body", func(t *testing.T) {
+		client, err := NewAPIClient(srv.URL, WithContentDecoders(map[string]ContentDecoder{"gzip": GzipDecoder}),
+			WithHTTPClient(HTTPClientDoer{Client: srv.Client()}))
+		require.NoError(t, err)
+		req, err := client.CreateRequest(context.Background(), RequestOptionsParameters{
+			RequestURL: srv.URL + "/corrupt",
+			Method:     http.MethodGet,
+		})
+		require.NoError(t, err)
+		_, err = client.ExecuteRequest(context.Background(), req, "/corrupt")
+		require.ErrorContains(t, err, "error decoding gzip response body")
+	})
+
+	t.Run("nil decoder", func(t *testing.T) {
+		_, err := NewAPIClient(srv.URL, WithContentDecoders(map[string]ContentDecoder{"br": nil}))
+		require.Error(t, err)
+	})
+}
+
+func TestWithContentEncoder(t *testing.T) {
+	client, err := NewAPIClient("https://api.example.com", WithContentEncoder("GZIP", GzipEncoder))
+	require.NoError(t, err)
+
+	create := func(t *testing.T, compress bool, editors ...RequestEditorFn) *http.Request {
+		t.Helper()
+		req, err := client.CreateRequest(context.Background(), RequestOptionsParameters{
+			RequestURL:   "https://api.example.com/pets",
+			Method:       http.MethodPost,
+			ContentType:  "application/json",
+			Options:      mockRequestOptions{body: map[string]string{"name": "Rex"}},
+			CompressBody: compress,
+		}, editors...)
+		require.NoError(t, err)
+		return req
+	}
+
+	t.Run("encodes the request body", func(t *testing.T) {
+		req := create(t, true)
+		assert.Equal(t, "gzip", req.Header.Get("Content-Encoding"))
+
+		for _, body := range []func() (io.ReadCloser, error){
+			func() (io.ReadCloser, error) { return req.Body, nil },
+			req.GetBody,
+		} {
+			rc, err := body()
+			require.NoError(t, err)
+			zr, err := gzip.NewReader(rc)
+			require.NoError(t, err)
+			decoded, err := io.ReadAll(zr)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"name":"Rex"}`, string(decoded))
+		}
+		assert.Positive(t, req.ContentLength)
+	})
+
+	t.Run("not compressed", func(t *testing.T) {
+		req := create(t, false)
+		assert.Empty(t, req.Header.Get("Content-Encoding"))
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name":"Rex"}`, string(body))
+	})
+
+	t.Run("encoding set by a request editor", func(t *testing.T) {
+		req := create(t, true, func(ctx context.Context, req *http.Request) error {
+			req.Header.Set("Content-Encoding", "identity")
+			return nil
+		})
+		assert.Equal(t, "identity", req.Header.Get("Content-Encoding"))
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name":"Rex"}`, string(body))
+	})
+
+	t.Run("nil encoder", func(t *testing.T) {
+		_, err := NewAPIClient("https://api.example.com", WithContentEncoder("br", nil))
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,183 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// maxDecimalPlaces is the number of decimal places of decimals without a finite decimal representation, e.g. 1/3.
+const maxDecimalPlaces = 34
+
+// Decimal is an arbitrary precision decimal number backed by big.Rat, the Go type of
+// `type: number, format: decimal` with the bigrat decimal type.
+// Unlike float64, it keeps monetary amounts exact, e.g. 0.1 + 0.2 is 0.3.
+// It's marshaled to a JSON number, and unmarshaled from a JSON number or string.
+// The zero value is 0.
+type Decimal struct {
+	rat *big.Rat
+}
+
+// DecimalString is a Decimal marshaled to a JSON string, the Go type of
+// `type: string, format: decimal` with the bigrat decimal type.
+type DecimalString struct {
+	Decimal
+}
+
+// NewDecimal returns the decimal with the value of r.
+func NewDecimal(r *big.Rat) Decimal {
+	if r == nil {
+		return Decimal{}
+	}
+	return Decimal{rat: new(big.Rat).Set(r)}
+}
+
+// ParseDecimal parses a decimal number such as "12.50", "-3" or "1e-2".
+func ParseDecimal(s string) (Decimal, error) {
+	if strings.Contains(s, "/") {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+	return Decimal{rat: r}, nil
+}
+
+// MustParseDecimal is like ParseDecimal but panics if s is not a valid decimal.
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// Rat returns a copy of the value of the decimal.
+func (d Decimal) Rat() *big.Rat {
+	if d.rat == nil {
+		return new(big.Rat)
+	}
+	return new(big.Rat).Set(d.rat)
+}
+
+// Float64 returns the nearest float64 value of the decimal.
+func (d Decimal) Float64() float64 {
+	if d.rat == nil {
+		return 0
+	}
+	f, _ := d.rat.Float64()
+	return f
+}
+
+// Cmp compares the decimal with other, returning -1, 0 or +1.
+func (d Decimal) Cmp(other Decimal) int {
+	return d.Rat().Cmp(other.Rat())
+}
+
+// String returns the decimal representation of the value, without exponent.
+// Values without a finite decimal representation are rounded to 34 decimal places.
+func (d Decimal) String() string {
+	if d.rat == nil {
+		return "0"
+	}
+	if d.rat.IsInt() {
+		return d.rat.Num().String()
+	}
+	return d.rat.FloatString(decimalPlaces(d.rat.Denom()))
+}
+
+// MarshalJSON marshals the decimal to a JSON number.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalJSON unmarshals the decimal from a JSON number or string.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	s := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+// MarshalText marshals the decimal to its decimal representation.
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses the decimal representation of the decimal.
+func (d *Decimal) UnmarshalText(data []byte) error {
+	v, err := ParseDecimal(string(data))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// MarshalJSON marshals the decimal to a JSON string.
+func (d DecimalString) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// RegisterDecimalTypeFunc registers a custom type function with the validator
+// validating Decimal and DecimalString values as their float64 value,
+// so that the gt, gte, lt and lte tags of the minimum and maximum of decimals apply.
+func RegisterDecimalTypeFunc(v *validator.Validate) {
+	v.RegisterCustomTypeFunc(func(field reflect.Value) any {
+		switch d := field.Interface().(type) {
+		case Decimal:
+			return d.Float64()
+		case DecimalString:
+			return d.Float64()
+		}
+		return nil
+	}, Decimal{}, DecimalString{})
+}
+
+// decimalPlaces returns the number of decimal places representing a fraction with the denominator exactly,
+// or maxDecimalPlaces if the denominator has prime factors other than 2 and 5.
+func decimalPlaces(denom *big.Int) int {
+	n := new(big.Int).Set(denom)
+	twos, fives := 0, 0
+	two, five, rem := big.NewInt(2), big.NewInt(5), new(big.Int)
+	for {
+		q, r := new(big.Int).QuoRem(n, two, rem)
+		if r.Sign() != 0 {
+			break
+		}
+		n, twos = q, twos+1
+	}
+	for {
+		q, r := new(big.Int).QuoRem(n, five, rem)
+		if r.Sign() != 0 {
+			break
+		}
+		n, fives = q, fives+1
+	}
+	if n.Cmp(big.NewInt(1)) != 0 {
+		return maxDecimalPlaces
+	}
+	return max(twos, fives)
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{in: "0", expected: "0"},
+		{in: "12.50", expected: "12.5"},
+		{in: "-3", expected: "-3"},
+		{in: "1e-2", expected: "0.01"},
+		{in: "1234567890123456789.000000000000000001", expected: "1234567890123456789.000000000000000001"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			d, err := ParseDecimal(tc.in)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, d.String())
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, in := range []string{"", "abc", "1/3", "1.2.3"} {
+			_, err := ParseDecimal(in)
+			assert.Error(t, err, in)
+		}
+	})
+}
+
+func TestDecimal_String(t *testing.T) {
+	assert.Equal(t, "0", Decimal{}.String())
+	assert.Equal(t, "0.3", NewDecimal(new(big.Rat).Add(big.NewRat(1, 10), big.NewRat(2, 10))).String())
+	assert.Equal(t, "0.3333333333333333333333333333333333", NewDecimal(big.NewRat(1, 3)).String())
+}
+
+func TestDecimal_JSON(t *testing.T) {
+	type payment struct {
+		Amount DecimalString `json:"amount"`
+		Fee    *Decimal      `json:"fee,omitempty"`
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		data := `{"amount":"19.99","fee":0.10000000000000000001}`
+
+		var p payment
+		require.NoError(t, json.Unmarshal([]byte(data), &p))
+		assert.Equal(t, 0, p.Amount.Cmp(MustParseDecimal("19.99")))
+		assert.Equal(t, "0.10000000000000000001", p.Fee.String())
+
+		res, err := json.Marshal(p)
+		require.NoError(t, err)
+		assert.Equal(t, data, string(res))
+	})
+
+	t.Run("number or string", func(t *testing.T) {
+		var p payment
+		require.NoError(t, json.Unmarshal([]byte(`{"amount":19.99,"fee":"1.5"}`), &p))
+		assert.Equal(t, "19.99", p.Amount.String())
+		assert.Equal(t, "1.5", p.Fee.String())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var p payment
+		assert.Error(t, json.Unmarshal([]byte(`{"amount":"ten"}`), &p))
+	})
+}
+
+func TestRegisterDecimalTypeFunc(t *testing.T) {
+	v := validator.New(validator.WithRequiredStructEnabled())
+	RegisterDecimalTypeFunc(v)
+
+	type payment struct {
+		Amount DecimalString `validate:"required,gte=0"`
+		Fee    *Decimal      `validate:"omitempty,lte=100"`
+	}
+
+	fee := MustParseDecimal("100.01")
+	assert.NoError(t, v.Struct(payment{Amount: DecimalString{MustParseDecimal("0.01")}}))
+	assert.Error(t, v.Struct(payment{Amount: DecimalString{MustParseDecimal("-0.01")}}))
+	assert.Error(t, v.Struct(payment{Amount: DecimalString{MustParseDecimal("1")}, Fee: &fee}))
+}
@@ -0,0 +1,276 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// DurationSeconds is a time.Duration marshaled to a JSON number of seconds,
+// the Go type of numbers and integers with `x-go-type-duration: seconds`.
+type DurationSeconds struct {
+	time.Duration
+}
+
+// DurationMillis is a time.Duration marshaled to a JSON number of milliseconds,
+// the Go type of numbers and integers with `x-go-type-duration: ms`.
+type DurationMillis struct {
+	time.Duration
+}
+
+// DurationISO8601 is a time.Duration marshaled to a JSON string in the ISO 8601 duration format, e.g. PT1H30M,
+// the Go type of strings with `x-go-type-duration: iso8601`.
+type DurationISO8601 struct {
+	time.Duration
+}
+
+// MarshalJSON marshals the duration to a JSON number of seconds, an integer unless it has a fraction of a second.
+func (d DurationSeconds) MarshalJSON() ([]byte, error) {
+	return d.MarshalText()
+}
+
+// UnmarshalJSON unmarshals the duration from a JSON number of seconds.
+func (d *DurationSeconds) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	return d.UnmarshalText(data)
+}
+
+// MarshalText marshals the duration to its number of seconds.
+func (d DurationSeconds) MarshalText() ([]byte, error) {
+	return []byte(formatDurationUnit(d.Duration, time.Second)), nil
+}
+
+// UnmarshalText parses a number of seconds.
+func (d *DurationSeconds) UnmarshalText(data []byte) error {
+	v, err := parseDurationUnit(string(data), time.Second)
+	if err != nil {
+		return err
+	}
+	d.Duration = v
+	return nil
+}
+
+// MarshalJSON marshals the duration to a JSON number of milliseconds, an integer unless it has a fraction of a millisecond.
+func (d DurationMillis) MarshalJSON() ([]byte, error) {
+	return d.MarshalText()
+}
+
+// UnmarshalJSON unmarshals the duration from a JSON number of milliseconds.
+func (d *DurationMillis) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	return d.UnmarshalText(data)
+}
+
+// MarshalText marshals the duration to its number of milliseconds.
+func (d DurationMillis) MarshalText() ([]byte, error) {
+	return []byte(formatDurationUnit(d.Duration, time.Millisecond)), nil
+}
+
+// UnmarshalText parses a number of milliseconds.
+func (d *DurationMillis) UnmarshalText(data []byte) error {
+	v, err := parseDurationUnit(string(data), time.Millisecond)
+	if err != nil {
+		return err
+	}
+	d.Duration = v
+	return nil
+}
+
+// String returns the ISO 8601 representation of the duration, e.g. PT1H30M.
+func (d DurationISO8601) String() string {
+	return FormatISO8601Duration(d.Duration)
+}
+
+// MarshalJSON marshals the duration to a JSON string in the ISO 8601 duration format.
+func (d DurationISO8601) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON unmarshals the duration from a JSON string in the ISO 8601 duration format.
+func (d *DurationISO8601) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+// MarshalText marshals the duration to the ISO 8601 duration format.
+func (d DurationISO8601) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses an ISO 8601 duration.
+func (d *DurationISO8601) UnmarshalText(data []byte) error {
+	v, err := ParseISO8601Duration(string(data))
+	if err != nil {
+		return err
+	}
+	d.Duration = v
+	return nil
+}
+
+// ParseISO8601Duration parses an ISO 8601 duration such as PT1H30M, P2DT12H or -PT0.5S.
+// Days are 24 hours and weeks 7 days; years and months, without a fixed length, are not supported.
+func ParseISO8601Duration(s string) (time.Duration, error) {
+	invalid := fmt.Errorf("invalid ISO 8601 duration %q", s)
+
+	rest, negative := strings.CutPrefix(s, "-")
+	rest, ok := strings.CutPrefix(rest, "P")
+	if !ok || rest == "" {
+		return 0, invalid
+	}
+
+	var (
+		total     float64
+		inTime    bool
+		hasValues bool
+	)
+	for rest != "" {
+		if rest[0] == 'T' {
+			if inTime || len(rest) == 1 {
+				return 0, invalid
+			}
+			inTime = true
+			rest = rest[1:]
+			continue
+		}
+
+		i := strings.IndexFunc(rest, func(r rune) bool { return (r < '0' || r > '9') && r != '.' && r != ',' })
+		if i <= 0 {
+			return 0, invalid
+		}
+		n, err := strconv.ParseFloat(strings.Replace(rest[:i], ",", ".", 1), 64)
+		if err != nil {
+			return 0, invalid
+		}
+
+		var unit time.Duration
+		switch designator := rest[i]; {
+		case !inTime && designator == 'W':
+			unit = 7 * 24 * time.Hour
+		case !inTime && designator == 'D':
+			unit = 24 * time.Hour
+		case inTime && designator == 'H':
+			unit = time.Hour
+		case inTime && designator == 'M':
+			unit = time.Minute
+		case inTime && designator == 'S':
+			unit = time.Second
+		default:
+			return 0, invalid
+		}
+		total += n * float64(unit)
+		hasValues = true
+		rest = rest[i+1:]
+	}
+	if !hasValues || total > math.MaxInt64 {
+		return 0, invalid
+	}
+
+	d := time.Duration(math.Round(total))
+	if negative {
+		d = -d
+	}
+	return d, nil
+}
+
+// FormatISO8601Duration formats the duration in the ISO 8601 duration format, in hours, minutes and seconds,
+// e.g. PT36H or PT1M30.5S. The zero duration is PT0S.
+func FormatISO8601Duration(d time.Duration) string {
+	if d == 0 {
+		return "PT0S"
+	}
+
+	var b strings.Builder
+	if d < 0 {
+		b.WriteByte('-')
+		d = -d
+	}
+	b.WriteString("PT")
+	if h := d / time.Hour; h > 0 {
+		b.WriteString(strconv.FormatInt(int64(h), 10) + "H")
+		d -= h * time.Hour
+	}
+	if m := d / time.Minute; m > 0 {
+		b.WriteString(strconv.FormatInt(int64(m), 10) + "M")
+		d -= m * time.Minute
+	}
+	if d > 0 {
+		b.WriteString(strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "S")
+	}
+	return b.String()
+}
+
+// RegisterDurationTypeFunc registers a custom type function with the validator validating
+// DurationSeconds and DurationMillis values as their number in their unit, and DurationISO8601 values as their string,
+// so that the gt, gte, lt and lte tags of the minimum and maximum apply to the value as sent.
+func RegisterDurationTypeFunc(v *validator.Validate) {
+	v.RegisterCustomTypeFunc(func(field reflect.Value) any {
+		switch d := field.Interface().(type) {
+		case DurationSeconds:
+			return durationInUnit(d.Duration, time.Second)
+		case DurationMillis:
+			return durationInUnit(d.Duration, time.Millisecond)
+		case DurationISO8601:
+			return d.String()
+		}
+		return nil
+	}, DurationSeconds{}, DurationMillis{}, DurationISO8601{})
+}
+
+// durationInUnit returns the duration as a number of units.
+func durationInUnit(d, unit time.Duration) float64 {
+	return float64(d) / float64(unit)
+}
+
+// formatDurationUnit formats the duration as a number of units.
+func formatDurationUnit(d, unit time.Duration) string {
+	if d%unit == 0 {
+		return strconv.FormatInt(int64(d/unit), 10)
+	}
+	return strconv.FormatFloat(durationInUnit(d, unit), 'f', -1, 64)
+}
+
+// parseDurationUnit parses a number of units.
+func parseDurationUnit(s string, unit time.Duration) (time.Duration, error) {
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if n > math.MaxInt64/int64(unit) || n < math.MinInt64/int64(unit) {
+			return 0, fmt.Errorf("duration %q out of range", s)
+		}
+		return time.Duration(n) * unit, nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q: %w", s, err)
+	}
+	total := f * float64(unit)
+	if math.IsNaN(total) || total > math.MaxInt64 || total < math.MinInt64 {
+		return 0, fmt.Errorf("duration %q out of range", s)
+	}
+	return time.Duration(math.Round(total)), nil
+}
@@ -0,0 +1,139 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type durationJob struct {
+	Timeout      DurationSeconds  `json:"timeout" validate:"required,gte=1,lte=3600"`
+	PollInterval *DurationMillis  `json:"pollInterval,omitempty" validate:"omitempty,gte=100"`
+	Retention    *DurationISO8601 `json:"retention,omitempty"`
+}
+
+func TestDuration_RoundTrip(t *testing.T) {
+	data := `{"timeout":90,"pollInterval":250,"retention":"P1DT12H"}`
+
+	var job durationJob
+	require.NoError(t, json.Unmarshal([]byte(data), &job))
+	assert.Equal(t, 90*time.Second, job.Timeout.Duration)
+	assert.Equal(t, 250*time.Millisecond, job.PollInterval.Duration)
+	assert.Equal(t, 36*time.Hour, job.Retention.Duration)
+
+	res, err := json.Marshal(job)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"timeout":90,"pollInterval":250,"retention":"PT36H"}`, string(res))
+}
+
+func TestDurationSeconds(t *testing.T) {
+	tests := []struct {
+		json     string
+		duration time.Duration
+	}{
+		{json: `0`, duration: 0},
+		{json: `3600`, duration: time.Hour},
+		{json: `1.5`, duration: 1500 * time.Millisecond},
+		{json: `-2`, duration: -2 * time.Second},
+	}
+	for _, tc := range tests {
+		t.Run(tc.json, func(t *testing.T) {
+			var d DurationSeconds
+			require.NoError(t, json.Unmarshal([]byte(tc.json), &d))
+			assert.Equal(t, tc.duration, d.Duration)
+
+			res, err := json.Marshal(d)
+			require.NoError(t, err)
+			assert.Equal(t, tc.json, string(res))
+		})
+	}
+
+	var d DurationSeconds
+	assert.Error(t, json.Unmarshal([]byte(`"90"`), &d))
+	assert.Error(t, json.Unmarshal([]byte(`1e300`), &d))
+}
+
+func TestDurationMillis(t *testing.T) {
+	var d DurationMillis
+	require.NoError(t, json.Unmarshal([]byte(`1500`), &d))
+	assert.Equal(t, 1500*time.Millisecond, d.Duration)
+
+	d.Duration = 2500 * time.Microsecond
+	res, err := json.Marshal(d)
+	require.NoError(t, err)
+	assert.Equal(t, `2.5`, string(res))
+}
+
+func TestParseISO8601Duration(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected time.Duration
+	}{
+		{value: "PT0S", expected: 0},
+		{value: "PT1H30M", expected: 90 * time.Minute},
+		{value: "PT1M30.5S", expected: 90500 * time.Millisecond},
+		{value: "PT0,5S", expected: 500 * time.Millisecond},
+		{value: "P2D", expected: 48 * time.Hour},
+		{value: "P1W", expected: 7 * 24 * time.Hour},
+		{value: "P1DT1H", expected: 25 * time.Hour},
+		{value: "-PT5M", expected: -5 * time.Minute},
+	}
+	for _, tc := range tests {
+		t.Run(tc.value, func(t *testing.T) {
+			d, err := ParseISO8601Duration(tc.value)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, d)
+		})
+	}
+
+	for _, value := range []string{"", "P", "PT", "1H", "P1Y", "P1M", "PT1D", "P1H", "PTH", "PT1H2", "P1DT"} {
+		t.Run("invalid "+value, func(t *testing.T) {
+			_, err := ParseISO8601Duration(value)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestFormatISO8601Duration(t *testing.T) {
+	assert.Equal(t, "PT0S", FormatISO8601Duration(0))
+	assert.Equal(t, "PT1H30M", FormatISO8601Duration(90*time.Minute))
+	assert.Equal(t, "PT36H", FormatISO8601Duration(36*time.Hour))
+	assert.Equal(t, "PT1M30.5S", FormatISO8601Duration(90500*time.Millisecond))
+	assert.Equal(t, "-PT5M", FormatISO8601Duration(-5*time.Minute))
+}
+
+func TestRegisterDurationTypeFunc(t *testing.T) {
+	v := validator.New(validator.WithRequiredStructEnabled())
+	RegisterCustomTypeFunc(v)
+
+	valid := durationJob{
+		Timeout:      DurationSeconds{Duration: time.Minute},
+		PollInterval: &DurationMillis{Duration: 100 * time.Millisecond},
+	}
+	assert.NoError(t, v.Struct(valid))
+
+	// the bounds apply to the number of the unit
+	tooLong := valid
+	tooLong.Timeout = DurationSeconds{Duration: 2 * time.Hour}
+	assert.Error(t, v.Struct(tooLong))
+
+	tooShort := valid
+	tooShort.PollInterval = &DurationMillis{Duration: 50 * time.Millisecond}
+	assert.Error(t, v.Struct(tooShort))
+
+	assert.Error(t, v.Struct(durationJob{}))
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"strings"
+)
+
+// EncodeHeaderFields converts a header params struct into header values using the
+// "simple" style, the only style OpenAPI allows for headers.
+// Arrays are comma-separated (X-Ids: 1,2,3) regardless of explode; nil values are omitted.
+func EncodeHeaderFields(v any) (map[string]string, error) {
+	m, err := AsMap[any](v)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, nil
+	}
+
+	res := make(map[string]string, len(m))
+	for k, val := range m {
+		if val == nil {
+			continue
+		}
+		values, _, err := toStringSlice(val)
+		if err != nil {
+			return nil, err
+		}
+		res[k] = strings.Join(values, ",")
+	}
+	return res, nil
+}
+
+// SplitHeaderValues flattens header values for an array parameter.
+// It accepts both repeated headers (X-Id: 1, X-Id: 2) and
+// comma-separated values (X-Id: 1,2), or a mix of the two.
+func SplitHeaderValues(values []string) []string {
+	res := make([]string, 0, len(values))
+	for _, v := range values {
+		for _, part := range strings.Split(v, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				res = append(res, part)
+			}
+		}
+	}
+	return res
+}
@@ -0,0 +1,64 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeHeaderFields(t *testing.T) {
+	type headers struct {
+		RequestID *string  `json:"X-Request-Id,omitempty"`
+		Filter    []string `json:"X-Filter,omitempty"`
+		IDs       []int    `json:"X-Ids,omitempty"`
+		Limit     int      `json:"X-Limit"`
+		Debug     *bool    `json:"X-Debug"`
+	}
+
+	t.Run("encodes scalars and arrays", func(t *testing.T) {
+		res, err := EncodeHeaderFields(&headers{
+			RequestID: Ptr("abc"),
+			Filter:    []string{"a", "b"},
+			IDs:       []int{1, 2, 3},
+			Limit:     10,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"X-Request-Id": "abc",
+			"X-Filter":     "a,b",
+			"X-Ids":        "1,2,3",
+			"X-Limit":      "10",
+		}, res)
+	})
+
+	t.Run("nil input", func(t *testing.T) {
+		res, err := EncodeHeaderFields(nil)
+		require.NoError(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("marshal error", func(t *testing.T) {
+		_, err := EncodeHeaderFields(func() {})
+		assert.Error(t, err)
+	})
+}
+
+func TestSplitHeaderValues(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []string
+		expected []string
+	}{
+		{"repeated", []string{"a", "b"}, []string{"a", "b"}},
+		{"comma-separated", []string{"a, b,c"}, []string{"a", "b", "c"}},
+		{"mixed", []string{"a,b", "c"}, []string{"a", "b", "c"}},
+		{"empty parts", []string{"a,,", ""}, []string{"a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, SplitHeaderValues(tt.values))
+		})
+	}
+}
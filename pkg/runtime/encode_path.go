@@ -0,0 +1,49 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// EncodePathParam formats a path parameter value as an escaped path segment.
+// Pointers are dereferenced, a nil value yields an empty segment and
+// slices are joined with commas, per the OAS simple style.
+func EncodePathParam(v any) string {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return ""
+		}
+		rv = rv.Elem()
+	}
+	if rv.IsValid() {
+		v = rv.Interface()
+	}
+
+	if rv.Kind() == reflect.Slice {
+		if _, ok := v.([]string); !ok {
+			items := make([]any, rv.Len())
+			for i := range items {
+				items[i] = rv.Index(i).Interface()
+			}
+			v = items
+		}
+	}
+
+	values, _, _ := toStringSlice(v)
+	for i, s := range values {
+		values[i] = url.PathEscape(s)
+	}
+	return strings.Join(values, ",")
+}
@@ -0,0 +1,37 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodePathParam(t *testing.T) {
+	type category string
+	name := "a b"
+
+	tests := []struct {
+		name     string
+		value    any
+		expected string
+	}{
+		{name: "string", value: "electronics", expected: "electronics"},
+		{name: "int", value: 42, expected: "42"},
+		{name: "int64", value: int64(-7), expected: "-7"},
+		{name: "float", value: 4.5, expected: "4.5"},
+		{name: "bool", value: true, expected: "true"},
+		{name: "named string", value: category("books"), expected: "books"},
+		{name: "escapes reserved characters", value: "a/b?c#d", expected: "a%2Fb%3Fc%23d"},
+		{name: "pointer", value: &name, expected: "a%20b"},
+		{name: "nil pointer", value: (*string)(nil), expected: ""},
+		{name: "nil", value: nil, expected: ""},
+		{name: "string slice", value: []string{"a", "b"}, expected: "a,b"},
+		{name: "int slice", value: []int{1, 2}, expected: "1,2"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, EncodePathParam(tc.value))
+		})
+	}
+}
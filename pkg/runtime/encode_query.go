@@ -20,15 +20,28 @@ import (
 type QueryEncoding struct {
 	Style   string
 	Explode *bool
+
+	// AllowReserved keeps the reserved characters of RFC 3986 in the values unescaped, e.g. a/b?c stays a/b?c.
+	// # is escaped all the same, as it would start the fragment of the URL.
+	AllowReserved bool
+
+	// AllowEmptyValue sends an empty value as the name of the parameter alone, e.g. ?flag instead of ?flag=.
+	AllowEmptyValue bool
 }
 
+// queryReservedChars are the reserved characters of RFC 3986 kept unescaped with QueryEncoding.AllowReserved, # excepted.
+const queryReservedChars = ":/?[]@!$&'()*+,;="
+
 // queryPair represents a key-value pair for query string encoding.
 // If preEncoded is true, the value is already properly encoded and should not
 // be encoded again by url.Values.Encode().
+// allowReserved and allowEmptyValue are the options of the QueryEncoding of the parameter.
 type queryPair struct {
-	key        string
-	value      string
-	preEncoded bool
+	key             string
+	value           string
+	preEncoded      bool
+	allowReserved   bool
+	allowEmptyValue bool
 }
 
 // EncodeQueryFields builds a query string for query params per OAS 3.1 style matrix.
@@ -75,6 +88,18 @@ func EncodeQueryFields(data any, encoding map[string]QueryEncoding) (string, err
 		}
 		explode := defaultExplode(style, enc.Explode)
 
+		escape := url.QueryEscape
+		if enc.AllowReserved {
+			escape = escapeAllowReserved
+		}
+		start := len(pairs)
+		setOptions := func() {
+			for i := start; i < len(pairs); i++ {
+				pairs[i].allowReserved = enc.AllowReserved
+				pairs[i].allowEmptyValue = enc.AllowEmptyValue
+			}
+		}
+
 		if obj, isObj, err := toStringMap(val); err != nil {
 			return "", fmt.Errorf("param %q: %w", name, err)
 		} else if isObj {
@@ -96,7 +121,7 @@ func EncodeQueryFields(data any, encoding map[string]QueryEncoding) (string, err
 					// This ensures commas within values are escaped, but delimiter commas are not.
 					pairs = append(pairs, queryPair{
 						key:        name,
-						value:      joinWithDelimiter(flattenMap(propKeys, obj), ","),
+						value:      joinWithDelimiter(flattenMap(propKeys, obj), ",", escape),
 						preEncoded: true,
 					})
 				}
@@ -104,23 +129,26 @@ func EncodeQueryFields(data any, encoding map[string]QueryEncoding) (string, err
 				// Space delimiter should be encoded as %20
 				pairs = append(pairs, queryPair{
 					key:        name,
-					value:      joinWithDelimiter(flattenMap(propKeys, obj), "%20"),
+					value:      joinWithDelimiter(flattenMap(propKeys, obj), "%20", escape),
 					preEncoded: true,
 				})
 			case "pipedelimited":
 				// Pipe delimiter should be encoded as %7C
 				pairs = append(pairs, queryPair{
 					key:        name,
-					value:      joinWithDelimiter(flattenMap(propKeys, obj), "%7C"),
+					value:      joinWithDelimiter(flattenMap(propKeys, obj), "%7C", escape),
 					preEncoded: true,
 				})
 			case "deepobject":
-				for _, k := range propKeys {
-					pairs = append(pairs, queryPair{key: name + "[" + k + "]", value: obj[k]})
+				nested, err := deepObjectPairs(name, val)
+				if err != nil {
+					return "", fmt.Errorf("param %q: %w", name, err)
 				}
+				pairs = append(pairs, nested...)
 			default:
 				return "", fmt.Errorf("param %q: unsupported style %q for object", name, style)
 			}
+			setOptions()
 			continue
 		}
 
@@ -142,7 +170,7 @@ func EncodeQueryFields(data any, encoding map[string]QueryEncoding) (string, err
 					// This ensures commas within values are escaped, but delimiter commas are not.
 					pairs = append(pairs, queryPair{
 						key:        name,
-						value:      joinWithDelimiter(ss, ","),
+						value:      joinWithDelimiter(ss, ",", escape),
 						preEncoded: true,
 					})
 				}
@@ -154,7 +182,7 @@ func EncodeQueryFields(data any, encoding map[string]QueryEncoding) (string, err
 				// Space delimiter should be encoded as %20
 				pairs = append(pairs, queryPair{
 					key:        name,
-					value:      joinWithDelimiter(ss, "%20"),
+					value:      joinWithDelimiter(ss, "%20", escape),
 					preEncoded: true,
 				})
 			} else {
@@ -165,7 +193,7 @@ func EncodeQueryFields(data any, encoding map[string]QueryEncoding) (string, err
 				// Pipe delimiter should be encoded as %7C
 				pairs = append(pairs, queryPair{
 					key:        name,
-					value:      joinWithDelimiter(ss, "%7C"),
+					value:      joinWithDelimiter(ss, "%7C", escape),
 					preEncoded: true,
 				})
 			} else {
@@ -184,21 +212,97 @@ func EncodeQueryFields(data any, encoding map[string]QueryEncoding) (string, err
 		default:
 			return "", fmt.Errorf("param %q: unsupported style %q", name, style)
 		}
+		setOptions()
 	}
 
 	return buildQueryString(pairs), nil
 }
 
-// joinWithDelimiter encodes each value individually and joins them with the given delimiter.
+// joinWithDelimiter encodes each value individually with escape and joins them with the given delimiter.
 // The delimiter is NOT encoded, allowing for proper OpenAPI style serialization.
-func joinWithDelimiter(values []string, delimiter string) string {
+func joinWithDelimiter(values []string, delimiter string, escape func(string) string) string {
 	encoded := make([]string, len(values))
 	for i, v := range values {
-		encoded[i] = url.QueryEscape(v)
+		encoded[i] = escape(v)
 	}
 	return strings.Join(encoded, delimiter)
 }
 
+// escapeAllowReserved escapes v like url.QueryEscape, keeping the reserved characters unescaped, see QueryEncoding.AllowReserved.
+func escapeAllowReserved(v string) string {
+	var sb strings.Builder
+	for _, r := range v {
+		if strings.ContainsRune(queryReservedChars, r) {
+			sb.WriteRune(r)
+		} else {
+			sb.WriteString(url.QueryEscape(string(r)))
+		}
+	}
+	return sb.String()
+}
+
+// deepObjectPairs encodes v with bracketed keys, walking nested objects and arrays:
+// objects as prefix[key], arrays of scalars as repeated prefix[] and other arrays as prefix[index].
+func deepObjectPairs(prefix string, v any) ([]queryPair, error) {
+	switch t := v.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(t))
+		for k := range t {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		var pairs []queryPair
+		for _, k := range keys {
+			nested, err := deepObjectPairs(prefix+"["+k+"]", t[k])
+			if err != nil {
+				return nil, err
+			}
+			pairs = append(pairs, nested...)
+		}
+		return pairs, nil
+	case map[string]string:
+		m := make(map[string]any, len(t))
+		for k, vv := range t {
+			m[k] = vv
+		}
+		return deepObjectPairs(prefix, m)
+	case []any:
+		var pairs []queryPair
+		scalars := true
+		for _, e := range t {
+			switch e.(type) {
+			case map[string]any, map[string]string, []any, []string:
+				scalars = false
+			}
+		}
+		for i, e := range t {
+			key := prefix + "[]"
+			if !scalars {
+				key = fmt.Sprintf("%s[%d]", prefix, i)
+			}
+			nested, err := deepObjectPairs(key, e)
+			if err != nil {
+				return nil, err
+			}
+			pairs = append(pairs, nested...)
+		}
+		return pairs, nil
+	case []string:
+		pairs := make([]queryPair, 0, len(t))
+		for _, e := range t {
+			pairs = append(pairs, queryPair{key: prefix + "[]", value: e})
+		}
+		return pairs, nil
+	default:
+		ss, _, err := toStringSlice(v)
+		if err != nil {
+			return nil, err
+		}
+		return []queryPair{{key: prefix, value: ss[0]}}, nil
+	}
+}
+
 // flattenMap flattens a map into a slice of alternating keys and values.
 func flattenMap(keys []string, m map[string]string) []string {
 	result := make([]string, 0, len(keys)*2)
@@ -217,10 +321,17 @@ func buildQueryString(pairs []queryPair) string {
 	var parts []string
 	for _, p := range pairs {
 		encodedKey := url.QueryEscape(p.key)
+		if p.allowEmptyValue && p.value == "" {
+			parts = append(parts, encodedKey)
+			continue
+		}
 		var encodedValue string
-		if p.preEncoded {
+		switch {
+		case p.preEncoded:
 			encodedValue = p.value
-		} else {
+		case p.allowReserved:
+			encodedValue = escapeAllowReserved(p.value)
+		default:
 			encodedValue = url.QueryEscape(p.value)
 		}
 		parts = append(parts, encodedKey+"="+encodedValue)
@@ -122,6 +122,16 @@ func TestEncodeQueryFields_Objects(t *testing.T) {
 			enc:      map[string]QueryEncoding{"color": {Style: "deepObject"}},
 			expected: "color%5BB%5D=150&color%5BG%5D=200&color%5BR%5D=100",
 		},
+		{
+			name: "deepObject nested objects and arrays",
+			data: map[string]any{"filter": map[string]any{
+				"status": []any{"open", "paid"},
+				"amount": map[string]any{"gte": float64(10)},
+				"or":     []any{map[string]any{"paid": true}},
+			}},
+			enc:      map[string]QueryEncoding{"filter": {Style: "deepObject"}},
+			expected: "filter%5Bamount%5D%5Bgte%5D=10&filter%5Bor%5D%5B0%5D%5Bpaid%5D=true&filter%5Bstatus%5D%5B%5D=open&filter%5Bstatus%5D%5B%5D=paid",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -182,3 +192,76 @@ func TestEncodeQueryFields_UnsupportedStyle(t *testing.T) {
 		t.Fatalf("expected error")
 	}
 }
+
+func TestEncodeQueryFields_AllowReservedAndEmptyValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     map[string]any
+		enc      map[string]QueryEncoding
+		expected string
+	}{
+		{
+			name:     "reserved escaped by default",
+			data:     map[string]any{"path": "a/b?c=d&e"},
+			expected: "path=a%2Fb%3Fc%3Dd%26e",
+		},
+		{
+			name:     "allowReserved scalar",
+			data:     map[string]any{"path": "a/b?c=d&e:f@g!$'()*+,;[]"},
+			enc:      map[string]QueryEncoding{"path": {AllowReserved: true}},
+			expected: "path=a/b?c=d&e:f@g!$'()*+,;[]",
+		},
+		{
+			name:     "allowReserved escapes the other characters",
+			data:     map[string]any{"path": "a b#c%d/é"},
+			enc:      map[string]QueryEncoding{"path": {AllowReserved: true}},
+			expected: "path=a+b%23c%25d/%C3%A9",
+		},
+		{
+			name:     "allowReserved form explode=false",
+			data:     map[string]any{"paths": []string{"a/b", "c:d"}},
+			enc:      map[string]QueryEncoding{"paths": {Style: "form", Explode: b(false), AllowReserved: true}},
+			expected: "paths=a/b,c:d",
+		},
+		{
+			name:     "allowReserved form explode=true",
+			data:     map[string]any{"paths": []string{"a/b", "c:d"}},
+			enc:      map[string]QueryEncoding{"paths": {AllowReserved: true}},
+			expected: "paths=a/b&paths=c:d",
+		},
+		{
+			name:     "allowReserved applies to its parameter only",
+			data:     map[string]any{"path": "a/b", "other": "a/b"},
+			enc:      map[string]QueryEncoding{"path": {AllowReserved: true}},
+			expected: "other=a%2Fb&path=a/b",
+		},
+		{
+			name:     "empty value by default",
+			data:     map[string]any{"flag": ""},
+			expected: "flag=",
+		},
+		{
+			name:     "allowEmptyValue",
+			data:     map[string]any{"flag": "", "x": "v"},
+			enc:      map[string]QueryEncoding{"flag": {AllowEmptyValue: true}},
+			expected: "flag&x=v",
+		},
+		{
+			name:     "allowEmptyValue with a value",
+			data:     map[string]any{"flag": "on"},
+			enc:      map[string]QueryEncoding{"flag": {AllowEmptyValue: true}},
+			expected: "flag=on",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EncodeQueryFields(tt.data, tt.enc)
+			if err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+			if got != tt.expected {
+				t.Fatalf("%s: got %q, want %q", tt.name, got, tt.expected)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@
 package runtime
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -23,6 +24,9 @@ var (
 	ErrValidationEmail         = errors.New("email: failed to pass regex validation")
 	ErrFailedToUnmarshalAsAOrB = errors.New("failed to unmarshal as either A or B")
 	ErrMustBeMap               = errors.New("value must be map[string]any")
+	ErrJSONPatchInvalidPath    = errors.New("invalid JSON Patch path")
+	ErrMissingResponseHeader   = errors.New("missing required response header")
+	ErrUnknownEnumValue        = errors.New("unknown enum value")
 )
 
 type ClientAPIErrorOption func(*ClientAPIError)
@@ -65,6 +69,34 @@ func WithStatusCode(code int) ClientAPIErrorOption {
 	}
 }
 
+// ResponseValidationError is returned by the generated client methods, with generate.validation.response,
+// when a success response was decoded but doesn't pass its Validate() method: the server sent malformed data.
+// The decoded response is returned along with it. Unlike a ClientAPIError, the status code is a success one.
+type ResponseValidationError struct {
+	err        error
+	statusCode int
+}
+
+// Error implements the error interface.
+func (e *ResponseValidationError) Error() string {
+	return fmt.Sprintf("response validation failed: %v", e.err)
+}
+
+// StatusCode returns the status code of the response.
+func (e *ResponseValidationError) StatusCode() int {
+	return e.statusCode
+}
+
+// Unwrap returns the validation error, usually ValidationErrors.
+func (e *ResponseValidationError) Unwrap() error {
+	return e.err
+}
+
+// NewResponseValidationError creates a new ResponseValidationError from the validation error of a response.
+func NewResponseValidationError(err error, statusCode int) error {
+	return &ResponseValidationError{err: err, statusCode: statusCode}
+}
+
 type ValidationError struct {
 	Field   string `json:"field"`
 	Message string `json:"message"`
@@ -85,6 +117,17 @@ func (e ValidationError) Unwrap() error {
 	return e.Err
 }
 
+// MarshalJSON encodes the error as {"field": ..., "message": ...}, omitting the field of errors on the whole value.
+func (e ValidationError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(validationErrorJSON{Field: e.Field, Message: e.Message})
+}
+
+// validationErrorJSON is the JSON representation of a ValidationError.
+type validationErrorJSON struct {
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
 func NewValidationError(field, message string) ValidationError {
 	return ValidationError{Field: field, Message: message}
 }
@@ -129,6 +172,33 @@ func (ve ValidationErrors) Append(field string, err error) ValidationErrors {
 	return append(ve, newErrors...)
 }
 
+// MarshalJSON encodes the errors as {"errors": [{"field": ..., "message": ...}]},
+// ready to be written as a 400 response body.
+func (ve ValidationErrors) MarshalJSON() ([]byte, error) {
+	errs := make([]ValidationError, len(ve))
+	copy(errs, ve)
+	return json.Marshal(struct {
+		Errors []ValidationError `json:"errors"`
+	}{Errors: errs})
+}
+
+// UnmarshalJSON decodes errors encoded by MarshalJSON, e.g. from a 400 response body.
+func (ve *ValidationErrors) UnmarshalJSON(data []byte) error {
+	var payload struct {
+		Errors []validationErrorJSON `json:"errors"`
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+
+	res := make(ValidationErrors, 0, len(payload.Errors))
+	for _, e := range payload.Errors {
+		res = append(res, ValidationError{Field: e.Field, Message: e.Message})
+	}
+	*ve = res
+	return nil
+}
+
 func (ve ValidationErrors) Unwrap() []error {
 	errs := make([]error, len(ve))
 	for i, e := range ve {
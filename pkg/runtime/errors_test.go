@@ -11,6 +11,7 @@
 package runtime
 
 import (
+	"encoding/json"
 	"errors"
 	"testing"
 
@@ -40,6 +41,23 @@ func TestNewClientAPIError(t *testing.T) {
 	})
 }
 
+func TestNewResponseValidationError(t *testing.T) {
+	errs := NewValidationErrorsFromString("Name", "is required")
+	err := NewResponseValidationError(errs, 200)
+	assert.Equal(t, "response validation failed: Name is required", err.Error())
+
+	var validationErr *ResponseValidationError
+	require.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, 200, validationErr.StatusCode())
+
+	var fieldErrs ValidationErrors
+	require.True(t, errors.As(err, &fieldErrs))
+	assert.Equal(t, errs, fieldErrs)
+
+	var apiErr *ClientAPIError
+	assert.False(t, errors.As(err, &apiErr))
+}
+
 func TestNewValidationError(t *testing.T) {
 	t.Run("empty field", func(t *testing.T) {
 		err := NewValidationError("", "is required")
@@ -551,3 +569,48 @@ func TestNewValidationErrorsFromErrors_MultipleErrors(t *testing.T) {
 		assert.Equal(t, expected, result.Error())
 	})
 }
+
+func TestValidationErrors_MarshalJSON(t *testing.T) {
+	t.Run("nested field paths", func(t *testing.T) {
+		item := ValidationErrors{}.Add("Name", "is required")
+		errs := ValidationErrors{}.
+			Append("Items[0]", item).
+			Add("Total", "must be greater than 0")
+
+		data, err := json.Marshal(errs)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"errors": [
+			{"field": "Items[0].Name", "message": "is required"},
+			{"field": "Total", "message": "must be greater than 0"}
+		]}`, string(data))
+	})
+
+	t.Run("error on the whole value omits the field", func(t *testing.T) {
+		data, err := json.Marshal(NewValidationErrorsFromString("", "must be a valid Status value"))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"errors": [{"message": "must be a valid Status value"}]}`, string(data))
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		data, err := json.Marshal(ValidationErrors(nil))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"errors": []}`, string(data))
+	})
+
+	t.Run("single error", func(t *testing.T) {
+		data, err := json.Marshal(ValidationError{Field: "Items[1].Price", Message: "is required", Err: errors.New("ignored")})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"field": "Items[1].Price", "message": "is required"}`, string(data))
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		errs := ValidationErrors{}.Add("Items[0].Name", "is required").Add("", "is not valid")
+
+		data, err := json.Marshal(errs)
+		require.NoError(t, err)
+
+		var decoded ValidationErrors
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, errs, decoded)
+	})
+}
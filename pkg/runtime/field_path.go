@@ -0,0 +1,172 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// optionalField is implemented by Optional, whose value ResolveField walks into.
+type optionalField interface {
+	fieldValue() (any, bool)
+}
+
+// ResolveField returns the value at the spec-style field path in obj, e.g. data[].message, following the JSON names
+// of the fields like the error-mapping paths: a [] suffix selects the first element of an array, [n] the element at n.
+// Map values, the additional properties of generated types included, are selected by key. Pointers, interfaces and
+// Optional values are dereferenced along the way.
+// It returns false if a segment doesn't exist, or if a value on the path is nil, absent, null or an empty array.
+func ResolveField(obj any, path string) (any, bool) {
+	if path == "" {
+		return obj, obj != nil
+	}
+
+	v := reflect.ValueOf(obj)
+
+	for _, part := range strings.Split(path, ".") {
+		name, indexes, ok := parseFieldPathSegment(part)
+		if !ok {
+			return nil, false
+		}
+		if name != "" {
+			if v, ok = fieldByJSONName(v, name); !ok {
+				return nil, false
+			}
+		}
+		for _, index := range indexes {
+			if v, ok = elemAt(v, index); !ok {
+				return nil, false
+			}
+		}
+	}
+
+	if v, ok := derefField(v); ok {
+		return v.Interface(), true
+	}
+	return nil, false
+}
+
+// parseFieldPathSegment splits a segment like items[][2] into its name and indexes, 0 for [].
+func parseFieldPathSegment(part string) (string, []int, bool) {
+	name, rest, _ := strings.Cut(part, "[")
+	if rest == "" {
+		return name, nil, name != ""
+	}
+
+	var indexes []int
+	for _, idx := range strings.Split(strings.TrimSuffix(rest, "]"), "][") {
+		if idx == "" {
+			indexes = append(indexes, 0)
+			continue
+		}
+		n, err := strconv.Atoi(idx)
+		if err != nil || n < 0 {
+			return "", nil, false
+		}
+		indexes = append(indexes, n)
+	}
+	return name, indexes, strings.HasSuffix(rest, "]")
+}
+
+// derefField dereferences the pointers, interfaces and Optional values of v.
+// It returns false if v is invalid, nil, absent or null.
+func derefField(v reflect.Value) (reflect.Value, bool) {
+	for v.IsValid() {
+		if v.CanInterface() {
+			if opt, ok := v.Interface().(optionalField); ok {
+				value, ok := opt.fieldValue()
+				if !ok {
+					return reflect.Value{}, false
+				}
+				v = reflect.ValueOf(value)
+				continue
+			}
+		}
+		switch v.Kind() {
+		case reflect.Pointer, reflect.Interface:
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		case reflect.Map, reflect.Slice:
+			return v, !v.IsNil()
+		default:
+			return v, true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// fieldByJSONName returns the field of the struct, or the value of the map, named name in JSON.
+// Embedded structs are searched, and the AdditionalProperties map of generated types last.
+func fieldByJSONName(v reflect.Value, name string) (reflect.Value, bool) {
+	v, ok := derefField(v)
+	if !ok {
+		return reflect.Value{}, false
+	}
+
+	switch v.Kind() {
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return reflect.Value{}, false
+		}
+		value := v.MapIndex(reflect.ValueOf(name).Convert(v.Type().Key()))
+		return value, value.IsValid()
+	case reflect.Struct:
+	default:
+		return reflect.Value{}, false
+	}
+
+	t := v.Type()
+	var embedded []reflect.Value
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		jsonName, _, _ := strings.Cut(tag, ",")
+		if field.Anonymous && jsonName == "" {
+			embedded = append(embedded, v.Field(i))
+			continue
+		}
+		if jsonName == "" {
+			jsonName = field.Name
+		}
+		if jsonName == name {
+			return v.Field(i), true
+		}
+	}
+
+	for _, e := range embedded {
+		if value, ok := fieldByJSONName(e, name); ok {
+			return value, true
+		}
+	}
+	if additional := v.FieldByName("AdditionalProperties"); additional.IsValid() && additional.Kind() == reflect.Map {
+		return fieldByJSONName(additional, name)
+	}
+	return reflect.Value{}, false
+}
+
+// elemAt returns the element at index of the array or slice v.
+func elemAt(v reflect.Value, index int) (reflect.Value, bool) {
+	v, ok := derefField(v)
+	if !ok || (v.Kind() != reflect.Slice && v.Kind() != reflect.Array) || index >= v.Len() {
+		return reflect.Value{}, false
+	}
+	return v.Index(index), true
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveField(t *testing.T) {
+	type detail struct {
+		Message string  `json:"message"`
+		Code    *string `json:"code,omitempty"`
+	}
+	type Meta struct {
+		RequestID string `json:"request_id"`
+	}
+	type errorResponse struct {
+		Meta
+		Details              []detail            `json:"details"`
+		Cause                *errorResponse      `json:"cause,omitempty"`
+		Hint                 Optional[string]    `json:"hint,omitempty"`
+		Tags                 map[string][]string `json:"tags,omitempty"`
+		Internal             string              `json:"-"`
+		AdditionalProperties map[string]any      `json:"-"`
+	}
+
+	obj := &errorResponse{
+		Meta: Meta{RequestID: "req-1"},
+		Details: []detail{
+			{Message: "first", Code: Ptr("E1")},
+			{Message: "second"},
+		},
+		Cause: &errorResponse{
+			Details: []detail{{Message: "root cause"}},
+			Hint:    NewNullOptional[string](),
+		},
+		Hint:                 NewOptional("retry later"),
+		Tags:                 map[string][]string{"env": {"prod", "eu"}},
+		Internal:             "secret",
+		AdditionalProperties: map[string]any{"trace": map[string]any{"id": "abc"}},
+	}
+
+	tests := []struct {
+		path string
+		want any
+		ok   bool
+	}{
+		{path: "details[].message", want: "first", ok: true},
+		{path: "details[1].message", want: "second", ok: true},
+		{path: "details[2].message"},
+		{path: "details[].code", want: "E1", ok: true},
+		{path: "details[1].code"},
+		{path: "details", want: obj.Details, ok: true},
+		{path: "cause.details[].message", want: "root cause", ok: true},
+		{path: "cause.cause.details[].message"},
+		{path: "hint", want: "retry later", ok: true},
+		{path: "cause.hint"},
+		{path: "tags.env[1]", want: "eu", ok: true},
+		{path: "tags.missing[]"},
+		{path: "request_id", want: "req-1", ok: true},
+		{path: "trace.id", want: "abc", ok: true},
+		{path: "Internal"},
+		{path: "details[x].message"},
+		{path: "details[.message"},
+		{path: "details[].message.length"},
+		{path: "unknown"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.path, func(t *testing.T) {
+			got, ok := ResolveField(obj, tc.path)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+
+	t.Run("empty path", func(t *testing.T) {
+		got, ok := ResolveField(obj, "")
+		assert.True(t, ok)
+		assert.Same(t, obj, got)
+
+		_, ok = ResolveField(nil, "details")
+		assert.False(t, ok)
+	})
+
+	t.Run("nil pointer", func(t *testing.T) {
+		_, ok := ResolveField((*errorResponse)(nil), "details[].message")
+		assert.False(t, ok)
+	})
+}
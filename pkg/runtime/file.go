@@ -20,6 +20,7 @@ import (
 type File struct {
 	multipart *multipart.FileHeader
 	data      []byte
+	reader    io.Reader
 	filename  string
 }
 
@@ -33,6 +34,17 @@ func (file *File) InitFromBytes(data []byte, filename string) {
 	file.data = data
 	file.filename = filename
 	file.multipart = nil
+	file.reader = nil
+}
+
+// InitFromReader sets the content of the file to the data read from r,
+// so that a binary response can be streamed without buffering it.
+// The file can only be read once, and r is closed after that if it's an io.Closer.
+func (file *File) InitFromReader(r io.Reader, filename string) {
+	file.reader = r
+	file.filename = filename
+	file.multipart = nil
+	file.data = nil
 }
 
 func (file File) MarshalJSON() ([]byte, error) {
@@ -56,6 +68,15 @@ func (file *File) Bytes() ([]byte, error) {
 		defer func() { _ = f.Close() }()
 		return io.ReadAll(f)
 	}
+	if file.reader != nil {
+		r, _ := file.Reader()
+		defer func() { _ = r.Close() }()
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		file.InitFromBytes(data, file.filename)
+	}
 	return file.data, nil
 }
 
@@ -63,6 +84,12 @@ func (file File) Reader() (io.ReadCloser, error) {
 	if file.multipart != nil {
 		return file.multipart.Open()
 	}
+	if file.reader != nil {
+		if rc, ok := file.reader.(io.ReadCloser); ok {
+			return rc, nil
+		}
+		return io.NopCloser(file.reader), nil
+	}
 	return io.NopCloser(bytes.NewReader(file.data)), nil
 }
 
@@ -73,9 +100,13 @@ func (file File) Filename() string {
 	return file.filename
 }
 
+// FileSize returns the size of the file, or -1 if it's read from a reader.
 func (file File) FileSize() int64 {
 	if file.multipart != nil {
 		return file.multipart.Size
 	}
+	if file.reader != nil {
+		return -1
+	}
 	return int64(len(file.data))
 }
@@ -12,6 +12,8 @@ package runtime
 
 import (
 	"encoding/json"
+	"io"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -62,3 +64,53 @@ func TestFileJSON(t *testing.T) {
 	assert.Equal(t, []byte("hello"), o4Bytes)
 
 }
+
+func TestFileInitFromReader(t *testing.T) {
+	t.Run("reader", func(t *testing.T) {
+		var f File
+		f.InitFromReader(strings.NewReader("hello"), "hello.txt")
+		assert.Equal(t, "hello.txt", f.Filename())
+		assert.Equal(t, int64(-1), f.FileSize())
+
+		r, err := f.Reader()
+		require.NoError(t, err)
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, "hello", string(data))
+	})
+
+	t.Run("bytes", func(t *testing.T) {
+		var f File
+		f.InitFromReader(strings.NewReader("hello"), "hello.txt")
+
+		data, err := f.Bytes()
+		require.NoError(t, err)
+		assert.Equal(t, "hello", string(data))
+		assert.Equal(t, int64(5), f.FileSize())
+
+		// the content is kept once read
+		data, err = f.Bytes()
+		require.NoError(t, err)
+		assert.Equal(t, "hello", string(data))
+	})
+
+	t.Run("closes the reader", func(t *testing.T) {
+		rc := &closeRecorder{Reader: strings.NewReader("hello")}
+		var f File
+		f.InitFromReader(rc, "")
+
+		_, err := f.Bytes()
+		require.NoError(t, err)
+		assert.True(t, rc.closed)
+	})
+}
+
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (c *closeRecorder) Close() error {
+	c.closed = true
+	return nil
+}
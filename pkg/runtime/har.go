@@ -0,0 +1,206 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+)
+
+// harFile is the part of an HTTP Archive (HAR 1.2) file used to replay its entries.
+type harFile struct {
+	Log struct {
+		Entries []harEntry `json:"entries"`
+	} `json:"log"`
+}
+
+type harEntry struct {
+	Request struct {
+		Method   string `json:"method"`
+		URL      string `json:"url"`
+		PostData *struct {
+			Text string `json:"text"`
+		} `json:"postData"`
+	} `json:"request"`
+	Response struct {
+		Status     int         `json:"status"`
+		StatusText string      `json:"statusText"`
+		Headers    []harHeader `json:"headers"`
+		Content    struct {
+			MimeType string `json:"mimeType"`
+			Text     string `json:"text"`
+			Encoding string `json:"encoding"`
+		} `json:"content"`
+	} `json:"response"`
+}
+
+type harHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// HARReplayer answers the requests with the responses recorded in an HTTP Archive (HAR) file,
+// e.g. captured by the browser developer tools or a proxy, to reproduce the behavior of a client
+// without sending any request.
+//
+// A request matches the entries with the same method, path and query parameters, whatever the host,
+// so that traffic captured in production can be replayed with another base URL.
+// When several entries match, those with the same body are preferred, and they are replayed in order:
+// a request repeated after the last matching entry gets its response again.
+// It's an HttpRequestDoer and an http.RoundTripper.
+type HARReplayer struct {
+	entries []harEntry
+
+	mu   sync.Mutex
+	used []bool
+}
+
+// NewHARReplayer reads the entries of a HAR file.
+func NewHARReplayer(r io.Reader) (*HARReplayer, error) {
+	var har harFile
+	if err := json.NewDecoder(r).Decode(&har); err != nil {
+		return nil, fmt.Errorf("error reading HAR file: %w", err)
+	}
+	return &HARReplayer{
+		entries: har.Log.Entries,
+		used:    make([]bool, len(har.Log.Entries)),
+	}, nil
+}
+
+// LoadHARReplayer reads the entries of the HAR file at path.
+func LoadHARReplayer(path string) (*HARReplayer, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+	return NewHARReplayer(f)
+}
+
+// WithHARReplay replaces the HTTP client with a HARReplayer of the HAR file at path,
+// answering the requests with the recorded responses.
+func WithHARReplay(path string) APIClientOption {
+	return func(c *Client) error {
+		replayer, err := LoadHARReplayer(path)
+		if err != nil {
+			return err
+		}
+		c.httpClient = replayer
+		return nil
+	}
+}
+
+// Do returns the recorded response of the request.
+func (h *HARReplayer) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return h.RoundTrip(req.WithContext(ctx))
+}
+
+// RoundTrip returns the recorded response of the request, or an error if no entry matches it.
+func (h *HARReplayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := req.Context().Err(); err != nil {
+		return nil, err
+	}
+
+	var body string
+	if req.Body != nil {
+		data, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("error reading request body: %w", err)
+		}
+		body = string(data)
+	}
+
+	h.mu.Lock()
+	idx := h.match(req, body)
+	if idx >= 0 {
+		h.used[idx] = true
+	}
+	h.mu.Unlock()
+
+	if idx < 0 {
+		return nil, fmt.Errorf("no HAR entry recorded for %s %s", req.Method, req.URL.RequestURI())
+	}
+	return h.response(req, h.entries[idx])
+}
+
+// match returns the index of the entry replayed for the request, -1 if there is none.
+// Must be called with the lock held.
+func (h *HARReplayer) match(req *http.Request, body string) int {
+	best, bestScore := -1, -1
+	for i, entry := range h.entries {
+		if !strings.EqualFold(entry.Request.Method, req.Method) {
+			continue
+		}
+		u, err := url.Parse(entry.Request.URL)
+		if err != nil || u.Path != req.URL.Path || u.Query().Encode() != req.URL.Query().Encode() {
+			continue
+		}
+
+		// prefer the same body, then the entries not replayed yet, then the first ones
+		score := 0
+		if entry.Request.PostData == nil && body == "" || entry.Request.PostData != nil && entry.Request.PostData.Text == body {
+			score += 2
+		}
+		if !h.used[i] {
+			score++
+		}
+		if score > bestScore || score == bestScore && h.used[i] {
+			best, bestScore = i, score
+		}
+	}
+	return best
+}
+
+func (h *HARReplayer) response(req *http.Request, entry harEntry) (*http.Response, error) {
+	content := []byte(entry.Response.Content.Text)
+	if entry.Response.Content.Encoding == "base64" {
+		var err error
+		if content, err = base64.StdEncoding.DecodeString(entry.Response.Content.Text); err != nil {
+			return nil, fmt.Errorf("error decoding HAR response content: %w", err)
+		}
+	}
+
+	header := make(http.Header, len(entry.Response.Headers))
+	for _, hdr := range entry.Response.Headers {
+		// the recorded content is decoded, and its length may differ
+		switch http.CanonicalHeaderKey(hdr.Name) {
+		case "Content-Encoding", "Content-Length", "Transfer-Encoding":
+			continue
+		}
+		header.Add(hdr.Name, hdr.Value)
+	}
+	if header.Get("Content-Type") == "" && entry.Response.Content.MimeType != "" {
+		header.Set("Content-Type", entry.Response.Content.MimeType)
+	}
+
+	status := entry.Response.Status
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, entry.Response.StatusText),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(content)),
+		ContentLength: int64(len(content)),
+		Request:       req,
+	}, nil
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testHAR = `{
+  "log": {
+    "version": "1.2",
+    "creator": {"name": "test", "version": "1.0"},
+    "entries": [
+      {
+        "request": {"method": "GET", "url": "https://api.example.com/pets?limit=2&kind=dog", "headers": []},
+        "response": {
+          "status": 200, "statusText": "OK",
+          "headers": [{"name": "Content-Type", "value": "application/json"}, {"name": "Content-Length", "value": "999"}],
+          "content": {"mimeType": "application/json", "text": "[{\"name\":\"Rex\"}]"}
+        }
+      },
+      {
+        "request": {"method": "GET", "url": "https://api.example.com/pets?limit=2&kind=dog", "headers": []},
+        "response": {
+          "status": 200, "statusText": "OK", "headers": [],
+          "content": {"mimeType": "application/json", "text": "[{\"name\":\"Fido\"}]"}
+        }
+      },
+      {
+        "request": {"method": "POST", "url": "https://api.example.com/pets", "postData": {"mimeType": "application/json", "text": "{\"name\":\"Tom\"}"}},
+        "response": {"status": 201, "statusText": "Created", "headers": [], "content": {"mimeType": "application/json", "text": "{\"id\":1}"}}
+      },
+      {
+        "request": {"method": "POST", "url": "https://api.example.com/pets", "postData": {"mimeType": "application/json", "text": "{\"name\":\"\"}"}},
+        "response": {"status": 400, "statusText": "Bad Request", "headers": [], "content": {"mimeType": "text/plain", "text": "aW52YWxpZCBuYW1l", "encoding": "base64"}}
+      }
+    ]
+  }
+}`
+
+func TestHARReplayer(t *testing.T) {
+	replay := func(t *testing.T, h *HARReplayer, method, url, body string) *http.Response {
+		t.Helper()
+		var reqBody io.Reader
+		if body != "" {
+			reqBody = strings.NewReader(body)
+		}
+		req, err := http.NewRequest(method, url, reqBody)
+		require.NoError(t, err)
+		resp, err := h.Do(context.Background(), req)
+		require.NoError(t, err)
+		return resp
+	}
+	readBody := func(t *testing.T, resp *http.Response) string {
+		t.Helper()
+		data, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return string(data)
+	}
+
+	t.Run("entries of the same request are replayed in order", func(t *testing.T) {
+		h, err := NewHARReplayer(strings.NewReader(testHAR))
+		require.NoError(t, err)
+
+		// another host, and the query parameters in another order
+		resp := replay(t, h, http.MethodGet, "http://localhost:8080/pets?kind=dog&limit=2", "")
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+		assert.Empty(t, resp.Header.Get("Content-Length"))
+		assert.Equal(t, `[{"name":"Rex"}]`, readBody(t, resp))
+
+		assert.Equal(t, `[{"name":"Fido"}]`, readBody(t, replay(t, h, http.MethodGet, "http://localhost:8080/pets?kind=dog&limit=2", "")))
+		assert.Equal(t, `[{"name":"Fido"}]`, readBody(t, replay(t, h, http.MethodGet, "http://localhost:8080/pets?kind=dog&limit=2", "")))
+	})
+
+	t.Run("matching body", func(t *testing.T) {
+		h, err := NewHARReplayer(strings.NewReader(testHAR))
+		require.NoError(t, err)
+
+		resp := replay(t, h, http.MethodPost, "http://localhost/pets", `{"name":""}`)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "400 Bad Request", resp.Status)
+		assert.Equal(t, "text/plain", resp.Header.Get("Content-Type"))
+		assert.Equal(t, "invalid name", readBody(t, resp))
+
+		resp = replay(t, h, http.MethodPost, "http://localhost/pets", `{"name":"Tom"}`)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Equal(t, `{"id":1}`, readBody(t, resp))
+	})
+
+	t.Run("no matching entry", func(t *testing.T) {
+		h, err := NewHARReplayer(strings.NewReader(testHAR))
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodGet, "http://localhost/pets?limit=3", nil)
+		require.NoError(t, err)
+		_, err = h.Do(context.Background(), req)
+		require.EqualError(t, err, "no HAR entry recorded for GET /pets?limit=3")
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		_, err := NewHARReplayer(strings.NewReader("not json"))
+		require.Error(t, err)
+	})
+
+	t.Run("http.Client transport", func(t *testing.T) {
+		h, err := NewHARReplayer(strings.NewReader(testHAR))
+		require.NoError(t, err)
+
+		resp, err := (&http.Client{Transport: h}).Post("http://localhost/pets", "application/json", strings.NewReader(`{"name":"Tom"}`))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	})
+}
+
+func TestWithHARReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traffic.har")
+	require.NoError(t, os.WriteFile(path, []byte(testHAR), 0o600))
+
+	client, err := NewAPIClient("https://staging.example.com", WithHARReplay(path))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	req, err := client.CreateRequest(ctx, RequestOptionsParameters{
+		RequestURL:  client.GetBaseURL() + "/pets",
+		Method:      http.MethodPost,
+		Options:     mockRequestOptions{body: map[string]string{"name": "Tom"}},
+		ContentType: "application/json",
+	})
+	require.NoError(t, err)
+
+	resp, err := client.ExecuteRequest(ctx, req, "/pets")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, `{"id":1}`, string(resp.Content))
+
+	_, err = NewAPIClient("https://staging.example.com", WithHARReplay(filepath.Join(t.TempDir(), "missing.har")))
+	require.Error(t, err)
+}
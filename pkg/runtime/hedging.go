@@ -0,0 +1,87 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// HedgePolicy configures hedged requests.
+// Delay is how long to wait for an attempt before starting the next one,
+// MaxConcurrent is the maximum number of attempts, 2 if zero.
+type HedgePolicy struct {
+	Delay         time.Duration
+	MaxConcurrent int
+}
+
+type hedgeResult struct {
+	resp *Response
+	err  error
+}
+
+// Hedge runs attempt, and starts another attempt each time Delay elapses without a successful response,
+// up to MaxConcurrent attempts in flight. A failed attempt starts the next one right away.
+// The first successful response wins and the context of the other attempts is canceled.
+// Responses with a 5xx status code are not successful; when all attempts fail, the result of the last one is returned.
+// Only use it for idempotent requests.
+func Hedge(ctx context.Context, policy HedgePolicy, attempt func(ctx context.Context) (*Response, error)) (*Response, error) {
+	maxAttempts := policy.MaxConcurrent
+	if maxAttempts <= 0 {
+		maxAttempts = 2
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Buffered so that the attempts canceled after the winner do not block.
+	results := make(chan hedgeResult, maxAttempts)
+	launched := 0
+	launch := func() {
+		launched++
+		go func() {
+			resp, err := attempt(ctx)
+			results <- hedgeResult{resp: resp, err: err}
+		}()
+	}
+
+	launch()
+	timer := time.NewTimer(policy.Delay)
+	defer timer.Stop()
+
+	completed := 0
+	for {
+		var next <-chan time.Time
+		if launched < maxAttempts {
+			next = timer.C
+		}
+
+		select {
+		case res := <-results:
+			completed++
+			if res.err == nil && (res.resp == nil || res.resp.StatusCode < http.StatusInternalServerError) {
+				return res.resp, nil
+			}
+			if launched < maxAttempts {
+				launch()
+				timer.Reset(policy.Delay)
+			} else if completed == launched {
+				return res.resp, res.err
+			}
+		case <-next:
+			launch()
+			timer.Reset(policy.Delay)
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// slowThenFastServer answers the first request after a second, unless canceled, and the other ones right away.
+func slowThenFastServer(t *testing.T, canceled chan<- struct{}) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := calls.Add(1)
+		if n == 1 {
+			select {
+			case <-time.After(time.Second):
+			case <-r.Context().Done():
+				canceled <- struct{}{}
+				return
+			}
+		}
+		_, _ = fmt.Fprintf(w, `{"attempt":%d}`, n)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func TestHedge(t *testing.T) {
+	t.Run("second attempt wins and the first is canceled", func(t *testing.T) {
+		canceled := make(chan struct{}, 1)
+		srv, calls := slowThenFastServer(t, canceled)
+		client, err := NewAPIClient(srv.URL, WithHTTPClient(HTTPClientDoer{}))
+		require.NoError(t, err)
+
+		start := time.Now()
+		resp, err := Hedge(context.Background(), HedgePolicy{Delay: 20 * time.Millisecond}, func(ctx context.Context) (*Response, error) {
+			req, err := client.CreateRequest(ctx, RequestOptionsParameters{RequestURL: srv.URL, Method: http.MethodGet})
+			if err != nil {
+				return nil, err
+			}
+			return client.ExecuteRequest(ctx, req, "/")
+		})
+		require.NoError(t, err)
+
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+		assert.JSONEq(t, `{"attempt":2}`, string(resp.Content))
+		assert.Equal(t, int32(2), calls.Load())
+
+		select {
+		case <-canceled:
+		case <-time.After(time.Second):
+			t.Fatal("slow attempt was not canceled")
+		}
+	})
+
+	t.Run("fast first attempt is not hedged", func(t *testing.T) {
+		var calls atomic.Int32
+		resp, err := Hedge(context.Background(), HedgePolicy{Delay: time.Second}, func(ctx context.Context) (*Response, error) {
+			calls.Add(1)
+			return &Response{StatusCode: http.StatusOK}, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("failed attempt starts the next one right away", func(t *testing.T) {
+		var calls atomic.Int32
+		resp, err := Hedge(context.Background(), HedgePolicy{Delay: time.Minute, MaxConcurrent: 3}, func(ctx context.Context) (*Response, error) {
+			if calls.Add(1) < 3 {
+				return &Response{StatusCode: http.StatusServiceUnavailable}, nil
+			}
+			return &Response{StatusCode: http.StatusOK}, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("all attempts fail", func(t *testing.T) {
+		errBoom := errors.New("boom")
+		var calls atomic.Int32
+		_, err := Hedge(context.Background(), HedgePolicy{MaxConcurrent: 2}, func(ctx context.Context) (*Response, error) {
+			calls.Add(1)
+			return nil, errBoom
+		})
+		require.ErrorIs(t, err, errBoom)
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := Hedge(ctx, HedgePolicy{Delay: time.Minute}, func(ctx context.Context) (*Response, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
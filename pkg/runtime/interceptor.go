@@ -0,0 +1,87 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"net/http"
+)
+
+// RoundTripFunc sends the request and returns its response, the next step of an interceptor chain.
+type RoundTripFunc func(ctx context.Context, req *http.Request) (*Response, error)
+
+// Interceptor wraps the execution of the requests of the client, see WithInterceptors.
+// RoundTrip calls next to continue the chain, possibly with another context or request, and returns its response,
+// possibly transformed. It can also return without calling next, e.g. with a cached response, or call it several times.
+type Interceptor interface {
+	RoundTrip(ctx context.Context, req *http.Request, next RoundTripFunc) (*Response, error)
+}
+
+// InterceptorFunc is a function implementing Interceptor.
+type InterceptorFunc func(ctx context.Context, req *http.Request, next RoundTripFunc) (*Response, error)
+
+// RoundTrip calls f.
+func (f InterceptorFunc) RoundTrip(ctx context.Context, req *http.Request, next RoundTripFunc) (*Response, error) {
+	return f(ctx, req, next)
+}
+
+// CallInfo describes the call of the request going through the interceptors, see CallInfoFromContext.
+type CallInfo struct {
+	// OperationID is the ID of the operation, set by the clients generated with client.interceptors.
+	OperationID string
+
+	// OperationPath is the path of the operation, e.g. /pets/{id}.
+	OperationPath string
+}
+
+type callInfoKey struct{}
+
+// WithCallInfo returns a copy of ctx carrying the call info.
+func WithCallInfo(ctx context.Context, info CallInfo) context.Context {
+	return context.WithValue(ctx, callInfoKey{}, info)
+}
+
+// CallInfoFromContext returns the call info of ctx, false if there's none.
+// The context of the interceptors always has one, with the OperationPath set.
+func CallInfoFromContext(ctx context.Context) (CallInfo, bool) {
+	info, ok := ctx.Value(callInfoKey{}).(CallInfo)
+	return info, ok
+}
+
+// WithInterceptors adds interceptors around the execution of the requests, the first one being the outermost.
+// They run before the rate limit wait and the circuit breaker, so every call of next, e.g. retries,
+// goes through them, and see the response as returned by ExecuteRequest, decoded but not parsed.
+func WithInterceptors(interceptors ...Interceptor) APIClientOption {
+	return func(c *Client) error {
+		c.interceptors = append(c.interceptors, interceptors...)
+		return nil
+	}
+}
+
+// intercept runs send through the interceptors of the client, with the call info of the operation at operationPath.
+func (c *Client) intercept(ctx context.Context, req *http.Request, operationPath string, send RoundTripFunc) (*Response, error) {
+	if len(c.interceptors) == 0 {
+		return send(ctx, req)
+	}
+
+	info, _ := CallInfoFromContext(ctx)
+	info.OperationPath = operationPath
+	ctx = WithCallInfo(ctx, info)
+
+	next := send
+	for i := len(c.interceptors) - 1; i >= 0; i-- {
+		interceptor, inner := c.interceptors[i], next
+		next = func(ctx context.Context, req *http.Request) (*Response, error) {
+			return interceptor.RoundTrip(ctx, req, inner)
+		}
+	}
+	return next(ctx, req)
+}
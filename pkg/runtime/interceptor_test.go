@@ -0,0 +1,111 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Interceptors(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := requests.Add(1)
+		if r.Header.Get("X-Fail-First") != "" && n == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("X-Tenant", r.Header.Get("X-Tenant"))
+		_, _ = w.Write([]byte(`{"name":"rex"}`))
+	}))
+	defer server.Close()
+
+	send := func(t *testing.T, c *Client, ctx context.Context, header string) (*Response, error) {
+		t.Helper()
+		req, err := c.CreateRequest(ctx, RequestOptionsParameters{RequestURL: c.GetBaseURL() + "/pets/1", Method: http.MethodGet})
+		require.NoError(t, err)
+		if header != "" {
+			req.Header.Set(header, "1")
+		}
+		return c.ExecuteRequest(ctx, req, "/pets/{id}")
+	}
+
+	t.Run("transforms the request and the response", func(t *testing.T) {
+		var calls []string
+		trace := func(name string) Interceptor {
+			return InterceptorFunc(func(ctx context.Context, req *http.Request, next RoundTripFunc) (*Response, error) {
+				info, ok := CallInfoFromContext(ctx)
+				require.True(t, ok)
+				calls = append(calls, name+" "+info.OperationID+" "+info.OperationPath)
+				return next(ctx, req)
+			})
+		}
+		tenant := InterceptorFunc(func(ctx context.Context, req *http.Request, next RoundTripFunc) (*Response, error) {
+			req = req.Clone(ctx)
+			req.Header.Set("X-Tenant", "acme")
+			resp, err := next(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			resp.Content = bytes.ToUpper(resp.Content)
+			return resp, nil
+		})
+
+		c, err := NewAPIClient(server.URL, WithHTTPClient(HTTPClientDoer{Client: server.Client()}),
+			WithInterceptors(trace("outer"), tenant), WithInterceptors(trace("inner")))
+		require.NoError(t, err)
+
+		resp, err := send(t, c, WithCallInfo(context.Background(), CallInfo{OperationID: "GetPet"}), "")
+		require.NoError(t, err)
+		assert.Equal(t, `{"NAME":"REX"}`, string(resp.Content))
+		assert.Equal(t, "acme", resp.Headers.Get("X-Tenant"))
+		assert.Equal(t, []string{"outer GetPet /pets/{id}", "inner GetPet /pets/{id}"}, calls)
+	})
+
+	t.Run("calls next several times", func(t *testing.T) {
+		requests.Store(0)
+		retry := InterceptorFunc(func(ctx context.Context, req *http.Request, next RoundTripFunc) (*Response, error) {
+			resp, err := next(ctx, req)
+			if err == nil && resp.StatusCode == http.StatusServiceUnavailable {
+				return next(ctx, req)
+			}
+			return resp, err
+		})
+		c, err := NewAPIClient(server.URL, WithHTTPClient(HTTPClientDoer{Client: server.Client()}), WithInterceptors(retry))
+		require.NoError(t, err)
+
+		resp, err := send(t, c, context.Background(), "X-Fail-First")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, int32(2), requests.Load())
+	})
+
+	t.Run("short-circuits the chain", func(t *testing.T) {
+		requests.Store(0)
+		cached := InterceptorFunc(func(ctx context.Context, req *http.Request, next RoundTripFunc) (*Response, error) {
+			return &Response{StatusCode: http.StatusOK, Content: []byte(`{"name":"cached"}`)}, nil
+		})
+		c, err := NewAPIClient(server.URL, WithHTTPClient(HTTPClientDoer{Client: server.Client()}), WithInterceptors(cached))
+		require.NoError(t, err)
+
+		resp, err := send(t, c, context.Background(), "")
+		require.NoError(t, err)
+		assert.Equal(t, `{"name":"cached"}`, string(resp.Content))
+		assert.Zero(t, requests.Load())
+	})
+}
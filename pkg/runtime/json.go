@@ -299,6 +299,16 @@ func AsMap[V any](v any) (map[string]V, error) {
 	return m, nil
 }
 
+// FromMap sets v, a pointer, from the map by marshaling it to JSON and unmarshaling back into v.
+// It is the reverse of AsMap.
+func FromMap(m map[string]any, v any) error {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return UnmarshalJSON(data, v)
+}
+
 // CoalesceOrMerge implements generic wrapper semantics:
 // - 0 non-null parts  -> "null"
 // - 1 non-null part   -> that value as-is (object/array/scalar)
@@ -0,0 +1,275 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+const hexDigits = "0123456789abcdef"
+
+// JSONEncoder writes JSON values one by one, without reflection.
+// It's used by the MarshalJSON methods generated for the types with x-go-fast-json,
+// and produces the same output as encoding/json.
+type JSONEncoder struct {
+	buf bytes.Buffer
+	err error
+
+	// first tells, for each open object and array, if no value has been written in it yet.
+	first []bool
+
+	// afterKey is true after a key, whose value doesn't take a separator.
+	afterKey bool
+}
+
+// NewJSONEncoder returns an encoder with room for size bytes.
+func NewJSONEncoder(size int) *JSONEncoder {
+	e := &JSONEncoder{first: make([]bool, 0, 8)}
+	e.buf.Grow(size)
+	return e
+}
+
+// BeginObject starts an object.
+func (e *JSONEncoder) BeginObject() {
+	e.separate()
+	e.buf.WriteByte('{')
+	e.first = append(e.first, true)
+}
+
+// EndObject ends the current object.
+func (e *JSONEncoder) EndObject() {
+	e.first = e.first[:len(e.first)-1]
+	e.buf.WriteByte('}')
+}
+
+// BeginArray starts an array.
+func (e *JSONEncoder) BeginArray() {
+	e.separate()
+	e.buf.WriteByte('[')
+	e.first = append(e.first, true)
+}
+
+// EndArray ends the current array.
+func (e *JSONEncoder) EndArray() {
+	e.first = e.first[:len(e.first)-1]
+	e.buf.WriteByte(']')
+}
+
+// Key writes the name of the next field of the current object.
+func (e *JSONEncoder) Key(name string) {
+	e.separate()
+	e.writeString(name)
+	e.buf.WriteByte(':')
+	e.afterKey = true
+}
+
+// String writes a string value.
+func (e *JSONEncoder) String(v string) {
+	e.separate()
+	e.writeString(v)
+}
+
+// Int writes an integer value.
+func (e *JSONEncoder) Int(v int64) {
+	e.separate()
+	e.buf.Write(strconv.AppendInt(e.buf.AvailableBuffer(), v, 10))
+}
+
+// Uint writes an unsigned integer value.
+func (e *JSONEncoder) Uint(v uint64) {
+	e.separate()
+	e.buf.Write(strconv.AppendUint(e.buf.AvailableBuffer(), v, 10))
+}
+
+// Float writes a floating-point value of the given bit size, 32 or 64.
+// Like encoding/json, it fails on NaN and infinite values.
+func (e *JSONEncoder) Float(v float64, bits int) {
+	e.separate()
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		e.setErr(fmt.Errorf("json: unsupported value: %s", strconv.FormatFloat(v, 'g', -1, bits)))
+		e.buf.WriteString("null")
+		return
+	}
+
+	// Same format as encoding/json: exponent only for very small and very large numbers.
+	format := byte('f')
+	if abs := math.Abs(v); abs != 0 {
+		if bits == 64 && (abs < 1e-6 || abs >= 1e21) || bits == 32 && (float32(abs) < 1e-6 || float32(abs) >= 1e21) {
+			format = 'e'
+		}
+	}
+	b := strconv.AppendFloat(e.buf.AvailableBuffer(), v, format, -1, bits)
+	if format == 'e' {
+		// clean up e-09 to e-9
+		if n := len(b); n >= 4 && b[n-4] == 'e' && b[n-3] == '-' && b[n-2] == '0' {
+			b[n-2] = b[n-1]
+			b = b[:n-1]
+		}
+	}
+	e.buf.Write(b)
+}
+
+// Bool writes a boolean value.
+func (e *JSONEncoder) Bool(v bool) {
+	e.separate()
+	if v {
+		e.buf.WriteString("true")
+	} else {
+		e.buf.WriteString("false")
+	}
+}
+
+// Null writes a null value.
+func (e *JSONEncoder) Null() {
+	e.separate()
+	e.buf.WriteString("null")
+}
+
+// Value writes any other value, encoded with encoding/json.
+func (e *JSONEncoder) Value(v any) {
+	e.separate()
+	b, err := json.Marshal(v)
+	if err != nil {
+		e.setErr(err)
+		e.buf.WriteString("null")
+		return
+	}
+	e.buf.Write(b)
+}
+
+// Time writes a time value, in the RFC 3339 format with sub-second precision.
+func (e *JSONEncoder) Time(v time.Time) {
+	// encoding/json fails on years that can't be formatted in RFC 3339.
+	if y := v.Year(); y < 0 || y >= 10000 {
+		e.Value(v)
+		return
+	}
+	e.separate()
+	b := e.buf.AvailableBuffer()
+	b = append(b, '"')
+	b = v.AppendFormat(b, time.RFC3339Nano)
+	b = append(b, '"')
+	e.buf.Write(b)
+}
+
+// Bytes returns the JSON encoding, or the first error of the written values.
+func (e *JSONEncoder) Bytes() ([]byte, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.buf.Bytes(), nil
+}
+
+// separate writes the separator before a value or a key, if it isn't the first one of its object or array.
+func (e *JSONEncoder) separate() {
+	if e.afterKey {
+		e.afterKey = false
+		return
+	}
+	if n := len(e.first); n > 0 {
+		if !e.first[n-1] {
+			e.buf.WriteByte(',')
+		}
+		e.first[n-1] = false
+	}
+}
+
+func (e *JSONEncoder) setErr(err error) {
+	if e.err == nil {
+		e.err = err
+	}
+}
+
+// writeString writes a quoted string, escaped like encoding/json does, including HTML characters.
+func (e *JSONEncoder) writeString(s string) {
+	buf := &e.buf
+	buf.WriteByte('"')
+	start := 0
+	for i := 0; i < len(s); {
+		if b := s[i]; b < utf8.RuneSelf {
+			if b >= 0x20 && b != '"' && b != '\\' && b != '<' && b != '>' && b != '&' {
+				i++
+				continue
+			}
+			buf.WriteString(s[start:i])
+			switch b {
+			case '\\', '"':
+				buf.WriteByte('\\')
+				buf.WriteByte(b)
+			case '\b':
+				buf.WriteString(`\b`)
+			case '\f':
+				buf.WriteString(`\f`)
+			case '\n':
+				buf.WriteString(`\n`)
+			case '\r':
+				buf.WriteString(`\r`)
+			case '\t':
+				buf.WriteString(`\t`)
+			default:
+				buf.WriteString(`\u00`)
+				buf.WriteByte(hexDigits[b>>4])
+				buf.WriteByte(hexDigits[b&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			buf.WriteString(s[start:i])
+			buf.WriteString("\ufffd")
+			i += size
+			start = i
+			continue
+		}
+		// U+2028 and U+2029 are escaped for JSONP, as in encoding/json.
+		if r == '\u2028' || r == '\u2029' {
+			buf.WriteString(s[start:i])
+			buf.WriteString(`\u202`)
+			buf.WriteByte(hexDigits[r&0xF])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	buf.WriteString(s[start:])
+	buf.WriteByte('"')
+}
+
+// IsEmptyJSONValue reports whether v is omitted by the omitempty option of encoding/json.
+// Generated encoders use it for the omitempty fields whose type doesn't tell it.
+func IsEmptyJSONValue(v any) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return rv.Len() == 0
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Interface, reflect.Pointer:
+		return rv.IsZero()
+	default:
+		return false
+	}
+}
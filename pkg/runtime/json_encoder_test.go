@@ -0,0 +1,125 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONEncoder(t *testing.T) {
+	t.Run("same output as encoding/json", func(t *testing.T) {
+		created := time.Date(2026, 1, 2, 3, 4, 5, 123400000, time.FixedZone("CET", 3600))
+		expected, err := json.Marshal(map[string]any{
+			"a": "quote\" backslash\\ <html> & \n\t\x01 \u2028 \xff ünïcode",
+			"b": int64(-42),
+			"c": uint64(math.MaxUint64),
+			"d": 1.5,
+			"e": 1e-7,
+			"f": float32(3.14),
+			"g": true,
+			"h": nil,
+			"i": []any{"x", 1, []any{}, map[string]any{"y": nil}},
+			"j": created,
+			"k": 1e21,
+		})
+		require.NoError(t, err)
+
+		enc := NewJSONEncoder(64)
+		enc.BeginObject()
+		enc.Key("a")
+		enc.String("quote\" backslash\\ <html> & \n\t\x01 \u2028 \xff ünïcode")
+		enc.Key("b")
+		enc.Int(-42)
+		enc.Key("c")
+		enc.Uint(math.MaxUint64)
+		enc.Key("d")
+		enc.Float(1.5, 64)
+		enc.Key("e")
+		enc.Float(1e-7, 64)
+		enc.Key("f")
+		enc.Float(float64(float32(3.14)), 32)
+		enc.Key("g")
+		enc.Bool(true)
+		enc.Key("h")
+		enc.Null()
+		enc.Key("i")
+		enc.BeginArray()
+		enc.String("x")
+		enc.Int(1)
+		enc.BeginArray()
+		enc.EndArray()
+		enc.BeginObject()
+		enc.Key("y")
+		enc.Null()
+		enc.EndObject()
+		enc.EndArray()
+		enc.Key("j")
+		enc.Time(created)
+		enc.Key("k")
+		enc.Float(1e21, 64)
+		enc.EndObject()
+		data, err := enc.Bytes()
+		require.NoError(t, err)
+
+		assert.Equal(t, string(expected), string(data))
+	})
+
+	t.Run("empty object", func(t *testing.T) {
+		enc := NewJSONEncoder(0)
+		enc.BeginObject()
+		enc.EndObject()
+		data, err := enc.Bytes()
+		require.NoError(t, err)
+		assert.Equal(t, "{}", string(data))
+	})
+
+	t.Run("time out of range", func(t *testing.T) {
+		enc := NewJSONEncoder(0)
+		enc.Time(time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC))
+		_, err := enc.Bytes()
+		require.Error(t, err)
+	})
+
+	t.Run("unsupported float", func(t *testing.T) {
+		enc := NewJSONEncoder(0)
+		enc.Float(math.NaN(), 64)
+		_, err := enc.Bytes()
+		require.Error(t, err)
+	})
+
+	t.Run("value error", func(t *testing.T) {
+		enc := NewJSONEncoder(0)
+		enc.Value(make(chan int))
+		_, err := enc.Bytes()
+		require.Error(t, err)
+	})
+}
+
+func TestIsEmptyJSONValue(t *testing.T) {
+	type status string
+	type object struct{ A int }
+
+	assert.True(t, IsEmptyJSONValue(nil))
+	assert.True(t, IsEmptyJSONValue(status("")))
+	assert.True(t, IsEmptyJSONValue(0))
+	assert.True(t, IsEmptyJSONValue([]int{}))
+	assert.True(t, IsEmptyJSONValue(map[string]int{}))
+	assert.True(t, IsEmptyJSONValue((*int)(nil)))
+	assert.False(t, IsEmptyJSONValue(status("active")))
+	assert.False(t, IsEmptyJSONValue(object{}))
+	assert.False(t, IsEmptyJSONValue(time.Time{}))
+}
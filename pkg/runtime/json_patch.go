@@ -0,0 +1,193 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// JSONPatch operation names, as described in RFC 6902.
+const (
+	JSONPatchOpAdd     = "add"
+	JSONPatchOpRemove  = "remove"
+	JSONPatchOpReplace = "replace"
+)
+
+// JSONPatch is a JSON Patch (RFC 6902) document: a list of operations applied in order.
+type JSONPatch []JSONPatchOperation
+
+// JSONPatchOperation is a single JSON Patch operation.
+type JSONPatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	From  string `json:"from,omitempty"`
+	Value any    `json:"value,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler. The value of add and replace operations is always marshaled,
+// so they can set a value to null.
+func (o JSONPatchOperation) MarshalJSON() ([]byte, error) {
+	type alias JSONPatchOperation
+	if o.Op != JSONPatchOpAdd && o.Op != JSONPatchOpReplace {
+		return json.Marshal(alias(o))
+	}
+
+	return json.Marshal(struct {
+		alias
+		Value any `json:"value"`
+	}{alias: alias(o), Value: o.Value})
+}
+
+// JSONPatchPath describes the JSON pointers (RFC 6901) of a JSON document,
+// to validate the paths of JSON Patch operations before they're sent.
+// A path with no Fields, Items, Values or Any is a leaf value.
+type JSONPatchPath struct {
+	// Fields are the paths of the object properties.
+	Fields map[string]*JSONPatchPath
+	// Items is the path of the array items, addressed by index.
+	Items *JSONPatchPath
+	// Values is the path of the map values, addressed by any key that's not in Fields.
+	Values *JSONPatchPath
+	// Any accepts any path below, for values with no fixed structure.
+	Any bool
+}
+
+// Validate returns an error wrapping ErrJSONPatchInvalidPath if pointer isn't a path of the document.
+// The "-" array index, appending to an array, is only valid as the last token of an add operation.
+func (p *JSONPatchPath) Validate(op, pointer string) error {
+	if pointer == "" {
+		return nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return fmt.Errorf("%w %q: must be empty or start with /", ErrJSONPatchInvalidPath, pointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	node := p
+	for i, token := range tokens {
+		if node.Any {
+			return nil
+		}
+
+		key, err := unescapeJSONPointerToken(token)
+		if err != nil {
+			return fmt.Errorf("%w %q: %w", ErrJSONPatchInvalidPath, pointer, err)
+		}
+
+		if next, ok := node.Fields[key]; ok {
+			node = next
+			continue
+		}
+
+		switch {
+		case node.Items != nil:
+			if key == "-" && (op != JSONPatchOpAdd || i != len(tokens)-1) {
+				return fmt.Errorf("%w %q: - is only valid at the end of an add path", ErrJSONPatchInvalidPath, pointer)
+			}
+			if key != "-" && !isJSONPointerIndex(key) {
+				return fmt.Errorf("%w %q: %q is not an array index", ErrJSONPatchInvalidPath, pointer, key)
+			}
+			node = node.Items
+		case node.Values != nil:
+			node = node.Values
+		default:
+			return fmt.Errorf("%w %q: unknown field %q", ErrJSONPatchInvalidPath, pointer, key)
+		}
+	}
+	return nil
+}
+
+// JSONPatchBuilder builds a JSON Patch, validating the operation paths against a JSONPatchPath.
+// Errors are collected and returned by Build, so calls can be chained.
+type JSONPatchBuilder struct {
+	paths *JSONPatchPath
+	ops   JSONPatch
+	errs  []error
+}
+
+// NewJSONPatchBuilder returns a builder validating paths against paths.
+// A nil paths accepts any path.
+func NewJSONPatchBuilder(paths *JSONPatchPath) *JSONPatchBuilder {
+	if paths == nil {
+		paths = &JSONPatchPath{Any: true}
+	}
+	return &JSONPatchBuilder{paths: paths}
+}
+
+// Add adds an operation adding value at path.
+func (b *JSONPatchBuilder) Add(path string, value any) *JSONPatchBuilder {
+	return b.append(JSONPatchOperation{Op: JSONPatchOpAdd, Path: path, Value: value})
+}
+
+// Remove adds an operation removing the value at path.
+func (b *JSONPatchBuilder) Remove(path string) *JSONPatchBuilder {
+	return b.append(JSONPatchOperation{Op: JSONPatchOpRemove, Path: path})
+}
+
+// Replace adds an operation replacing the value at path with value.
+func (b *JSONPatchBuilder) Replace(path string, value any) *JSONPatchBuilder {
+	return b.append(JSONPatchOperation{Op: JSONPatchOpReplace, Path: path, Value: value})
+}
+
+// Build returns the operations, or the errors of the invalid ones.
+func (b *JSONPatchBuilder) Build() (JSONPatch, error) {
+	if len(b.errs) > 0 {
+		return nil, errors.Join(b.errs...)
+	}
+	return append(JSONPatch(nil), b.ops...), nil
+}
+
+func (b *JSONPatchBuilder) append(op JSONPatchOperation) *JSONPatchBuilder {
+	if err := b.paths.Validate(op.Op, op.Path); err != nil {
+		b.errs = append(b.errs, fmt.Errorf("%s: %w", op.Op, err))
+		return b
+	}
+	b.ops = append(b.ops, op)
+	return b
+}
+
+// unescapeJSONPointerToken unescapes ~1 and ~0, as described in RFC 6901.
+func unescapeJSONPointerToken(token string) (string, error) {
+	if !strings.Contains(token, "~") {
+		return token, nil
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(token); i++ {
+		if token[i] != '~' {
+			sb.WriteByte(token[i])
+			continue
+		}
+		if i+1 == len(token) || (token[i+1] != '0' && token[i+1] != '1') {
+			return "", fmt.Errorf("invalid escape in %q", token)
+		}
+		if token[i+1] == '0' {
+			sb.WriteByte('~')
+		} else {
+			sb.WriteByte('/')
+		}
+		i++
+	}
+	return sb.String(), nil
+}
+
+// isJSONPointerIndex returns true for array indexes: 0 or digits with no leading zero.
+func isJSONPointerIndex(token string) bool {
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return false
+	}
+	_, err := strconv.ParseUint(token, 10, 64)
+	return err == nil
+}
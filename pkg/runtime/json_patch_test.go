@@ -0,0 +1,99 @@
+package runtime
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testJSONPatchPaths() *JSONPatchPath {
+	node := &JSONPatchPath{}
+	node.Fields = map[string]*JSONPatchPath{
+		"name":   {},
+		"a/b":    {},
+		"tags":   {Items: &JSONPatchPath{}},
+		"labels": {Values: &JSONPatchPath{}},
+		"extra":  {Any: true},
+		"child":  node,
+	}
+	return node
+}
+
+func TestJSONPatchPath_Validate(t *testing.T) {
+	paths := testJSONPatchPaths()
+
+	tests := []struct {
+		name    string
+		op      string
+		pointer string
+		wantErr bool
+	}{
+		{name: "root", op: JSONPatchOpReplace, pointer: ""},
+		{name: "field", op: JSONPatchOpReplace, pointer: "/name"},
+		{name: "escaped field", op: JSONPatchOpReplace, pointer: "/a~1b"},
+		{name: "array index", op: JSONPatchOpReplace, pointer: "/tags/0"},
+		{name: "array append", op: JSONPatchOpAdd, pointer: "/tags/-"},
+		{name: "map key", op: JSONPatchOpAdd, pointer: "/labels/team"},
+		{name: "any below", op: JSONPatchOpAdd, pointer: "/extra/x/y/0"},
+		{name: "recursive", op: JSONPatchOpRemove, pointer: "/child/child/name"},
+		{name: "unknown field", op: JSONPatchOpReplace, pointer: "/nickname", wantErr: true},
+		{name: "below leaf", op: JSONPatchOpReplace, pointer: "/name/first", wantErr: true},
+		{name: "not an index", op: JSONPatchOpReplace, pointer: "/tags/first", wantErr: true},
+		{name: "leading zero index", op: JSONPatchOpReplace, pointer: "/tags/01", wantErr: true},
+		{name: "append with replace", op: JSONPatchOpReplace, pointer: "/tags/-", wantErr: true},
+		{name: "missing slash", op: JSONPatchOpReplace, pointer: "name", wantErr: true},
+		{name: "invalid escape", op: JSONPatchOpReplace, pointer: "/a~2b", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := paths.Validate(tt.op, tt.pointer)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrJSONPatchInvalidPath)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestJSONPatchBuilder(t *testing.T) {
+	t.Run("valid patch", func(t *testing.T) {
+		patch, err := NewJSONPatchBuilder(testJSONPatchPaths()).
+			Replace("/name", "Jane").
+			Add("/tags/-", "new").
+			Remove("/labels/team").
+			Replace("/child/name", nil).
+			Build()
+		require.NoError(t, err)
+
+		data, err := json.Marshal(patch)
+		require.NoError(t, err)
+		assert.JSONEq(t, `[
+			{"op":"replace","path":"/name","value":"Jane"},
+			{"op":"add","path":"/tags/-","value":"new"},
+			{"op":"remove","path":"/labels/team"},
+			{"op":"replace","path":"/child/name","value":null}
+		]`, string(data))
+	})
+
+	t.Run("unknown path", func(t *testing.T) {
+		patch, err := NewJSONPatchBuilder(testJSONPatchPaths()).
+			Replace("/name", "Jane").
+			Replace("/nickname", "jj").
+			Remove("/unknown").
+			Build()
+		require.ErrorIs(t, err, ErrJSONPatchInvalidPath)
+		assert.Contains(t, err.Error(), `"/nickname"`)
+		assert.Contains(t, err.Error(), `"/unknown"`)
+		assert.Nil(t, patch)
+	})
+
+	t.Run("nil paths accept any path", func(t *testing.T) {
+		patch, err := NewJSONPatchBuilder(nil).Remove("/a/b/c").Build()
+		require.NoError(t, err)
+		assert.Len(t, patch, 1)
+	})
+}
@@ -92,6 +92,33 @@ func TestAsMap(t *testing.T) {
 	})
 }
 
+func TestFromMap(t *testing.T) {
+	type Address struct {
+		City string `json:"city"`
+	}
+	type TestStruct struct {
+		Name    string   `json:"name"`
+		Age     int      `json:"age"`
+		Address *Address `json:"address,omitempty"`
+	}
+
+	t.Run("round trips AsMap", func(t *testing.T) {
+		input := TestStruct{Name: "John", Age: 30, Address: &Address{City: "NYC"}}
+		m, err := AsMap[any](input)
+		require.NoError(t, err)
+
+		var result TestStruct
+		require.NoError(t, FromMap(m, &result))
+		assert.Equal(t, input, result)
+	})
+
+	t.Run("returns decoding error", func(t *testing.T) {
+		var result TestStruct
+		err := FromMap(map[string]any{"age": "thirty"}, &result)
+		assert.Error(t, err)
+	})
+}
+
 var (
 	outputJSON, outputIndentJSON, outputNonexistentJSON string
 	input                                               = `
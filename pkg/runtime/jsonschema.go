@@ -0,0 +1,646 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// JSONSchema is a compiled JSON Schema document used to validate raw JSON payloads.
+// It complements the tag-based Validate() methods with constraints that struct tags
+// can't express, e.g. anyOf/oneOf/not on a body.
+//
+// The supported keywords are: $ref (to #/$defs/...), type, enum, const, properties,
+// required, additionalProperties, items, allOf, anyOf, oneOf, not, minLength,
+// maxLength, pattern, minimum, maximum, exclusiveMinimum, exclusiveMaximum, multipleOf,
+// minItems, maxItems, uniqueItems, minProperties and maxProperties.
+// Other keywords, including format, are treated as annotations.
+type JSONSchema struct {
+	root *jsonSchemaNode
+	defs map[string]*jsonSchemaNode
+}
+
+type jsonSchemaNode struct {
+	ref                  string
+	types                []string
+	enum                 []any
+	hasConst             bool
+	constValue           any
+	properties           map[string]*jsonSchemaNode
+	required             []string
+	additionalProperties *jsonSchemaNode
+	noAdditional         bool
+	items                *jsonSchemaNode
+	allOf                []*jsonSchemaNode
+	anyOf                []*jsonSchemaNode
+	oneOf                []*jsonSchemaNode
+	not                  *jsonSchemaNode
+	minLength            *int
+	maxLength            *int
+	pattern              *regexp.Regexp
+	minimum              *float64
+	maximum              *float64
+	exclusiveMinimum     *float64
+	exclusiveMaximum     *float64
+	multipleOf           *float64
+	minItems             *int
+	maxItems             *int
+	uniqueItems          bool
+	minProperties        *int
+	maxProperties        *int
+	// never is set for the boolean schema false.
+	never bool
+}
+
+type rawJSONSchema struct {
+	Ref                  string                     `json:"$ref"`
+	Defs                 map[string]json.RawMessage `json:"$defs"`
+	Type                 json.RawMessage            `json:"type"`
+	Enum                 []json.RawMessage          `json:"enum"`
+	Const                json.RawMessage            `json:"const"`
+	Properties           map[string]json.RawMessage `json:"properties"`
+	Required             []string                   `json:"required"`
+	AdditionalProperties json.RawMessage            `json:"additionalProperties"`
+	Items                json.RawMessage            `json:"items"`
+	AllOf                []json.RawMessage          `json:"allOf"`
+	AnyOf                []json.RawMessage          `json:"anyOf"`
+	OneOf                []json.RawMessage          `json:"oneOf"`
+	Not                  json.RawMessage            `json:"not"`
+	MinLength            *int                       `json:"minLength"`
+	MaxLength            *int                       `json:"maxLength"`
+	Pattern              string                     `json:"pattern"`
+	Minimum              *float64                   `json:"minimum"`
+	Maximum              *float64                   `json:"maximum"`
+	ExclusiveMinimum     *float64                   `json:"exclusiveMinimum"`
+	ExclusiveMaximum     *float64                   `json:"exclusiveMaximum"`
+	MultipleOf           *float64                   `json:"multipleOf"`
+	MinItems             *int                       `json:"minItems"`
+	MaxItems             *int                       `json:"maxItems"`
+	UniqueItems          bool                       `json:"uniqueItems"`
+	MinProperties        *int                       `json:"minProperties"`
+	MaxProperties        *int                       `json:"maxProperties"`
+}
+
+// CompileJSONSchema parses a JSON Schema document.
+// Definitions referenced with "#/$defs/<name>" must live in the root "$defs" object.
+func CompileJSONSchema(src []byte) (*JSONSchema, error) {
+	var raw rawJSONSchema
+	if err := json.Unmarshal(src, &raw); err != nil {
+		return nil, fmt.Errorf("error parsing JSON schema: %w", err)
+	}
+
+	s := &JSONSchema{defs: make(map[string]*jsonSchemaNode, len(raw.Defs))}
+	for name, def := range raw.Defs {
+		node, err := compileJSONSchemaNode(def)
+		if err != nil {
+			return nil, fmt.Errorf("error compiling $defs/%s: %w", name, err)
+		}
+		s.defs[name] = node
+	}
+
+	root, err := compileJSONSchemaNode(src)
+	if err != nil {
+		return nil, err
+	}
+	s.root = root
+
+	if err = s.checkRefs(root, map[*jsonSchemaNode]bool{}); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// MustCompileJSONSchema is like CompileJSONSchema but panics if the schema is invalid.
+// It is intended for package-level variables in generated code.
+func MustCompileJSONSchema(src string) *JSONSchema {
+	s, err := CompileJSONSchema([]byte(src))
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// Validate validates a raw JSON document against the schema.
+// It returns ValidationErrors describing every violation, or nil.
+func (s *JSONSchema) Validate(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return NewValidationErrorsFromString("", fmt.Sprintf("invalid JSON: %v", err))
+	}
+
+	var errs ValidationErrors
+	s.validate(s.root, value, "", &errs)
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// ValidateValue marshals v to JSON and validates the result against the schema.
+func (s *JSONSchema) ValidateValue(v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("error marshaling value: %w", err)
+	}
+	return s.Validate(data)
+}
+
+func compileJSONSchemaNode(src json.RawMessage) (*jsonSchemaNode, error) {
+	switch string(bytes.TrimSpace(src)) {
+	case "true":
+		return &jsonSchemaNode{}, nil
+	case "false":
+		return &jsonSchemaNode{never: true}, nil
+	}
+
+	var raw rawJSONSchema
+	if err := json.Unmarshal(src, &raw); err != nil {
+		return nil, fmt.Errorf("error parsing JSON schema: %w", err)
+	}
+
+	node := &jsonSchemaNode{
+		ref:              raw.Ref,
+		required:         raw.Required,
+		minLength:        raw.MinLength,
+		maxLength:        raw.MaxLength,
+		minimum:          raw.Minimum,
+		maximum:          raw.Maximum,
+		exclusiveMinimum: raw.ExclusiveMinimum,
+		exclusiveMaximum: raw.ExclusiveMaximum,
+		multipleOf:       raw.MultipleOf,
+		minItems:         raw.MinItems,
+		maxItems:         raw.MaxItems,
+		uniqueItems:      raw.UniqueItems,
+		minProperties:    raw.MinProperties,
+		maxProperties:    raw.MaxProperties,
+	}
+
+	if len(raw.Type) > 0 {
+		if raw.Type[0] == '[' {
+			if err := json.Unmarshal(raw.Type, &node.types); err != nil {
+				return nil, fmt.Errorf("error parsing type: %w", err)
+			}
+		} else {
+			var typ string
+			if err := json.Unmarshal(raw.Type, &typ); err != nil {
+				return nil, fmt.Errorf("error parsing type: %w", err)
+			}
+			node.types = []string{typ}
+		}
+	}
+
+	for _, e := range raw.Enum {
+		v, err := decodeJSONValue(e)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing enum: %w", err)
+		}
+		node.enum = append(node.enum, v)
+	}
+
+	if len(raw.Const) > 0 {
+		v, err := decodeJSONValue(raw.Const)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing const: %w", err)
+		}
+		node.hasConst = true
+		node.constValue = v
+	}
+
+	if raw.Pattern != "" {
+		re, err := regexp.Compile(raw.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("error compiling pattern %q: %w", raw.Pattern, err)
+		}
+		node.pattern = re
+	}
+
+	if len(raw.Properties) > 0 {
+		node.properties = make(map[string]*jsonSchemaNode, len(raw.Properties))
+		for name, prop := range raw.Properties {
+			child, err := compileJSONSchemaNode(prop)
+			if err != nil {
+				return nil, fmt.Errorf("error compiling property %s: %w", name, err)
+			}
+			node.properties[name] = child
+		}
+	}
+
+	if len(raw.AdditionalProperties) > 0 {
+		child, err := compileJSONSchemaNode(raw.AdditionalProperties)
+		if err != nil {
+			return nil, fmt.Errorf("error compiling additionalProperties: %w", err)
+		}
+		if child.never {
+			node.noAdditional = true
+		} else {
+			node.additionalProperties = child
+		}
+	}
+
+	if len(raw.Items) > 0 {
+		child, err := compileJSONSchemaNode(raw.Items)
+		if err != nil {
+			return nil, fmt.Errorf("error compiling items: %w", err)
+		}
+		node.items = child
+	}
+
+	if len(raw.Not) > 0 {
+		child, err := compileJSONSchemaNode(raw.Not)
+		if err != nil {
+			return nil, fmt.Errorf("error compiling not: %w", err)
+		}
+		node.not = child
+	}
+
+	var err error
+	if node.allOf, err = compileJSONSchemaNodes("allOf", raw.AllOf); err != nil {
+		return nil, err
+	}
+	if node.anyOf, err = compileJSONSchemaNodes("anyOf", raw.AnyOf); err != nil {
+		return nil, err
+	}
+	if node.oneOf, err = compileJSONSchemaNodes("oneOf", raw.OneOf); err != nil {
+		return nil, err
+	}
+
+	return node, nil
+}
+
+func compileJSONSchemaNodes(keyword string, srcs []json.RawMessage) ([]*jsonSchemaNode, error) {
+	if len(srcs) == 0 {
+		return nil, nil
+	}
+	res := make([]*jsonSchemaNode, len(srcs))
+	for i, src := range srcs {
+		node, err := compileJSONSchemaNode(src)
+		if err != nil {
+			return nil, fmt.Errorf("error compiling %s[%d]: %w", keyword, i, err)
+		}
+		res[i] = node
+	}
+	return res, nil
+}
+
+// checkRefs makes sure every $ref points to a known definition, so that
+// Validate never has to deal with dangling references.
+func (s *JSONSchema) checkRefs(node *jsonSchemaNode, seen map[*jsonSchemaNode]bool) error {
+	if node == nil || seen[node] {
+		return nil
+	}
+	seen[node] = true
+
+	if node.ref != "" {
+		def, err := s.resolveRef(node.ref)
+		if err != nil {
+			return err
+		}
+		if err = s.checkRefs(def, seen); err != nil {
+			return err
+		}
+	}
+
+	children := []*jsonSchemaNode{node.additionalProperties, node.items, node.not}
+	for _, prop := range node.properties {
+		children = append(children, prop)
+	}
+	children = append(children, node.allOf...)
+	children = append(children, node.anyOf...)
+	children = append(children, node.oneOf...)
+
+	for _, child := range children {
+		if err := s.checkRefs(child, seen); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *JSONSchema) resolveRef(ref string) (*jsonSchemaNode, error) {
+	name, ok := strings.CutPrefix(ref, "#/$defs/")
+	if !ok {
+		return nil, fmt.Errorf("unsupported $ref %q", ref)
+	}
+	def, ok := s.defs[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown $ref %q", ref)
+	}
+	return def, nil
+}
+
+func (s *JSONSchema) validate(node *jsonSchemaNode, value any, path string, errs *ValidationErrors) {
+	if node.never {
+		*errs = errs.Add(path, "no value is allowed")
+		return
+	}
+
+	if node.ref != "" {
+		// references were checked at compile time
+		def, _ := s.resolveRef(node.ref)
+		s.validate(def, value, path, errs)
+	}
+
+	if len(node.types) > 0 && !matchesAnyJSONType(node.types, value) {
+		*errs = errs.Add(path, fmt.Sprintf("must be of type %s", strings.Join(node.types, " or ")))
+		return
+	}
+
+	if len(node.enum) > 0 {
+		found := false
+		for _, e := range node.enum {
+			if jsonValuesEqual(e, value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			*errs = errs.Add(path, "must be one of the allowed values")
+		}
+	}
+
+	if node.hasConst && !jsonValuesEqual(node.constValue, value) {
+		*errs = errs.Add(path, "must be equal to the constant value")
+	}
+
+	switch v := value.(type) {
+	case string:
+		s.validateString(node, v, path, errs)
+	case json.Number:
+		s.validateNumber(node, v, path, errs)
+	case []any:
+		s.validateArray(node, v, path, errs)
+	case map[string]any:
+		s.validateObject(node, v, path, errs)
+	}
+
+	for _, sub := range node.allOf {
+		s.validate(sub, value, path, errs)
+	}
+
+	if len(node.anyOf) > 0 {
+		matched := false
+		for _, sub := range node.anyOf {
+			if s.matches(sub, value) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			*errs = errs.Add(path, "must match at least one schema in anyOf")
+		}
+	}
+
+	if len(node.oneOf) > 0 {
+		matched := 0
+		for _, sub := range node.oneOf {
+			if s.matches(sub, value) {
+				matched++
+			}
+		}
+		if matched != 1 {
+			*errs = errs.Add(path, fmt.Sprintf("must match exactly one schema in oneOf, matched %d", matched))
+		}
+	}
+
+	if node.not != nil && s.matches(node.not, value) {
+		*errs = errs.Add(path, "must not match the schema in not")
+	}
+}
+
+func (s *JSONSchema) matches(node *jsonSchemaNode, value any) bool {
+	var errs ValidationErrors
+	s.validate(node, value, "", &errs)
+	return len(errs) == 0
+}
+
+func (s *JSONSchema) validateString(node *jsonSchemaNode, v string, path string, errs *ValidationErrors) {
+	length := utf8.RuneCountInString(v)
+	if node.minLength != nil && length < *node.minLength {
+		*errs = errs.Add(path, fmt.Sprintf("length must be at least %d", *node.minLength))
+	}
+	if node.maxLength != nil && length > *node.maxLength {
+		*errs = errs.Add(path, fmt.Sprintf("length must be at most %d", *node.maxLength))
+	}
+	if node.pattern != nil && !node.pattern.MatchString(v) {
+		*errs = errs.Add(path, fmt.Sprintf("must match pattern %q", node.pattern.String()))
+	}
+}
+
+func (s *JSONSchema) validateNumber(node *jsonSchemaNode, v json.Number, path string, errs *ValidationErrors) {
+	f, err := v.Float64()
+	if err != nil {
+		*errs = errs.Add(path, fmt.Sprintf("invalid number %s", v))
+		return
+	}
+	if node.minimum != nil && f < *node.minimum {
+		*errs = errs.Add(path, fmt.Sprintf("must be greater than or equal to %s", formatJSONFloat(*node.minimum)))
+	}
+	if node.maximum != nil && f > *node.maximum {
+		*errs = errs.Add(path, fmt.Sprintf("must be less than or equal to %s", formatJSONFloat(*node.maximum)))
+	}
+	if node.exclusiveMinimum != nil && f <= *node.exclusiveMinimum {
+		*errs = errs.Add(path, fmt.Sprintf("must be greater than %s", formatJSONFloat(*node.exclusiveMinimum)))
+	}
+	if node.exclusiveMaximum != nil && f >= *node.exclusiveMaximum {
+		*errs = errs.Add(path, fmt.Sprintf("must be less than %s", formatJSONFloat(*node.exclusiveMaximum)))
+	}
+	if node.multipleOf != nil && !IsMultipleOf(f, *node.multipleOf) {
+		*errs = errs.Add(path, fmt.Sprintf("must be a multiple of %s", formatJSONFloat(*node.multipleOf)))
+	}
+}
+
+func (s *JSONSchema) validateArray(node *jsonSchemaNode, v []any, path string, errs *ValidationErrors) {
+	if node.minItems != nil && len(v) < *node.minItems {
+		*errs = errs.Add(path, fmt.Sprintf("must contain at least %d items", *node.minItems))
+	}
+	if node.maxItems != nil && len(v) > *node.maxItems {
+		*errs = errs.Add(path, fmt.Sprintf("must contain at most %d items", *node.maxItems))
+	}
+	if node.uniqueItems {
+	outer:
+		for i := range v {
+			for j := i + 1; j < len(v); j++ {
+				if jsonValuesEqual(v[i], v[j]) {
+					*errs = errs.Add(path, "items must be unique")
+					break outer
+				}
+			}
+		}
+	}
+	if node.items != nil {
+		for i, item := range v {
+			s.validate(node.items, item, fmt.Sprintf("%s[%d]", path, i), errs)
+		}
+	}
+}
+
+func (s *JSONSchema) validateObject(node *jsonSchemaNode, v map[string]any, path string, errs *ValidationErrors) {
+	if node.minProperties != nil && len(v) < *node.minProperties {
+		*errs = errs.Add(path, fmt.Sprintf("must contain at least %d properties", *node.minProperties))
+	}
+	if node.maxProperties != nil && len(v) > *node.maxProperties {
+		*errs = errs.Add(path, fmt.Sprintf("must contain at most %d properties", *node.maxProperties))
+	}
+
+	for _, name := range node.required {
+		if _, ok := v[name]; !ok {
+			*errs = errs.Add(joinJSONPath(path, name), "is required")
+		}
+	}
+
+	// iterate in a stable order so error messages are deterministic
+	keys := make([]string, 0, len(v))
+	for k := range v {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		childPath := joinJSONPath(path, k)
+		if prop, ok := node.properties[k]; ok {
+			s.validate(prop, v[k], childPath, errs)
+			continue
+		}
+		if node.noAdditional {
+			*errs = errs.Add(childPath, "is not allowed")
+			continue
+		}
+		if node.additionalProperties != nil {
+			s.validate(node.additionalProperties, v[k], childPath, errs)
+		}
+	}
+}
+
+func matchesAnyJSONType(types []string, value any) bool {
+	for _, t := range types {
+		if jsonValueIsType(value, t) {
+			return true
+		}
+	}
+	return false
+}
+
+func jsonValueIsType(value any, typ string) bool {
+	switch typ {
+	case "null":
+		return value == nil
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "number":
+		_, ok := value.(json.Number)
+		return ok
+	case "integer":
+		n, ok := value.(json.Number)
+		if !ok {
+			return false
+		}
+		if _, err := strconv.ParseInt(n.String(), 10, 64); err == nil {
+			return true
+		}
+		f, err := n.Float64()
+		return err == nil && f == math.Trunc(f)
+	case "array":
+		_, ok := value.([]any)
+		return ok
+	case "object":
+		_, ok := value.(map[string]any)
+		return ok
+	}
+	return false
+}
+
+func jsonValuesEqual(a, b any) bool {
+	switch av := a.(type) {
+	case nil:
+		return b == nil
+	case bool:
+		bv, ok := b.(bool)
+		return ok && av == bv
+	case string:
+		bv, ok := b.(string)
+		return ok && av == bv
+	case json.Number:
+		bv, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		if av == bv {
+			return true
+		}
+		af, aErr := av.Float64()
+		bf, bErr := bv.Float64()
+		return aErr == nil && bErr == nil && af == bf
+	case []any:
+		bv, ok := b.([]any)
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range av {
+			if !jsonValuesEqual(av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]any:
+		bv, ok := b.(map[string]any)
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for k, v := range av {
+			other, exists := bv[k]
+			if !exists || !jsonValuesEqual(v, other) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func decodeJSONValue(data []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func joinJSONPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func formatJSONFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,160 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONSchema(t *testing.T) {
+	schema := MustCompileJSONSchema(`{
+		"type": "object",
+		"required": ["name", "pet"],
+		"properties": {
+			"name": {"type": "string", "minLength": 2, "maxLength": 5, "pattern": "^[a-z]+$"},
+			"age": {"type": ["integer", "null"], "minimum": 0, "exclusiveMaximum": 150},
+			"tags": {"type": "array", "items": {"type": "string"}, "maxItems": 2, "uniqueItems": true},
+			"kind": {"type": "string", "enum": ["a", "b"]},
+			"price": {"type": "number", "multipleOf": 0.5},
+			"pet": {"$ref": "#/$defs/Pet"}
+		},
+		"additionalProperties": false,
+		"$defs": {
+			"Pet": {
+				"anyOf": [
+					{"type": "object", "required": ["bark"], "properties": {"bark": {"type": "boolean"}}},
+					{"type": "object", "required": ["meow"], "properties": {"meow": {"type": "boolean"}}}
+				]
+			}
+		}
+	}`)
+
+	t.Run("valid document", func(t *testing.T) {
+		err := schema.Validate([]byte(`{"name": "rex", "age": null, "tags": ["a"], "kind": "a", "price": 1.5, "pet": {"bark": true}}`))
+		assert.NoError(t, err)
+	})
+
+	t.Run("reports every violation", func(t *testing.T) {
+		err := schema.Validate([]byte(`{"name": "R", "age": 1.5, "tags": ["a", "a", "b"], "kind": "c", "price": 0.3, "pet": {}, "extra": 1}`))
+		require.Error(t, err)
+
+		var errs ValidationErrors
+		require.ErrorAs(t, err, &errs)
+
+		messages := map[string]string{}
+		for _, e := range errs {
+			messages[e.Field] = e.Message
+		}
+		assert.Equal(t, map[string]string{
+			"name":  `must match pattern "^[a-z]+$"`,
+			"age":   "must be of type integer or null",
+			"tags":  "items must be unique",
+			"kind":  "must be one of the allowed values",
+			"price": "must be a multiple of 0.5",
+			"pet":   "must match at least one schema in anyOf",
+			"extra": "is not allowed",
+		}, messages)
+	})
+
+	t.Run("required properties", func(t *testing.T) {
+		err := schema.Validate([]byte(`{}`))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "name")
+		assert.Contains(t, err.Error(), "pet")
+	})
+
+	t.Run("nested paths", func(t *testing.T) {
+		err := schema.Validate([]byte(`{"name": "rex", "pet": {"bark": true}, "tags": ["a", 1]}`))
+		require.Error(t, err)
+
+		var errs ValidationErrors
+		require.ErrorAs(t, err, &errs)
+		require.Len(t, errs, 1)
+		assert.Equal(t, "tags[1]", errs[0].Field)
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		err := schema.Validate([]byte(`{`))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid JSON")
+	})
+
+	t.Run("validate value", func(t *testing.T) {
+		type body struct {
+			Name string         `json:"name"`
+			Pet  map[string]any `json:"pet"`
+		}
+		assert.NoError(t, schema.ValidateValue(body{Name: "rex", Pet: map[string]any{"meow": true}}))
+		assert.Error(t, schema.ValidateValue(body{Name: "rex", Pet: map[string]any{"meow": "yes"}}))
+	})
+}
+
+func TestJSONSchemaOneOfAndNot(t *testing.T) {
+	schema := MustCompileJSONSchema(`{
+		"oneOf": [
+			{"type": "integer"},
+			{"type": "number", "minimum": 10}
+		],
+		"not": {"const": 42}
+	}`)
+
+	assert.NoError(t, schema.Validate([]byte(`10.5`)))
+	assert.NoError(t, schema.Validate([]byte(`3`)))
+
+	err := schema.Validate([]byte(`12`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "matched 2")
+
+	err = schema.Validate([]byte(`42.0`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "must not match")
+}
+
+func TestCompileJSONSchemaErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		errMsg string
+	}{
+		{name: "invalid JSON", schema: `{`, errMsg: "error parsing JSON schema"},
+		{name: "unknown ref", schema: `{"$ref": "#/$defs/Missing"}`, errMsg: `unknown $ref "#/$defs/Missing"`},
+		{name: "unsupported ref", schema: `{"$ref": "other.json"}`, errMsg: `unsupported $ref "other.json"`},
+		{name: "invalid pattern", schema: `{"pattern": "("}`, errMsg: "error compiling pattern"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := CompileJSONSchema([]byte(tc.schema))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.errMsg)
+		})
+	}
+
+	assert.Panics(t, func() { MustCompileJSONSchema(`{`) })
+}
+
+func TestJSONSchemaRecursiveRef(t *testing.T) {
+	schema := MustCompileJSONSchema(`{
+		"$ref": "#/$defs/Node",
+		"$defs": {
+			"Node": {
+				"type": "object",
+				"required": ["value"],
+				"properties": {
+					"value": {"type": "integer"},
+					"children": {"type": "array", "items": {"$ref": "#/$defs/Node"}}
+				}
+			}
+		}
+	}`)
+
+	assert.NoError(t, schema.Validate([]byte(`{"value": 1, "children": [{"value": 2, "children": []}]}`)))
+
+	err := schema.Validate([]byte(`{"value": 1, "children": [{"children": []}]}`))
+	require.Error(t, err)
+
+	var errs ValidationErrors
+	require.ErrorAs(t, err, &errs)
+	assert.Equal(t, "children[0].value", errs[0].Field)
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ResolveJSONPointer returns the value at the JSON pointer (RFC 6901) inside the JSON
+// representation of v. It resolves $response.body#/pointer link expressions.
+// An empty pointer returns the whole document.
+func ResolveJSONPointer(v any, pointer string) (any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling value: %w", err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var doc any
+	if err = dec.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("error decoding value: %w", err)
+	}
+
+	if pointer == "" {
+		return doc, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer %q", pointer)
+	}
+
+	unescape := strings.NewReplacer("~1", "/", "~0", "~")
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = unescape.Replace(token)
+		switch node := doc.(type) {
+		case map[string]any:
+			value, ok := node[token]
+			if !ok {
+				return nil, fmt.Errorf("JSON pointer %q: key %q not found", pointer, token)
+			}
+			doc = value
+		case []any:
+			idx, err := strconv.Atoi(token)
+			if err != nil || idx < 0 || idx >= len(node) {
+				return nil, fmt.Errorf("JSON pointer %q: invalid index %q", pointer, token)
+			}
+			doc = node[idx]
+		default:
+			return nil, fmt.Errorf("JSON pointer %q: cannot descend into %q", pointer, token)
+		}
+	}
+	return doc, nil
+}
+
+// ResolveLinkHeader returns the value of the response header,
+// resolving $response.header.name link expressions.
+func ResolveLinkHeader(header http.Header, name string) (any, error) {
+	values := header.Values(name)
+	if len(values) == 0 {
+		return nil, fmt.Errorf("header %q not found", name)
+	}
+	return values[0], nil
+}
+
+// ConvertLinkParams converts the link parameter values, keyed by parameter name,
+// into the parameters struct T. Scalars are converted to the type of the target field,
+// e.g. a numeric id in the response body fills a string path parameter.
+func ConvertLinkParams[T any](values map[string]any) (*T, error) {
+	fields := map[string]reflect.Type{}
+	t := reflect.TypeFor[T]()
+	if t.Kind() == reflect.Struct {
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			if name == "" {
+				name = f.Name
+			}
+			fields[name] = f.Type
+		}
+	}
+
+	converted := make(map[string]any, len(values))
+	for name, value := range values {
+		if ft, ok := fields[name]; ok {
+			value = convertLinkValue(value, ft)
+		}
+		converted[name] = value
+	}
+
+	data, err := json.Marshal(converted)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling link parameters: %w", err)
+	}
+	res := new(T)
+	if err = json.Unmarshal(data, res); err != nil {
+		return nil, fmt.Errorf("error converting link parameters: %w", err)
+	}
+	return res, nil
+}
+
+// convertLinkValue adapts a scalar value to the kind of the target type.
+func convertLinkValue(value any, target reflect.Type) any {
+	for target.Kind() == reflect.Pointer {
+		target = target.Elem()
+	}
+
+	switch target.Kind() {
+	case reflect.String:
+		switch v := value.(type) {
+		case json.Number, bool, float64, int, int64:
+			return fmt.Sprint(v)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64, reflect.Bool:
+		if v, ok := value.(string); ok && json.Valid([]byte(v)) {
+			return json.RawMessage(v)
+		}
+	}
+	return value
+}
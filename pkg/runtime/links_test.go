@@ -0,0 +1,94 @@
+package runtime
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveJSONPointer(t *testing.T) {
+	type owner struct {
+		Name string `json:"name"`
+	}
+	type user struct {
+		ID     int            `json:"id"`
+		Owners []owner        `json:"owners"`
+		Extra  map[string]any `json:"extra"`
+	}
+	doc := user{ID: 42, Owners: []owner{{Name: "a"}, {Name: "b"}}, Extra: map[string]any{"a/b": "slash", "m~n": "tilde"}}
+
+	tests := []struct {
+		name     string
+		pointer  string
+		expected any
+		errMsg   string
+	}{
+		{name: "top level number", pointer: "/id", expected: json.Number("42")},
+		{name: "array index", pointer: "/owners/1/name", expected: "b"},
+		{name: "escaped slash", pointer: "/extra/a~1b", expected: "slash"},
+		{name: "escaped tilde", pointer: "/extra/m~0n", expected: "tilde"},
+		{name: "missing key", pointer: "/missing", errMsg: `key "missing" not found`},
+		{name: "invalid index", pointer: "/owners/5", errMsg: `invalid index "5"`},
+		{name: "scalar descent", pointer: "/id/x", errMsg: `cannot descend into "x"`},
+		{name: "invalid pointer", pointer: "id", errMsg: "invalid JSON pointer"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := ResolveJSONPointer(doc, tc.pointer)
+			if tc.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, res)
+		})
+	}
+
+	t.Run("whole document", func(t *testing.T) {
+		res, err := ResolveJSONPointer(owner{Name: "a"}, "")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"name": "a"}, res)
+	})
+}
+
+func TestResolveLinkHeader(t *testing.T) {
+	header := http.Header{}
+	header.Set("X-Next-Cursor", "abc")
+
+	v, err := ResolveLinkHeader(header, "x-next-cursor")
+	require.NoError(t, err)
+	assert.Equal(t, "abc", v)
+
+	_, err = ResolveLinkHeader(header, "X-Missing")
+	assert.ErrorContains(t, err, `header "X-Missing" not found`)
+}
+
+func TestConvertLinkParams(t *testing.T) {
+	type params struct {
+		ID     string   `json:"id"`
+		Limit  *int     `json:"limit,omitempty"`
+		Rating float64  `json:"rating"`
+		Tags   []string `json:"tags,omitempty"`
+	}
+
+	res, err := ConvertLinkParams[params](map[string]any{
+		"id":     json.Number("42"),
+		"limit":  "10",
+		"rating": json.Number("4.5"),
+		"tags":   []any{"a", "b"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "42", res.ID)
+	require.NotNil(t, res.Limit)
+	assert.Equal(t, 10, *res.Limit)
+	assert.Equal(t, 4.5, res.Rating)
+	assert.Equal(t, []string{"a", "b"}, res.Tags)
+
+	_, err = ConvertLinkParams[params](map[string]any{"limit": "ten"})
+	assert.ErrorContains(t, err, "error converting link parameters")
+}
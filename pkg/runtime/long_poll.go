@@ -0,0 +1,67 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// LongPoll runs call, a long-poll request held by the server until it has data or its own timeout elapses,
+// until a response with data is received or ctx is done. A 304 Not Modified or 204 No Content response
+// means nothing changed before the server timeout: the request is sent again right away,
+// or after the Retry-After delay of the response if it has one.
+// Errors of call are returned as is, the ones of ctx when it's done between two requests.
+func LongPoll[T any](ctx context.Context, apiClient APIClient,
+	call func(ctx context.Context, apiClient APIClient) (*T, error)) (*T, error) {
+	for {
+		// the APIClient of Conditional without an ETag keeps the response, to tell an empty one
+		poll := &conditionalAPIClient{APIClient: apiClient}
+		body, err := call(ctx, poll)
+
+		resp := poll.response()
+		if resp == nil || (resp.StatusCode != http.StatusNotModified && resp.StatusCode != http.StatusNoContent) {
+			return body, err
+		}
+
+		delay := retryAfter(resp.Headers, time.Now())
+		if delay <= 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// retryAfter returns the delay of the Retry-After header at now, either seconds or an HTTP date, 0 if it's missing or invalid.
+func retryAfter(header http.Header, now time.Time) time.Duration {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return t.Sub(now)
+	}
+	return 0
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLongPoll(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := requests.Add(1)
+		switch {
+		case r.URL.Path == "/missing":
+			w.WriteHeader(http.StatusNotFound)
+		case r.URL.Path == "/never":
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusNoContent)
+		case n == 1:
+			w.WriteHeader(http.StatusNoContent)
+		case n == 2:
+			w.WriteHeader(http.StatusNotModified)
+		default:
+			_, _ = w.Write([]byte(`{"event": "created"}`))
+		}
+	}))
+	defer srv.Close()
+
+	client, err := NewAPIClient(srv.URL, WithHTTPClient(HTTPClientDoer{Client: srv.Client()}))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	t.Run("polls until data", func(t *testing.T) {
+		requests.Store(0)
+		body, err := LongPoll(ctx, client, getConditionalCall("/events"))
+		require.NoError(t, err)
+		require.NotNil(t, body)
+		assert.JSONEq(t, `{"event": "created"}`, *body)
+		assert.Equal(t, int32(3), requests.Load())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		requests.Store(0)
+		_, err := LongPoll(ctx, client, getConditionalCall("/missing"))
+		var apiErr *ClientAPIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusNotFound, apiErr.StatusCode())
+		assert.Equal(t, int32(1), requests.Load())
+	})
+
+	t.Run("context done while waiting", func(t *testing.T) {
+		requests.Store(0)
+		ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		_, err := LongPoll(ctx, client, getConditionalCall("/never"))
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, int32(1), requests.Load())
+	})
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	header := func(value string) http.Header {
+		h := http.Header{}
+		h.Set("Retry-After", value)
+		return h
+	}
+
+	assert.Equal(t, time.Duration(0), retryAfter(http.Header{}, now))
+	assert.Equal(t, 3*time.Second, retryAfter(header("3"), now))
+	assert.Equal(t, 10*time.Second, retryAfter(header(now.Add(10*time.Second).Format(http.TimeFormat)), now))
+	assert.Equal(t, time.Duration(0), retryAfter(header("soon"), now))
+}
@@ -0,0 +1,51 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"net/url"
+	"strings"
+)
+
+// MatchPathTemplate matches an escaped request path against an OpenAPI path template, e.g. /pets/{id}.
+// It returns the unescaped values of the path parameters, keyed by their name.
+// A parameter matches a non-empty part of a single segment, optionally surrounded by literal text, e.g. {name}.json.
+func MatchPathTemplate(template, path string) (map[string]string, bool) {
+	tplSegments := strings.Split(template, "/")
+	segments := strings.Split(path, "/")
+	if len(tplSegments) != len(segments) {
+		return nil, false
+	}
+
+	values := make(map[string]string)
+	for i, tpl := range tplSegments {
+		start := strings.IndexByte(tpl, '{')
+		end := strings.LastIndexByte(tpl, '}')
+		if start < 0 || end < start {
+			if tpl != segments[i] {
+				return nil, false
+			}
+			continue
+		}
+
+		prefix, suffix := tpl[:start], tpl[end+1:]
+		segment := segments[i]
+		if len(segment) <= len(prefix)+len(suffix) || !strings.HasPrefix(segment, prefix) || !strings.HasSuffix(segment, suffix) {
+			return nil, false
+		}
+		value, err := url.PathUnescape(segment[len(prefix) : len(segment)-len(suffix)])
+		if err != nil {
+			return nil, false
+		}
+		values[tpl[start+1:end]] = value
+	}
+	return values, true
+}
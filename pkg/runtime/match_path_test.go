@@ -0,0 +1,48 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchPathTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		path     string
+		expected map[string]string
+		matched  bool
+	}{
+		{name: "static", template: "/pets", path: "/pets", expected: map[string]string{}, matched: true},
+		{name: "static mismatch", template: "/pets", path: "/owners", matched: false},
+		{name: "param", template: "/pets/{id}", path: "/pets/42", expected: map[string]string{"id": "42"}, matched: true},
+		{name: "params", template: "/owners/{owner}/pets/{id}", path: "/owners/jane/pets/42", expected: map[string]string{"owner": "jane", "id": "42"}, matched: true},
+		{name: "unescaped", template: "/pets/{name}", path: "/pets/a%2Fb%20c", expected: map[string]string{"name": "a/b c"}, matched: true},
+		{name: "prefix and suffix", template: "/files/v{version}.json", path: "/files/v2.json", expected: map[string]string{"version": "2"}, matched: true},
+		{name: "suffix mismatch", template: "/files/{name}.json", path: "/files/a.yaml", matched: false},
+		{name: "empty param", template: "/pets/{id}", path: "/pets/", matched: false},
+		{name: "more segments", template: "/pets/{id}", path: "/pets/42/photos", matched: false},
+		{name: "trailing slash", template: "/pets", path: "/pets/", matched: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			values, matched := MatchPathTemplate(tc.template, tc.path)
+			assert.Equal(t, tc.matched, matched)
+			if tc.matched {
+				assert.Equal(t, tc.expected, values)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// MergePatch applies the JSON Merge Patch patch to the target document, as described in RFC 7396:
+// objects are merged recursively, null removes a member and any other value replaces the target.
+func MergePatch(target, patch []byte) ([]byte, error) {
+	var targetValue any
+	if len(bytes.TrimSpace(target)) > 0 {
+		if err := decodeJSONNumber(target, &targetValue); err != nil {
+			return nil, fmt.Errorf("error decoding merge patch target: %w", err)
+		}
+	}
+
+	var patchValue any
+	if err := decodeJSONNumber(patch, &patchValue); err != nil {
+		return nil, fmt.Errorf("error decoding merge patch: %w", err)
+	}
+
+	return json.Marshal(mergePatchValue(targetValue, patchValue))
+}
+
+// ApplyMergePatch returns a copy of base with the JSON Merge Patch applied, leaving base untouched.
+// The patch is encoded with its MarshalJSON, so generated patch types only change the fields they set.
+func ApplyMergePatch[T any](base T, patch any) (T, error) {
+	var res T
+
+	target, err := json.Marshal(base)
+	if err != nil {
+		return res, fmt.Errorf("error encoding merge patch target: %w", err)
+	}
+
+	patchData, err := json.Marshal(patch)
+	if err != nil {
+		return res, fmt.Errorf("error encoding merge patch: %w", err)
+	}
+
+	merged, err := MergePatch(target, patchData)
+	if err != nil {
+		return res, err
+	}
+
+	if err = json.Unmarshal(merged, &res); err != nil {
+		return res, fmt.Errorf("error decoding merged value: %w", err)
+	}
+	return res, nil
+}
+
+func mergePatchValue(target, patch any) any {
+	patchObject, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]any)
+	if !ok {
+		targetObject = make(map[string]any, len(patchObject))
+	}
+
+	for k, v := range patchObject {
+		if v == nil {
+			delete(targetObject, k)
+			continue
+		}
+		targetObject[k] = mergePatchValue(targetObject[k], v)
+	}
+	return targetObject
+}
+
+// decodeJSONNumber decodes data into v, keeping numbers as json.Number so they round-trip unchanged.
+func decodeJSONNumber(data []byte, v any) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
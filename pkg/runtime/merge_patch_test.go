@@ -0,0 +1,100 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergePatch(t *testing.T) {
+	// test cases from RFC 7396, Appendix A
+	tests := []struct {
+		target   string
+		patch    string
+		expected string
+	}{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+		{`{"a":"b"}`, `["c"]`, `["c"]`},
+		{`{"a":"foo"}`, `null`, `null`},
+		{`{"a":"foo"}`, `"bar"`, `"bar"`},
+		{`{"e":null}`, `{"a":1}`, `{"e":null,"a":1}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+		{``, `{"a":1}`, `{"a":1}`},
+		{`{"n":12345678901234567890}`, `{"m":1.5}`, `{"n":12345678901234567890,"m":1.5}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.target+" "+tt.patch, func(t *testing.T) {
+			res, err := MergePatch([]byte(tt.target), []byte(tt.patch))
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.expected, string(res))
+		})
+	}
+
+	t.Run("invalid patch", func(t *testing.T) {
+		_, err := MergePatch([]byte(`{}`), []byte(`{`))
+		assert.Error(t, err)
+	})
+}
+
+type mergePatchUser struct {
+	Name     string            `json:"name"`
+	Nickname *string           `json:"nickname,omitempty"`
+	Address  mergePatchAddress `json:"address"`
+}
+
+type mergePatchAddress struct {
+	City string `json:"city"`
+	Zip  string `json:"zip,omitempty"`
+}
+
+type mergePatchUserPatch struct {
+	Nickname Optional[string]         `json:"nickname,omitzero"`
+	Address  Optional[map[string]any] `json:"address,omitzero"`
+}
+
+func TestApplyMergePatch(t *testing.T) {
+	base := mergePatchUser{
+		Name:     "Jane",
+		Nickname: Ptr("jj"),
+		Address:  mergePatchAddress{City: "Paris", Zip: "75001"},
+	}
+
+	t.Run("partial update with explicit null", func(t *testing.T) {
+		patch := mergePatchUserPatch{
+			Address: NewOptional(map[string]any{"zip": "75002"}),
+		}
+		patch.Nickname.SetNull()
+
+		res, err := ApplyMergePatch(base, patch)
+		require.NoError(t, err)
+		assert.Equal(t, mergePatchUser{
+			Name:    "Jane",
+			Address: mergePatchAddress{City: "Paris", Zip: "75002"},
+		}, res)
+
+		// base is left untouched
+		assert.Equal(t, "jj", *base.Nickname)
+	})
+
+	t.Run("empty patch", func(t *testing.T) {
+		res, err := ApplyMergePatch(base, mergePatchUserPatch{})
+		require.NoError(t, err)
+		assert.Equal(t, base, res)
+	})
+
+	t.Run("incompatible patch", func(t *testing.T) {
+		_, err := ApplyMergePatch(base, map[string]any{"name": 1})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,154 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+)
+
+// Optional is an optional value that distinguishes an absent value from a value explicitly set to null.
+// The zero value is absent.
+//
+// Fields of type Optional should be tagged with `json:",omitzero"`, so that absent values are
+// omitted when marshaling, while null values are marshaled as null.
+type Optional[T any] struct {
+	value   T
+	present bool
+	null    bool
+}
+
+// NewOptional returns an Optional set to v.
+func NewOptional[T any](v T) Optional[T] {
+	return Optional[T]{value: v, present: true}
+}
+
+// NewNullOptional returns an Optional explicitly set to null.
+func NewNullOptional[T any]() Optional[T] {
+	return Optional[T]{present: true, null: true}
+}
+
+// Set sets the value to v.
+func (o *Optional[T]) Set(v T) {
+	*o = NewOptional(v)
+}
+
+// SetNull sets the value to null.
+func (o *Optional[T]) SetNull() {
+	*o = NewNullOptional[T]()
+}
+
+// Unset makes the value absent.
+func (o *Optional[T]) Unset() {
+	*o = Optional[T]{}
+}
+
+// Get returns the value and true if it's present and not null.
+func (o Optional[T]) Get() (T, bool) {
+	if !o.present || o.null {
+		var zero T
+		return zero, false
+	}
+	return o.value, true
+}
+
+// GetOr returns the value if it's present and not null, def otherwise.
+func (o Optional[T]) GetOr(def T) T {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return def
+}
+
+// Present returns true if the value is set, including to null.
+func (o Optional[T]) Present() bool {
+	return o.present
+}
+
+// IsNull returns true if the value is explicitly set to null.
+func (o Optional[T]) IsNull() bool {
+	return o.present && o.null
+}
+
+// IsZero returns true if the value is absent. It makes the omitzero JSON option omit absent values.
+func (o Optional[T]) IsZero() bool {
+	return !o.present
+}
+
+// Ptr returns a pointer to a copy of the value, or nil if it's absent or null.
+func (o Optional[T]) Ptr() *T {
+	if v, ok := o.Get(); ok {
+		return &v
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. Absent and null values are marshaled as null.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if v, ok := o.Get(); ok {
+		return json.Marshal(v)
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It's only called for keys present in the JSON object,
+// so a missing key leaves the value absent.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		o.SetNull()
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	o.Set(v)
+	return nil
+}
+
+// Validate validates the value with its Validate method, if it's present and not null.
+func (o Optional[T]) Validate() error {
+	v, ok := o.Get()
+	if !ok {
+		return nil
+	}
+	if val, ok := any(v).(Validator); ok {
+		return val.Validate()
+	}
+	return nil
+}
+
+// ValidateContext validates the value like ValidateContext, if it's present and not null.
+func (o Optional[T]) ValidateContext(ctx context.Context) error {
+	v, ok := o.Get()
+	if !ok {
+		return nil
+	}
+	return ValidateContext(ctx, v)
+}
+
+// redactValue masks the sensitive values of the value in place, see Redact.
+func (o *Optional[T]) redactValue() {
+	if o.present && !o.null {
+		redactValue(reflect.ValueOf(&o.value).Elem())
+	}
+}
+
+// fieldValue returns the value, false if absent or null, see ResolveField.
+func (o Optional[T]) fieldValue() (any, bool) {
+	if !o.present || o.null {
+		return nil, false
+	}
+	return o.value, true
+}
@@ -0,0 +1,109 @@
+package runtime
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type optionalPatch struct {
+	Name  Optional[string]     `json:"name,omitzero"`
+	Count Optional[int]        `json:"count,omitzero"`
+	Card  Optional[redactCard] `json:"card,omitzero"`
+}
+
+func TestOptional(t *testing.T) {
+	t.Run("zero value is absent", func(t *testing.T) {
+		var o Optional[string]
+		v, ok := o.Get()
+		assert.False(t, ok)
+		assert.Equal(t, "", v)
+		assert.False(t, o.Present())
+		assert.False(t, o.IsNull())
+		assert.True(t, o.IsZero())
+		assert.Nil(t, o.Ptr())
+		assert.Equal(t, "def", o.GetOr("def"))
+	})
+
+	t.Run("set, null and unset", func(t *testing.T) {
+		var o Optional[int]
+		o.Set(5)
+		v, ok := o.Get()
+		assert.True(t, ok)
+		assert.Equal(t, 5, v)
+		assert.Equal(t, 5, *o.Ptr())
+
+		o.SetNull()
+		_, ok = o.Get()
+		assert.False(t, ok)
+		assert.True(t, o.Present())
+		assert.True(t, o.IsNull())
+		assert.False(t, o.IsZero())
+
+		o.Unset()
+		assert.False(t, o.Present())
+		assert.False(t, o.IsNull())
+	})
+}
+
+func TestOptional_JSONRoundTrip(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		wantName  Optional[string]
+		wantCount Optional[int]
+	}{
+		{
+			name: "absent",
+			data: `{}`,
+		},
+		{
+			name:      "null",
+			data:      `{"name":null,"count":null}`,
+			wantName:  NewNullOptional[string](),
+			wantCount: NewNullOptional[int](),
+		},
+		{
+			name:      "value",
+			data:      `{"name":"Jane","count":0}`,
+			wantName:  NewOptional("Jane"),
+			wantCount: NewOptional(0),
+		},
+		{
+			name:      "mixed",
+			data:      `{"name":null,"count":3}`,
+			wantName:  NewNullOptional[string](),
+			wantCount: NewOptional(3),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var p optionalPatch
+			require.NoError(t, json.Unmarshal([]byte(tt.data), &p))
+			assert.Equal(t, tt.wantName, p.Name)
+			assert.Equal(t, tt.wantCount, p.Count)
+
+			res, err := json.Marshal(p)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.data, string(res))
+		})
+	}
+
+	t.Run("invalid value", func(t *testing.T) {
+		var p optionalPatch
+		err := json.Unmarshal([]byte(`{"count":"three"}`), &p)
+		assert.Error(t, err)
+	})
+}
+
+func TestOptional_Redact(t *testing.T) {
+	p := optionalPatch{Card: NewOptional(redactCard{Number: "4111111111111111", Holder: "Jane"})}
+
+	assert.JSONEq(t, `{"card":{"number":"********1111","holder":"Jane"}}`, Redact(p))
+
+	card, _ := p.Card.Get()
+	assert.Equal(t, "4111111111111111", card.Number)
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Pinger is implemented by generated clients with a health check operation,
+// giving a uniform readiness check across APIs.
+type Pinger interface {
+	Ping(ctx context.Context, reqEditors ...RequestEditorFn) error
+}
+
+// Ping sends a request without parameters nor body to the health check operation at path,
+// and returns an error if the response status isn't 2xx. The response body is ignored.
+func Ping(ctx context.Context, apiClient APIClient, method, path string, reqEditors ...RequestEditorFn) error {
+	req, err := apiClient.CreateRequest(ctx, RequestOptionsParameters{
+		RequestURL: apiClient.GetBaseURL() + path,
+		Method:     method,
+	}, reqEditors...)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	resp, err := apiClient.ExecuteRequest(ctx, req, path)
+	if err != nil {
+		return fmt.Errorf("error executing request: %w", err)
+	}
+	if resp == nil {
+		return errors.New("health check returned no response")
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return NewClientAPIError(fmt.Errorf("health check failed with status code: %d", resp.StatusCode),
+			WithStatusCode(resp.StatusCode))
+	}
+	return nil
+}
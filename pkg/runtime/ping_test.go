@@ -0,0 +1,62 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPing(t *testing.T) {
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/healthz", r.URL.Path)
+		assert.Equal(t, "probe", r.Header.Get("User-Agent"))
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	apiClient, err := NewAPIClient(server.URL, WithHTTPClient(HTTPClientDoer{}))
+	require.NoError(t, err)
+	userAgent := func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("User-Agent", "probe")
+		return nil
+	}
+
+	t.Run("2xx", func(t *testing.T) {
+		for _, status = range []int{http.StatusOK, http.StatusNoContent} {
+			require.NoError(t, Ping(context.Background(), apiClient, http.MethodGet, "/healthz", userAgent))
+		}
+	})
+
+	t.Run("unhealthy", func(t *testing.T) {
+		status = http.StatusServiceUnavailable
+		err := Ping(context.Background(), apiClient, http.MethodGet, "/healthz", userAgent)
+		require.Error(t, err)
+
+		var apiErr *ClientAPIError
+		require.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, http.StatusServiceUnavailable, apiErr.StatusCode())
+	})
+
+	t.Run("unreachable", func(t *testing.T) {
+		apiClient, err := NewAPIClient("http://127.0.0.1:1", WithHTTPClient(HTTPClientDoer{}))
+		require.NoError(t, err)
+		assert.Error(t, Ping(context.Background(), apiClient, http.MethodGet, "/healthz"))
+	})
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// ProblemDetailsContentType is the media type of RFC 9457 problem details.
+const ProblemDetailsContentType = "application/problem+json"
+
+// problemDetailsMembers are the members defined by RFC 9457.
+var problemDetailsMembers = map[string]bool{
+	"type":     true,
+	"title":    true,
+	"status":   true,
+	"detail":   true,
+	"instance": true,
+}
+
+// ProblemDetails is an RFC 9457 problem details object, the body of application/problem+json error responses.
+// Extensions holds the extension members, which are inlined in the JSON object.
+type ProblemDetails struct {
+	Type     string `json:"type,omitempty"`
+	Title    string `json:"title,omitempty"`
+	Status   int    `json:"status,omitempty"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+
+	Extensions map[string]any `json:"-"`
+}
+
+// NewProblemDetails returns the problem details of a status code, titled with the status text.
+func NewProblemDetails(status int, detail string) *ProblemDetails {
+	return &ProblemDetails{
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+	}
+}
+
+// Error implements the error interface, so that the client returns the problem details of error responses.
+// Use errors.As with a *ProblemDetails target to get them.
+func (p *ProblemDetails) Error() string {
+	msg := p.Title
+	if msg == "" {
+		msg = http.StatusText(p.Status)
+	}
+	if msg == "" {
+		msg = "problem"
+	}
+	if p.Detail != "" {
+		msg += ": " + p.Detail
+	}
+	return msg
+}
+
+// MarshalJSON inlines the extension members. The members defined by RFC 9457 take precedence over them.
+func (p ProblemDetails) MarshalJSON() ([]byte, error) {
+	m := make(map[string]any, len(p.Extensions)+len(problemDetailsMembers))
+	for k, v := range p.Extensions {
+		if !problemDetailsMembers[k] {
+			m[k] = v
+		}
+	}
+	if p.Type != "" {
+		m["type"] = p.Type
+	}
+	if p.Title != "" {
+		m["title"] = p.Title
+	}
+	if p.Status != 0 {
+		m["status"] = p.Status
+	}
+	if p.Detail != "" {
+		m["detail"] = p.Detail
+	}
+	if p.Instance != "" {
+		m["instance"] = p.Instance
+	}
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON reads the members defined by RFC 9457 and keeps the other ones in Extensions.
+// As required by the RFC, a member with a value of the wrong type is ignored.
+func (p *ProblemDetails) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*p = ProblemDetails{}
+	for k, v := range raw {
+		switch k {
+		case "type":
+			_ = json.Unmarshal(v, &p.Type)
+		case "title":
+			_ = json.Unmarshal(v, &p.Title)
+		case "status":
+			_ = json.Unmarshal(v, &p.Status)
+		case "detail":
+			_ = json.Unmarshal(v, &p.Detail)
+		case "instance":
+			_ = json.Unmarshal(v, &p.Instance)
+		default:
+			var ext any
+			if err := json.Unmarshal(v, &ext); err != nil {
+				return err
+			}
+			if p.Extensions == nil {
+				p.Extensions = make(map[string]any)
+			}
+			p.Extensions[k] = ext
+		}
+	}
+	return nil
+}
+
+// WriteProblemDetails writes the problem details as an application/problem+json response.
+// The status code of the response is problem.Status, or 500 if it's not set.
+func WriteProblemDetails(w http.ResponseWriter, problem *ProblemDetails) error {
+	body, err := json.Marshal(problem)
+	if err != nil {
+		return err
+	}
+
+	status := problem.Status
+	if status == 0 {
+		status = http.StatusInternalServerError
+	}
+	w.Header().Set("Content-Type", ProblemDetailsContentType)
+	w.WriteHeader(status)
+	_, err = w.Write(body)
+	return err
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProblemDetails_JSON(t *testing.T) {
+	t.Run("extension members", func(t *testing.T) {
+		var problem ProblemDetails
+		err := json.Unmarshal([]byte(`{
+			"type": "https://example.com/probs/out-of-credit",
+			"title": "You do not have enough credit.",
+			"status": 403,
+			"detail": "Your current balance is 30, but that costs 50.",
+			"instance": "/account/12345/msgs/abc",
+			"balance": 30
+		}`), &problem)
+		require.NoError(t, err)
+
+		assert.Equal(t, ProblemDetails{
+			Type:       "https://example.com/probs/out-of-credit",
+			Title:      "You do not have enough credit.",
+			Status:     403,
+			Detail:     "Your current balance is 30, but that costs 50.",
+			Instance:   "/account/12345/msgs/abc",
+			Extensions: map[string]any{"balance": float64(30)},
+		}, problem)
+
+		data, err := json.Marshal(problem)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"type": "https://example.com/probs/out-of-credit",
+			"title": "You do not have enough credit.",
+			"status": 403,
+			"detail": "Your current balance is 30, but that costs 50.",
+			"instance": "/account/12345/msgs/abc",
+			"balance": 30
+		}`, string(data))
+	})
+
+	t.Run("members of the wrong type are ignored", func(t *testing.T) {
+		var problem ProblemDetails
+		require.NoError(t, json.Unmarshal([]byte(`{"title": "Bad Request", "status": "400"}`), &problem))
+		assert.Equal(t, ProblemDetails{Title: "Bad Request"}, problem)
+	})
+
+	t.Run("not an object", func(t *testing.T) {
+		var problem ProblemDetails
+		require.Error(t, json.Unmarshal([]byte(`"oops"`), &problem))
+	})
+}
+
+func TestProblemDetails_Error(t *testing.T) {
+	assert.Equal(t, "Not Found: no such pet", NewProblemDetails(http.StatusNotFound, "no such pet").Error())
+	assert.Equal(t, "Conflict", (&ProblemDetails{Status: http.StatusConflict}).Error())
+	assert.Equal(t, "problem", (&ProblemDetails{}).Error())
+
+	var problem *ProblemDetails
+	err := NewClientAPIError(NewProblemDetails(http.StatusBadRequest, "invalid name"), WithStatusCode(http.StatusBadRequest))
+	require.True(t, errors.As(err, &problem))
+	assert.Equal(t, "invalid name", problem.Detail)
+}
+
+func TestWriteProblemDetails(t *testing.T) {
+	t.Run("status of the problem", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		require.NoError(t, WriteProblemDetails(rec, NewProblemDetails(http.StatusUnprocessableEntity, "invalid name")))
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+		assert.Equal(t, ProblemDetailsContentType, rec.Header().Get("Content-Type"))
+		assert.JSONEq(t, `{"title": "Unprocessable Entity", "status": 422, "detail": "invalid name"}`, rec.Body.String())
+	})
+
+	t.Run("defaults to 500", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		require.NoError(t, WriteProblemDetails(rec, &ProblemDetails{Title: "Oops"}))
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
@@ -0,0 +1,54 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"io"
+)
+
+// ProgressFunc reports the progress of a response body download: the number of bytes read so far,
+// and the total from the Content-Length header, -1 if the response has none.
+// It's called after each read of the body, from the goroutine sending the request.
+type ProgressFunc func(bytesRead, total int64)
+
+type progressKey struct{}
+
+// WithDownloadProgress returns a copy of ctx reporting the download progress of the response bodies
+// of the requests executed with it to progress, see ProgressFunc.
+// The generated <OperationID>WithProgress client methods use it.
+func WithDownloadProgress(ctx context.Context, progress ProgressFunc) context.Context {
+	return context.WithValue(ctx, progressKey{}, progress)
+}
+
+// downloadProgressFromContext returns the download progress callback of ctx, nil if there's none.
+func downloadProgressFromContext(ctx context.Context) ProgressFunc {
+	progress, _ := ctx.Value(progressKey{}).(ProgressFunc)
+	return progress
+}
+
+// progressReader reports the bytes read from the underlying reader to progress.
+type progressReader struct {
+	io.ReadCloser
+	total    int64
+	read     int64
+	progress ProgressFunc
+}
+
+// Read reads from the underlying reader and reports the bytes read so far, if any were read.
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if n > 0 {
+		r.read += int64(n)
+		r.progress(r.read, r.total)
+	}
+	return n, err
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_DownloadProgress(t *testing.T) {
+	const size = 256 * 1024
+	file := bytes.Repeat([]byte("x"), size)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		if r.URL.Query().Get("sized") == "true" {
+			w.Header().Set("Content-Length", strconv.Itoa(size))
+		}
+		// sent in chunks, read as they arrive
+		for start := 0; start < size; start += 16 * 1024 {
+			_, _ = w.Write(file[start : start+16*1024])
+			w.(http.Flusher).Flush()
+		}
+	}))
+	defer server.Close()
+
+	c, err := NewAPIClient(server.URL, WithHTTPClient(HTTPClientDoer{Client: server.Client()}))
+	require.NoError(t, err)
+
+	download := func(t *testing.T, sized bool) ([]int64, []int64) {
+		t.Helper()
+		var read, totals []int64
+		ctx := WithDownloadProgress(context.Background(), func(bytesRead, total int64) {
+			read = append(read, bytesRead)
+			totals = append(totals, total)
+		})
+
+		req, err := c.CreateRequest(ctx, RequestOptionsParameters{
+			RequestURL: server.URL + "/files/report?sized=" + strconv.FormatBool(sized),
+			Method:     http.MethodGet,
+		})
+		require.NoError(t, err)
+		resp, err := c.ExecuteRequest(ctx, req, "/files/{id}")
+		require.NoError(t, err)
+		assert.Equal(t, file, resp.Content)
+		return read, totals
+	}
+
+	t.Run("with Content-Length", func(t *testing.T) {
+		read, totals := download(t, true)
+
+		require.Greater(t, len(read), 1)
+		assert.IsIncreasing(t, read)
+		assert.Equal(t, int64(size), read[len(read)-1])
+		for _, total := range totals {
+			assert.Equal(t, int64(size), total)
+		}
+	})
+
+	t.Run("without Content-Length", func(t *testing.T) {
+		read, totals := download(t, false)
+
+		assert.IsIncreasing(t, read)
+		assert.Equal(t, int64(size), read[len(read)-1])
+		for _, total := range totals {
+			assert.Equal(t, int64(-1), total)
+		}
+	})
+
+	t.Run("without progress", func(t *testing.T) {
+		req, err := c.CreateRequest(context.Background(), RequestOptionsParameters{RequestURL: server.URL + "/files/report", Method: http.MethodGet})
+		require.NoError(t, err)
+		resp, err := c.ExecuteRequest(context.Background(), req, "/files/{id}")
+		require.NoError(t, err)
+		assert.Len(t, resp.Content, size)
+	})
+}
@@ -0,0 +1,148 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// rateLimitNearExhaustion is the fraction of the limit below which the remaining requests are spread
+// until the reset of the limit, when the client respects the rate limit.
+const rateLimitNearExhaustion = 0.1
+
+// epochSecondsThreshold tells a reset given as Unix epoch seconds apart from one given as seconds from now.
+const epochSecondsThreshold = 1_000_000_000
+
+// RateLimit is the rate limit of an operation, parsed from the X-RateLimit-Limit, X-RateLimit-Remaining
+// and X-RateLimit-Reset headers of its responses, or the same headers without the X- prefix.
+type RateLimit struct {
+	// Limit is the number of requests allowed in the time window, 0 if unknown.
+	Limit int
+
+	// Remaining is the number of requests left in the time window.
+	Remaining int
+
+	// Reset is when the time window resets, zero if unknown.
+	Reset time.Time
+}
+
+// RateLimitReporter is implemented by API clients keeping the rate limit of the last response of each operation.
+type RateLimitReporter interface {
+	RateLimit(operationPath string) (RateLimit, bool)
+}
+
+// ParseRateLimit parses the rate limit headers. It returns false if the remaining requests header is missing or invalid.
+// The reset header can be Unix epoch seconds, seconds from now, an RFC 3339 date-time or an HTTP date.
+func ParseRateLimit(header http.Header) (RateLimit, bool) {
+	return parseRateLimit(header, time.Now())
+}
+
+func parseRateLimit(header http.Header, now time.Time) (RateLimit, bool) {
+	value := func(name string) string {
+		if v := header.Get("X-" + name); v != "" {
+			return v
+		}
+		return header.Get(name)
+	}
+
+	remaining, err := strconv.Atoi(value("RateLimit-Remaining"))
+	if err != nil {
+		return RateLimit{}, false
+	}
+	res := RateLimit{Remaining: remaining}
+	res.Limit, _ = strconv.Atoi(value("RateLimit-Limit"))
+
+	reset := value("RateLimit-Reset")
+	if seconds, err := strconv.ParseInt(reset, 10, 64); err == nil {
+		if seconds >= epochSecondsThreshold {
+			res.Reset = time.Unix(seconds, 0)
+		} else {
+			res.Reset = now.Add(time.Duration(seconds) * time.Second)
+		}
+	} else if t, err := time.Parse(time.RFC3339, reset); err == nil {
+		res.Reset = t
+	} else if t, err := http.ParseTime(reset); err == nil {
+		res.Reset = t
+	}
+	return res, true
+}
+
+// Delay returns how long to wait at now before sending the next request, to stay within the limit.
+// It's the time until the reset when no request is left, and the time until the reset spread over
+// the remaining requests when they're less than 10% of the limit. Otherwise, or if the reset is unknown, it's 0.
+func (r RateLimit) Delay(now time.Time) time.Duration {
+	untilReset := r.Reset.Sub(now)
+	if r.Reset.IsZero() || untilReset <= 0 {
+		return 0
+	}
+	if r.Remaining <= 0 {
+		return untilReset
+	}
+	if r.Limit <= 0 || float64(r.Remaining) >= float64(r.Limit)*rateLimitNearExhaustion {
+		return 0
+	}
+	return untilReset / time.Duration(r.Remaining+1)
+}
+
+// WithRespectRateLimit makes the client delay the requests of an operation when the rate limit of its last response
+// is near exhaustion, see RateLimit.Delay.
+func WithRespectRateLimit() APIClientOption {
+	return func(c *Client) error {
+		c.respectRateLimit = true
+		return nil
+	}
+}
+
+// RateLimit returns the rate limit of the last response of the operation at operationPath, e.g. /pets/{id},
+// with rate limit headers.
+func (c *Client) RateLimit(operationPath string) (RateLimit, bool) {
+	c.rateLimitsMu.Lock()
+	defer c.rateLimitsMu.Unlock()
+	rl, ok := c.rateLimits[operationPath]
+	return rl, ok
+}
+
+// setRateLimit keeps the rate limit of the last response of the operation at operationPath.
+func (c *Client) setRateLimit(operationPath string, rl RateLimit) {
+	c.rateLimitsMu.Lock()
+	defer c.rateLimitsMu.Unlock()
+	if c.rateLimits == nil {
+		c.rateLimits = make(map[string]RateLimit)
+	}
+	c.rateLimits[operationPath] = rl
+}
+
+// waitRateLimit waits for the delay of the rate limit of the operation at operationPath, if the client respects it.
+func (c *Client) waitRateLimit(ctx context.Context, operationPath string) error {
+	if !c.respectRateLimit {
+		return nil
+	}
+	rl, ok := c.RateLimit(operationPath)
+	if !ok {
+		return nil
+	}
+	delay := rl.Delay(time.Now())
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRateLimit(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		header   http.Header
+		expected RateLimit
+		ok       bool
+	}{
+		{
+			name: "epoch seconds",
+			header: http.Header{
+				"X-Ratelimit-Limit":     {"100"},
+				"X-Ratelimit-Remaining": {"42"},
+				"X-Ratelimit-Reset":     {strconv.FormatInt(now.Add(time.Minute).Unix(), 10)},
+			},
+			expected: RateLimit{Limit: 100, Remaining: 42, Reset: now.Add(time.Minute)},
+			ok:       true,
+		},
+		{
+			name: "seconds from now",
+			header: http.Header{
+				"X-Ratelimit-Remaining": {"0"},
+				"X-Ratelimit-Reset":     {"30"},
+			},
+			expected: RateLimit{Remaining: 0, Reset: now.Add(30 * time.Second)},
+			ok:       true,
+		},
+		{
+			name: "date-time",
+			header: http.Header{
+				"X-Ratelimit-Limit":     {"10"},
+				"X-Ratelimit-Remaining": {"9"},
+				"X-Ratelimit-Reset":     {"2026-05-01T13:00:00Z"},
+			},
+			expected: RateLimit{Limit: 10, Remaining: 9, Reset: now.Add(time.Hour)},
+			ok:       true,
+		},
+		{
+			name: "without prefix",
+			header: http.Header{
+				"Ratelimit-Limit":     {"10"},
+				"Ratelimit-Remaining": {"1"},
+			},
+			expected: RateLimit{Limit: 10, Remaining: 1},
+			ok:       true,
+		},
+		{
+			name:   "missing",
+			header: http.Header{"X-Ratelimit-Limit": {"10"}},
+		},
+		{
+			name:   "invalid",
+			header: http.Header{"X-Ratelimit-Remaining": {"many"}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, ok := parseRateLimit(tc.header, now)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected.Limit, res.Limit)
+			assert.Equal(t, tc.expected.Remaining, res.Remaining)
+			assert.True(t, tc.expected.Reset.Equal(res.Reset), "reset %v, expected %v", res.Reset, tc.expected.Reset)
+		})
+	}
+}
+
+func TestRateLimit_Delay(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	reset := now.Add(10 * time.Second)
+
+	assert.Equal(t, time.Duration(0), RateLimit{Limit: 100, Remaining: 50, Reset: reset}.Delay(now))
+	assert.Equal(t, 10*time.Second, RateLimit{Limit: 100, Remaining: 0, Reset: reset}.Delay(now))
+	assert.Equal(t, 2*time.Second, RateLimit{Limit: 100, Remaining: 4, Reset: reset}.Delay(now))
+	assert.Equal(t, time.Duration(0), RateLimit{Limit: 100, Remaining: 0, Reset: now.Add(-time.Second)}.Delay(now))
+	assert.Equal(t, time.Duration(0), RateLimit{Limit: 100, Remaining: 0}.Delay(now))
+}
+
+func TestClient_RateLimit(t *testing.T) {
+	var requests []time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, time.Now())
+		w.Header().Set("X-RateLimit-Limit", "10")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", "1")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	send := func(t *testing.T, c *Client) *Response {
+		t.Helper()
+		req, err := c.CreateRequest(context.Background(), RequestOptionsParameters{RequestURL: c.GetBaseURL() + "/pets", Method: http.MethodGet})
+		require.NoError(t, err)
+		resp, err := c.ExecuteRequest(context.Background(), req, "/pets")
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Run("parsed", func(t *testing.T) {
+		c, err := NewAPIClient(server.URL, WithHTTPClient(HTTPClientDoer{}))
+		require.NoError(t, err)
+
+		resp := send(t, c)
+		require.NotNil(t, resp.RateLimit)
+		assert.Equal(t, 10, resp.RateLimit.Limit)
+		assert.Equal(t, 0, resp.RateLimit.Remaining)
+
+		rl, ok := c.RateLimit("/pets")
+		require.True(t, ok)
+		assert.Equal(t, *resp.RateLimit, rl)
+
+		_, ok = c.RateLimit("/users")
+		assert.False(t, ok)
+	})
+
+	t.Run("respected", func(t *testing.T) {
+		c, err := NewAPIClient(server.URL, WithHTTPClient(HTTPClientDoer{}), WithRespectRateLimit())
+		require.NoError(t, err)
+
+		requests = nil
+		send(t, c)
+		send(t, c)
+		require.Len(t, requests, 2)
+		assert.GreaterOrEqual(t, requests[1].Sub(requests[0]), 900*time.Millisecond)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		c, err := NewAPIClient(server.URL, WithHTTPClient(HTTPClientDoer{}), WithRespectRateLimit())
+		require.NoError(t, err)
+		send(t, c)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		req, err := c.CreateRequest(ctx, RequestOptionsParameters{RequestURL: c.GetBaseURL() + "/pets", Method: http.MethodGet})
+		require.NoError(t, err)
+		_, err = c.ExecuteRequest(ctx, req, "/pets")
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"reflect"
+)
+
+// Redact returns a log-safe JSON rendering of v.
+// Every value in the object graph that implements slog.LogValuer (generated types with
+// x-sensitive-data fields do) is replaced by its masked form before encoding,
+// so nested sensitive fields are masked as well. Non-sensitive fields are rendered as-is.
+func Redact(v any) string {
+	if v == nil {
+		return "null"
+	}
+
+	data, err := json.Marshal(redactedCopy(v))
+	if err != nil {
+		return fmt.Sprintf("<redact error: %v>", err)
+	}
+	return string(data)
+}
+
+// AsRedactedMap converts v to a map like AsMap, with sensitive values masked as in Redact.
+func AsRedactedMap(v any) (map[string]any, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	return AsMap[any](redactedCopy(v))
+}
+
+// redactedCopy returns a copy of v with sensitive values masked, leaving v untouched.
+func redactedCopy(v any) any {
+	rv := reflect.ValueOf(v)
+	cp := reflect.New(rv.Type()).Elem()
+	cp.Set(rv)
+	redactValue(cp)
+	return cp.Interface()
+}
+
+// redactValue masks v in place. v must be settable; containers are copied before
+// being modified so the caller's value is never mutated.
+func redactValue(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return
+		}
+		cp := reflect.New(v.Type().Elem())
+		cp.Elem().Set(v.Elem())
+		redactValue(cp.Elem())
+		v.Set(cp)
+
+	case reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		inner := v.Elem()
+		cp := reflect.New(inner.Type()).Elem()
+		cp.Set(inner)
+		redactValue(cp)
+		v.Set(cp)
+
+	case reflect.Struct:
+		// Optional values keep their value in an unexported field.
+		if v.CanAddr() {
+			if o, ok := v.Addr().Interface().(interface{ redactValue() }); ok {
+				o.redactValue()
+				return
+			}
+		}
+		if lv, ok := v.Interface().(slog.LogValuer); ok {
+			if masked := lv.LogValue(); masked.Kind() == slog.KindAny {
+				mv := reflect.ValueOf(masked.Any())
+				if mv.IsValid() && mv.Type().ConvertibleTo(v.Type()) {
+					v.Set(mv.Convert(v.Type()))
+				}
+			}
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if f := v.Field(i); f.CanSet() {
+				redactValue(f)
+			}
+		}
+
+	case reflect.Slice:
+		if v.IsNil() || v.Type().Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		cp := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		reflect.Copy(cp, v)
+		for i := 0; i < cp.Len(); i++ {
+			redactValue(cp.Index(i))
+		}
+		v.Set(cp)
+
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			redactValue(v.Index(i))
+		}
+
+	case reflect.Map:
+		if v.IsNil() {
+			return
+		}
+		cp := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			val := reflect.New(v.Type().Elem()).Elem()
+			val.Set(iter.Value())
+			redactValue(val)
+			cp.SetMapIndex(iter.Key(), val)
+		}
+		v.Set(cp)
+	}
+}
@@ -0,0 +1,92 @@
+package runtime
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type redactCard struct {
+	Number string `json:"number"`
+	Holder string `json:"holder"`
+}
+
+func (c redactCard) LogValue() slog.Value {
+	type plain redactCard
+	c.Number = MaskSensitiveString(c.Number, SensitiveDataConfig{Type: MaskTypePartial, KeepSuffix: 4})
+	return slog.AnyValue(plain(c))
+}
+
+type redactOrder struct {
+	ID       int                   `json:"id"`
+	Card     *redactCard           `json:"card,omitempty"`
+	Cards    []redactCard          `json:"cards,omitempty"`
+	ByName   map[string]redactCard `json:"byName,omitempty"`
+	Payment  Either[redactCard, string]
+	Extra    any    `json:"extra,omitempty"`
+	Raw      []byte `json:"raw,omitempty"`
+	Fixed    [1]redactCard
+	internal redactCard
+}
+
+func TestRedact(t *testing.T) {
+	card := redactCard{Number: "4111111111111111", Holder: "Jane"}
+
+	t.Run("nil", func(t *testing.T) {
+		assert.Equal(t, "null", Redact(nil))
+	})
+
+	t.Run("top-level value", func(t *testing.T) {
+		assert.Equal(t, `{"number":"********1111","holder":"Jane"}`, Redact(card))
+	})
+
+	t.Run("nested values are masked", func(t *testing.T) {
+		order := redactOrder{
+			ID:       1,
+			Card:     &card,
+			Cards:    []redactCard{card},
+			ByName:   map[string]redactCard{"jane": card},
+			Payment:  NewEitherFromA[redactCard, string](card),
+			Extra:    card,
+			Raw:      []byte("x"),
+			Fixed:    [1]redactCard{card},
+			internal: card,
+		}
+
+		out := Redact(&order)
+		assert.NotContains(t, out, "4111111111111111")
+		assert.Contains(t, out, `"id":1`)
+		assert.Contains(t, out, `"holder":"Jane"`)
+		assert.Contains(t, out, `"raw":"eA=="`)
+
+		// The original value is left untouched
+		assert.Equal(t, "4111111111111111", order.Card.Number)
+		assert.Equal(t, "4111111111111111", order.Cards[0].Number)
+		assert.Equal(t, "4111111111111111", order.ByName["jane"].Number)
+		assert.Equal(t, "4111111111111111", order.Fixed[0].Number)
+	})
+
+	t.Run("nil containers", func(t *testing.T) {
+		assert.Equal(t, `{"id":2,"Payment":null,"Fixed":[{"number":"","holder":""}]}`, Redact(redactOrder{ID: 2}))
+	})
+
+	t.Run("encoding error", func(t *testing.T) {
+		assert.Contains(t, Redact(func() {}), "<redact error:")
+	})
+}
+
+func TestAsRedactedMap(t *testing.T) {
+	card := redactCard{Number: "4111111111111111", Holder: "Jane"}
+	order := redactOrder{ID: 1, Card: &card}
+
+	m, err := AsRedactedMap(order)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(1), m["id"])
+	assert.Equal(t, map[string]any{"number": "********1111", "holder": "Jane"}, m["card"])
+	assert.Equal(t, "4111111111111111", order.Card.Number)
+
+	m, err = AsRedactedMap(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, m)
+}
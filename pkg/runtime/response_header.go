@@ -0,0 +1,76 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ValidateResponseHeader checks a header declared by a response of the spec before the response is written.
+// A required header must be set, and the first value of a set header must parse as T.
+// For string headers, the uuid, date-time and date formats are checked too.
+// Values implementing Validator, such as enums, are validated after parsing.
+func ValidateResponseHeader[T any](header http.Header, name string, required bool, format ...string) error {
+	values := header.Values(name)
+	if len(values) == 0 {
+		values = header[name]
+	}
+	if len(values) == 0 {
+		if required {
+			return fmt.Errorf("%w: %s", ErrMissingResponseHeader, name)
+		}
+		return nil
+	}
+
+	value, err := parseResponseHeader[T](values[0], format...)
+	if err != nil {
+		return fmt.Errorf("response header %s: %w", name, err)
+	}
+	if v, ok := any(value).(Validator); ok {
+		if err := v.Validate(); err != nil {
+			return fmt.Errorf("response header %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func parseResponseHeader[T any](s string, format ...string) (T, error) {
+	value, err := ParseString[T](s, format...)
+	if err != nil {
+		return value, err
+	}
+
+	// string based types, i.e. enums, are not handled by ParseString
+	rv := reflect.ValueOf(&value).Elem()
+	if rv.Kind() == reflect.String {
+		rv.SetString(s)
+	}
+
+	if len(format) == 0 {
+		return value, nil
+	}
+	if _, ok := any(value).(string); ok {
+		switch format[0] {
+		case "uuid":
+			_, err = ParseString[uuid.UUID](s, format...)
+		case "date-time":
+			_, err = ParseString[time.Time](s, format...)
+		case "date":
+			_, err = ParseString[Date](s, format...)
+		}
+	}
+	return value, err
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testHeaderEnum string
+
+func (e testHeaderEnum) Validate() error {
+	if e != "a" && e != "b" {
+		return errors.New("invalid value")
+	}
+	return nil
+}
+
+func TestValidateResponseHeader(t *testing.T) {
+	header := http.Header{}
+	header.Set("X-Rate-Limit", "10")
+	header.Set("X-Request-Id", "not-a-uuid")
+	header.Set("X-Kind", "c")
+	header["x-raw"] = []string{"true"}
+
+	t.Run("required missing", func(t *testing.T) {
+		err := ValidateResponseHeader[int](header, "X-Missing", true)
+		require.ErrorIs(t, err, ErrMissingResponseHeader)
+		assert.Contains(t, err.Error(), "X-Missing")
+	})
+
+	t.Run("optional missing", func(t *testing.T) {
+		assert.NoError(t, ValidateResponseHeader[int](header, "X-Missing", false))
+	})
+
+	t.Run("parses", func(t *testing.T) {
+		assert.NoError(t, ValidateResponseHeader[int](header, "x-rate-limit", true))
+		assert.NoError(t, ValidateResponseHeader[bool](header, "x-raw", true))
+	})
+
+	t.Run("unparseable", func(t *testing.T) {
+		err := ValidateResponseHeader[bool](header, "X-Rate-Limit", true)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "response header X-Rate-Limit")
+	})
+
+	t.Run("string format", func(t *testing.T) {
+		assert.Error(t, ValidateResponseHeader[string](header, "X-Request-Id", true, "uuid"))
+		header.Set("X-Request-Id", "8a1f0a2e-6c1b-4f4e-9a44-9c1f6f0b1c11")
+		assert.NoError(t, ValidateResponseHeader[string](header, "X-Request-Id", true, "uuid"))
+	})
+
+	t.Run("validator", func(t *testing.T) {
+		assert.Error(t, ValidateResponseHeader[testHeaderEnum](header, "X-Kind", true))
+		header.Set("X-Kind", "a")
+		assert.NoError(t, ValidateResponseHeader[testHeaderEnum](header, "X-Kind", true))
+	})
+}
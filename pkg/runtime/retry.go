@@ -0,0 +1,160 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+)
+
+// RetryClassifier decides whether a request is sent again, given the response or the error of the last attempt.
+// The response body can be read: it's a copy of the received content.
+type RetryClassifier interface {
+	ShouldRetry(resp *http.Response, err error) bool
+}
+
+// RetryClassifierFunc is a function implementing RetryClassifier.
+type RetryClassifierFunc func(resp *http.Response, err error) bool
+
+// ShouldRetry calls f.
+func (f RetryClassifierFunc) ShouldRetry(resp *http.Response, err error) bool {
+	return f(resp, err)
+}
+
+// DefaultRetryClassifier retries the transport errors and the 429, 502, 503 and 504 responses.
+// It doesn't retry the requests failed with ErrCircuitOpen or canceled by their context.
+type DefaultRetryClassifier struct{}
+
+// ShouldRetry returns true if the attempt failed with a retryable error or status code.
+func (DefaultRetryClassifier) ShouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, ErrCircuitOpen) && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	if resp == nil {
+		return false
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// RetryPolicy configures the retries of the requests, see WithRetry.
+// MaxAttempts is the maximum number of attempts, the first one included, 3 if zero.
+// Backoff is the delay before the first retry, doubled for every next one, 100 milliseconds if zero.
+// MaxBackoff, if set, caps the delay between two attempts.
+type RetryPolicy struct {
+	MaxAttempts int
+	Backoff     time.Duration
+	MaxBackoff  time.Duration
+}
+
+// WithRetry sends the requests again, with an exponential backoff, while the retry classifier of the client
+// reports their response or error as retryable, DefaultRetryClassifier unless set with WithRetryClassifier.
+// Requests with a body that can't be recreated, e.g. streamed from an io.Reader, are sent once.
+func WithRetry(policy RetryPolicy) APIClientOption {
+	return func(c *Client) error {
+		c.retryPolicy = &policy
+		return nil
+	}
+}
+
+// WithRetryClassifier replaces DefaultRetryClassifier to decide which requests are retried, see WithRetry.
+func WithRetryClassifier(classifier RetryClassifier) APIClientOption {
+	return func(c *Client) error {
+		c.retryClassifier = classifier
+		return nil
+	}
+}
+
+// delay returns the delay before the retry following the given attempt, starting at 1.
+func (p RetryPolicy) delay(attempt int) time.Duration {
+	d := p.Backoff
+	if d <= 0 {
+		d = 100 * time.Millisecond
+	}
+	for i := 1; i < attempt; i++ {
+		d *= 2
+		if p.MaxBackoff > 0 && d >= p.MaxBackoff {
+			break
+		}
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	return d
+}
+
+// executeWithRetry runs send, again for every retryable attempt, if the client has a retry policy.
+func (c *Client) executeWithRetry(ctx context.Context, req *http.Request, send RoundTripFunc) (*Response, error) {
+	if c.retryPolicy == nil {
+		return send(ctx, req)
+	}
+
+	maxAttempts := c.retryPolicy.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 3
+	}
+	classifier := c.retryClassifier
+	if classifier == nil {
+		classifier = DefaultRetryClassifier{}
+	}
+	// Bodies are only replayable when the request can recreate them.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		maxAttempts = 1
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := send(ctx, req)
+		if attempt >= maxAttempts || ctx.Err() != nil || !classifier.ShouldRetry(retryClassifierResponse(resp), err) {
+			return resp, err
+		}
+		timer := time.NewTimer(c.retryPolicy.delay(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return resp, err
+		case <-timer.C:
+		}
+		// The streamed body of the discarded response is not read, see WithStreamedResponse.
+		if resp != nil && resp.Body != nil {
+			_ = resp.Body.Close()
+		}
+
+		if req.GetBody != nil {
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return resp, err
+			}
+			req = req.Clone(ctx)
+			req.Body = body
+		}
+	}
+}
+
+// retryClassifierResponse returns the raw response of resp, with its body readable from the received content.
+func retryClassifierResponse(resp *Response) *http.Response {
+	if resp == nil {
+		return nil
+	}
+	raw := &http.Response{StatusCode: resp.StatusCode, Header: resp.Headers}
+	if resp.Raw != nil {
+		cp := *resp.Raw
+		raw = &cp
+	}
+	raw.Body = io.NopCloser(bytes.NewReader(resp.Content))
+	return raw
+}
@@ -0,0 +1,204 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultRetryClassifier(t *testing.T) {
+	c := DefaultRetryClassifier{}
+
+	assert.True(t, c.ShouldRetry(nil, errors.New("connection reset")))
+	assert.True(t, c.ShouldRetry(&http.Response{StatusCode: http.StatusServiceUnavailable}, nil))
+	assert.True(t, c.ShouldRetry(&http.Response{StatusCode: http.StatusTooManyRequests}, nil))
+	assert.False(t, c.ShouldRetry(&http.Response{StatusCode: http.StatusInternalServerError}, nil))
+	assert.False(t, c.ShouldRetry(&http.Response{StatusCode: http.StatusOK}, nil))
+	assert.False(t, c.ShouldRetry(nil, ErrCircuitOpen))
+	assert.False(t, c.ShouldRetry(nil, context.Canceled))
+	assert.False(t, c.ShouldRetry(nil, nil))
+}
+
+func TestRetryPolicy_delay(t *testing.T) {
+	p := RetryPolicy{Backoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond}
+	assert.Equal(t, 10*time.Millisecond, p.delay(1))
+	assert.Equal(t, 20*time.Millisecond, p.delay(2))
+	assert.Equal(t, 40*time.Millisecond, p.delay(3))
+	assert.Equal(t, 50*time.Millisecond, p.delay(4))
+	assert.Equal(t, 100*time.Millisecond, RetryPolicy{}.delay(1))
+}
+
+func TestClient_Retry(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := requests.Add(1)
+		body, _ := io.ReadAll(r.Body)
+		if n < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	send := func(c *Client, method string) (*Response, error) {
+		req, err := c.CreateRequest(context.Background(), RequestOptionsParameters{
+			Options:    mockRequestOptions{body: map[string]string{"name": "rex"}},
+			RequestURL: c.GetBaseURL() + "/pets",
+			Method:     method,
+		})
+		require.NoError(t, err)
+		return c.ExecuteRequest(context.Background(), req, "/pets")
+	}
+
+	t.Run("retries until success, replaying the body", func(t *testing.T) {
+		requests.Store(0)
+		c, err := NewAPIClient(server.URL, WithHTTPClient(HTTPClientDoer{Client: server.Client()}),
+			WithRetry(RetryPolicy{Backoff: time.Millisecond}))
+		require.NoError(t, err)
+
+		resp, err := send(c, http.MethodPost)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.JSONEq(t, `{"name":"rex"}`, string(resp.Content))
+		assert.Equal(t, int32(3), requests.Load())
+	})
+
+	t.Run("stops after max attempts", func(t *testing.T) {
+		requests.Store(0)
+		c, err := NewAPIClient(server.URL, WithHTTPClient(HTTPClientDoer{Client: server.Client()}),
+			WithRetry(RetryPolicy{MaxAttempts: 2, Backoff: time.Millisecond}))
+		require.NoError(t, err)
+
+		resp, err := send(c, http.MethodPost)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Equal(t, int32(2), requests.Load())
+	})
+
+	t.Run("without retry policy", func(t *testing.T) {
+		requests.Store(0)
+		c, err := NewAPIClient(server.URL, WithHTTPClient(HTTPClientDoer{Client: server.Client()}))
+		require.NoError(t, err)
+
+		resp, err := send(c, http.MethodPost)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Equal(t, int32(1), requests.Load())
+	})
+}
+
+func TestClient_RetryClassifier(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		if requests.Add(1) < 3 {
+			_, _ = w.Write([]byte(`{"code":"LOCK_HELD"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"code":"DUPLICATE"}`))
+	}))
+	defer server.Close()
+
+	// retries the conflicts caused by a lock held by another request, not the other ones
+	classifier := RetryClassifierFunc(func(resp *http.Response, err error) bool {
+		if err != nil || resp.StatusCode != http.StatusConflict {
+			return false
+		}
+		body, _ := io.ReadAll(resp.Body)
+		return bytes.Contains(body, []byte("LOCK_HELD"))
+	})
+	c, err := NewAPIClient(server.URL, WithHTTPClient(HTTPClientDoer{Client: server.Client()}),
+		WithRetry(RetryPolicy{MaxAttempts: 5, Backoff: time.Millisecond}), WithRetryClassifier(classifier))
+	require.NoError(t, err)
+
+	req, err := c.CreateRequest(context.Background(), RequestOptionsParameters{RequestURL: server.URL + "/locks", Method: http.MethodPut})
+	require.NoError(t, err)
+	resp, err := c.ExecuteRequest(context.Background(), req, "/locks")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	assert.JSONEq(t, `{"code":"DUPLICATE"}`, string(resp.Content))
+	assert.Equal(t, int32(3), requests.Load())
+}
+
+func TestClient_RetryStreamedBody(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c, err := NewAPIClient(server.URL, WithHTTPClient(HTTPClientDoer{Client: server.Client()}),
+		WithRetry(RetryPolicy{Backoff: time.Millisecond}))
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/upload", io.NopCloser(strings.NewReader("data")))
+	require.NoError(t, err)
+	_, err = c.ExecuteRequest(context.Background(), req, "/upload")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestClient_RetryIdempotencyKey(t *testing.T) {
+	var keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get(IdempotencyKeyHeader))
+		if len(keys)%3 != 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	c, err := NewAPIClient(server.URL, WithHTTPClient(HTTPClientDoer{Client: server.Client()}),
+		WithRetry(RetryPolicy{Backoff: time.Millisecond}))
+	require.NoError(t, err)
+
+	send := func(header map[string]string) {
+		req, err := c.CreateRequest(context.Background(), RequestOptionsParameters{
+			Options:        mockRequestOptions{body: map[string]string{"name": "rex"}, header: header},
+			RequestURL:     server.URL + "/pets",
+			Method:         http.MethodPost,
+			IdempotencyKey: true,
+		})
+		require.NoError(t, err)
+		resp, err := c.ExecuteRequest(context.Background(), req, "/pets")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+
+	// the retries of a call keep its key, the next call gets another one
+	send(nil)
+	send(nil)
+	require.Len(t, keys, 6)
+	assert.NotEmpty(t, keys[0])
+	assert.Equal(t, []string{keys[0], keys[0], keys[0]}, keys[:3])
+	assert.Equal(t, []string{keys[3], keys[3], keys[3]}, keys[3:])
+	assert.NotEqual(t, keys[0], keys[3])
+
+	// a key set by the caller is kept
+	send(map[string]string{IdempotencyKeyHeader: "order-42"})
+	assert.Equal(t, []string{"order-42", "order-42", "order-42"}, keys[6:])
+}
@@ -16,6 +16,7 @@ import (
 	"fmt"
 	"log/slog"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"go.yaml.in/yaml/v4"
@@ -23,6 +24,9 @@ import (
 
 const defaultMaskReplacement = "********"
 
+// showPattern matches the "show" shorthand, e.g. "last4", "first2" or "first2last2".
+var showPattern = regexp.MustCompile(`^(?:first(\d+))?(?:last(\d+))?$`)
+
 // MaskType represents the type of masking to apply
 type MaskType string
 
@@ -37,7 +41,7 @@ const (
 // SensitiveDataConfig holds configuration for masking sensitive data
 type SensitiveDataConfig struct {
 	Type        MaskType // masking type: full, regex, hash, or partial
-	Replacement string   // custom replacement string for "full" and "partial" masks (default: "********")
+	Replacement string   // custom replacement for "full" and "partial" masks (default: "********"), or regex replacement template
 	Pattern     string   // regex pattern for "regex" type
 	Algorithm   string   // hash algorithm for "hash" type (e.g., "sha256")
 	KeepPrefix  int      // number of characters to keep at start for "partial" type
@@ -58,6 +62,9 @@ type sensitiveDataYAML struct {
 	Algorithm  string `yaml:"algorithm" json:"algorithm"`
 	KeepPrefix int    `yaml:"keepPrefix" json:"keepPrefix"`
 	KeepSuffix int    `yaml:"keepSuffix" json:"keepSuffix"`
+	Show       string `yaml:"show" json:"show"`
+	Regex      string `yaml:"regex" json:"regex"`
+	Replace    string `yaml:"replace" json:"replace"`
 }
 
 // Unmarshal parses the x-sensitive-data extension value from YAML/JSON
@@ -65,6 +72,7 @@ type sensitiveDataYAML struct {
 // - boolean: true -> full masking
 // - string: "full", "hash", "regex", "partial" -> that masking type
 // - object: detailed configuration with mask type and parameters
+// - object shorthands: {show: "last4"}, {show: "first2last2"}, {regex: "...", replace: "..."}
 func (s *SensitiveDataConfig) Unmarshal(value any) error {
 	// Handle simple boolean value (defaults to "full" masking)
 	if b, ok := value.(bool); ok {
@@ -99,10 +107,43 @@ func (s *SensitiveDataConfig) Unmarshal(value any) error {
 	s.Algorithm = helper.Algorithm
 	s.KeepPrefix = helper.KeepPrefix
 	s.KeepSuffix = helper.KeepSuffix
+	s.Replacement = helper.Replace
+
+	if helper.Regex != "" {
+		s.Type = MaskTypeRegex
+		s.Pattern = helper.Regex
+	}
+
+	if helper.Show != "" {
+		prefix, suffix, err := parseShow(helper.Show)
+		if err != nil {
+			return err
+		}
+		s.Type = MaskTypePartial
+		s.KeepPrefix = prefix
+		s.KeepSuffix = suffix
+	}
 
 	return nil
 }
 
+// parseShow converts the "show" shorthand into the number of characters to keep at each end.
+func parseShow(show string) (int, int, error) {
+	m := showPattern.FindStringSubmatch(show)
+	if m == nil || (m[1] == "" && m[2] == "") {
+		return 0, 0, fmt.Errorf("invalid x-sensitive-data show value %q: expected firstN, lastN or firstNlastM", show)
+	}
+
+	var prefix, suffix int
+	if m[1] != "" {
+		prefix, _ = strconv.Atoi(m[1])
+	}
+	if m[2] != "" {
+		suffix, _ = strconv.Atoi(m[2])
+	}
+	return prefix, suffix, nil
+}
+
 // Mask returns the Type as a string for template compatibility
 func (s *SensitiveDataConfig) Mask() string {
 	return string(s.Type)
@@ -143,7 +184,7 @@ func MaskSensitiveValue(value any, config SensitiveDataConfig) any {
 		if config.Pattern == "" {
 			return maskFull(strValue, replacement)
 		}
-		return maskRegex(strValue, config.Pattern)
+		return maskRegex(strValue, config.Pattern, config.Replacement)
 	case MaskTypeHash:
 		algorithm := config.Algorithm
 		if algorithm == "" {
@@ -191,14 +232,20 @@ func maskFull(value, replacement string) string {
 	return replacement
 }
 
-// maskRegex masks parts of the value matching the regex pattern
-func maskRegex(value, pattern string) string {
+// maskRegex masks parts of the value matching the regex pattern.
+// When replacement is set, it is used as a regexp replacement template (supports $1 etc.),
+// otherwise every matched character is replaced with "*".
+func maskRegex(value, pattern, replacement string) string {
 	re, err := regexp.Compile(pattern)
 	if err != nil {
 		// If regex is invalid, fall back to full masking
 		return maskFull(value, defaultMaskReplacement)
 	}
 
+	if replacement != "" {
+		return re.ReplaceAllString(value, replacement)
+	}
+
 	return re.ReplaceAllStringFunc(value, func(match string) string {
 		return strings.Repeat("*", len(match))
 	})
@@ -89,6 +89,12 @@ func TestMaskSensitiveValue(t *testing.T) {
 			config:   SensitiveDataConfig{Type: MaskTypeFull, Replacement: "[REDACTED]"},
 			expected: "[REDACTED]",
 		},
+		{
+			name:     "regex masking with replacement template",
+			value:    "123-45-6789",
+			config:   SensitiveDataConfig{Type: MaskTypeRegex, Pattern: `^\d{3}-\d{2}-(\d{4})$`, Replacement: "***-**-$1"},
+			expected: "***-**-6789",
+		},
 		{
 			name:     "custom replacement - partial",
 			value:    "1234567890",
@@ -105,6 +111,74 @@ func TestMaskSensitiveValue(t *testing.T) {
 	}
 }
 
+func TestSensitiveDataConfigUnmarshal(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		expected SensitiveDataConfig
+		wantErr  bool
+	}{
+		{
+			name:     "boolean",
+			value:    true,
+			expected: SensitiveDataConfig{Type: MaskTypeFull},
+		},
+		{
+			name:     "string",
+			value:    "hash",
+			expected: SensitiveDataConfig{Type: MaskTypeHash},
+		},
+		{
+			name:     "object",
+			value:    map[string]any{"mask": "partial", "keepSuffix": 4, "replace": "###"},
+			expected: SensitiveDataConfig{Type: MaskTypePartial, KeepSuffix: 4, Replacement: "###"},
+		},
+		{
+			name:     "show last4",
+			value:    map[string]any{"show": "last4"},
+			expected: SensitiveDataConfig{Type: MaskTypePartial, KeepSuffix: 4},
+		},
+		{
+			name:     "show first6",
+			value:    map[string]any{"show": "first6"},
+			expected: SensitiveDataConfig{Type: MaskTypePartial, KeepPrefix: 6},
+		},
+		{
+			name:     "show first2last2",
+			value:    map[string]any{"show": "first2last2"},
+			expected: SensitiveDataConfig{Type: MaskTypePartial, KeepPrefix: 2, KeepSuffix: 2},
+		},
+		{
+			name:     "regex with replace",
+			value:    map[string]any{"regex": `(\w)[^@]*@`, "replace": "$1***@"},
+			expected: SensitiveDataConfig{Type: MaskTypeRegex, Pattern: `(\w)[^@]*@`, Replacement: "$1***@"},
+		},
+		{
+			name:    "invalid show",
+			value:   map[string]any{"show": "middle3"},
+			wantErr: true,
+		},
+		{
+			name:    "empty show",
+			value:   map[string]any{"show": "first"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := NewDefaultSensitiveDataConfig()
+			err := cfg.Unmarshal(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, *cfg)
+		})
+	}
+}
+
 func TestMaskSensitivePointer(t *testing.T) {
 	t.Run("nil pointer returns nil", func(t *testing.T) {
 		var ptr *string
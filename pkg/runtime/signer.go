@@ -0,0 +1,117 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// DefaultSignatureHeader is the header of the signature set by HMACSigner when its Header is empty.
+const DefaultSignatureHeader = "X-Signature"
+
+// RequestSigner signs the requests of a client, e.g. by setting a signature header, see WithRequestSigner.
+type RequestSigner interface {
+	// SignRequest signs req, whose final body is body, nil for requests without one.
+	SignRequest(ctx context.Context, req *http.Request, body []byte) error
+}
+
+// RequestSignerFunc is a function implementing RequestSigner.
+type RequestSignerFunc func(ctx context.Context, req *http.Request, body []byte) error
+
+// SignRequest calls f.
+func (f RequestSignerFunc) SignRequest(ctx context.Context, req *http.Request, body []byte) error {
+	return f(ctx, req, body)
+}
+
+// WithRequestSigner signs every request right before it's sent, after the request editors ran,
+// so the signature covers the final method, URL and body.
+func WithRequestSigner(signer RequestSigner) APIClientOption {
+	return func(c *Client) error {
+		c.signer = signer
+		return nil
+	}
+}
+
+// HMACSigner is a RequestSigner setting the hex-encoded HMAC-SHA256 of the CanonicalRequest in a header.
+type HMACSigner struct {
+	// Key is the secret key of the HMAC.
+	Key []byte
+
+	// Header is the header of the signature, DefaultSignatureHeader if empty.
+	Header string
+}
+
+// SignRequest sets the signature header of req.
+func (s HMACSigner) SignRequest(_ context.Context, req *http.Request, body []byte) error {
+	header := s.Header
+	if header == "" {
+		header = DefaultSignatureHeader
+	}
+	mac := hmac.New(sha256.New, s.Key)
+	_, _ = io.WriteString(mac, CanonicalRequest(req, body))
+	req.Header.Set(header, hex.EncodeToString(mac.Sum(nil)))
+	return nil
+}
+
+// CanonicalRequest returns the string signed by HMACSigner: the method, the escaped path,
+// the query sorted by key and the hex-encoded SHA-256 of the body, separated by newlines.
+func CanonicalRequest(req *http.Request, body []byte) string {
+	bodyHash := sha256.Sum256(body)
+	return strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.Query().Encode(),
+		hex.EncodeToString(bodyHash[:]),
+	}, "\n")
+}
+
+// signRequest reads the body of req without consuming it and passes it to the signer.
+func signRequest(ctx context.Context, signer RequestSigner, req *http.Request) error {
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var (
+			r   io.ReadCloser
+			err error
+		)
+		if req.GetBody != nil {
+			r, err = req.GetBody()
+			if err != nil {
+				return fmt.Errorf("error getting request body: %w", err)
+			}
+		} else {
+			r = req.Body
+		}
+		body, err = io.ReadAll(r)
+		_ = r.Close()
+		if err != nil {
+			return fmt.Errorf("error reading request body: %w", err)
+		}
+		if req.GetBody == nil {
+			req.Body = io.NopCloser(bytes.NewReader(body))
+			req.GetBody = func() (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewReader(body)), nil
+			}
+		}
+	}
+
+	if err := signer.SignRequest(ctx, req, body); err != nil {
+		return fmt.Errorf("error signing request: %w", err)
+	}
+	return nil
+}
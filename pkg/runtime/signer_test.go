@@ -0,0 +1,99 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalRequest(t *testing.T) {
+	req, err := http.NewRequest(http.MethodPost, "https://api.example.com/pets/a%20b?z=1&a=2", nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "POST\n/pets/a%20b\na=2&z=1\n"+
+		"2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae", CanonicalRequest(req, []byte("foo")))
+}
+
+func TestWithRequestSigner(t *testing.T) {
+	type received struct {
+		signature string
+		body      string
+	}
+	var got received
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		got = received{signature: r.Header.Get("X-Signature"), body: string(body)}
+	}))
+	defer srv.Close()
+
+	execute := func(t *testing.T, signer RequestSigner, editors ...RequestEditorFn) error {
+		t.Helper()
+		client, err := NewAPIClient(srv.URL, WithRequestSigner(signer), WithHTTPClient(HTTPClientDoer{Client: srv.Client()}))
+		require.NoError(t, err)
+		req, err := client.CreateRequest(context.Background(), RequestOptionsParameters{
+			RequestURL:  srv.URL + "/pets?limit=10",
+			Method:      http.MethodPost,
+			ContentType: "application/json",
+			Options:     mockRequestOptions{body: map[string]string{"name": "Rex"}},
+		}, editors...)
+		require.NoError(t, err)
+		_, err = client.ExecuteRequest(context.Background(), req, "/pets")
+		return err
+	}
+
+	t.Run("deterministic HMAC signature", func(t *testing.T) {
+		require.NoError(t, execute(t, HMACSigner{Key: []byte("secret")}))
+		assert.Equal(t, `{"name":"Rex"}`, got.body)
+		assert.Equal(t, "4bc48d4c998a2b30bf76b039037e2102b400faa00b4ce7145536369d72e5a73c", got.signature)
+
+		first := got.signature
+		require.NoError(t, execute(t, HMACSigner{Key: []byte("secret")}))
+		assert.Equal(t, first, got.signature)
+
+		require.NoError(t, execute(t, HMACSigner{Key: []byte("other")}))
+		assert.NotEqual(t, first, got.signature)
+	})
+
+	t.Run("signs after the request editors", func(t *testing.T) {
+		var signedBody string
+		signer := RequestSignerFunc(func(_ context.Context, req *http.Request, body []byte) error {
+			signedBody = string(body)
+			req.Header.Set("X-Signature", req.URL.RawQuery)
+			return nil
+		})
+		require.NoError(t, execute(t, signer, func(_ context.Context, req *http.Request) error {
+			req.URL.RawQuery = "limit=20"
+			req.Body = io.NopCloser(strings.NewReader(`{"name":"Fido"}`))
+			req.GetBody = nil
+			req.ContentLength = 15
+			return nil
+		}))
+		assert.Equal(t, `{"name":"Fido"}`, signedBody)
+		assert.Equal(t, `{"name":"Fido"}`, got.body)
+		assert.Equal(t, "limit=20", got.signature)
+	})
+
+	t.Run("signer error", func(t *testing.T) {
+		err := execute(t, RequestSignerFunc(func(context.Context, *http.Request, []byte) error {
+			return errors.New("no key")
+		}))
+		require.ErrorContains(t, err, "error signing request: no key")
+	})
+}
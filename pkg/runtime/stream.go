@@ -0,0 +1,47 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"io"
+	"slices"
+)
+
+type streamedResponseKey struct{}
+
+// WithStreamedResponse returns a copy of ctx streaming the response bodies with the given status codes
+// of the requests executed with it: Response.Body is set to the undrained body instead of reading it into Response.Content.
+// The generated clients use it for the binary responses, held by a File.
+func WithStreamedResponse(ctx context.Context, statusCodes ...int) context.Context {
+	return context.WithValue(ctx, streamedResponseKey{}, statusCodes)
+}
+
+// isStreamedResponse returns true if the response body with the status code is streamed with ctx.
+func isStreamedResponse(ctx context.Context, statusCode int) bool {
+	statusCodes, _ := ctx.Value(streamedResponseKey{}).([]int)
+	return slices.Contains(statusCodes, statusCode)
+}
+
+// decodedBody is a decoded response body, closing both the decoder and the received body.
+type decodedBody struct {
+	io.ReadCloser
+	body io.Closer
+}
+
+// Close closes the decoder, then the received body.
+func (b *decodedBody) Close() error {
+	err := b.ReadCloser.Close()
+	if bodyErr := b.body.Close(); err == nil {
+		err = bodyErr
+	}
+	return err
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_StreamedResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/files/missing" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":"not found"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, _ = w.Write([]byte("binary-data"))
+	}))
+	defer server.Close()
+
+	c, err := NewAPIClient(server.URL, WithHTTPClient(HTTPClientDoer{Client: server.Client()}))
+	require.NoError(t, err)
+
+	execute := func(t *testing.T, ctx context.Context, path string) *Response {
+		t.Helper()
+		req, err := c.CreateRequest(ctx, RequestOptionsParameters{
+			RequestURL: server.URL + path,
+			Method:     http.MethodGet,
+		})
+		require.NoError(t, err)
+		resp, err := c.ExecuteRequest(ctx, req, "/files/{id}")
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Run("streamed status code", func(t *testing.T) {
+		resp := execute(t, WithStreamedResponse(context.Background(), http.StatusOK), "/files/report")
+
+		assert.Nil(t, resp.Content)
+		require.NotNil(t, resp.Body)
+		defer func() { _ = resp.Body.Close() }()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "binary-data", string(body))
+	})
+
+	t.Run("other status codes are read", func(t *testing.T) {
+		resp := execute(t, WithStreamedResponse(context.Background(), http.StatusOK), "/files/missing")
+
+		assert.Nil(t, resp.Body)
+		assert.Equal(t, `{"error":"not found"}`, string(resp.Content))
+	})
+
+	t.Run("not streamed by default", func(t *testing.T) {
+		resp := execute(t, context.Background(), "/files/report")
+
+		assert.Nil(t, resp.Body)
+		assert.Equal(t, "binary-data", string(resp.Content))
+	})
+}
@@ -0,0 +1,27 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+// TruncateString returns s clamped to maxLength characters.
+// Like the maxLength validation, the length is counted in runes, so multibyte characters are never split.
+func TruncateString(s string, maxLength int) string {
+	if maxLength < 0 || len(s) <= maxLength {
+		return s
+	}
+	n := 0
+	for i := range s {
+		if n == maxLength {
+			return s[:i]
+		}
+		n++
+	}
+	return s
+}
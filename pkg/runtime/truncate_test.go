@@ -0,0 +1,41 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruncateString(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		maxLength int
+		expected  string
+	}{
+		{name: "shorter", value: "abc", maxLength: 5, expected: "abc"},
+		{name: "exact", value: "abcde", maxLength: 5, expected: "abcde"},
+		{name: "longer", value: "abcdefgh", maxLength: 5, expected: "abcde"},
+		{name: "zero", value: "abc", maxLength: 0, expected: ""},
+		{name: "empty", value: "", maxLength: 3, expected: ""},
+		{name: "multibyte", value: "héllo wörld", maxLength: 7, expected: "héllo w"},
+		{name: "multibyte fits", value: "日本語", maxLength: 3, expected: "日本語"},
+		{name: "multibyte longer", value: "日本語です", maxLength: 2, expected: "日本"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, TruncateString(tc.value, tc.maxLength))
+		})
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"runtime"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// ConcurrentValidationMinItems is the number of items from which ValidateConcurrently validates them in parallel.
+// Smaller slices are validated sequentially, the goroutines costing more than they save.
+var ConcurrentValidationMinItems = 64
+
+// ValidateConcurrently calls validate with the index of each of the n items, in parallel in GOMAXPROCS goroutines,
+// and returns the errors by index, nil for the valid items, so that they're merged in the same order
+// as by a sequential validation, or nil if the items are validated sequentially and all valid.
+// The generated Validate methods use it with the concurrent validation option.
+func ValidateConcurrently(n int, validate func(i int) error) []error {
+	if n < ConcurrentValidationMinItems {
+		// Allocated on the first error only, the valid small slices being the common case.
+		var errs []error
+		for i := range n {
+			if err := validate(i); err != nil {
+				if errs == nil {
+					errs = make([]error, n)
+				}
+				errs[i] = err
+			}
+		}
+		return errs
+	}
+
+	errs := make([]error, n)
+
+	// The items are split in a chunk per goroutine, each writing the errors of its own indexes.
+	workers := min(runtime.GOMAXPROCS(0), n)
+	chunk := (n + workers - 1) / workers
+	var g errgroup.Group
+	for start := 0; start < n; start += chunk {
+		end := min(start+chunk, n)
+		g.Go(func() error {
+			for i := start; i < end; i++ {
+				errs[i] = validate(i)
+			}
+			return nil
+		})
+	}
+	_ = g.Wait()
+	return errs
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateConcurrently(t *testing.T) {
+	validate := func(i int) error {
+		if i%7 == 3 {
+			return fmt.Errorf("item %d is invalid", i)
+		}
+		return nil
+	}
+
+	for _, n := range []int{0, 1, ConcurrentValidationMinItems - 1, ConcurrentValidationMinItems, 10_000} {
+		t.Run(strconv.Itoa(n), func(t *testing.T) {
+			var sequential, concurrent ValidationErrors
+			for i := range n {
+				if err := validate(i); err != nil {
+					sequential = sequential.Append(fmt.Sprintf("[%d]", i), err)
+				}
+			}
+			for i, err := range ValidateConcurrently(n, validate) {
+				if err != nil {
+					concurrent = concurrent.Append(fmt.Sprintf("[%d]", i), err)
+				}
+			}
+			assert.Equal(t, sequential, concurrent)
+		})
+	}
+}
+
+func BenchmarkValidateConcurrently(b *testing.B) {
+	v := validator.New(validator.WithRequiredStructEnabled())
+	items := make([]string, 100_000)
+	for i := range items {
+		items[i] = "item-" + strconv.Itoa(i)
+	}
+	validate := func(i int) error {
+		return v.Var(items[i], "required,min=3,max=20,startswith=item-")
+	}
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			for i := range items {
+				_ = validate(i)
+			}
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			_ = ValidateConcurrently(len(items), validate)
+		}
+	})
+}
@@ -15,8 +15,12 @@
 package runtime
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
+	"math"
 	"reflect"
+	"strconv"
 
 	"github.com/go-playground/validator/v10"
 )
@@ -26,6 +30,104 @@ type Validator interface {
 	Validate() error
 }
 
+// ContextValidator is an interface for types that can validate themselves,
+// returning the context error early when the context is done.
+type ContextValidator interface {
+	ValidateContext(ctx context.Context) error
+}
+
+// ValidationContextCheckInterval is the number of items validated between two checks of the context,
+// when validating slices and maps with ValidateContext.
+const ValidationContextCheckInterval = 64
+
+// ValidateContext validates v with its ValidateContext method, or with its Validate method
+// if it doesn't take a context. Values implementing neither are valid.
+func ValidateContext(ctx context.Context, v any) error {
+	switch val := v.(type) {
+	case ContextValidator:
+		return val.ValidateContext(ctx)
+	case Validator:
+		return val.Validate()
+	default:
+		return nil
+	}
+}
+
+// CheckValidationContext returns the context error every ValidationContextCheckInterval items,
+// n being the index of the item being validated.
+func CheckValidationContext(ctx context.Context, n int) error {
+	if n%ValidationContextCheckInterval != 0 {
+		return nil
+	}
+	return ctx.Err()
+}
+
+// DuplicateItem returns the indexes of the first two equal items of a slice,
+// used to validate uniqueItems. ok is false if all the items are unique.
+func DuplicateItem[T comparable](items []T) (first, second int, ok bool) {
+	seen := make(map[T]int, len(items))
+	for i, item := range items {
+		if j, found := seen[item]; found {
+			return j, i, true
+		}
+		seen[item] = i
+	}
+	return 0, 0, false
+}
+
+// DuplicateItemJSON is DuplicateItem for items that are not comparable with ==, e.g. structs with pointer fields,
+// comparing their JSON encoding instead. Items that fail to encode are skipped.
+func DuplicateItemJSON[T any](items []T) (first, second int, ok bool) {
+	seen := make(map[string]int, len(items))
+	for i, item := range items {
+		data, err := json.Marshal(item)
+		if err != nil {
+			continue
+		}
+		if j, found := seen[string(data)]; found {
+			return j, i, true
+		}
+		seen[string(data)] = i
+	}
+	return 0, 0, false
+}
+
+// multipleOfTolerance is the tolerance of IsMultipleOf relative to the quotient, absorbing float rounding errors,
+// e.g. 0.3 / 0.1, which grow with the magnitude of the quotient, e.g. 98765432.1 / 0.1.
+const multipleOfTolerance = 1e-9
+
+// Number is the constraint of the numeric types validated with IsMultipleOf.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~float32 | ~float64
+}
+
+// IsMultipleOf reports whether value is an integer multiple of multipleOf, used to validate multipleOf.
+// float32 values are compared by their shortest decimal representation, e.g. 0.07 and not 0.07000000029802322.
+func IsMultipleOf[T Number](value T, multipleOf float64) bool {
+	if multipleOf <= 0 {
+		return true
+	}
+	f := float64(value)
+	if reflect.ValueOf(value).Kind() == reflect.Float32 {
+		f, _ = strconv.ParseFloat(strconv.FormatFloat(f, 'g', -1, 32), 64)
+	}
+	q := f / multipleOf
+	return math.Abs(q-math.Round(q)) <= multipleOfTolerance*math.Max(1, math.Abs(q))
+}
+
+// IsZero reports whether value is nil, used to tell whether an optional property is absent, e.g. to validate dependentRequired.
+// Only pointers, maps, slices, interfaces, channels and functions can be absent: a value of any other kind,
+// e.g. of an optional property generated without a pointer, is present even when it's false, 0 or "".
+func IsZero[T any](value T) bool {
+	v := reflect.ValueOf(&value).Elem()
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Interface, reflect.Chan, reflect.Func:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
+
 // RegisterCustomTypeFunc registers a custom type function with the validator
 // to extract values from types that have a Value() interface{} method.
 // This is useful for union types (like Either) where only the active variant
@@ -41,7 +143,10 @@ type Validator interface {
 // Note: The struct fields should also have `validate:"-"` tags to prevent the
 // validator from validating them directly. This function only affects validation
 // when using validator.Var() on the struct itself.
+//
+// It also registers the duration types, see RegisterDurationTypeFunc.
 func RegisterCustomTypeFunc(v *validator.Validate) {
+	RegisterDurationTypeFunc(v)
 	v.RegisterCustomTypeFunc(func(field reflect.Value) interface{} {
 		// Check if this field is a struct with a Value() method
 		if field.Kind() == reflect.Struct {
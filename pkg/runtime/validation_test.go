@@ -15,6 +15,7 @@
 package runtime
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -229,3 +230,149 @@ func TestConvertValidatorError(t *testing.T) {
 		assert.Len(t, unwrapped, 2)
 	})
 }
+
+type plainValidator struct{ err error }
+
+func (v plainValidator) Validate() error { return v.err }
+
+type contextValidator struct{ plainValidator }
+
+func (v contextValidator) ValidateContext(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return v.Validate()
+}
+
+func TestValidateContext(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	t.Run("uses ValidateContext when implemented", func(t *testing.T) {
+		v := contextValidator{plainValidator{err: errors.New("invalid")}}
+
+		assert.EqualError(t, ValidateContext(context.Background(), v), "invalid")
+		assert.ErrorIs(t, ValidateContext(cancelled, v), context.Canceled)
+	})
+
+	t.Run("falls back to Validate", func(t *testing.T) {
+		v := plainValidator{err: errors.New("invalid")}
+
+		assert.EqualError(t, ValidateContext(cancelled, v), "invalid")
+	})
+
+	t.Run("values without validation are valid", func(t *testing.T) {
+		assert.NoError(t, ValidateContext(cancelled, "value"))
+	})
+}
+
+func TestCheckValidationContext(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.ErrorIs(t, CheckValidationContext(cancelled, 0), context.Canceled)
+	assert.NoError(t, CheckValidationContext(cancelled, 1))
+	assert.ErrorIs(t, CheckValidationContext(cancelled, ValidationContextCheckInterval), context.Canceled)
+	assert.NoError(t, CheckValidationContext(context.Background(), 0))
+}
+
+func TestDuplicateItem(t *testing.T) {
+	first, second, ok := DuplicateItem([]string{"a", "b", "c", "b", "a"})
+	assert.True(t, ok)
+	assert.Equal(t, 1, first)
+	assert.Equal(t, 3, second)
+
+	_, _, ok = DuplicateItem([]int{1, 2, 3})
+	assert.False(t, ok)
+
+	_, _, ok = DuplicateItem([]int(nil))
+	assert.False(t, ok)
+}
+
+func TestDuplicateItemJSON(t *testing.T) {
+	type point struct {
+		X *int `json:"x,omitempty"`
+		Y *int `json:"y,omitempty"`
+	}
+	one, two := 1, 2
+
+	// Equal values behind different pointers are duplicates.
+	first, second, ok := DuplicateItemJSON([]point{{X: &one}, {X: &two}, {X: new(int)}, {X: &[]int{2}[0]}})
+	assert.True(t, ok)
+	assert.Equal(t, 1, first)
+	assert.Equal(t, 3, second)
+
+	_, _, ok = DuplicateItemJSON([]point{{X: &one}, {Y: &one}, {}})
+	assert.False(t, ok)
+
+	_, _, ok = DuplicateItemJSON([]map[string]any{{"a": 1, "b": 2}, {"b": 2, "a": 1}})
+	assert.True(t, ok)
+}
+
+func TestIsZero(t *testing.T) {
+	t.Run("nil values", func(t *testing.T) {
+		type tags []string
+		var v any
+		assert.True(t, IsZero(v))
+		assert.True(t, IsZero((*string)(nil)))
+		assert.True(t, IsZero(tags(nil)))
+		assert.True(t, IsZero(map[string]int(nil)))
+	})
+
+	t.Run("non-nil values", func(t *testing.T) {
+		assert.False(t, IsZero(Ptr("")))
+		assert.False(t, IsZero([]string{}))
+		assert.False(t, IsZero(map[string]int{}))
+	})
+
+	t.Run("zero values are present", func(t *testing.T) {
+		assert.False(t, IsZero(""))
+		assert.False(t, IsZero(0))
+		assert.False(t, IsZero(false))
+		assert.False(t, IsZero(struct{ Tags []string }{}))
+	})
+}
+
+func TestIsMultipleOf(t *testing.T) {
+	t.Run("integers", func(t *testing.T) {
+		assert.True(t, IsMultipleOf(15, 5))
+		assert.True(t, IsMultipleOf(int32(-10), 5))
+		assert.True(t, IsMultipleOf(uint8(0), 5))
+		assert.False(t, IsMultipleOf(int64(12), 5))
+		assert.True(t, IsMultipleOf(3, 0.5))
+	})
+
+	t.Run("floats", func(t *testing.T) {
+		assert.True(t, IsMultipleOf(0.3, 0.1))
+		assert.True(t, IsMultipleOf(19.99, 0.01))
+		assert.True(t, IsMultipleOf(2.5, 0.5))
+		assert.False(t, IsMultipleOf(2.25, 0.5))
+		assert.False(t, IsMultipleOf(0.015, 0.01))
+	})
+
+	t.Run("large values", func(t *testing.T) {
+		assert.True(t, IsMultipleOf(98765432.1, 0.1))
+		assert.True(t, IsMultipleOf(123456789012.34, 0.01))
+		assert.True(t, IsMultipleOf(int64(9007199254740990), 3))
+		assert.False(t, IsMultipleOf(9876543.25, 0.1))
+		assert.False(t, IsMultipleOf(int64(10000007), 2))
+	})
+
+	t.Run("tiny multipleOf", func(t *testing.T) {
+		assert.True(t, IsMultipleOf(3e-12, 1e-12))
+		assert.True(t, IsMultipleOf(0.5, 1e-12))
+		assert.False(t, IsMultipleOf(1.5e-12, 1e-12))
+		assert.False(t, IsMultipleOf(2.5e-12, 1e-12))
+	})
+
+	t.Run("float32 by decimal representation", func(t *testing.T) {
+		type price float32
+		assert.True(t, IsMultipleOf(float32(0.07), 0.01))
+		assert.True(t, IsMultipleOf(price(19.99), 0.01))
+		assert.False(t, IsMultipleOf(float32(0.075), 0.01))
+	})
+
+	t.Run("non-positive multipleOf", func(t *testing.T) {
+		assert.True(t, IsMultipleOf(7, 0))
+	})
+}